@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE maker.pot_drip
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX pot_drip_header_index
+    ON maker.pot_drip (header_id);
+
+
+-- +goose Down
+DROP INDEX maker.pot_drip_header_index;
+
+DROP TABLE maker.pot_drip;
@@ -0,0 +1,102 @@
+-- +goose Up
+CREATE TABLE maker.pot_user_pie
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    msg_sender   TEXT,
+    pie          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, msg_sender, pie)
+);
+
+CREATE INDEX pot_user_pie_block_number_index
+    ON maker.pot_user_pie (block_number);
+
+CREATE TABLE maker.pot_pie
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    pie          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, pie)
+);
+
+CREATE INDEX pot_pie_block_number_index
+    ON maker.pot_pie (block_number);
+
+CREATE TABLE maker.pot_dsr
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    dsr          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, dsr)
+);
+
+CREATE INDEX pot_dsr_block_number_index
+    ON maker.pot_dsr (block_number);
+
+CREATE TABLE maker.pot_chi
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    chi          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, chi)
+);
+
+CREATE INDEX pot_chi_block_number_index
+    ON maker.pot_chi (block_number);
+
+CREATE TABLE maker.pot_vow
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    vow          TEXT,
+    UNIQUE (block_number, block_hash, vow)
+);
+
+CREATE INDEX pot_vow_block_number_index
+    ON maker.pot_vow (block_number);
+
+CREATE TABLE maker.pot_rho
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    rho          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, rho)
+);
+
+CREATE INDEX pot_rho_block_number_index
+    ON maker.pot_rho (block_number);
+
+CREATE TABLE maker.pot_live
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    live         NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, live)
+);
+
+CREATE INDEX pot_live_block_number_index
+    ON maker.pot_live (block_number);
+
+-- +goose Down
+DROP INDEX maker.pot_user_pie_block_number_index;
+DROP INDEX maker.pot_pie_block_number_index;
+DROP INDEX maker.pot_dsr_block_number_index;
+DROP INDEX maker.pot_chi_block_number_index;
+DROP INDEX maker.pot_vow_block_number_index;
+DROP INDEX maker.pot_rho_block_number_index;
+DROP INDEX maker.pot_live_block_number_index;
+
+DROP TABLE maker.pot_user_pie;
+DROP TABLE maker.pot_pie;
+DROP TABLE maker.pot_dsr;
+DROP TABLE maker.pot_chi;
+DROP TABLE maker.pot_vow;
+DROP TABLE maker.pot_rho;
+DROP TABLE maker.pot_live;
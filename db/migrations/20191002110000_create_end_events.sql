@@ -0,0 +1,136 @@
+-- +goose Up
+CREATE TABLE maker.end_cage
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_cage_header_index
+    ON maker.end_cage (header_id);
+
+CREATE TABLE maker.end_cage_ilk
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    ilk_id    INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_cage_ilk_header_index
+    ON maker.end_cage_ilk (header_id);
+
+CREATE INDEX end_cage_ilk_ilk_index
+    ON maker.end_cage_ilk (ilk_id);
+
+CREATE TABLE maker.end_skim
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    urn_id    INTEGER NOT NULL REFERENCES maker.urns (id) ON DELETE CASCADE,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_skim_header_index
+    ON maker.end_skim (header_id);
+
+CREATE INDEX end_skim_urn_index
+    ON maker.end_skim (urn_id);
+
+CREATE TABLE maker.end_free
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    urn_id    INTEGER NOT NULL REFERENCES maker.urns (id) ON DELETE CASCADE,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_free_header_index
+    ON maker.end_free (header_id);
+
+CREATE INDEX end_free_urn_index
+    ON maker.end_free (urn_id);
+
+CREATE TABLE maker.end_thaw
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_thaw_header_index
+    ON maker.end_thaw (header_id);
+
+CREATE TABLE maker.end_flow
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    ilk_id    INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_flow_header_index
+    ON maker.end_flow (header_id);
+
+CREATE INDEX end_flow_ilk_index
+    ON maker.end_flow (ilk_id);
+
+CREATE TABLE maker.end_pack
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_pack_header_index
+    ON maker.end_pack (header_id);
+
+CREATE TABLE maker.end_cash
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    ilk_id     INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX end_cash_header_index
+    ON maker.end_cash (header_id);
+
+CREATE INDEX end_cash_ilk_index
+    ON maker.end_cash (ilk_id);
+
+-- +goose Down
+DROP INDEX maker.end_cage_header_index;
+DROP INDEX maker.end_cage_ilk_header_index;
+DROP INDEX maker.end_cage_ilk_ilk_index;
+DROP INDEX maker.end_skim_header_index;
+DROP INDEX maker.end_skim_urn_index;
+DROP INDEX maker.end_free_header_index;
+DROP INDEX maker.end_free_urn_index;
+DROP INDEX maker.end_thaw_header_index;
+DROP INDEX maker.end_flow_header_index;
+DROP INDEX maker.end_flow_ilk_index;
+DROP INDEX maker.end_pack_header_index;
+DROP INDEX maker.end_cash_header_index;
+DROP INDEX maker.end_cash_ilk_index;
+
+DROP TABLE maker.end_cage;
+DROP TABLE maker.end_cage_ilk;
+DROP TABLE maker.end_skim;
+DROP TABLE maker.end_free;
+DROP TABLE maker.end_thaw;
+DROP TABLE maker.end_flow;
+DROP TABLE maker.end_pack;
+DROP TABLE maker.end_cash;
@@ -0,0 +1,170 @@
+-- +goose Up
+CREATE TABLE maker.end_live
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    live         NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, live)
+);
+
+CREATE INDEX end_live_block_number_index
+    ON maker.end_live (block_number);
+
+CREATE TABLE maker.end_when
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    "when"       NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, "when")
+);
+
+CREATE INDEX end_when_block_number_index
+    ON maker.end_when (block_number);
+
+CREATE TABLE maker.end_wait
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    wait         NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, wait)
+);
+
+CREATE INDEX end_wait_block_number_index
+    ON maker.end_wait (block_number);
+
+CREATE TABLE maker.end_debt
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    debt         NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, debt)
+);
+
+CREATE INDEX end_debt_block_number_index
+    ON maker.end_debt (block_number);
+
+CREATE TABLE maker.end_ilk_tag
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    ilk_id       INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    tag          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, ilk_id, tag)
+);
+
+CREATE INDEX end_ilk_tag_block_number_index
+    ON maker.end_ilk_tag (block_number);
+
+CREATE INDEX end_ilk_tag_ilk_index
+    ON maker.end_ilk_tag (ilk_id);
+
+CREATE TABLE maker.end_ilk_gap
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    ilk_id       INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    gap          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, ilk_id, gap)
+);
+
+CREATE INDEX end_ilk_gap_block_number_index
+    ON maker.end_ilk_gap (block_number);
+
+CREATE INDEX end_ilk_gap_ilk_index
+    ON maker.end_ilk_gap (ilk_id);
+
+CREATE TABLE maker.end_ilk_art
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    ilk_id       INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    art          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, ilk_id, art)
+);
+
+CREATE INDEX end_ilk_art_block_number_index
+    ON maker.end_ilk_art (block_number);
+
+CREATE INDEX end_ilk_art_ilk_index
+    ON maker.end_ilk_art (ilk_id);
+
+CREATE TABLE maker.end_ilk_fix
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    ilk_id       INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    fix          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, ilk_id, fix)
+);
+
+CREATE INDEX end_ilk_fix_block_number_index
+    ON maker.end_ilk_fix (block_number);
+
+CREATE INDEX end_ilk_fix_ilk_index
+    ON maker.end_ilk_fix (ilk_id);
+
+CREATE TABLE maker.end_bag
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    msg_sender   TEXT,
+    bag          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, msg_sender, bag)
+);
+
+CREATE INDEX end_bag_block_number_index
+    ON maker.end_bag (block_number);
+
+CREATE TABLE maker.end_out
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    ilk_id       INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    msg_sender   TEXT,
+    out          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, ilk_id, msg_sender, out)
+);
+
+CREATE INDEX end_out_block_number_index
+    ON maker.end_out (block_number);
+
+CREATE INDEX end_out_ilk_index
+    ON maker.end_out (ilk_id);
+
+-- +goose Down
+DROP INDEX maker.end_live_block_number_index;
+DROP INDEX maker.end_when_block_number_index;
+DROP INDEX maker.end_wait_block_number_index;
+DROP INDEX maker.end_debt_block_number_index;
+DROP INDEX maker.end_ilk_tag_block_number_index;
+DROP INDEX maker.end_ilk_tag_ilk_index;
+DROP INDEX maker.end_ilk_gap_block_number_index;
+DROP INDEX maker.end_ilk_gap_ilk_index;
+DROP INDEX maker.end_ilk_art_block_number_index;
+DROP INDEX maker.end_ilk_art_ilk_index;
+DROP INDEX maker.end_ilk_fix_block_number_index;
+DROP INDEX maker.end_ilk_fix_ilk_index;
+DROP INDEX maker.end_bag_block_number_index;
+DROP INDEX maker.end_out_block_number_index;
+DROP INDEX maker.end_out_ilk_index;
+
+DROP TABLE maker.end_live;
+DROP TABLE maker.end_when;
+DROP TABLE maker.end_wait;
+DROP TABLE maker.end_debt;
+DROP TABLE maker.end_ilk_tag;
+DROP TABLE maker.end_ilk_gap;
+DROP TABLE maker.end_ilk_art;
+DROP TABLE maker.end_ilk_fix;
+DROP TABLE maker.end_bag;
+DROP TABLE maker.end_out;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE maker.log_median_price
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    ilk_id     INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    val        NUMERIC,
+    age        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX log_median_price_header_index
+    ON maker.log_median_price (header_id);
+
+CREATE INDEX log_median_price_address_index
+    ON maker.log_median_price (address_id);
+
+CREATE INDEX log_median_price_ilk_index
+    ON maker.log_median_price (ilk_id);
+
+-- +goose Down
+DROP INDEX maker.log_median_price_header_index;
+DROP INDEX maker.log_median_price_address_index;
+DROP INDEX maker.log_median_price_ilk_index;
+
+DROP TABLE maker.log_median_price;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.median_price AS (
+    ilk_identifier TEXT,
+    val NUMERIC,
+    age NUMERIC,
+    block_height BIGINT,
+    log_id BIGINT
+    );
+
+COMMENT ON COLUMN api.median_price.block_height
+    IS E'@omit';
+COMMENT ON COLUMN api.median_price.log_id
+    IS E'@omit';
+
+-- Raw oracle prices reported by an ilk's median contract, before the liquidation ratio is applied in spot_poke
+CREATE FUNCTION api.all_median_prices(ilk_identifier TEXT, beginTime NUMERIC DEFAULT 0,
+                                      endTime NUMERIC DEFAULT api.max_timestamp(),
+                                      max_results INTEGER DEFAULT NULL, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.median_price AS
+$body$
+SELECT ilks.identifier, val, age, block_number AS block_height, log_id
+FROM maker.log_median_price
+         LEFT JOIN maker.ilks ON log_median_price.ilk_id = ilks.id
+         LEFT JOIN public.headers ON log_median_price.header_id = headers.id
+WHERE ilks.identifier = all_median_prices.ilk_identifier
+  AND block_timestamp BETWEEN beginTime AND endTime
+ORDER BY block_height DESC
+LIMIT all_median_prices.max_results OFFSET all_median_prices.result_offset
+$body$
+    LANGUAGE sql
+    STABLE;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP FUNCTION api.all_median_prices(TEXT, NUMERIC, NUMERIC, INTEGER, INTEGER);
+DROP TYPE api.median_price CASCADE;
@@ -0,0 +1,136 @@
+-- +goose Up
+CREATE TABLE maker.osm_stopped
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    stopped      NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, stopped)
+);
+
+CREATE INDEX osm_stopped_block_number_index
+    ON maker.osm_stopped (block_number);
+
+CREATE INDEX osm_stopped_address_index
+    ON maker.osm_stopped (address_id);
+
+CREATE TABLE maker.osm_hop
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    hop          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, hop)
+);
+
+CREATE INDEX osm_hop_block_number_index
+    ON maker.osm_hop (block_number);
+
+CREATE INDEX osm_hop_address_index
+    ON maker.osm_hop (address_id);
+
+CREATE TABLE maker.osm_zzz
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    zzz          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, zzz)
+);
+
+CREATE INDEX osm_zzz_block_number_index
+    ON maker.osm_zzz (block_number);
+
+CREATE INDEX osm_zzz_address_index
+    ON maker.osm_zzz (address_id);
+
+CREATE TABLE maker.osm_cur_val
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    val          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, val)
+);
+
+CREATE INDEX osm_cur_val_block_number_index
+    ON maker.osm_cur_val (block_number);
+
+CREATE INDEX osm_cur_val_address_index
+    ON maker.osm_cur_val (address_id);
+
+CREATE TABLE maker.osm_cur_has
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    has          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, has)
+);
+
+CREATE INDEX osm_cur_has_block_number_index
+    ON maker.osm_cur_has (block_number);
+
+CREATE INDEX osm_cur_has_address_index
+    ON maker.osm_cur_has (address_id);
+
+CREATE TABLE maker.osm_nxt_val
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    val          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, val)
+);
+
+CREATE INDEX osm_nxt_val_block_number_index
+    ON maker.osm_nxt_val (block_number);
+
+CREATE INDEX osm_nxt_val_address_index
+    ON maker.osm_nxt_val (address_id);
+
+CREATE TABLE maker.osm_nxt_has
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    has          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, has)
+);
+
+CREATE INDEX osm_nxt_has_block_number_index
+    ON maker.osm_nxt_has (block_number);
+
+CREATE INDEX osm_nxt_has_address_index
+    ON maker.osm_nxt_has (address_id);
+
+-- +goose Down
+DROP INDEX maker.osm_stopped_block_number_index;
+DROP INDEX maker.osm_stopped_address_index;
+DROP INDEX maker.osm_hop_block_number_index;
+DROP INDEX maker.osm_hop_address_index;
+DROP INDEX maker.osm_zzz_block_number_index;
+DROP INDEX maker.osm_zzz_address_index;
+DROP INDEX maker.osm_cur_val_block_number_index;
+DROP INDEX maker.osm_cur_val_address_index;
+DROP INDEX maker.osm_cur_has_block_number_index;
+DROP INDEX maker.osm_cur_has_address_index;
+DROP INDEX maker.osm_nxt_val_block_number_index;
+DROP INDEX maker.osm_nxt_val_address_index;
+DROP INDEX maker.osm_nxt_has_block_number_index;
+DROP INDEX maker.osm_nxt_has_address_index;
+
+DROP TABLE maker.osm_stopped;
+DROP TABLE maker.osm_hop;
+DROP TABLE maker.osm_zzz;
+DROP TABLE maker.osm_cur_val;
+DROP TABLE maker.osm_cur_has;
+DROP TABLE maker.osm_nxt_val;
+DROP TABLE maker.osm_nxt_has;
@@ -0,0 +1,113 @@
+-- +goose Up
+CREATE TABLE maker.gem_join
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    ilk_id     INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    usr        TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX gem_join_header_index
+    ON maker.gem_join (header_id);
+
+CREATE INDEX gem_join_address_index
+    ON maker.gem_join (address_id);
+
+CREATE INDEX gem_join_msg_sender_index
+    ON maker.gem_join (msg_sender);
+
+CREATE INDEX gem_join_ilk_index
+    ON maker.gem_join (ilk_id);
+
+CREATE TABLE maker.gem_exit
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    ilk_id     INTEGER NOT NULL REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    usr        TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX gem_exit_header_index
+    ON maker.gem_exit (header_id);
+
+CREATE INDEX gem_exit_address_index
+    ON maker.gem_exit (address_id);
+
+CREATE INDEX gem_exit_msg_sender_index
+    ON maker.gem_exit (msg_sender);
+
+CREATE INDEX gem_exit_ilk_index
+    ON maker.gem_exit (ilk_id);
+
+CREATE TABLE maker.dai_join
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    usr        TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX dai_join_header_index
+    ON maker.dai_join (header_id);
+
+CREATE INDEX dai_join_address_index
+    ON maker.dai_join (address_id);
+
+CREATE INDEX dai_join_msg_sender_index
+    ON maker.dai_join (msg_sender);
+
+CREATE TABLE maker.dai_exit
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    usr        TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX dai_exit_header_index
+    ON maker.dai_exit (header_id);
+
+CREATE INDEX dai_exit_address_index
+    ON maker.dai_exit (address_id);
+
+CREATE INDEX dai_exit_msg_sender_index
+    ON maker.dai_exit (msg_sender);
+
+-- +goose Down
+DROP INDEX maker.gem_join_header_index;
+DROP INDEX maker.gem_join_address_index;
+DROP INDEX maker.gem_join_msg_sender_index;
+DROP INDEX maker.gem_join_ilk_index;
+DROP INDEX maker.gem_exit_header_index;
+DROP INDEX maker.gem_exit_address_index;
+DROP INDEX maker.gem_exit_msg_sender_index;
+DROP INDEX maker.gem_exit_ilk_index;
+DROP INDEX maker.dai_join_header_index;
+DROP INDEX maker.dai_join_address_index;
+DROP INDEX maker.dai_join_msg_sender_index;
+DROP INDEX maker.dai_exit_header_index;
+DROP INDEX maker.dai_exit_address_index;
+DROP INDEX maker.dai_exit_msg_sender_index;
+
+DROP TABLE maker.gem_join;
+DROP TABLE maker.gem_exit;
+DROP TABLE maker.dai_join;
+DROP TABLE maker.dai_exit;
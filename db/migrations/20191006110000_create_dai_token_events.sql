@@ -0,0 +1,51 @@
+-- +goose Up
+CREATE TABLE maker.dai_transfer
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    src       TEXT,
+    dst       TEXT,
+    wad       NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX dai_transfer_header_index
+    ON maker.dai_transfer (header_id);
+
+CREATE INDEX dai_transfer_src_index
+    ON maker.dai_transfer (src);
+
+CREATE INDEX dai_transfer_dst_index
+    ON maker.dai_transfer (dst);
+
+CREATE TABLE maker.dai_approval
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    src       TEXT,
+    guy       TEXT,
+    wad       NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX dai_approval_header_index
+    ON maker.dai_approval (header_id);
+
+CREATE INDEX dai_approval_src_index
+    ON maker.dai_approval (src);
+
+CREATE INDEX dai_approval_guy_index
+    ON maker.dai_approval (guy);
+
+-- +goose Down
+DROP INDEX maker.dai_transfer_header_index;
+DROP INDEX maker.dai_transfer_src_index;
+DROP INDEX maker.dai_transfer_dst_index;
+DROP INDEX maker.dai_approval_header_index;
+DROP INDEX maker.dai_approval_src_index;
+DROP INDEX maker.dai_approval_guy_index;
+
+DROP TABLE maker.dai_transfer;
+DROP TABLE maker.dai_approval;
@@ -0,0 +1,63 @@
+-- +goose Up
+CREATE TABLE maker.dai_total_supply
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    total_supply NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, total_supply)
+);
+
+CREATE INDEX dai_total_supply_block_number_index
+    ON maker.dai_total_supply (block_number);
+
+CREATE TABLE maker.dai_balance_of
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    guy          TEXT,
+    balance      NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, guy, balance)
+);
+
+CREATE INDEX dai_balance_of_block_number_index
+    ON maker.dai_balance_of (block_number);
+
+CREATE TABLE maker.dai_allowance
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    owner        TEXT,
+    spender      TEXT,
+    allowance    NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, owner, spender, allowance)
+);
+
+CREATE INDEX dai_allowance_block_number_index
+    ON maker.dai_allowance (block_number);
+
+CREATE TABLE maker.dai_nonces
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    guy          TEXT,
+    nonce        NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, guy, nonce)
+);
+
+CREATE INDEX dai_nonces_block_number_index
+    ON maker.dai_nonces (block_number);
+
+-- +goose Down
+DROP INDEX maker.dai_total_supply_block_number_index;
+DROP INDEX maker.dai_balance_of_block_number_index;
+DROP INDEX maker.dai_allowance_block_number_index;
+DROP INDEX maker.dai_nonces_block_number_index;
+
+DROP TABLE maker.dai_total_supply;
+DROP TABLE maker.dai_balance_of;
+DROP TABLE maker.dai_allowance;
+DROP TABLE maker.dai_nonces;
@@ -0,0 +1,39 @@
+-- +goose Up
+CREATE TABLE maker.vat_hope
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    usr       TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX vat_hope_header_index
+    ON maker.vat_hope (header_id);
+
+CREATE INDEX vat_hope_usr_index
+    ON maker.vat_hope (usr);
+
+CREATE TABLE maker.vat_nope
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    usr       TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX vat_nope_header_index
+    ON maker.vat_nope (header_id);
+
+CREATE INDEX vat_nope_usr_index
+    ON maker.vat_nope (usr);
+
+-- +goose Down
+DROP INDEX maker.vat_hope_header_index;
+DROP INDEX maker.vat_hope_usr_index;
+DROP INDEX maker.vat_nope_header_index;
+DROP INDEX maker.vat_nope_usr_index;
+
+DROP TABLE maker.vat_hope;
+DROP TABLE maker.vat_nope;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE maker.vat_can
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    bit          TEXT,
+    usr          TEXT,
+    can          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, bit, usr, can)
+);
+
+CREATE INDEX vat_can_block_number_index
+    ON maker.vat_can (block_number);
+
+CREATE INDEX vat_can_bit_index
+    ON maker.vat_can (bit);
+
+-- +goose Down
+DROP INDEX maker.vat_can_block_number_index;
+DROP INDEX maker.vat_can_bit_index;
+
+DROP TABLE maker.vat_can;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.urn_permission AS (
+    urn_identifier TEXT,
+    usr TEXT,
+    block_height BIGINT
+    );
+
+CREATE FUNCTION api.urn_permissions(urn_identifier TEXT, block_height BIGINT DEFAULT api.max_block())
+    RETURNS SETOF api.urn_permission AS
+$body$
+WITH latest_permissions AS (
+    SELECT DISTINCT ON (usr) usr, can, block_number
+    FROM maker.vat_can
+    WHERE bit = urn_permissions.urn_identifier
+      AND block_number <= urn_permissions.block_height
+    ORDER BY usr, block_number DESC
+)
+SELECT urn_permissions.urn_identifier, usr, block_number AS block_height
+FROM latest_permissions
+WHERE can = 1
+ORDER BY block_height DESC
+$body$
+    LANGUAGE sql
+    STABLE
+    STRICT;
+
+COMMENT ON FUNCTION api.urn_permissions(TEXT, BIGINT)
+    IS E'Addresses allowed to manage an urn via vat.hope as of the given block, excluding any since revoked with vat.nope. Vat notes don''t record who called hope, so the urn is inferred from the transaction: its sender, proxies owned by the sender, or the UrnHandler of a cdp opened in it. Permissions granted by any other caller are not listed.';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP FUNCTION api.urn_permissions(TEXT, BIGINT);
+DROP TYPE api.urn_permission CASCADE;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE maker.auth
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    usr        TEXT,
+    action     TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX auth_header_index
+    ON maker.auth (header_id);
+
+CREATE INDEX auth_address_index
+    ON maker.auth (address_id);
+
+CREATE INDEX auth_usr_index
+    ON maker.auth (usr);
+
+-- +goose Down
+DROP INDEX maker.auth_header_index;
+DROP INDEX maker.auth_address_index;
+DROP INDEX maker.auth_usr_index;
+
+DROP TABLE maker.auth;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE maker.wards
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    address_id   INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    usr          TEXT,
+    wards        NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, address_id, usr, wards)
+);
+
+CREATE INDEX wards_block_number_index
+    ON maker.wards (block_number);
+
+CREATE INDEX wards_address_index
+    ON maker.wards (address_id);
+
+-- +goose Down
+DROP INDEX maker.wards_block_number_index;
+DROP INDEX maker.wards_address_index;
+
+DROP TABLE maker.wards;
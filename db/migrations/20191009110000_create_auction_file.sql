@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE maker.auction_file
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    what       TEXT,
+    data       NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX auction_file_header_index
+    ON maker.auction_file (header_id);
+
+CREATE INDEX auction_file_address_index
+    ON maker.auction_file (address_id);
+
+-- +goose Down
+DROP INDEX maker.auction_file_header_index;
+DROP INDEX maker.auction_file_address_index;
+
+DROP TABLE maker.auction_file;
@@ -0,0 +1,33 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.auction_file_event AS (
+    contract_address TEXT,
+    what TEXT,
+    data NUMERIC,
+    block_height BIGINT,
+    log_id BIGINT
+    );
+
+COMMENT ON COLUMN api.auction_file_event.log_id
+    IS E'@omit';
+
+-- beg, ttl and tau changes on every flipper, the flapper and the flopper, newest first
+CREATE FUNCTION api.all_auction_file_events(max_results INTEGER DEFAULT -1, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.auction_file_event AS
+$$
+SELECT addresses.address AS contract_address, what, data, block_number AS block_height, log_id
+FROM maker.auction_file
+         LEFT JOIN public.addresses ON auction_file.address_id = addresses.id
+         LEFT JOIN public.headers ON auction_file.header_id = headers.id
+ORDER BY block_height DESC, log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_auction_file_events.result_offset
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.all_auction_file_events(INTEGER, INTEGER);
+DROP TYPE api.auction_file_event CASCADE;
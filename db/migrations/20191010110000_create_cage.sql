@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE maker.cage
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    module     TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cage_header_index
+    ON maker.cage (header_id);
+
+CREATE INDEX cage_address_index
+    ON maker.cage (address_id);
+
+CREATE INDEX cage_module_index
+    ON maker.cage (module);
+
+-- +goose Down
+DROP INDEX maker.cage_header_index;
+DROP INDEX maker.cage_address_index;
+DROP INDEX maker.cage_module_index;
+
+DROP TABLE maker.cage;
@@ -0,0 +1,85 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.system_status AS (
+    block_height BIGINT,
+    vat_live BOOLEAN,
+    cat_live BOOLEAN,
+    vow_live BOOLEAN,
+    flap_live BOOLEAN,
+    flop_live BOOLEAN,
+    spot_live BOOLEAN,
+    pot_live BOOLEAN,
+    flip_bids_yanked BIGINT
+    );
+
+-- A module counts as shut down once a cage event or a zeroed live storage value is seen at or before the block.
+-- flip_bids_yanked counts flip auctions closed early with yank (as End does when skipping auctions during shutdown).
+CREATE FUNCTION api.system_live_status(block_height BIGINT DEFAULT api.max_block())
+    RETURNS api.system_status AS
+$body$
+WITH caged_modules AS (
+    SELECT DISTINCT module
+    FROM maker.cage
+             LEFT JOIN public.headers ON cage.header_id = headers.id
+    WHERE headers.block_number <= system_live_status.block_height
+),
+     latest_vat_live AS (
+         SELECT live FROM maker.vat_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_cat_live AS (
+         SELECT live FROM maker.cat_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_flap_live AS (
+         SELECT live FROM maker.flap_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_flop_live AS (
+         SELECT live FROM maker.flop_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_pot_live AS (
+         SELECT live FROM maker.pot_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     flip_yanks AS (
+         SELECT COUNT(*) AS yanked
+         FROM maker.yank
+                  LEFT JOIN public.headers ON yank.header_id = headers.id
+         WHERE headers.block_number <= system_live_status.block_height
+           AND yank.address_id IN (SELECT DISTINCT address_id FROM maker.flip_kick)
+     )
+SELECT system_live_status.block_height,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'vat') AND
+       COALESCE((SELECT live FROM latest_vat_live), 1) <> 0 AS vat_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'cat') AND
+       COALESCE((SELECT live FROM latest_cat_live), 1) <> 0 AS cat_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'vow') AS vow_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'flap') AND
+       COALESCE((SELECT live FROM latest_flap_live), 1) <> 0 AS flap_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'flop') AND
+       COALESCE((SELECT live FROM latest_flop_live), 1) <> 0 AS flop_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'spot') AS spot_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'pot') AND
+       COALESCE((SELECT live FROM latest_pot_live), 1) <> 0 AS pot_live,
+       (SELECT yanked FROM flip_yanks) AS flip_bids_yanked
+$body$
+    LANGUAGE sql
+    STABLE
+    STRICT;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.system_live_status(BIGINT);
+DROP TYPE api.system_status CASCADE;
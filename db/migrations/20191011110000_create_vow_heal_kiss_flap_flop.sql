@@ -0,0 +1,59 @@
+-- +goose Up
+CREATE TABLE maker.vow_heal
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    rad       NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX vow_heal_header_index
+    ON maker.vow_heal (header_id);
+
+CREATE TABLE maker.vow_kiss
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    rad       NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX vow_kiss_header_index
+    ON maker.vow_kiss (header_id);
+
+CREATE TABLE maker.vow_flap
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX vow_flap_header_index
+    ON maker.vow_flap (header_id);
+
+CREATE TABLE maker.vow_flop
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX vow_flop_header_index
+    ON maker.vow_flop (header_id);
+
+-- +goose Down
+DROP INDEX maker.vow_heal_header_index;
+DROP INDEX maker.vow_kiss_header_index;
+DROP INDEX maker.vow_flap_header_index;
+DROP INDEX maker.vow_flop_header_index;
+
+DROP TABLE maker.vow_heal;
+DROP TABLE maker.vow_kiss;
+DROP TABLE maker.vow_flap;
+DROP TABLE maker.vow_flop;
@@ -0,0 +1,99 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.vow_auction_trigger AS (
+    msg_sender TEXT,
+    bid_id NUMERIC,
+    block_height BIGINT,
+    log_id BIGINT
+    );
+
+COMMENT ON COLUMN api.vow_auction_trigger.log_id
+    IS E'@omit';
+
+CREATE TYPE api.vow_debt_settlement AS (
+    act TEXT,
+    rad NUMERIC,
+    block_height BIGINT,
+    log_id BIGINT
+    );
+
+COMMENT ON COLUMN api.vow_debt_settlement.log_id
+    IS E'@omit';
+
+-- vow.flap() calls with the flap auction kicked in the same transaction, newest first
+CREATE FUNCTION api.all_vow_flap_events(max_results INTEGER DEFAULT -1, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.vow_auction_trigger AS
+$$
+SELECT vow_flap.msg_sender, kicked.bid_id, headers.block_number AS block_height, vow_flap.log_id
+FROM maker.vow_flap
+         LEFT JOIN public.headers ON vow_flap.header_id = headers.id
+         LEFT JOIN public.header_sync_logs vow_flap_log ON vow_flap.log_id = vow_flap_log.id
+         LEFT JOIN LATERAL (
+    SELECT flap_kick.bid_id
+    FROM maker.flap_kick
+             JOIN public.header_sync_logs flap_kick_log ON flap_kick.log_id = flap_kick_log.id
+    WHERE flap_kick_log.header_id = vow_flap.header_id
+      AND flap_kick_log.tx_hash = vow_flap_log.tx_hash
+    LIMIT 1
+    ) kicked ON TRUE
+ORDER BY block_height DESC, vow_flap.log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_vow_flap_events.result_offset
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- vow.flop() calls with the flop auction kicked in the same transaction, newest first
+CREATE FUNCTION api.all_vow_flop_events(max_results INTEGER DEFAULT -1, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.vow_auction_trigger AS
+$$
+SELECT vow_flop.msg_sender, kicked.bid_id, headers.block_number AS block_height, vow_flop.log_id
+FROM maker.vow_flop
+         LEFT JOIN public.headers ON vow_flop.header_id = headers.id
+         LEFT JOIN public.header_sync_logs vow_flop_log ON vow_flop.log_id = vow_flop_log.id
+         LEFT JOIN LATERAL (
+    SELECT flop_kick.bid_id
+    FROM maker.flop_kick
+             JOIN public.header_sync_logs flop_kick_log ON flop_kick.log_id = flop_kick_log.id
+    WHERE flop_kick_log.header_id = vow_flop.header_id
+      AND flop_kick_log.tx_hash = vow_flop_log.tx_hash
+    LIMIT 1
+    ) kicked ON TRUE
+ORDER BY block_height DESC, vow_flop.log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_vow_flop_events.result_offset
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- heal and kiss calls netting surplus against bad debt, newest first
+CREATE FUNCTION api.all_vow_debt_settlements(max_results INTEGER DEFAULT -1, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.vow_debt_settlement AS
+$$
+WITH settlements AS (
+    SELECT 'heal' AS act, rad, header_id, log_id
+    FROM maker.vow_heal
+    UNION ALL
+    SELECT 'kiss' AS act, rad, header_id, log_id
+    FROM maker.vow_kiss
+)
+SELECT act, rad, headers.block_number AS block_height, log_id
+FROM settlements
+         LEFT JOIN public.headers ON settlements.header_id = headers.id
+ORDER BY block_height DESC, log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_vow_debt_settlements.result_offset
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.all_vow_debt_settlements(INTEGER, INTEGER);
+DROP FUNCTION api.all_vow_flop_events(INTEGER, INTEGER);
+DROP FUNCTION api.all_vow_flap_events(INTEGER, INTEGER);
+DROP TYPE api.vow_debt_settlement CASCADE;
+DROP TYPE api.vow_auction_trigger CASCADE;
@@ -0,0 +1,191 @@
+-- +goose Up
+CREATE TABLE maker.cdp_manager_give
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    cdpi       NUMERIC,
+    dst        TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_give_header_index
+    ON maker.cdp_manager_give (header_id);
+
+CREATE INDEX cdp_manager_give_cdpi_index
+    ON maker.cdp_manager_give (cdpi);
+
+CREATE TABLE maker.cdp_manager_cdp_allow
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    cdpi       NUMERIC,
+    usr        TEXT,
+    ok         NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_cdp_allow_header_index
+    ON maker.cdp_manager_cdp_allow (header_id);
+
+CREATE INDEX cdp_manager_cdp_allow_cdpi_index
+    ON maker.cdp_manager_cdp_allow (cdpi);
+
+CREATE TABLE maker.cdp_manager_urn_allow
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    usr        TEXT,
+    ok         NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_urn_allow_header_index
+    ON maker.cdp_manager_urn_allow (header_id);
+
+CREATE INDEX cdp_manager_urn_allow_msg_sender_index
+    ON maker.cdp_manager_urn_allow (msg_sender);
+
+CREATE TABLE maker.cdp_manager_frob
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    cdpi       NUMERIC,
+    dink       NUMERIC,
+    dart       NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_frob_header_index
+    ON maker.cdp_manager_frob (header_id);
+
+CREATE INDEX cdp_manager_frob_cdpi_index
+    ON maker.cdp_manager_frob (cdpi);
+
+CREATE TABLE maker.cdp_manager_flux
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    cdpi       NUMERIC,
+    dst        TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_flux_header_index
+    ON maker.cdp_manager_flux (header_id);
+
+CREATE INDEX cdp_manager_flux_cdpi_index
+    ON maker.cdp_manager_flux (cdpi);
+
+CREATE TABLE maker.cdp_manager_move
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    cdpi       NUMERIC,
+    dst        TEXT,
+    rad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_move_header_index
+    ON maker.cdp_manager_move (header_id);
+
+CREATE INDEX cdp_manager_move_cdpi_index
+    ON maker.cdp_manager_move (cdpi);
+
+CREATE TABLE maker.cdp_manager_quit
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    cdpi       NUMERIC,
+    dst        TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_quit_header_index
+    ON maker.cdp_manager_quit (header_id);
+
+CREATE INDEX cdp_manager_quit_cdpi_index
+    ON maker.cdp_manager_quit (cdpi);
+
+CREATE TABLE maker.cdp_manager_enter
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    src        TEXT,
+    cdpi       NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_enter_header_index
+    ON maker.cdp_manager_enter (header_id);
+
+CREATE INDEX cdp_manager_enter_cdpi_index
+    ON maker.cdp_manager_enter (cdpi);
+
+CREATE TABLE maker.cdp_manager_shift
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    cdp_src    NUMERIC,
+    cdp_dst    NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX cdp_manager_shift_header_index
+    ON maker.cdp_manager_shift (header_id);
+
+CREATE INDEX cdp_manager_shift_cdp_src_index
+    ON maker.cdp_manager_shift (cdp_src);
+
+CREATE INDEX cdp_manager_shift_cdp_dst_index
+    ON maker.cdp_manager_shift (cdp_dst);
+
+-- +goose Down
+DROP INDEX maker.cdp_manager_give_header_index;
+DROP INDEX maker.cdp_manager_give_cdpi_index;
+DROP INDEX maker.cdp_manager_cdp_allow_header_index;
+DROP INDEX maker.cdp_manager_cdp_allow_cdpi_index;
+DROP INDEX maker.cdp_manager_urn_allow_header_index;
+DROP INDEX maker.cdp_manager_urn_allow_msg_sender_index;
+DROP INDEX maker.cdp_manager_frob_header_index;
+DROP INDEX maker.cdp_manager_frob_cdpi_index;
+DROP INDEX maker.cdp_manager_flux_header_index;
+DROP INDEX maker.cdp_manager_flux_cdpi_index;
+DROP INDEX maker.cdp_manager_move_header_index;
+DROP INDEX maker.cdp_manager_move_cdpi_index;
+DROP INDEX maker.cdp_manager_quit_header_index;
+DROP INDEX maker.cdp_manager_quit_cdpi_index;
+DROP INDEX maker.cdp_manager_enter_header_index;
+DROP INDEX maker.cdp_manager_enter_cdpi_index;
+DROP INDEX maker.cdp_manager_shift_header_index;
+DROP INDEX maker.cdp_manager_shift_cdp_src_index;
+DROP INDEX maker.cdp_manager_shift_cdp_dst_index;
+
+DROP TABLE maker.cdp_manager_give;
+DROP TABLE maker.cdp_manager_cdp_allow;
+DROP TABLE maker.cdp_manager_urn_allow;
+DROP TABLE maker.cdp_manager_frob;
+DROP TABLE maker.cdp_manager_flux;
+DROP TABLE maker.cdp_manager_move;
+DROP TABLE maker.cdp_manager_quit;
+DROP TABLE maker.cdp_manager_enter;
+DROP TABLE maker.cdp_manager_shift;
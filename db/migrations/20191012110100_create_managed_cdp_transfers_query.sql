@@ -0,0 +1,47 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.managed_cdp_transfer AS (
+    cdpi NUMERIC,
+    owner TEXT,
+    msg_sender TEXT,
+    block_height BIGINT,
+    transferred TIMESTAMP,
+    log_id BIGINT
+    );
+
+COMMENT ON COLUMN api.managed_cdp_transfer.log_id
+    IS E'@omit';
+
+-- Ownership history of a managed CDP: the owner it was opened for, then each cdp manager give, newest first
+CREATE FUNCTION api.managed_cdp_transfers(cdpi NUMERIC, max_results INTEGER DEFAULT -1, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.managed_cdp_transfer AS
+$$
+WITH transfers AS (
+    SELECT cdp AS cdpi, own AS owner, usr AS msg_sender, header_id, log_id
+    FROM maker.new_cdp
+    WHERE new_cdp.cdp = managed_cdp_transfers.cdpi
+    UNION ALL
+    SELECT cdpi, dst AS owner, msg_sender, header_id, log_id
+    FROM maker.cdp_manager_give
+    WHERE cdp_manager_give.cdpi = managed_cdp_transfers.cdpi
+)
+SELECT transfers.cdpi,
+       transfers.owner,
+       transfers.msg_sender,
+       headers.block_number                           AS block_height,
+       api.epoch_to_datetime(headers.block_timestamp) AS transferred,
+       transfers.log_id
+FROM transfers
+         LEFT JOIN public.headers ON transfers.header_id = headers.id
+ORDER BY block_height DESC, log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+managed_cdp_transfers.result_offset
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.managed_cdp_transfers(NUMERIC, INTEGER, INTEGER);
+DROP TYPE api.managed_cdp_transfer CASCADE;
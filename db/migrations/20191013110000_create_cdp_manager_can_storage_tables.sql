@@ -0,0 +1,43 @@
+-- +goose Up
+CREATE TABLE maker.cdp_manager_cdp_can
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    owner        TEXT    NOT NULL,
+    cdpi         NUMERIC NOT NULL,
+    usr          TEXT    NOT NULL,
+    can          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, owner, cdpi, usr, can)
+);
+
+CREATE INDEX cdp_manager_cdp_can_block_number_index
+    ON maker.cdp_manager_cdp_can (block_number);
+CREATE INDEX cdp_manager_cdp_can_owner_cdpi_index
+    ON maker.cdp_manager_cdp_can (owner, cdpi);
+
+CREATE TABLE maker.cdp_manager_urn_can
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    owner        TEXT    NOT NULL,
+    usr          TEXT    NOT NULL,
+    can          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, owner, usr, can)
+);
+
+CREATE INDEX cdp_manager_urn_can_block_number_index
+    ON maker.cdp_manager_urn_can (block_number);
+CREATE INDEX cdp_manager_urn_can_owner_index
+    ON maker.cdp_manager_urn_can (owner);
+
+
+-- +goose Down
+DROP INDEX maker.cdp_manager_urn_can_owner_index;
+DROP INDEX maker.cdp_manager_urn_can_block_number_index;
+DROP INDEX maker.cdp_manager_cdp_can_owner_cdpi_index;
+DROP INDEX maker.cdp_manager_cdp_can_block_number_index;
+
+DROP TABLE maker.cdp_manager_urn_can;
+DROP TABLE maker.cdp_manager_cdp_can;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Extend managed_cdp with the addresses the current owner has allowed to operate the cdp
+CREATE FUNCTION api.managed_cdp_allowed_operators(cdp api.managed_cdp)
+    RETURNS SETOF TEXT AS
+$$
+WITH latest_permissions AS (
+    SELECT DISTINCT ON (usr) usr, can
+    FROM maker.cdp_manager_cdp_can
+    WHERE cdp_manager_cdp_can.owner = cdp.usr
+      AND cdp_manager_cdp_can.cdpi = cdp.cdpi
+    ORDER BY usr, block_number DESC
+)
+SELECT usr
+FROM latest_permissions
+WHERE can = 1
+ORDER BY usr
+$$
+    LANGUAGE sql
+    STABLE;
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.managed_cdp_allowed_operators(api.managed_cdp);
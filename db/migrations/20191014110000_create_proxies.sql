@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE maker.proxies
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    sender    TEXT,
+    owner     TEXT,
+    proxy     TEXT,
+    cache     TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+COMMENT ON COLUMN maker.proxies.id
+    IS E'@omit';
+
+CREATE INDEX proxies_header_index
+    ON maker.proxies (header_id);
+CREATE INDEX proxies_owner_index
+    ON maker.proxies (owner);
+CREATE INDEX proxies_proxy_index
+    ON maker.proxies (proxy);
+
+
+-- +goose Down
+DROP INDEX maker.proxies_proxy_index;
+DROP INDEX maker.proxies_owner_index;
+DROP INDEX maker.proxies_header_index;
+
+DROP TABLE maker.proxies;
@@ -0,0 +1,45 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Extend managed_cdp with the account that owns the cdp, resolving DSProxy owners to the proxy's owner.
+-- Proxy ownership comes from the registry's Created events only, so a later DSProxy setOwner transfer is not reflected.
+-- Addresses are compared lower cased, since callers and transformers may case the same address differently.
+CREATE FUNCTION api.managed_cdp_owner(cdp api.managed_cdp)
+    RETURNS TEXT AS
+$$
+SELECT COALESCE(
+               (SELECT proxies.owner
+                FROM maker.proxies
+                         LEFT JOIN public.headers ON proxies.header_id = headers.id
+                WHERE lower(proxies.proxy) = lower(cdp.usr)
+                ORDER BY headers.block_number DESC
+                LIMIT 1),
+               cdp.usr)
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- Function returning the managed cdps owned by an account, either directly or through a DSProxy it built.
+-- As with managed_cdp_owner, proxies transferred with setOwner stay with the account that built them.
+CREATE FUNCTION api.get_managed_cdps_by_owner(owner TEXT, max_results INTEGER DEFAULT -1, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.managed_cdp AS
+$$
+SELECT *
+FROM api.managed_cdp
+WHERE lower(managed_cdp.usr) = lower(get_managed_cdps_by_owner.owner)
+   OR lower(managed_cdp.usr) IN (SELECT lower(proxies.proxy)
+                                 FROM maker.proxies
+                                 WHERE lower(proxies.owner) = lower(get_managed_cdps_by_owner.owner))
+ORDER BY managed_cdp.cdpi
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+get_managed_cdps_by_owner.result_offset
+$$
+    LANGUAGE sql
+    STABLE;
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.get_managed_cdps_by_owner(TEXT, INTEGER, INTEGER);
+DROP FUNCTION api.managed_cdp_owner(api.managed_cdp);
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE maker.chief_free
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX chief_free_header_index
+    ON maker.chief_free (header_id);
+
+CREATE INDEX chief_free_msg_sender_index
+    ON maker.chief_free (msg_sender);
+
+
+-- +goose Down
+DROP INDEX maker.chief_free_header_index;
+DROP INDEX maker.chief_free_msg_sender_index;
+
+DROP TABLE maker.chief_free;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE maker.chief_vote
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    slate      TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX chief_vote_header_index
+    ON maker.chief_vote (header_id);
+
+CREATE INDEX chief_vote_msg_sender_index
+    ON maker.chief_vote (msg_sender);
+
+CREATE INDEX chief_vote_slate_index
+    ON maker.chief_vote (slate);
+
+
+-- +goose Down
+DROP INDEX maker.chief_vote_header_index;
+DROP INDEX maker.chief_vote_msg_sender_index;
+DROP INDEX maker.chief_vote_slate_index;
+
+DROP TABLE maker.chief_vote;
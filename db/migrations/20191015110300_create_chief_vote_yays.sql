@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE maker.chief_vote_yays
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    slate      TEXT,
+    yays       TEXT[],
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX chief_vote_yays_header_index
+    ON maker.chief_vote_yays (header_id);
+
+CREATE INDEX chief_vote_yays_msg_sender_index
+    ON maker.chief_vote_yays (msg_sender);
+
+CREATE INDEX chief_vote_yays_slate_index
+    ON maker.chief_vote_yays (slate);
+
+
+-- +goose Down
+DROP INDEX maker.chief_vote_yays_header_index;
+DROP INDEX maker.chief_vote_yays_msg_sender_index;
+DROP INDEX maker.chief_vote_yays_slate_index;
+
+DROP TABLE maker.chief_vote_yays;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE maker.chief_etch
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    slate      TEXT,
+    yays       TEXT[],
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX chief_etch_header_index
+    ON maker.chief_etch (header_id);
+
+CREATE INDEX chief_etch_slate_index
+    ON maker.chief_etch (slate);
+
+
+-- +goose Down
+DROP INDEX maker.chief_etch_header_index;
+DROP INDEX maker.chief_etch_slate_index;
+
+DROP TABLE maker.chief_etch;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE maker.chief_lift
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    whom       TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX chief_lift_header_index
+    ON maker.chief_lift (header_id);
+
+CREATE INDEX chief_lift_whom_index
+    ON maker.chief_lift (whom);
+
+
+-- +goose Down
+DROP INDEX maker.chief_lift_header_index;
+DROP INDEX maker.chief_lift_whom_index;
+
+DROP TABLE maker.chief_lift;
@@ -0,0 +1,63 @@
+-- +goose Up
+CREATE TABLE maker.chief_votes
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    msg_sender   TEXT,
+    slate        TEXT,
+    UNIQUE (block_number, block_hash, msg_sender, slate)
+);
+
+CREATE INDEX chief_votes_block_number_index
+    ON maker.chief_votes (block_number);
+
+CREATE TABLE maker.chief_approvals
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    candidate    TEXT,
+    approvals    NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, candidate, approvals)
+);
+
+CREATE INDEX chief_approvals_block_number_index
+    ON maker.chief_approvals (block_number);
+
+CREATE TABLE maker.chief_deposits
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    msg_sender   TEXT,
+    deposits     NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, msg_sender, deposits)
+);
+
+CREATE INDEX chief_deposits_block_number_index
+    ON maker.chief_deposits (block_number);
+
+CREATE TABLE maker.chief_hat
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    hat          TEXT,
+    UNIQUE (block_number, block_hash, hat)
+);
+
+CREATE INDEX chief_hat_block_number_index
+    ON maker.chief_hat (block_number);
+
+
+-- +goose Down
+DROP INDEX maker.chief_votes_block_number_index;
+DROP INDEX maker.chief_approvals_block_number_index;
+DROP INDEX maker.chief_deposits_block_number_index;
+DROP INDEX maker.chief_hat_block_number_index;
+
+DROP TABLE maker.chief_votes;
+DROP TABLE maker.chief_approvals;
+DROP TABLE maker.chief_deposits;
+DROP TABLE maker.chief_hat;
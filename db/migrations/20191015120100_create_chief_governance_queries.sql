@@ -0,0 +1,76 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+CREATE TYPE api.chief_slate AS (
+    slate   TEXT,
+    yays    TEXT[],
+    support NUMERIC
+    );
+
+-- Function returning the address that held the hat as of the given block
+CREATE FUNCTION api.chief_hat(block_height BIGINT DEFAULT api.max_block())
+    RETURNS TEXT AS
+$$
+SELECT hat
+FROM maker.chief_hat
+WHERE block_number <= chief_hat.block_height
+ORDER BY block_number DESC
+LIMIT 1
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- Function returning the MKR deposited by accounts whose current vote is the given slate
+CREATE FUNCTION api.chief_slate_support(slate TEXT, block_height BIGINT DEFAULT api.max_block())
+    RETURNS NUMERIC AS
+$$
+WITH latest_votes AS (
+    SELECT DISTINCT ON (msg_sender) msg_sender, slate
+    FROM maker.chief_votes
+    WHERE block_number <= chief_slate_support.block_height
+    ORDER BY msg_sender, block_number DESC
+),
+     latest_deposits AS (
+         SELECT DISTINCT ON (msg_sender) msg_sender, deposits
+         FROM maker.chief_deposits
+         WHERE block_number <= chief_slate_support.block_height
+         ORDER BY msg_sender, block_number DESC
+     )
+SELECT COALESCE(SUM(latest_deposits.deposits), 0)
+FROM latest_votes
+         JOIN latest_deposits ON latest_votes.msg_sender = latest_deposits.msg_sender
+WHERE latest_votes.slate = chief_slate_support.slate
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- Function returning every slate etched by the given block with its candidates and supporting MKR
+CREATE FUNCTION api.all_chief_slates(block_height BIGINT DEFAULT api.max_block())
+    RETURNS SETOF api.chief_slate AS
+$$
+WITH slates AS (
+    SELECT DISTINCT ON (slate) slate, yays
+    FROM (SELECT chief_etch.slate, chief_etch.yays
+          FROM maker.chief_etch
+                   LEFT JOIN public.headers ON chief_etch.header_id = headers.id
+          WHERE headers.block_number <= all_chief_slates.block_height
+          UNION
+          SELECT chief_vote_yays.slate, chief_vote_yays.yays
+          FROM maker.chief_vote_yays
+                   LEFT JOIN public.headers ON chief_vote_yays.header_id = headers.id
+          WHERE headers.block_number <= all_chief_slates.block_height) etched
+    ORDER BY slate
+)
+SELECT slates.slate, slates.yays, api.chief_slate_support(slates.slate, all_chief_slates.block_height)
+FROM slates
+$$
+    LANGUAGE sql
+    STABLE;
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.all_chief_slates(BIGINT);
+DROP FUNCTION api.chief_slate_support(TEXT, BIGINT);
+DROP FUNCTION api.chief_hat(BIGINT);
+DROP TYPE api.chief_slate CASCADE;
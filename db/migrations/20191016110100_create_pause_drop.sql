@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE maker.pause_drop
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    usr        TEXT,
+    tag        TEXT,
+    fax        TEXT,
+    eta        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX pause_drop_header_index
+    ON maker.pause_drop (header_id);
+
+CREATE INDEX pause_drop_usr_index
+    ON maker.pause_drop (usr);
+
+
+-- +goose Down
+DROP INDEX maker.pause_drop_header_index;
+DROP INDEX maker.pause_drop_usr_index;
+
+DROP TABLE maker.pause_drop;
@@ -0,0 +1,136 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.spell_exec_event AS (
+    usr TEXT,
+    tag TEXT,
+    fax TEXT,
+    eta NUMERIC,
+    block_height BIGINT,
+    log_id BIGINT
+    -- tx
+    -- file_events
+    );
+
+COMMENT ON COLUMN api.spell_exec_event.block_height
+    IS E'@omit';
+COMMENT ON COLUMN api.spell_exec_event.log_id
+    IS E'@omit';
+
+CREATE TYPE api.spell_file_event AS (
+    source TEXT,
+    ilk_identifier TEXT,
+    what TEXT,
+    data TEXT,
+    block_height BIGINT,
+    log_id BIGINT
+    );
+
+COMMENT ON COLUMN api.spell_file_event.block_height
+    IS E'@omit';
+COMMENT ON COLUMN api.spell_file_event.log_id
+    IS E'@omit';
+
+CREATE FUNCTION api.all_spell_exec_events(max_results INTEGER DEFAULT -1, result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.spell_exec_event AS
+$$
+SELECT usr, tag, fax, eta, block_number, log_id
+FROM maker.pause_exec
+         LEFT JOIN headers ON pause_exec.header_id = headers.id
+ORDER BY block_number DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_spell_exec_events.result_offset
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- Extend spell_exec_event with txs
+CREATE FUNCTION api.spell_exec_event_tx(event api.spell_exec_event)
+    RETURNS api.tx AS
+$$
+SELECT * FROM get_tx_data(event.block_height, event.log_id)
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- Extend spell_exec_event with the file events emitted while the spell executed, i.e. in the same transaction
+CREATE FUNCTION api.spell_exec_event_file_events(event api.spell_exec_event)
+    RETURNS SETOF api.spell_file_event AS
+$$
+SELECT file_events.source, ilks.identifier, file_events.what, file_events.data, headers.block_number, file_events.log_id
+FROM (SELECT 'vat_file_ilk' AS source, ilk_id, what, data :: TEXT AS data, log_id
+      FROM maker.vat_file_ilk
+      UNION ALL
+      SELECT 'vat_file_debt_ceiling', NULL, what, data :: TEXT, log_id
+      FROM maker.vat_file_debt_ceiling
+      UNION ALL
+      SELECT 'jug_file_base', NULL, what, data :: TEXT, log_id
+      FROM maker.jug_file_base
+      UNION ALL
+      SELECT 'jug_file_ilk', ilk_id, what, data :: TEXT, log_id
+      FROM maker.jug_file_ilk
+      UNION ALL
+      SELECT 'jug_file_vow', NULL, what, data, log_id
+      FROM maker.jug_file_vow
+      UNION ALL
+      SELECT 'cat_file_chop_lump', ilk_id, what, data :: TEXT, log_id
+      FROM maker.cat_file_chop_lump
+      UNION ALL
+      SELECT 'cat_file_flip', ilk_id, what, flip, log_id
+      FROM maker.cat_file_flip
+      UNION ALL
+      SELECT 'cat_file_vow', NULL, what, data, log_id
+      FROM maker.cat_file_vow
+      UNION ALL
+      SELECT 'spot_file_mat', ilk_id, what, data :: TEXT, log_id
+      FROM maker.spot_file_mat
+      UNION ALL
+      SELECT 'spot_file_pip', ilk_id, what, pip, log_id
+      FROM maker.spot_file_pip
+      UNION ALL
+      SELECT 'vow_file', NULL, what, data :: TEXT, log_id
+      FROM maker.vow_file
+      UNION ALL
+      SELECT 'pot_file_dsr', NULL, what, data :: TEXT, log_id
+      FROM maker.pot_file_dsr
+      UNION ALL
+      SELECT 'pot_file_vow', NULL, what, data, log_id
+      FROM maker.pot_file_vow
+      UNION ALL
+      SELECT 'auction_file', NULL, what, data :: TEXT, log_id
+      FROM maker.auction_file) file_events
+         JOIN public.header_sync_logs file_logs ON file_events.log_id = file_logs.id
+         JOIN public.header_sync_logs exec_logs ON exec_logs.id = event.log_id
+         LEFT JOIN public.headers ON file_logs.header_id = headers.id
+         LEFT JOIN maker.ilks ON file_events.ilk_id = ilks.id
+WHERE file_logs.header_id = exec_logs.header_id
+  AND file_logs.tx_hash = exec_logs.tx_hash
+ORDER BY file_logs.log_index
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- Extend ilk_file_event with the spell that caused it, if it was made through DSPause
+CREATE FUNCTION api.ilk_file_event_spell(event api.ilk_file_event)
+    RETURNS api.spell_exec_event AS
+$$
+SELECT pause_exec.usr, pause_exec.tag, pause_exec.fax, pause_exec.eta, headers.block_number, pause_exec.log_id
+FROM maker.pause_exec
+         JOIN public.header_sync_logs exec_logs ON pause_exec.log_id = exec_logs.id
+         JOIN public.header_sync_logs file_logs ON file_logs.id = event.log_id
+         LEFT JOIN public.headers ON pause_exec.header_id = headers.id
+WHERE exec_logs.header_id = file_logs.header_id
+  AND exec_logs.tx_hash = file_logs.tx_hash
+LIMIT 1
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.ilk_file_event_spell(api.ilk_file_event);
+DROP FUNCTION api.spell_exec_event_file_events(api.spell_exec_event);
+DROP FUNCTION api.spell_exec_event_tx(api.spell_exec_event);
+DROP FUNCTION api.all_spell_exec_events(INTEGER, INTEGER);
+DROP TYPE api.spell_file_event CASCADE;
+DROP TYPE api.spell_exec_event CASCADE;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE maker.esm_join
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    msg_sender TEXT,
+    wad        NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX esm_join_header_index
+    ON maker.esm_join (header_id);
+
+CREATE INDEX esm_join_msg_sender_index
+    ON maker.esm_join (msg_sender);
+
+
+-- +goose Down
+DROP INDEX maker.esm_join_header_index;
+DROP INDEX maker.esm_join_msg_sender_index;
+
+DROP TABLE maker.esm_join;
@@ -0,0 +1,61 @@
+-- +goose Up
+CREATE TABLE maker.esm_min
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    min          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, min)
+);
+
+CREATE INDEX esm_min_block_number_index
+    ON maker.esm_min (block_number);
+
+CREATE TABLE maker.esm_fired
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    fired        NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, fired)
+);
+
+CREATE INDEX esm_fired_block_number_index
+    ON maker.esm_fired (block_number);
+
+CREATE TABLE maker.esm_user_sum
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    msg_sender   TEXT,
+    sum          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, msg_sender, sum)
+);
+
+CREATE INDEX esm_user_sum_block_number_index
+    ON maker.esm_user_sum (block_number);
+
+CREATE TABLE maker.esm_sum
+(
+    id           SERIAL PRIMARY KEY,
+    block_number BIGINT,
+    block_hash   TEXT,
+    sum          NUMERIC NOT NULL,
+    UNIQUE (block_number, block_hash, sum)
+);
+
+CREATE INDEX esm_sum_block_number_index
+    ON maker.esm_sum (block_number);
+
+
+-- +goose Down
+DROP INDEX maker.esm_min_block_number_index;
+DROP INDEX maker.esm_fired_block_number_index;
+DROP INDEX maker.esm_user_sum_block_number_index;
+DROP INDEX maker.esm_sum_block_number_index;
+
+DROP TABLE maker.esm_min;
+DROP TABLE maker.esm_fired;
+DROP TABLE maker.esm_user_sum;
+DROP TABLE maker.esm_sum;
@@ -0,0 +1,63 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TYPE api.esm_state AS (
+    block_height BIGINT,
+    sum NUMERIC,
+    min NUMERIC,
+    remaining NUMERIC,
+    fired BOOLEAN
+    );
+
+-- remaining is the MKR that still has to be joined before fire can be called.
+-- The ESM counts as fired once a fire event or a non-zero fired storage value is seen at or before the block.
+CREATE FUNCTION api.esm_state(block_height BIGINT DEFAULT api.max_block())
+    RETURNS api.esm_state AS
+$$
+WITH latest_sum AS (
+    SELECT sum FROM maker.esm_sum
+    WHERE block_number <= esm_state.block_height
+    ORDER BY block_number DESC
+    LIMIT 1
+),
+     latest_min AS (
+         SELECT min FROM maker.esm_min
+         WHERE block_number <= esm_state.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_fired AS (
+         SELECT fired FROM maker.esm_fired
+         WHERE block_number <= esm_state.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     fire_events AS (
+         SELECT esm_fire.id
+         FROM maker.esm_fire
+                  LEFT JOIN public.headers ON esm_fire.header_id = headers.id
+         WHERE headers.block_number <= esm_state.block_height
+     )
+SELECT esm_state.block_height,
+       COALESCE((SELECT sum FROM latest_sum), 0) AS sum,
+       (SELECT min FROM latest_min) AS min,
+       GREATEST((SELECT min FROM latest_min) - COALESCE((SELECT sum FROM latest_sum), 0), 0) AS remaining,
+       EXISTS(SELECT 1 FROM fire_events) OR COALESCE((SELECT fired FROM latest_fired), 0) <> 0 AS fired
+$$
+    LANGUAGE sql
+    STABLE
+    STRICT;
+
+-- Extend system_status with the ESM's progress towards shutdown
+CREATE FUNCTION api.system_status_esm(status api.system_status)
+    RETURNS api.esm_state AS
+$$
+SELECT * FROM api.esm_state(status.block_height)
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP FUNCTION api.system_status_esm(api.system_status);
+DROP FUNCTION api.esm_state(BIGINT);
+DROP TYPE api.esm_state CASCADE;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE maker.spot_file_par
+(
+    id        SERIAL PRIMARY KEY,
+    header_id INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id    BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    what      TEXT,
+    data      NUMERIC,
+    UNIQUE (header_id, log_id)
+);
+
+CREATE INDEX spot_file_par_header_index
+    ON maker.spot_file_par (header_id);
+
+
+-- +goose Down
+DROP INDEX maker.spot_file_par_header_index;
+
+DROP TABLE maker.spot_file_par;
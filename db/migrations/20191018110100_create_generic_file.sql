@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE maker.generic_file
+(
+    id         SERIAL PRIMARY KEY,
+    header_id  INTEGER NOT NULL REFERENCES headers (id) ON DELETE CASCADE,
+    log_id     BIGINT  NOT NULL REFERENCES header_sync_logs (id) ON DELETE CASCADE,
+    address_id INTEGER NOT NULL REFERENCES addresses (id) ON DELETE CASCADE,
+    ilk_id     INTEGER REFERENCES maker.ilks (id) ON DELETE CASCADE,
+    signature  TEXT,
+    what       TEXT,
+    data       TEXT,
+    UNIQUE (header_id, log_id)
+);
+
+COMMENT ON TABLE maker.generic_file
+    IS E'File events without a dedicated transformer. Data holds the raw 32 byte argument as hex.';
+
+CREATE INDEX generic_file_header_index
+    ON maker.generic_file (header_id);
+
+CREATE INDEX generic_file_address_index
+    ON maker.generic_file (address_id);
+
+CREATE INDEX generic_file_ilk_index
+    ON maker.generic_file (ilk_id);
+
+
+-- +goose Down
+DROP INDEX maker.generic_file_header_index;
+DROP INDEX maker.generic_file_address_index;
+DROP INDEX maker.generic_file_ilk_index;
+
+DROP TABLE maker.generic_file;
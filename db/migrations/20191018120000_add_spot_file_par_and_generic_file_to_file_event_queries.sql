@@ -0,0 +1,217 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Extend spell_exec_event with the file events emitted while the spell executed, i.e. in the same transaction
+CREATE OR REPLACE FUNCTION api.spell_exec_event_file_events(event api.spell_exec_event)
+    RETURNS SETOF api.spell_file_event AS
+$$
+SELECT file_events.source, ilks.identifier, file_events.what, file_events.data, headers.block_number, file_events.log_id
+FROM (SELECT 'vat_file_ilk' AS source, ilk_id, what, data :: TEXT AS data, log_id
+      FROM maker.vat_file_ilk
+      UNION ALL
+      SELECT 'vat_file_debt_ceiling', NULL, what, data :: TEXT, log_id
+      FROM maker.vat_file_debt_ceiling
+      UNION ALL
+      SELECT 'jug_file_base', NULL, what, data :: TEXT, log_id
+      FROM maker.jug_file_base
+      UNION ALL
+      SELECT 'jug_file_ilk', ilk_id, what, data :: TEXT, log_id
+      FROM maker.jug_file_ilk
+      UNION ALL
+      SELECT 'jug_file_vow', NULL, what, data, log_id
+      FROM maker.jug_file_vow
+      UNION ALL
+      SELECT 'cat_file_chop_lump', ilk_id, what, data :: TEXT, log_id
+      FROM maker.cat_file_chop_lump
+      UNION ALL
+      SELECT 'cat_file_flip', ilk_id, what, flip, log_id
+      FROM maker.cat_file_flip
+      UNION ALL
+      SELECT 'cat_file_vow', NULL, what, data, log_id
+      FROM maker.cat_file_vow
+      UNION ALL
+      SELECT 'spot_file_mat', ilk_id, what, data :: TEXT, log_id
+      FROM maker.spot_file_mat
+      UNION ALL
+      SELECT 'spot_file_pip', ilk_id, what, pip, log_id
+      FROM maker.spot_file_pip
+      UNION ALL
+      SELECT 'spot_file_par', NULL, what, data :: TEXT, log_id
+      FROM maker.spot_file_par
+      UNION ALL
+      SELECT 'vow_file', NULL, what, data :: TEXT, log_id
+      FROM maker.vow_file
+      UNION ALL
+      SELECT 'pot_file_dsr', NULL, what, data :: TEXT, log_id
+      FROM maker.pot_file_dsr
+      UNION ALL
+      SELECT 'pot_file_vow', NULL, what, data, log_id
+      FROM maker.pot_file_vow
+      UNION ALL
+      SELECT 'auction_file', NULL, what, data :: TEXT, log_id
+      FROM maker.auction_file
+      UNION ALL
+      SELECT 'generic_file', ilk_id, what, data, log_id
+      FROM maker.generic_file) file_events
+         JOIN public.header_sync_logs file_logs ON file_events.log_id = file_logs.id
+         JOIN public.header_sync_logs exec_logs ON exec_logs.id = event.log_id
+         LEFT JOIN public.headers ON file_logs.header_id = headers.id
+         LEFT JOIN maker.ilks ON file_events.ilk_id = ilks.id
+WHERE file_logs.header_id = exec_logs.header_id
+  AND file_logs.tx_hash = exec_logs.tx_hash
+ORDER BY file_logs.log_index
+$$
+    LANGUAGE sql
+    STABLE;
+
+-- Include ilk file events without a dedicated transformer
+CREATE OR REPLACE FUNCTION api.all_ilk_file_events(ilk_identifier TEXT, max_results INTEGER DEFAULT -1,
+                                        result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.ilk_file_event AS
+$$
+WITH ilk AS (SELECT id FROM maker.ilks WHERE ilks.identifier = ilk_identifier)
+
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.cat_file_chop_lump
+         LEFT JOIN headers ON cat_file_chop_lump.header_id = headers.id
+WHERE cat_file_chop_lump.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, flip AS data, block_number, log_id
+FROM maker.cat_file_flip
+         LEFT JOIN headers ON cat_file_flip.header_id = headers.id
+WHERE cat_file_flip.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.jug_file_ilk
+         LEFT JOIN headers ON jug_file_ilk.header_id = headers.id
+WHERE jug_file_ilk.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.spot_file_mat
+         LEFT JOIN headers ON spot_file_mat.header_id = headers.id
+WHERE spot_file_mat.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, pip AS data, block_number, log_id
+FROM maker.spot_file_pip
+         LEFT JOIN headers ON spot_file_pip.header_id = headers.id
+WHERE spot_file_pip.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.vat_file_ilk
+         LEFT JOIN headers ON vat_file_ilk.header_id = headers.id
+WHERE vat_file_ilk.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data, block_number, log_id
+FROM maker.generic_file
+         LEFT JOIN headers ON generic_file.header_id = headers.id
+WHERE generic_file.ilk_id = (SELECT id FROM ilk)
+ORDER BY block_number DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_ilk_file_events.result_offset
+$$
+    LANGUAGE sql
+    STRICT --necessary for postgraphile queries with required arguments
+    STABLE;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+-- Extend spell_exec_event with the file events emitted while the spell executed, i.e. in the same transaction
+CREATE OR REPLACE FUNCTION api.spell_exec_event_file_events(event api.spell_exec_event)
+    RETURNS SETOF api.spell_file_event AS
+$$
+SELECT file_events.source, ilks.identifier, file_events.what, file_events.data, headers.block_number, file_events.log_id
+FROM (SELECT 'vat_file_ilk' AS source, ilk_id, what, data :: TEXT AS data, log_id
+      FROM maker.vat_file_ilk
+      UNION ALL
+      SELECT 'vat_file_debt_ceiling', NULL, what, data :: TEXT, log_id
+      FROM maker.vat_file_debt_ceiling
+      UNION ALL
+      SELECT 'jug_file_base', NULL, what, data :: TEXT, log_id
+      FROM maker.jug_file_base
+      UNION ALL
+      SELECT 'jug_file_ilk', ilk_id, what, data :: TEXT, log_id
+      FROM maker.jug_file_ilk
+      UNION ALL
+      SELECT 'jug_file_vow', NULL, what, data, log_id
+      FROM maker.jug_file_vow
+      UNION ALL
+      SELECT 'cat_file_chop_lump', ilk_id, what, data :: TEXT, log_id
+      FROM maker.cat_file_chop_lump
+      UNION ALL
+      SELECT 'cat_file_flip', ilk_id, what, flip, log_id
+      FROM maker.cat_file_flip
+      UNION ALL
+      SELECT 'cat_file_vow', NULL, what, data, log_id
+      FROM maker.cat_file_vow
+      UNION ALL
+      SELECT 'spot_file_mat', ilk_id, what, data :: TEXT, log_id
+      FROM maker.spot_file_mat
+      UNION ALL
+      SELECT 'spot_file_pip', ilk_id, what, pip, log_id
+      FROM maker.spot_file_pip
+      UNION ALL
+      SELECT 'vow_file', NULL, what, data :: TEXT, log_id
+      FROM maker.vow_file
+      UNION ALL
+      SELECT 'pot_file_dsr', NULL, what, data :: TEXT, log_id
+      FROM maker.pot_file_dsr
+      UNION ALL
+      SELECT 'pot_file_vow', NULL, what, data, log_id
+      FROM maker.pot_file_vow
+      UNION ALL
+      SELECT 'auction_file', NULL, what, data :: TEXT, log_id
+      FROM maker.auction_file) file_events
+         JOIN public.header_sync_logs file_logs ON file_events.log_id = file_logs.id
+         JOIN public.header_sync_logs exec_logs ON exec_logs.id = event.log_id
+         LEFT JOIN public.headers ON file_logs.header_id = headers.id
+         LEFT JOIN maker.ilks ON file_events.ilk_id = ilks.id
+WHERE file_logs.header_id = exec_logs.header_id
+  AND file_logs.tx_hash = exec_logs.tx_hash
+ORDER BY file_logs.log_index
+$$
+    LANGUAGE sql
+    STABLE;
+
+CREATE OR REPLACE FUNCTION api.all_ilk_file_events(ilk_identifier TEXT, max_results INTEGER DEFAULT -1,
+                                        result_offset INTEGER DEFAULT 0)
+    RETURNS SETOF api.ilk_file_event AS
+$$
+WITH ilk AS (SELECT id FROM maker.ilks WHERE ilks.identifier = ilk_identifier)
+
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.cat_file_chop_lump
+         LEFT JOIN headers ON cat_file_chop_lump.header_id = headers.id
+WHERE cat_file_chop_lump.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, flip AS data, block_number, log_id
+FROM maker.cat_file_flip
+         LEFT JOIN headers ON cat_file_flip.header_id = headers.id
+WHERE cat_file_flip.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.jug_file_ilk
+         LEFT JOIN headers ON jug_file_ilk.header_id = headers.id
+WHERE jug_file_ilk.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.spot_file_mat
+         LEFT JOIN headers ON spot_file_mat.header_id = headers.id
+WHERE spot_file_mat.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, pip AS data, block_number, log_id
+FROM maker.spot_file_pip
+         LEFT JOIN headers ON spot_file_pip.header_id = headers.id
+WHERE spot_file_pip.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data :: text, block_number, log_id
+FROM maker.vat_file_ilk
+         LEFT JOIN headers ON vat_file_ilk.header_id = headers.id
+WHERE vat_file_ilk.ilk_id = (SELECT id FROM ilk)
+ORDER BY block_number DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_ilk_file_events.result_offset
+$$
+    LANGUAGE sql
+    STRICT --necessary for postgraphile queries with required arguments
+    STABLE;
@@ -44,6 +44,26 @@ CREATE EXTENSION IF NOT EXISTS citext WITH SCHEMA public;
 COMMENT ON EXTENSION citext IS 'data type for case-insensitive character strings';
 
 
+--
+-- Name: auction_file_event; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.auction_file_event AS (
+	contract_address text,
+	what text,
+	data numeric,
+	block_height bigint,
+	log_id bigint
+);
+
+
+--
+-- Name: COLUMN auction_file_event.log_id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.auction_file_event.log_id IS '@omit';
+
+
 --
 -- Name: bid_act; Type: TYPE; Schema: api; Owner: -
 --
@@ -88,6 +108,17 @@ COMMENT ON COLUMN api.bite_event.block_height IS '@omit';
 COMMENT ON COLUMN api.bite_event.log_id IS '@omit';
 
 
+--
+-- Name: chief_slate; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.chief_slate AS (
+	slate text,
+	yays text[],
+	support numeric
+);
+
+
 --
 -- Name: era; Type: TYPE; Schema: api; Owner: -
 --
@@ -98,6 +129,19 @@ CREATE TYPE api.era AS (
 );
 
 
+--
+-- Name: esm_state; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.esm_state AS (
+	block_height bigint,
+	sum numeric,
+	min numeric,
+	remaining numeric,
+	fired boolean
+);
+
+
 --
 -- Name: flap_bid_event; Type: TYPE; Schema: api; Owner: -
 --
@@ -370,6 +414,54 @@ CREATE TYPE api.ilk_state AS (
 );
 
 
+--
+-- Name: managed_cdp_transfer; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.managed_cdp_transfer AS (
+	cdpi numeric,
+	owner text,
+	msg_sender text,
+	block_height bigint,
+	transferred timestamp without time zone,
+	log_id bigint
+);
+
+
+--
+-- Name: COLUMN managed_cdp_transfer.log_id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.managed_cdp_transfer.log_id IS '@omit';
+
+
+--
+-- Name: median_price; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.median_price AS (
+	ilk_identifier text,
+	val numeric,
+	age numeric,
+	block_height bigint,
+	log_id bigint
+);
+
+
+--
+-- Name: COLUMN median_price.block_height; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.median_price.block_height IS '@omit';
+
+
+--
+-- Name: COLUMN median_price.log_id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.median_price.log_id IS '@omit';
+
+
 --
 -- Name: poke_event; Type: TYPE; Schema: api; Owner: -
 --
@@ -464,6 +556,79 @@ COMMENT ON COLUMN api.sin_queue_event.block_height IS '@omit';
 COMMENT ON COLUMN api.sin_queue_event.log_id IS '@omit';
 
 
+--
+-- Name: spell_exec_event; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.spell_exec_event AS (
+	usr text,
+	tag text,
+	fax text,
+	eta numeric,
+	block_height bigint,
+	log_id bigint
+);
+
+
+--
+-- Name: COLUMN spell_exec_event.block_height; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.spell_exec_event.block_height IS '@omit';
+
+
+--
+-- Name: COLUMN spell_exec_event.log_id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.spell_exec_event.log_id IS '@omit';
+
+
+--
+-- Name: spell_file_event; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.spell_file_event AS (
+	source text,
+	ilk_identifier text,
+	what text,
+	data text,
+	block_height bigint,
+	log_id bigint
+);
+
+
+--
+-- Name: COLUMN spell_file_event.block_height; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.spell_file_event.block_height IS '@omit';
+
+
+--
+-- Name: COLUMN spell_file_event.log_id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.spell_file_event.log_id IS '@omit';
+
+
+--
+-- Name: system_status; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.system_status AS (
+	block_height bigint,
+	vat_live boolean,
+	cat_live boolean,
+	vow_live boolean,
+	flap_live boolean,
+	flop_live boolean,
+	spot_live boolean,
+	pot_live boolean,
+	flip_bids_yanked bigint
+);
+
+
 --
 -- Name: tx; Type: TYPE; Schema: api; Owner: -
 --
@@ -478,6 +643,17 @@ CREATE TYPE api.tx AS (
 );
 
 
+--
+-- Name: urn_permission; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.urn_permission AS (
+	urn_identifier text,
+	usr text,
+	block_height bigint
+);
+
+
 --
 -- Name: urn_state; Type: TYPE; Schema: api; Owner: -
 --
@@ -493,6 +669,62 @@ CREATE TYPE api.urn_state AS (
 );
 
 
+--
+-- Name: vow_auction_trigger; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.vow_auction_trigger AS (
+	msg_sender text,
+	bid_id numeric,
+	block_height bigint,
+	log_id bigint
+);
+
+
+--
+-- Name: COLUMN vow_auction_trigger.log_id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.vow_auction_trigger.log_id IS '@omit';
+
+
+--
+-- Name: vow_debt_settlement; Type: TYPE; Schema: api; Owner: -
+--
+
+CREATE TYPE api.vow_debt_settlement AS (
+	act text,
+	rad numeric,
+	block_height bigint,
+	log_id bigint
+);
+
+
+--
+-- Name: COLUMN vow_debt_settlement.log_id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.vow_debt_settlement.log_id IS '@omit';
+
+
+--
+-- Name: all_auction_file_events(integer, integer); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.all_auction_file_events(max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.auction_file_event
+    LANGUAGE sql STABLE
+    AS $$
+SELECT addresses.address AS contract_address, what, data, block_number AS block_height, log_id
+FROM maker.auction_file
+         LEFT JOIN public.addresses ON auction_file.address_id = addresses.id
+         LEFT JOIN public.headers ON auction_file.header_id = headers.id
+ORDER BY block_height DESC, log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_auction_file_events.result_offset
+$$;
+
+
 --
 -- Name: all_bites(text, integer, integer); Type: FUNCTION; Schema: api; Owner: -
 --
@@ -521,6 +753,124 @@ all_bites.result_offset
 $$;
 
 
+--
+-- Name: all_spell_exec_events(integer, integer); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.all_spell_exec_events(max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.spell_exec_event
+    LANGUAGE sql STABLE
+    AS $$
+SELECT usr, tag, fax, eta, block_number, log_id
+FROM maker.pause_exec
+         LEFT JOIN headers ON pause_exec.header_id = headers.id
+ORDER BY block_number DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_spell_exec_events.result_offset
+$$;
+
+
+--
+-- Name: max_block(); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.max_block() RETURNS bigint
+    LANGUAGE sql STABLE
+    AS $$
+SELECT max(block_number)
+FROM public.headers
+$$;
+
+
+--
+-- Name: FUNCTION max_block(); Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON FUNCTION api.max_block() IS '@omit';
+
+
+--
+-- Name: esm_state(bigint); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.esm_state(block_height bigint DEFAULT api.max_block()) RETURNS api.esm_state
+    LANGUAGE sql STABLE STRICT
+    AS $$
+WITH latest_sum AS (
+    SELECT sum FROM maker.esm_sum
+    WHERE block_number <= esm_state.block_height
+    ORDER BY block_number DESC
+    LIMIT 1
+),
+     latest_min AS (
+         SELECT min FROM maker.esm_min
+         WHERE block_number <= esm_state.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_fired AS (
+         SELECT fired FROM maker.esm_fired
+         WHERE block_number <= esm_state.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     fire_events AS (
+         SELECT esm_fire.id
+         FROM maker.esm_fire
+                  LEFT JOIN public.headers ON esm_fire.header_id = headers.id
+         WHERE headers.block_number <= esm_state.block_height
+     )
+SELECT esm_state.block_height,
+       COALESCE((SELECT sum FROM latest_sum), 0) AS sum,
+       (SELECT min FROM latest_min) AS min,
+       GREATEST((SELECT min FROM latest_min) - COALESCE((SELECT sum FROM latest_sum), 0), 0) AS remaining,
+       EXISTS(SELECT 1 FROM fire_events) OR COALESCE((SELECT fired FROM latest_fired), 0) <> 0 AS fired
+$$;
+
+
+--
+-- Name: ilk_file_event_spell(api.ilk_file_event); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.ilk_file_event_spell(event api.ilk_file_event) RETURNS api.spell_exec_event
+    LANGUAGE sql STABLE
+    AS $$
+SELECT pause_exec.usr, pause_exec.tag, pause_exec.fax, pause_exec.eta, headers.block_number, pause_exec.log_id
+FROM maker.pause_exec
+         JOIN public.header_sync_logs exec_logs ON pause_exec.log_id = exec_logs.id
+         JOIN public.header_sync_logs file_logs ON file_logs.id = event.log_id
+         LEFT JOIN public.headers ON pause_exec.header_id = headers.id
+WHERE exec_logs.header_id = file_logs.header_id
+  AND exec_logs.tx_hash = file_logs.tx_hash
+LIMIT 1
+$$;
+
+
+--
+-- Name: all_chief_slates(bigint); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.all_chief_slates(block_height bigint DEFAULT api.max_block()) RETURNS SETOF api.chief_slate
+    LANGUAGE sql STABLE
+    AS $$
+WITH slates AS (
+    SELECT DISTINCT ON (slate) slate, yays
+    FROM (SELECT chief_etch.slate, chief_etch.yays
+          FROM maker.chief_etch
+                   LEFT JOIN public.headers ON chief_etch.header_id = headers.id
+          WHERE headers.block_number <= all_chief_slates.block_height
+          UNION
+          SELECT chief_vote_yays.slate, chief_vote_yays.yays
+          FROM maker.chief_vote_yays
+                   LEFT JOIN public.headers ON chief_vote_yays.header_id = headers.id
+          WHERE headers.block_number <= all_chief_slates.block_height) etched
+    ORDER BY slate
+)
+SELECT slates.slate, slates.yays, api.chief_slate_support(slates.slate, all_chief_slates.block_height)
+FROM slates
+$$;
+
+
 --
 -- Name: all_flap_bid_events(integer, integer); Type: FUNCTION; Schema: api; Owner: -
 --
@@ -998,6 +1348,11 @@ SELECT ilk_identifier, what, data :: text, block_number, log_id
 FROM maker.vat_file_ilk
          LEFT JOIN headers ON vat_file_ilk.header_id = headers.id
 WHERE vat_file_ilk.ilk_id = (SELECT id FROM ilk)
+UNION
+SELECT ilk_identifier, what, data, block_number, log_id
+FROM maker.generic_file
+         LEFT JOIN headers ON generic_file.header_id = headers.id
+WHERE generic_file.ilk_id = (SELECT id FROM ilk)
 ORDER BY block_number DESC
 LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
 OFFSET
@@ -1006,50 +1361,310 @@ $$;
 
 
 --
--- Name: max_block(); Type: FUNCTION; Schema: api; Owner: -
+-- Name: all_vow_debt_settlements(integer, integer); Type: FUNCTION; Schema: api; Owner: -
 --
 
-CREATE FUNCTION api.max_block() RETURNS bigint
+CREATE FUNCTION api.all_vow_debt_settlements(max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.vow_debt_settlement
     LANGUAGE sql STABLE
     AS $$
-SELECT max(block_number)
-FROM public.headers
+WITH settlements AS (
+    SELECT 'heal' AS act, rad, header_id, log_id
+    FROM maker.vow_heal
+    UNION ALL
+    SELECT 'kiss' AS act, rad, header_id, log_id
+    FROM maker.vow_kiss
+)
+SELECT act, rad, headers.block_number AS block_height, log_id
+FROM settlements
+         LEFT JOIN public.headers ON settlements.header_id = headers.id
+ORDER BY block_height DESC, log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_vow_debt_settlements.result_offset
 $$;
 
 
 --
--- Name: FUNCTION max_block(); Type: COMMENT; Schema: api; Owner: -
+-- Name: all_vow_flap_events(integer, integer); Type: FUNCTION; Schema: api; Owner: -
 --
 
-COMMENT ON FUNCTION api.max_block() IS '@omit';
+CREATE FUNCTION api.all_vow_flap_events(max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.vow_auction_trigger
+    LANGUAGE sql STABLE
+    AS $$
+SELECT vow_flap.msg_sender, kicked.bid_id, headers.block_number AS block_height, vow_flap.log_id
+FROM maker.vow_flap
+         LEFT JOIN public.headers ON vow_flap.header_id = headers.id
+         LEFT JOIN public.header_sync_logs vow_flap_log ON vow_flap.log_id = vow_flap_log.id
+         LEFT JOIN LATERAL (
+    SELECT flap_kick.bid_id
+    FROM maker.flap_kick
+             JOIN public.header_sync_logs flap_kick_log ON flap_kick.log_id = flap_kick_log.id
+    WHERE flap_kick_log.header_id = vow_flap.header_id
+      AND flap_kick_log.tx_hash = vow_flap_log.tx_hash
+    LIMIT 1
+    ) kicked ON TRUE
+ORDER BY block_height DESC, vow_flap.log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_vow_flap_events.result_offset
+$$;
 
 
 --
--- Name: all_ilk_states(text, bigint, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+-- Name: all_vow_flop_events(integer, integer); Type: FUNCTION; Schema: api; Owner: -
 --
 
-CREATE FUNCTION api.all_ilk_states(ilk_identifier text, block_height bigint DEFAULT api.max_block(), max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.ilk_state
-    LANGUAGE plpgsql STABLE STRICT
+CREATE FUNCTION api.all_vow_flop_events(max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.vow_auction_trigger
+    LANGUAGE sql STABLE
     AS $$
-BEGIN
-    RETURN QUERY (
-        WITH relevant_blocks AS (
-            SELECT get_ilk_blocks_before.block_height
-            FROM api.get_ilk_blocks_before(ilk_identifier, all_ilk_states.block_height)
-        )
-        SELECT r.*
-        FROM relevant_blocks,
-             LATERAL api.get_ilk(ilk_identifier, relevant_blocks.block_height) r
-        LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
-        OFFSET
-        all_ilk_states.result_offset
-    );
-END;
+SELECT vow_flop.msg_sender, kicked.bid_id, headers.block_number AS block_height, vow_flop.log_id
+FROM maker.vow_flop
+         LEFT JOIN public.headers ON vow_flop.header_id = headers.id
+         LEFT JOIN public.header_sync_logs vow_flop_log ON vow_flop.log_id = vow_flop_log.id
+         LEFT JOIN LATERAL (
+    SELECT flop_kick.bid_id
+    FROM maker.flop_kick
+             JOIN public.header_sync_logs flop_kick_log ON flop_kick.log_id = flop_kick_log.id
+    WHERE flop_kick_log.header_id = vow_flop.header_id
+      AND flop_kick_log.tx_hash = vow_flop_log.tx_hash
+    LIMIT 1
+    ) kicked ON TRUE
+ORDER BY block_height DESC, vow_flop.log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+all_vow_flop_events.result_offset
 $$;
 
 
 --
--- Name: all_ilks(bigint, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+-- Name: managed_cdp; Type: TABLE; Schema: api; Owner: -
+--
+
+CREATE TABLE api.managed_cdp (
+    id integer NOT NULL,
+    cdpi numeric,
+    usr text,
+    urn_identifier text,
+    ilk_identifier text,
+    created timestamp without time zone
+);
+
+
+--
+-- Name: TABLE managed_cdp; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON TABLE api.managed_cdp IS '@omit create,update,delete';
+
+
+--
+-- Name: COLUMN managed_cdp.id; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.managed_cdp.id IS '@omit';
+
+
+--
+-- Name: COLUMN managed_cdp.cdpi; Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON COLUMN api.managed_cdp.cdpi IS '@name id';
+
+
+--
+-- Name: chief_hat(bigint); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.chief_hat(block_height bigint DEFAULT api.max_block()) RETURNS text
+    LANGUAGE sql STABLE
+    AS $$
+SELECT hat
+FROM maker.chief_hat
+WHERE block_number <= chief_hat.block_height
+ORDER BY block_number DESC
+LIMIT 1
+$$;
+
+
+--
+-- Name: chief_slate_support(text, bigint); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.chief_slate_support(slate text, block_height bigint DEFAULT api.max_block()) RETURNS numeric
+    LANGUAGE sql STABLE
+    AS $$
+WITH latest_votes AS (
+    SELECT DISTINCT ON (msg_sender) msg_sender, slate
+    FROM maker.chief_votes
+    WHERE block_number <= chief_slate_support.block_height
+    ORDER BY msg_sender, block_number DESC
+),
+     latest_deposits AS (
+         SELECT DISTINCT ON (msg_sender) msg_sender, deposits
+         FROM maker.chief_deposits
+         WHERE block_number <= chief_slate_support.block_height
+         ORDER BY msg_sender, block_number DESC
+     )
+SELECT COALESCE(SUM(latest_deposits.deposits), 0)
+FROM latest_votes
+         JOIN latest_deposits ON latest_votes.msg_sender = latest_deposits.msg_sender
+WHERE latest_votes.slate = chief_slate_support.slate
+$$;
+
+
+--
+-- Name: get_managed_cdps_by_owner(text, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.get_managed_cdps_by_owner(owner text, max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.managed_cdp
+    LANGUAGE sql STABLE
+    AS $$
+SELECT *
+FROM api.managed_cdp
+WHERE lower(managed_cdp.usr) = lower(get_managed_cdps_by_owner.owner)
+   OR lower(managed_cdp.usr) IN (SELECT lower(proxies.proxy)
+                                 FROM maker.proxies
+                                 WHERE lower(proxies.owner) = lower(get_managed_cdps_by_owner.owner))
+ORDER BY managed_cdp.cdpi
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+get_managed_cdps_by_owner.result_offset
+$$;
+
+
+--
+-- Name: managed_cdp_allowed_operators(api.managed_cdp); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.managed_cdp_allowed_operators(cdp api.managed_cdp) RETURNS SETOF text
+    LANGUAGE sql STABLE
+    AS $$
+WITH latest_permissions AS (
+    SELECT DISTINCT ON (usr) usr, can
+    FROM maker.cdp_manager_cdp_can
+    WHERE cdp_manager_cdp_can.owner = cdp.usr
+      AND cdp_manager_cdp_can.cdpi = cdp.cdpi
+    ORDER BY usr, block_number DESC
+)
+SELECT usr
+FROM latest_permissions
+WHERE can = 1
+ORDER BY usr
+$$;
+
+
+--
+-- Name: managed_cdp_owner(api.managed_cdp); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.managed_cdp_owner(cdp api.managed_cdp) RETURNS text
+    LANGUAGE sql STABLE
+    AS $$
+SELECT COALESCE(
+               (SELECT proxies.owner
+                FROM maker.proxies
+                         LEFT JOIN public.headers ON proxies.header_id = headers.id
+                WHERE lower(proxies.proxy) = lower(cdp.usr)
+                ORDER BY headers.block_number DESC
+                LIMIT 1),
+               cdp.usr)
+$$;
+
+
+--
+-- Name: managed_cdp_transfers(numeric, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.managed_cdp_transfers(cdpi numeric, max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.managed_cdp_transfer
+    LANGUAGE sql STABLE
+    AS $$
+WITH transfers AS (
+    SELECT cdp AS cdpi, own AS owner, usr AS msg_sender, header_id, log_id
+    FROM maker.new_cdp
+    WHERE new_cdp.cdp = managed_cdp_transfers.cdpi
+    UNION ALL
+    SELECT cdpi, dst AS owner, msg_sender, header_id, log_id
+    FROM maker.cdp_manager_give
+    WHERE cdp_manager_give.cdpi = managed_cdp_transfers.cdpi
+)
+SELECT transfers.cdpi,
+       transfers.owner,
+       transfers.msg_sender,
+       headers.block_number                           AS block_height,
+       api.epoch_to_datetime(headers.block_timestamp) AS transferred,
+       transfers.log_id
+FROM transfers
+         LEFT JOIN public.headers ON transfers.header_id = headers.id
+ORDER BY block_height DESC, log_id DESC
+LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+OFFSET
+managed_cdp_transfers.result_offset
+$$;
+
+
+--
+-- Name: max_timestamp(); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.max_timestamp() RETURNS numeric
+    LANGUAGE sql STABLE
+    AS $$
+SELECT max(block_timestamp)
+FROM public.headers
+$$;
+
+
+--
+-- Name: FUNCTION max_timestamp(); Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON FUNCTION api.max_timestamp() IS '@omit';
+
+
+--
+-- Name: all_median_prices(text, numeric, numeric, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.all_median_prices(ilk_identifier text, begintime numeric DEFAULT 0, endtime numeric DEFAULT api.max_timestamp(), max_results integer DEFAULT NULL::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.median_price
+    LANGUAGE sql STABLE
+    AS $$
+SELECT ilks.identifier, val, age, block_number AS block_height, log_id
+FROM maker.log_median_price
+         LEFT JOIN maker.ilks ON log_median_price.ilk_id = ilks.id
+         LEFT JOIN public.headers ON log_median_price.header_id = headers.id
+WHERE ilks.identifier = all_median_prices.ilk_identifier
+  AND block_timestamp BETWEEN beginTime AND endTime
+ORDER BY block_height DESC
+LIMIT all_median_prices.max_results OFFSET all_median_prices.result_offset
+$$;
+
+
+--
+-- Name: all_ilk_states(text, bigint, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.all_ilk_states(ilk_identifier text, block_height bigint DEFAULT api.max_block(), max_results integer DEFAULT '-1'::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.ilk_state
+    LANGUAGE plpgsql STABLE STRICT
+    AS $$
+BEGIN
+    RETURN QUERY (
+        WITH relevant_blocks AS (
+            SELECT get_ilk_blocks_before.block_height
+            FROM api.get_ilk_blocks_before(ilk_identifier, all_ilk_states.block_height)
+        )
+        SELECT r.*
+        FROM relevant_blocks,
+             LATERAL api.get_ilk(ilk_identifier, relevant_blocks.block_height) r
+        LIMIT CASE WHEN max_results = -1 THEN NULL ELSE max_results END
+        OFFSET
+        all_ilk_states.result_offset
+    );
+END;
+$$;
+
+
+--
+-- Name: all_ilks(bigint, integer, integer); Type: FUNCTION; Schema: api; Owner: -
 --
 
 CREATE FUNCTION api.all_ilks(block_height bigint DEFAULT api.max_block(), max_results integer DEFAULT NULL::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.ilk_state
@@ -1159,25 +1774,6 @@ LIMIT all_ilks.max_results OFFSET all_ilks.result_offset
 $$;
 
 
---
--- Name: max_timestamp(); Type: FUNCTION; Schema: api; Owner: -
---
-
-CREATE FUNCTION api.max_timestamp() RETURNS numeric
-    LANGUAGE sql STABLE
-    AS $$
-SELECT max(block_timestamp)
-FROM public.headers
-$$;
-
-
---
--- Name: FUNCTION max_timestamp(); Type: COMMENT; Schema: api; Owner: -
---
-
-COMMENT ON FUNCTION api.max_timestamp() IS '@omit';
-
-
 --
 -- Name: all_poke_events(numeric, numeric, integer, integer); Type: FUNCTION; Schema: api; Owner: -
 --
@@ -2262,41 +2858,6 @@ LIMIT ilk_state_ilk_file_events.max_results OFFSET ilk_state_ilk_file_events.res
 $$;
 
 
---
--- Name: managed_cdp; Type: TABLE; Schema: api; Owner: -
---
-
-CREATE TABLE api.managed_cdp (
-    id integer NOT NULL,
-    cdpi numeric,
-    usr text,
-    urn_identifier text,
-    ilk_identifier text,
-    created timestamp without time zone
-);
-
-
---
--- Name: TABLE managed_cdp; Type: COMMENT; Schema: api; Owner: -
---
-
-COMMENT ON TABLE api.managed_cdp IS '@omit create,update,delete';
-
-
---
--- Name: COLUMN managed_cdp.id; Type: COMMENT; Schema: api; Owner: -
---
-
-COMMENT ON COLUMN api.managed_cdp.id IS '@omit';
-
-
---
--- Name: COLUMN managed_cdp.cdpi; Type: COMMENT; Schema: api; Owner: -
---
-
-COMMENT ON COLUMN api.managed_cdp.cdpi IS '@name id';
-
-
 --
 -- Name: managed_cdp_ilk(api.managed_cdp); Type: FUNCTION; Schema: api; Owner: -
 --
@@ -2370,6 +2931,160 @@ SELECT * FROM get_tx_data(event.block_height, event.log_id)
 $$;
 
 
+--
+-- Name: spell_exec_event_file_events(api.spell_exec_event); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.spell_exec_event_file_events(event api.spell_exec_event) RETURNS SETOF api.spell_file_event
+    LANGUAGE sql STABLE
+    AS $$
+SELECT file_events.source, ilks.identifier, file_events.what, file_events.data, headers.block_number, file_events.log_id
+FROM (SELECT 'vat_file_ilk' AS source, ilk_id, what, data :: TEXT AS data, log_id
+      FROM maker.vat_file_ilk
+      UNION ALL
+      SELECT 'vat_file_debt_ceiling', NULL, what, data :: TEXT, log_id
+      FROM maker.vat_file_debt_ceiling
+      UNION ALL
+      SELECT 'jug_file_base', NULL, what, data :: TEXT, log_id
+      FROM maker.jug_file_base
+      UNION ALL
+      SELECT 'jug_file_ilk', ilk_id, what, data :: TEXT, log_id
+      FROM maker.jug_file_ilk
+      UNION ALL
+      SELECT 'jug_file_vow', NULL, what, data, log_id
+      FROM maker.jug_file_vow
+      UNION ALL
+      SELECT 'cat_file_chop_lump', ilk_id, what, data :: TEXT, log_id
+      FROM maker.cat_file_chop_lump
+      UNION ALL
+      SELECT 'cat_file_flip', ilk_id, what, flip, log_id
+      FROM maker.cat_file_flip
+      UNION ALL
+      SELECT 'cat_file_vow', NULL, what, data, log_id
+      FROM maker.cat_file_vow
+      UNION ALL
+      SELECT 'spot_file_mat', ilk_id, what, data :: TEXT, log_id
+      FROM maker.spot_file_mat
+      UNION ALL
+      SELECT 'spot_file_pip', ilk_id, what, pip, log_id
+      FROM maker.spot_file_pip
+      UNION ALL
+      SELECT 'spot_file_par', NULL, what, data :: TEXT, log_id
+      FROM maker.spot_file_par
+      UNION ALL
+      SELECT 'vow_file', NULL, what, data :: TEXT, log_id
+      FROM maker.vow_file
+      UNION ALL
+      SELECT 'pot_file_dsr', NULL, what, data :: TEXT, log_id
+      FROM maker.pot_file_dsr
+      UNION ALL
+      SELECT 'pot_file_vow', NULL, what, data, log_id
+      FROM maker.pot_file_vow
+      UNION ALL
+      SELECT 'auction_file', NULL, what, data :: TEXT, log_id
+      FROM maker.auction_file
+      UNION ALL
+      SELECT 'generic_file', ilk_id, what, data, log_id
+      FROM maker.generic_file) file_events
+         JOIN public.header_sync_logs file_logs ON file_events.log_id = file_logs.id
+         JOIN public.header_sync_logs exec_logs ON exec_logs.id = event.log_id
+         LEFT JOIN public.headers ON file_logs.header_id = headers.id
+         LEFT JOIN maker.ilks ON file_events.ilk_id = ilks.id
+WHERE file_logs.header_id = exec_logs.header_id
+  AND file_logs.tx_hash = exec_logs.tx_hash
+ORDER BY file_logs.log_index
+$$;
+
+
+--
+-- Name: spell_exec_event_tx(api.spell_exec_event); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.spell_exec_event_tx(event api.spell_exec_event) RETURNS api.tx
+    LANGUAGE sql STABLE
+    AS $$
+SELECT * FROM get_tx_data(event.block_height, event.log_id)
+$$;
+
+
+--
+-- Name: system_live_status(bigint); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.system_live_status(block_height bigint DEFAULT api.max_block()) RETURNS api.system_status
+    LANGUAGE sql STABLE STRICT
+    AS $$
+WITH caged_modules AS (
+    SELECT DISTINCT module
+    FROM maker.cage
+             LEFT JOIN public.headers ON cage.header_id = headers.id
+    WHERE headers.block_number <= system_live_status.block_height
+),
+     latest_vat_live AS (
+         SELECT live FROM maker.vat_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_cat_live AS (
+         SELECT live FROM maker.cat_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_flap_live AS (
+         SELECT live FROM maker.flap_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_flop_live AS (
+         SELECT live FROM maker.flop_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     latest_pot_live AS (
+         SELECT live FROM maker.pot_live
+         WHERE block_number <= system_live_status.block_height
+         ORDER BY block_number DESC
+         LIMIT 1
+     ),
+     flip_yanks AS (
+         SELECT COUNT(*) AS yanked
+         FROM maker.yank
+                  LEFT JOIN public.headers ON yank.header_id = headers.id
+         WHERE headers.block_number <= system_live_status.block_height
+           AND yank.address_id IN (SELECT DISTINCT address_id FROM maker.flip_kick)
+     )
+SELECT system_live_status.block_height,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'vat') AND
+       COALESCE((SELECT live FROM latest_vat_live), 1) <> 0 AS vat_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'cat') AND
+       COALESCE((SELECT live FROM latest_cat_live), 1) <> 0 AS cat_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'vow') AS vow_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'flap') AND
+       COALESCE((SELECT live FROM latest_flap_live), 1) <> 0 AS flap_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'flop') AND
+       COALESCE((SELECT live FROM latest_flop_live), 1) <> 0 AS flop_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'spot') AS spot_live,
+       NOT EXISTS(SELECT 1 FROM caged_modules WHERE module = 'pot') AND
+       COALESCE((SELECT live FROM latest_pot_live), 1) <> 0 AS pot_live,
+       (SELECT yanked FROM flip_yanks) AS flip_bids_yanked
+$$;
+
+
+--
+-- Name: system_status_esm(api.system_status); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.system_status_esm(status api.system_status) RETURNS api.esm_state
+    LANGUAGE sql STABLE
+    AS $$
+SELECT * FROM api.esm_state(status.block_height)
+$$;
+
+
 --
 -- Name: total_ink(text, bigint); Type: FUNCTION; Schema: api; Owner: -
 --
@@ -2468,11 +3183,39 @@ $$;
 
 
 --
--- Name: urn_state_bites(api.urn_state, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+-- Name: urn_permissions(text, bigint); Type: FUNCTION; Schema: api; Owner: -
 --
 
-CREATE FUNCTION api.urn_state_bites(state api.urn_state, max_results integer DEFAULT NULL::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.bite_event
-    LANGUAGE sql STABLE
+CREATE FUNCTION api.urn_permissions(urn_identifier text, block_height bigint DEFAULT api.max_block()) RETURNS SETOF api.urn_permission
+    LANGUAGE sql STABLE STRICT
+    AS $$
+WITH latest_permissions AS (
+    SELECT DISTINCT ON (usr) usr, can, block_number
+    FROM maker.vat_can
+    WHERE bit = urn_permissions.urn_identifier
+      AND block_number <= urn_permissions.block_height
+    ORDER BY usr, block_number DESC
+)
+SELECT urn_permissions.urn_identifier, usr, block_number AS block_height
+FROM latest_permissions
+WHERE can = 1
+ORDER BY block_height DESC
+$$;
+
+
+--
+-- Name: FUNCTION urn_permissions(urn_identifier text, block_height bigint); Type: COMMENT; Schema: api; Owner: -
+--
+
+COMMENT ON FUNCTION api.urn_permissions(urn_identifier text, block_height bigint) IS 'Addresses allowed to manage an urn via vat.hope as of the given block, excluding any since revoked with vat.nope. Vat notes don''t record who called hope, so the urn is inferred from the transaction: its sender, proxies owned by the sender, or the UrnHandler of a cdp opened in it. Permissions granted by any other caller are not listed.';
+
+
+--
+-- Name: urn_state_bites(api.urn_state, integer, integer); Type: FUNCTION; Schema: api; Owner: -
+--
+
+CREATE FUNCTION api.urn_state_bites(state api.urn_state, max_results integer DEFAULT NULL::integer, result_offset integer DEFAULT 0) RETURNS SETOF api.bite_event
+    LANGUAGE sql STABLE
     AS $$
 SELECT *
 FROM api.urn_bites(state.ilk_identifier, state.urn_identifier)
@@ -3970,6 +4713,74 @@ CREATE SEQUENCE api.managed_cdp_id_seq
 ALTER SEQUENCE api.managed_cdp_id_seq OWNED BY api.managed_cdp.id;
 
 
+--
+-- Name: auction_file; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.auction_file (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    address_id integer NOT NULL,
+    what text,
+    data numeric
+);
+
+
+--
+-- Name: auction_file_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.auction_file_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: auction_file_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.auction_file_id_seq OWNED BY maker.auction_file.id;
+
+
+--
+-- Name: auth; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.auth (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    address_id integer NOT NULL,
+    usr text,
+    action text
+);
+
+
+--
+-- Name: auth_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.auth_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: auth_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.auth_id_seq OWNED BY maker.auth.id;
+
+
 --
 -- Name: bite; Type: TABLE; Schema: maker; Owner: -
 --
@@ -4021,6 +4832,39 @@ CREATE SEQUENCE maker.bite_id_seq
 ALTER SEQUENCE maker.bite_id_seq OWNED BY maker.bite.id;
 
 
+--
+-- Name: cage; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.cage (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    address_id integer NOT NULL,
+    module text
+);
+
+
+--
+-- Name: cage_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.cage_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: cage_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.cage_id_seq OWNED BY maker.cage.id;
+
+
 --
 -- Name: cat_file_chop_lump; Type: TABLE; Schema: maker; Owner: -
 --
@@ -4318,22 +5162,25 @@ ALTER SEQUENCE maker.cat_vow_id_seq OWNED BY maker.cat_vow.id;
 
 
 --
--- Name: cdp_manager_cdpi; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_allow; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_cdpi (
+CREATE TABLE maker.cdp_manager_cdp_allow (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    cdpi numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    cdpi numeric,
+    usr text,
+    ok numeric
 );
 
 
 --
--- Name: cdp_manager_cdpi_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_allow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_cdpi_id_seq
+CREATE SEQUENCE maker.cdp_manager_cdp_allow_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4343,30 +5190,32 @@ CREATE SEQUENCE maker.cdp_manager_cdpi_id_seq
 
 
 --
--- Name: cdp_manager_cdpi_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_allow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_cdpi_id_seq OWNED BY maker.cdp_manager_cdpi.id;
+ALTER SEQUENCE maker.cdp_manager_cdp_allow_id_seq OWNED BY maker.cdp_manager_cdp_allow.id;
 
 
 --
--- Name: cdp_manager_count; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_can; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_count (
+CREATE TABLE maker.cdp_manager_cdp_can (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    owner text,
-    count numeric NOT NULL
+    owner text NOT NULL,
+    cdpi numeric NOT NULL,
+    usr text NOT NULL,
+    can numeric NOT NULL
 );
 
 
 --
--- Name: cdp_manager_count_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_can_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_count_id_seq
+CREATE SEQUENCE maker.cdp_manager_cdp_can_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4376,30 +5225,29 @@ CREATE SEQUENCE maker.cdp_manager_count_id_seq
 
 
 --
--- Name: cdp_manager_count_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_can_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_count_id_seq OWNED BY maker.cdp_manager_count.id;
+ALTER SEQUENCE maker.cdp_manager_cdp_can_id_seq OWNED BY maker.cdp_manager_cdp_can.id;
 
 
 --
--- Name: cdp_manager_first; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_cdpi; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_first (
+CREATE TABLE maker.cdp_manager_cdpi (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    owner text,
-    first numeric NOT NULL
+    cdpi numeric NOT NULL
 );
 
 
 --
--- Name: cdp_manager_first_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_cdpi_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_first_id_seq
+CREATE SEQUENCE maker.cdp_manager_cdpi_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4409,30 +5257,30 @@ CREATE SEQUENCE maker.cdp_manager_first_id_seq
 
 
 --
--- Name: cdp_manager_first_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_cdpi_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_first_id_seq OWNED BY maker.cdp_manager_first.id;
+ALTER SEQUENCE maker.cdp_manager_cdpi_id_seq OWNED BY maker.cdp_manager_cdpi.id;
 
 
 --
--- Name: cdp_manager_ilks; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_count; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_ilks (
+CREATE TABLE maker.cdp_manager_count (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    cdpi numeric NOT NULL,
-    ilk_id integer NOT NULL
+    owner text,
+    count numeric NOT NULL
 );
 
 
 --
--- Name: cdp_manager_ilks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_count_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_ilks_id_seq
+CREATE SEQUENCE maker.cdp_manager_count_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4442,30 +5290,31 @@ CREATE SEQUENCE maker.cdp_manager_ilks_id_seq
 
 
 --
--- Name: cdp_manager_ilks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_count_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_ilks_id_seq OWNED BY maker.cdp_manager_ilks.id;
+ALTER SEQUENCE maker.cdp_manager_count_id_seq OWNED BY maker.cdp_manager_count.id;
 
 
 --
--- Name: cdp_manager_last; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_enter; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_last (
+CREATE TABLE maker.cdp_manager_enter (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    owner text,
-    last numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    src text,
+    cdpi numeric
 );
 
 
 --
--- Name: cdp_manager_last_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_enter_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_last_id_seq
+CREATE SEQUENCE maker.cdp_manager_enter_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4475,30 +5324,30 @@ CREATE SEQUENCE maker.cdp_manager_last_id_seq
 
 
 --
--- Name: cdp_manager_last_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_enter_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_last_id_seq OWNED BY maker.cdp_manager_last.id;
+ALTER SEQUENCE maker.cdp_manager_enter_id_seq OWNED BY maker.cdp_manager_enter.id;
 
 
 --
--- Name: cdp_manager_list_next; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_first; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_list_next (
+CREATE TABLE maker.cdp_manager_first (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    cdpi numeric NOT NULL,
-    next numeric NOT NULL
+    owner text,
+    first numeric NOT NULL
 );
 
 
 --
--- Name: cdp_manager_list_next_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_first_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_list_next_id_seq
+CREATE SEQUENCE maker.cdp_manager_first_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4508,30 +5357,32 @@ CREATE SEQUENCE maker.cdp_manager_list_next_id_seq
 
 
 --
--- Name: cdp_manager_list_next_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_first_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_list_next_id_seq OWNED BY maker.cdp_manager_list_next.id;
+ALTER SEQUENCE maker.cdp_manager_first_id_seq OWNED BY maker.cdp_manager_first.id;
 
 
 --
--- Name: cdp_manager_list_prev; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_flux; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_list_prev (
+CREATE TABLE maker.cdp_manager_flux (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    cdpi numeric NOT NULL,
-    prev numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    cdpi numeric,
+    dst text,
+    wad numeric
 );
 
 
 --
--- Name: cdp_manager_list_prev_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_flux_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_list_prev_id_seq
+CREATE SEQUENCE maker.cdp_manager_flux_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4541,30 +5392,32 @@ CREATE SEQUENCE maker.cdp_manager_list_prev_id_seq
 
 
 --
--- Name: cdp_manager_list_prev_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_flux_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_list_prev_id_seq OWNED BY maker.cdp_manager_list_prev.id;
+ALTER SEQUENCE maker.cdp_manager_flux_id_seq OWNED BY maker.cdp_manager_flux.id;
 
 
 --
--- Name: cdp_manager_owns; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_frob; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_owns (
+CREATE TABLE maker.cdp_manager_frob (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    cdpi numeric NOT NULL,
-    owner text
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    cdpi numeric,
+    dink numeric,
+    dart numeric
 );
 
 
 --
--- Name: cdp_manager_owns_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_frob_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_owns_id_seq
+CREATE SEQUENCE maker.cdp_manager_frob_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4574,30 +5427,31 @@ CREATE SEQUENCE maker.cdp_manager_owns_id_seq
 
 
 --
--- Name: cdp_manager_owns_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_frob_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_owns_id_seq OWNED BY maker.cdp_manager_owns.id;
+ALTER SEQUENCE maker.cdp_manager_frob_id_seq OWNED BY maker.cdp_manager_frob.id;
 
 
 --
--- Name: cdp_manager_urns; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_give; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_urns (
+CREATE TABLE maker.cdp_manager_give (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    cdpi numeric NOT NULL,
-    urn text
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    cdpi numeric,
+    dst text
 );
 
 
 --
--- Name: cdp_manager_urns_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_give_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_urns_id_seq
+CREATE SEQUENCE maker.cdp_manager_give_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4607,29 +5461,30 @@ CREATE SEQUENCE maker.cdp_manager_urns_id_seq
 
 
 --
--- Name: cdp_manager_urns_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_give_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_urns_id_seq OWNED BY maker.cdp_manager_urns.id;
+ALTER SEQUENCE maker.cdp_manager_give_id_seq OWNED BY maker.cdp_manager_give.id;
 
 
 --
--- Name: cdp_manager_vat; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_ilks; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.cdp_manager_vat (
+CREATE TABLE maker.cdp_manager_ilks (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    vat text
+    cdpi numeric NOT NULL,
+    ilk_id integer NOT NULL
 );
 
 
 --
--- Name: cdp_manager_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_ilks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.cdp_manager_vat_id_seq
+CREATE SEQUENCE maker.cdp_manager_ilks_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4639,30 +5494,30 @@ CREATE SEQUENCE maker.cdp_manager_vat_id_seq
 
 
 --
--- Name: cdp_manager_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_ilks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.cdp_manager_vat_id_seq OWNED BY maker.cdp_manager_vat.id;
+ALTER SEQUENCE maker.cdp_manager_ilks_id_seq OWNED BY maker.cdp_manager_ilks.id;
 
 
 --
--- Name: deal; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_last; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.deal (
+CREATE TABLE maker.cdp_manager_last (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    bid_id numeric NOT NULL,
-    address_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    owner text,
+    last numeric NOT NULL
 );
 
 
 --
--- Name: deal_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_last_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.deal_id_seq
+CREATE SEQUENCE maker.cdp_manager_last_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4672,32 +5527,30 @@ CREATE SEQUENCE maker.deal_id_seq
 
 
 --
--- Name: deal_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_last_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.deal_id_seq OWNED BY maker.deal.id;
+ALTER SEQUENCE maker.cdp_manager_last_id_seq OWNED BY maker.cdp_manager_last.id;
 
 
 --
--- Name: dent; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_list_next; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.dent (
+CREATE TABLE maker.cdp_manager_list_next (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric,
-    bid numeric,
-    address_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    cdpi numeric NOT NULL,
+    next numeric NOT NULL
 );
 
 
 --
--- Name: dent_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_list_next_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.dent_id_seq
+CREATE SEQUENCE maker.cdp_manager_list_next_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4707,50 +5560,30 @@ CREATE SEQUENCE maker.dent_id_seq
 
 
 --
--- Name: dent_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
---
-
-ALTER SEQUENCE maker.dent_id_seq OWNED BY maker.dent.id;
-
-
---
--- Name: flap; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_list_next_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap (
-    id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric,
-    guy text,
-    tic bigint,
-    "end" bigint,
-    lot numeric,
-    bid numeric,
-    created timestamp without time zone,
-    updated timestamp without time zone
-);
+ALTER SEQUENCE maker.cdp_manager_list_next_id_seq OWNED BY maker.cdp_manager_list_next.id;
 
 
 --
--- Name: flap_beg; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_list_prev; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_beg (
+CREATE TABLE maker.cdp_manager_list_prev (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    beg numeric NOT NULL
+    cdpi numeric NOT NULL,
+    prev numeric NOT NULL
 );
 
 
 --
--- Name: flap_beg_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_list_prev_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_beg_id_seq
+CREATE SEQUENCE maker.cdp_manager_list_prev_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4760,31 +5593,32 @@ CREATE SEQUENCE maker.flap_beg_id_seq
 
 
 --
--- Name: flap_beg_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_list_prev_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_beg_id_seq OWNED BY maker.flap_beg.id;
+ALTER SEQUENCE maker.cdp_manager_list_prev_id_seq OWNED BY maker.cdp_manager_list_prev.id;
 
 
 --
--- Name: flap_bid_bid; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_move; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_bid_bid (
+CREATE TABLE maker.cdp_manager_move (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    bid numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    cdpi numeric,
+    dst text,
+    rad numeric
 );
 
 
 --
--- Name: flap_bid_bid_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_move_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_bid_bid_id_seq
+CREATE SEQUENCE maker.cdp_manager_move_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4794,31 +5628,30 @@ CREATE SEQUENCE maker.flap_bid_bid_id_seq
 
 
 --
--- Name: flap_bid_bid_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_move_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_bid_bid_id_seq OWNED BY maker.flap_bid_bid.id;
+ALTER SEQUENCE maker.cdp_manager_move_id_seq OWNED BY maker.cdp_manager_move.id;
 
 
 --
--- Name: flap_bid_end; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_owns; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_bid_end (
+CREATE TABLE maker.cdp_manager_owns (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    "end" bigint NOT NULL
+    cdpi numeric NOT NULL,
+    owner text
 );
 
 
 --
--- Name: flap_bid_end_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_owns_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_bid_end_id_seq
+CREATE SEQUENCE maker.cdp_manager_owns_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4828,31 +5661,31 @@ CREATE SEQUENCE maker.flap_bid_end_id_seq
 
 
 --
--- Name: flap_bid_end_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_owns_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_bid_end_id_seq OWNED BY maker.flap_bid_end.id;
+ALTER SEQUENCE maker.cdp_manager_owns_id_seq OWNED BY maker.cdp_manager_owns.id;
 
 
 --
--- Name: flap_bid_guy; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_quit; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_bid_guy (
+CREATE TABLE maker.cdp_manager_quit (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    guy text NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    cdpi numeric,
+    dst text
 );
 
 
 --
--- Name: flap_bid_guy_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_quit_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_bid_guy_id_seq
+CREATE SEQUENCE maker.cdp_manager_quit_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4862,31 +5695,31 @@ CREATE SEQUENCE maker.flap_bid_guy_id_seq
 
 
 --
--- Name: flap_bid_guy_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_quit_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_bid_guy_id_seq OWNED BY maker.flap_bid_guy.id;
+ALTER SEQUENCE maker.cdp_manager_quit_id_seq OWNED BY maker.cdp_manager_quit.id;
 
 
 --
--- Name: flap_bid_lot; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_shift; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_bid_lot (
+CREATE TABLE maker.cdp_manager_shift (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    cdp_src numeric,
+    cdp_dst numeric
 );
 
 
 --
--- Name: flap_bid_lot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_shift_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_bid_lot_id_seq
+CREATE SEQUENCE maker.cdp_manager_shift_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4896,31 +5729,31 @@ CREATE SEQUENCE maker.flap_bid_lot_id_seq
 
 
 --
--- Name: flap_bid_lot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_shift_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_bid_lot_id_seq OWNED BY maker.flap_bid_lot.id;
+ALTER SEQUENCE maker.cdp_manager_shift_id_seq OWNED BY maker.cdp_manager_shift.id;
 
 
 --
--- Name: flap_bid_tic; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_allow; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_bid_tic (
+CREATE TABLE maker.cdp_manager_urn_allow (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    tic bigint NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    usr text,
+    ok numeric
 );
 
 
 --
--- Name: flap_bid_tic_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_allow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_bid_tic_id_seq
+CREATE SEQUENCE maker.cdp_manager_urn_allow_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4930,30 +5763,31 @@ CREATE SEQUENCE maker.flap_bid_tic_id_seq
 
 
 --
--- Name: flap_bid_tic_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_allow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_bid_tic_id_seq OWNED BY maker.flap_bid_tic.id;
+ALTER SEQUENCE maker.cdp_manager_urn_allow_id_seq OWNED BY maker.cdp_manager_urn_allow.id;
 
 
 --
--- Name: flap_gem; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_can; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_gem (
+CREATE TABLE maker.cdp_manager_urn_can (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    gem text NOT NULL
+    owner text NOT NULL,
+    usr text NOT NULL,
+    can numeric NOT NULL
 );
 
 
 --
--- Name: flap_gem_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_can_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_gem_id_seq
+CREATE SEQUENCE maker.cdp_manager_urn_can_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4963,17 +5797,30 @@ CREATE SEQUENCE maker.flap_gem_id_seq
 
 
 --
--- Name: flap_gem_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_can_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_gem_id_seq OWNED BY maker.flap_gem.id;
+ALTER SEQUENCE maker.cdp_manager_urn_can_id_seq OWNED BY maker.cdp_manager_urn_can.id;
 
 
 --
--- Name: flap_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_urns; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_id_seq
+CREATE TABLE maker.cdp_manager_urns (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    cdpi numeric NOT NULL,
+    urn text
+);
+
+
+--
+-- Name: cdp_manager_urns_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.cdp_manager_urns_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -4983,39 +5830,29 @@ CREATE SEQUENCE maker.flap_id_seq
 
 
 --
--- Name: flap_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_urns_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_id_seq OWNED BY maker.flap.id;
+ALTER SEQUENCE maker.cdp_manager_urns_id_seq OWNED BY maker.cdp_manager_urns.id;
 
 
 --
--- Name: flap_kick; Type: TABLE; Schema: maker; Owner: -
+-- Name: cdp_manager_vat; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_kick (
+CREATE TABLE maker.cdp_manager_vat (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric NOT NULL,
-    bid numeric NOT NULL,
-    address_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    vat text
 );
 
 
 --
--- Name: TABLE flap_kick; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON TABLE maker.flap_kick IS '@name flapKickEvent';
-
-
---
--- Name: flap_kick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: cdp_manager_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_kick_id_seq
+CREATE SEQUENCE maker.cdp_manager_vat_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5025,37 +5862,30 @@ CREATE SEQUENCE maker.flap_kick_id_seq
 
 
 --
--- Name: flap_kick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: cdp_manager_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_kick_id_seq OWNED BY maker.flap_kick.id;
+ALTER SEQUENCE maker.cdp_manager_vat_id_seq OWNED BY maker.cdp_manager_vat.id;
 
 
 --
--- Name: flap_kicks; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_approvals; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_kicks (
+CREATE TABLE maker.chief_approvals (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    kicks numeric NOT NULL
+    candidate text,
+    approvals numeric NOT NULL
 );
 
 
 --
--- Name: TABLE flap_kicks; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON TABLE maker.flap_kicks IS '@name flapKicksStorage';
-
-
---
--- Name: flap_kicks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_approvals_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_kicks_id_seq
+CREATE SEQUENCE maker.chief_approvals_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5065,30 +5895,30 @@ CREATE SEQUENCE maker.flap_kicks_id_seq
 
 
 --
--- Name: flap_kicks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_approvals_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_kicks_id_seq OWNED BY maker.flap_kicks.id;
+ALTER SEQUENCE maker.chief_approvals_id_seq OWNED BY maker.chief_approvals.id;
 
 
 --
--- Name: flap_live; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_deposits; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_live (
+CREATE TABLE maker.chief_deposits (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    live numeric NOT NULL
+    msg_sender text,
+    deposits numeric NOT NULL
 );
 
 
 --
--- Name: flap_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_deposits_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_live_id_seq
+CREATE SEQUENCE maker.chief_deposits_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5098,30 +5928,31 @@ CREATE SEQUENCE maker.flap_live_id_seq
 
 
 --
--- Name: flap_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_deposits_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_live_id_seq OWNED BY maker.flap_live.id;
+ALTER SEQUENCE maker.chief_deposits_id_seq OWNED BY maker.chief_deposits.id;
 
 
 --
--- Name: flap_tau; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_etch; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_tau (
+CREATE TABLE maker.chief_etch (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    tau integer NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    slate text,
+    yays text[]
 );
 
 
 --
--- Name: flap_tau_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_etch_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_tau_id_seq
+CREATE SEQUENCE maker.chief_etch_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5131,30 +5962,30 @@ CREATE SEQUENCE maker.flap_tau_id_seq
 
 
 --
--- Name: flap_tau_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_etch_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_tau_id_seq OWNED BY maker.flap_tau.id;
+ALTER SEQUENCE maker.chief_etch_id_seq OWNED BY maker.chief_etch.id;
 
 
 --
--- Name: flap_ttl; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_free; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_ttl (
+CREATE TABLE maker.chief_free (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    ttl integer NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    wad numeric
 );
 
 
 --
--- Name: flap_ttl_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_free_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_ttl_id_seq
+CREATE SEQUENCE maker.chief_free_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5164,30 +5995,29 @@ CREATE SEQUENCE maker.flap_ttl_id_seq
 
 
 --
--- Name: flap_ttl_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_free_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_ttl_id_seq OWNED BY maker.flap_ttl.id;
+ALTER SEQUENCE maker.chief_free_id_seq OWNED BY maker.chief_free.id;
 
 
 --
--- Name: flap_vat; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_hat; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flap_vat (
+CREATE TABLE maker.chief_hat (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    vat text NOT NULL
+    hat text
 );
 
 
 --
--- Name: flap_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_hat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flap_vat_id_seq
+CREATE SEQUENCE maker.chief_hat_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5197,52 +6027,63 @@ CREATE SEQUENCE maker.flap_vat_id_seq
 
 
 --
--- Name: flap_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_hat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flap_vat_id_seq OWNED BY maker.flap_vat.id;
+ALTER SEQUENCE maker.chief_hat_id_seq OWNED BY maker.chief_hat.id;
 
 
 --
--- Name: flip; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_lift; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip (
+CREATE TABLE maker.chief_lift (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric,
-    guy text,
-    tic bigint,
-    "end" bigint,
-    lot numeric,
-    bid numeric,
-    gal text,
-    tab numeric,
-    created timestamp without time zone,
-    updated timestamp without time zone
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    whom text
 );
 
 
 --
--- Name: flip_beg; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_lift_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_beg (
+CREATE SEQUENCE maker.chief_lift_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: chief_lift_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.chief_lift_id_seq OWNED BY maker.chief_lift.id;
+
+
+--
+-- Name: chief_lock; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.chief_lock (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    beg numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    wad numeric
 );
 
 
 --
--- Name: flip_beg_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_lock_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_beg_id_seq
+CREATE SEQUENCE maker.chief_lock_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5252,31 +6093,30 @@ CREATE SEQUENCE maker.flip_beg_id_seq
 
 
 --
--- Name: flip_beg_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_lock_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_beg_id_seq OWNED BY maker.flip_beg.id;
+ALTER SEQUENCE maker.chief_lock_id_seq OWNED BY maker.chief_lock.id;
 
 
 --
--- Name: flip_bid_bid; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_vote; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_bid (
+CREATE TABLE maker.chief_vote (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    bid numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    slate text
 );
 
 
 --
--- Name: flip_bid_bid_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_vote_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_bid_id_seq
+CREATE SEQUENCE maker.chief_vote_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5286,31 +6126,31 @@ CREATE SEQUENCE maker.flip_bid_bid_id_seq
 
 
 --
--- Name: flip_bid_bid_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_vote_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_bid_id_seq OWNED BY maker.flip_bid_bid.id;
+ALTER SEQUENCE maker.chief_vote_id_seq OWNED BY maker.chief_vote.id;
 
 
 --
--- Name: flip_bid_end; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_vote_yays; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_end (
+CREATE TABLE maker.chief_vote_yays (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    "end" bigint NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    slate text,
+    yays text[]
 );
 
 
 --
--- Name: flip_bid_end_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_vote_yays_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_end_id_seq
+CREATE SEQUENCE maker.chief_vote_yays_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5320,31 +6160,30 @@ CREATE SEQUENCE maker.flip_bid_end_id_seq
 
 
 --
--- Name: flip_bid_end_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_vote_yays_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_end_id_seq OWNED BY maker.flip_bid_end.id;
+ALTER SEQUENCE maker.chief_vote_yays_id_seq OWNED BY maker.chief_vote_yays.id;
 
 
 --
--- Name: flip_bid_gal; Type: TABLE; Schema: maker; Owner: -
+-- Name: chief_votes; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_gal (
+CREATE TABLE maker.chief_votes (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    gal text
+    msg_sender text,
+    slate text
 );
 
 
 --
--- Name: flip_bid_gal_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: chief_votes_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_gal_id_seq
+CREATE SEQUENCE maker.chief_votes_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5354,31 +6193,31 @@ CREATE SEQUENCE maker.flip_bid_gal_id_seq
 
 
 --
--- Name: flip_bid_gal_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: chief_votes_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_gal_id_seq OWNED BY maker.flip_bid_gal.id;
+ALTER SEQUENCE maker.chief_votes_id_seq OWNED BY maker.chief_votes.id;
 
 
 --
--- Name: flip_bid_guy; Type: TABLE; Schema: maker; Owner: -
+-- Name: dai_allowance; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_guy (
+CREATE TABLE maker.dai_allowance (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    guy text
+    owner text,
+    spender text,
+    allowance numeric NOT NULL
 );
 
 
 --
--- Name: flip_bid_guy_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_allowance_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_guy_id_seq
+CREATE SEQUENCE maker.dai_allowance_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5388,31 +6227,31 @@ CREATE SEQUENCE maker.flip_bid_guy_id_seq
 
 
 --
--- Name: flip_bid_guy_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_allowance_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_guy_id_seq OWNED BY maker.flip_bid_guy.id;
+ALTER SEQUENCE maker.dai_allowance_id_seq OWNED BY maker.dai_allowance.id;
 
 
 --
--- Name: flip_bid_lot; Type: TABLE; Schema: maker; Owner: -
+-- Name: dai_approval; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_lot (
+CREATE TABLE maker.dai_approval (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    src text,
+    guy text,
+    wad numeric
 );
 
 
 --
--- Name: flip_bid_lot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_approval_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_lot_id_seq
+CREATE SEQUENCE maker.dai_approval_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5422,31 +6261,30 @@ CREATE SEQUENCE maker.flip_bid_lot_id_seq
 
 
 --
--- Name: flip_bid_lot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_approval_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_lot_id_seq OWNED BY maker.flip_bid_lot.id;
+ALTER SEQUENCE maker.dai_approval_id_seq OWNED BY maker.dai_approval.id;
 
 
 --
--- Name: flip_bid_tab; Type: TABLE; Schema: maker; Owner: -
+-- Name: dai_balance_of; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_tab (
+CREATE TABLE maker.dai_balance_of (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    tab numeric NOT NULL
+    guy text,
+    balance numeric NOT NULL
 );
 
 
 --
--- Name: flip_bid_tab_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_balance_of_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_tab_id_seq
+CREATE SEQUENCE maker.dai_balance_of_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5456,31 +6294,32 @@ CREATE SEQUENCE maker.flip_bid_tab_id_seq
 
 
 --
--- Name: flip_bid_tab_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_balance_of_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_tab_id_seq OWNED BY maker.flip_bid_tab.id;
+ALTER SEQUENCE maker.dai_balance_of_id_seq OWNED BY maker.dai_balance_of.id;
 
 
 --
--- Name: flip_bid_tic; Type: TABLE; Schema: maker; Owner: -
+-- Name: dai_exit; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_tic (
+CREATE TABLE maker.dai_exit (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
     address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    tic bigint NOT NULL
+    msg_sender text,
+    usr text,
+    wad numeric
 );
 
 
 --
--- Name: flip_bid_tic_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_exit_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_tic_id_seq
+CREATE SEQUENCE maker.dai_exit_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5490,31 +6329,32 @@ CREATE SEQUENCE maker.flip_bid_tic_id_seq
 
 
 --
--- Name: flip_bid_tic_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_exit_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_tic_id_seq OWNED BY maker.flip_bid_tic.id;
+ALTER SEQUENCE maker.dai_exit_id_seq OWNED BY maker.dai_exit.id;
 
 
 --
--- Name: flip_bid_usr; Type: TABLE; Schema: maker; Owner: -
+-- Name: dai_join; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_bid_usr (
+CREATE TABLE maker.dai_join (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
     address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    usr text
+    msg_sender text,
+    usr text,
+    wad numeric
 );
 
 
 --
--- Name: flip_bid_usr_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_join_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_bid_usr_id_seq
+CREATE SEQUENCE maker.dai_join_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5524,17 +6364,30 @@ CREATE SEQUENCE maker.flip_bid_usr_id_seq
 
 
 --
--- Name: flip_bid_usr_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_join_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_bid_usr_id_seq OWNED BY maker.flip_bid_usr.id;
+ALTER SEQUENCE maker.dai_join_id_seq OWNED BY maker.dai_join.id;
 
 
 --
--- Name: flip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_nonces; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_id_seq
+CREATE TABLE maker.dai_nonces (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    guy text,
+    nonce numeric NOT NULL
+);
+
+
+--
+-- Name: dai_nonces_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.dai_nonces_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5544,30 +6397,29 @@ CREATE SEQUENCE maker.flip_id_seq
 
 
 --
--- Name: flip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_nonces_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_id_seq OWNED BY maker.flip.id;
+ALTER SEQUENCE maker.dai_nonces_id_seq OWNED BY maker.dai_nonces.id;
 
 
 --
--- Name: flip_ilk; Type: TABLE; Schema: maker; Owner: -
+-- Name: dai_total_supply; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_ilk (
+CREATE TABLE maker.dai_total_supply (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    ilk_id integer NOT NULL
+    total_supply numeric NOT NULL
 );
 
 
 --
--- Name: flip_ilk_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_total_supply_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_ilk_id_seq
+CREATE SEQUENCE maker.dai_total_supply_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5577,42 +6429,31 @@ CREATE SEQUENCE maker.flip_ilk_id_seq
 
 
 --
--- Name: flip_ilk_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_total_supply_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_ilk_id_seq OWNED BY maker.flip_ilk.id;
+ALTER SEQUENCE maker.dai_total_supply_id_seq OWNED BY maker.dai_total_supply.id;
 
 
 --
--- Name: flip_kick; Type: TABLE; Schema: maker; Owner: -
+-- Name: dai_transfer; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_kick (
+CREATE TABLE maker.dai_transfer (
     id integer NOT NULL,
     header_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric,
-    bid numeric,
-    tab numeric,
-    usr text,
-    gal text,
-    address_id integer NOT NULL,
-    log_id bigint NOT NULL
+    log_id bigint NOT NULL,
+    src text,
+    dst text,
+    wad numeric
 );
 
 
 --
--- Name: TABLE flip_kick; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON TABLE maker.flip_kick IS '@name flipKickEvent';
-
-
---
--- Name: flip_kick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dai_transfer_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_kick_id_seq
+CREATE SEQUENCE maker.dai_transfer_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5622,37 +6463,30 @@ CREATE SEQUENCE maker.flip_kick_id_seq
 
 
 --
--- Name: flip_kick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dai_transfer_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_kick_id_seq OWNED BY maker.flip_kick.id;
+ALTER SEQUENCE maker.dai_transfer_id_seq OWNED BY maker.dai_transfer.id;
 
 
 --
--- Name: flip_kicks; Type: TABLE; Schema: maker; Owner: -
+-- Name: deal; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_kicks (
+CREATE TABLE maker.deal (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    kicks numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    bid_id numeric NOT NULL,
+    address_id integer NOT NULL
 );
 
 
 --
--- Name: TABLE flip_kicks; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON TABLE maker.flip_kicks IS '@name flipKicksStorage';
-
-
---
--- Name: flip_kicks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: deal_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_kicks_id_seq
+CREATE SEQUENCE maker.deal_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5662,30 +6496,32 @@ CREATE SEQUENCE maker.flip_kicks_id_seq
 
 
 --
--- Name: flip_kicks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: deal_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_kicks_id_seq OWNED BY maker.flip_kicks.id;
+ALTER SEQUENCE maker.deal_id_seq OWNED BY maker.deal.id;
 
 
 --
--- Name: flip_tau; Type: TABLE; Schema: maker; Owner: -
+-- Name: dent; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_tau (
+CREATE TABLE maker.dent (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    tau numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    bid_id numeric NOT NULL,
+    lot numeric,
+    bid numeric,
+    address_id integer NOT NULL
 );
 
 
 --
--- Name: flip_tau_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: dent_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_tau_id_seq
+CREATE SEQUENCE maker.dent_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5695,30 +6531,30 @@ CREATE SEQUENCE maker.flip_tau_id_seq
 
 
 --
--- Name: flip_tau_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: dent_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_tau_id_seq OWNED BY maker.flip_tau.id;
+ALTER SEQUENCE maker.dent_id_seq OWNED BY maker.dent.id;
 
 
 --
--- Name: flip_ttl; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_bag; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_ttl (
+CREATE TABLE maker.end_bag (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    ttl numeric NOT NULL
+    msg_sender text,
+    bag numeric NOT NULL
 );
 
 
 --
--- Name: flip_ttl_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_bag_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_ttl_id_seq
+CREATE SEQUENCE maker.end_bag_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5728,30 +6564,28 @@ CREATE SEQUENCE maker.flip_ttl_id_seq
 
 
 --
--- Name: flip_ttl_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_bag_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_ttl_id_seq OWNED BY maker.flip_ttl.id;
+ALTER SEQUENCE maker.end_bag_id_seq OWNED BY maker.end_bag.id;
 
 
 --
--- Name: flip_vat; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_cage; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flip_vat (
+CREATE TABLE maker.end_cage (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    vat text
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL
 );
 
 
 --
--- Name: flip_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_cage_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flip_vat_id_seq
+CREATE SEQUENCE maker.end_cage_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5761,50 +6595,29 @@ CREATE SEQUENCE maker.flip_vat_id_seq
 
 
 --
--- Name: flip_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_cage_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flip_vat_id_seq OWNED BY maker.flip_vat.id;
+ALTER SEQUENCE maker.end_cage_id_seq OWNED BY maker.end_cage.id;
 
 
 --
--- Name: flop; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_cage_ilk; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop (
+CREATE TABLE maker.end_cage_ilk (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric,
-    guy text,
-    tic bigint,
-    "end" bigint,
-    lot numeric,
-    bid numeric,
-    created timestamp without time zone,
-    updated timestamp without time zone
-);
-
-
---
--- Name: flop_beg; Type: TABLE; Schema: maker; Owner: -
---
-
-CREATE TABLE maker.flop_beg (
-    id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    beg numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL
 );
 
 
 --
--- Name: flop_beg_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_cage_ilk_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_beg_id_seq
+CREATE SEQUENCE maker.end_cage_ilk_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5814,31 +6627,31 @@ CREATE SEQUENCE maker.flop_beg_id_seq
 
 
 --
--- Name: flop_beg_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_cage_ilk_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_beg_id_seq OWNED BY maker.flop_beg.id;
+ALTER SEQUENCE maker.end_cage_ilk_id_seq OWNED BY maker.end_cage_ilk.id;
 
 
 --
--- Name: flop_bid_bid; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_cash; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_bid_bid (
+CREATE TABLE maker.end_cash (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    bid numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    msg_sender text,
+    wad numeric
 );
 
 
 --
--- Name: flop_bid_bid_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_cash_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_bid_bid_id_seq
+CREATE SEQUENCE maker.end_cash_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5848,31 +6661,29 @@ CREATE SEQUENCE maker.flop_bid_bid_id_seq
 
 
 --
--- Name: flop_bid_bid_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_cash_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_bid_bid_id_seq OWNED BY maker.flop_bid_bid.id;
+ALTER SEQUENCE maker.end_cash_id_seq OWNED BY maker.end_cash.id;
 
 
 --
--- Name: flop_bid_end; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_debt; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_bid_end (
+CREATE TABLE maker.end_debt (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    "end" bigint NOT NULL
+    debt numeric NOT NULL
 );
 
 
 --
--- Name: flop_bid_end_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_debt_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_bid_end_id_seq
+CREATE SEQUENCE maker.end_debt_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5882,31 +6693,29 @@ CREATE SEQUENCE maker.flop_bid_end_id_seq
 
 
 --
--- Name: flop_bid_end_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_debt_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_bid_end_id_seq OWNED BY maker.flop_bid_end.id;
+ALTER SEQUENCE maker.end_debt_id_seq OWNED BY maker.end_debt.id;
 
 
 --
--- Name: flop_bid_guy; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_flow; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_bid_guy (
+CREATE TABLE maker.end_flow (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    guy text
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL
 );
 
 
 --
--- Name: flop_bid_guy_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_flow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_bid_guy_id_seq
+CREATE SEQUENCE maker.end_flow_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5916,31 +6725,29 @@ CREATE SEQUENCE maker.flop_bid_guy_id_seq
 
 
 --
--- Name: flop_bid_guy_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_flow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_bid_guy_id_seq OWNED BY maker.flop_bid_guy.id;
+ALTER SEQUENCE maker.end_flow_id_seq OWNED BY maker.end_flow.id;
 
 
 --
--- Name: flop_bid_lot; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_free; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_bid_lot (
+CREATE TABLE maker.end_free (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    urn_id integer NOT NULL
 );
 
 
 --
--- Name: flop_bid_lot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_free_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_bid_lot_id_seq
+CREATE SEQUENCE maker.end_free_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5950,31 +6757,30 @@ CREATE SEQUENCE maker.flop_bid_lot_id_seq
 
 
 --
--- Name: flop_bid_lot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_free_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_bid_lot_id_seq OWNED BY maker.flop_bid_lot.id;
+ALTER SEQUENCE maker.end_free_id_seq OWNED BY maker.end_free.id;
 
 
 --
--- Name: flop_bid_tic; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_ilk_art; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_bid_tic (
+CREATE TABLE maker.end_ilk_art (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    bid_id numeric NOT NULL,
-    tic bigint NOT NULL
+    ilk_id integer NOT NULL,
+    art numeric NOT NULL
 );
 
 
 --
--- Name: flop_bid_tic_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_ilk_art_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_bid_tic_id_seq
+CREATE SEQUENCE maker.end_ilk_art_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -5984,30 +6790,30 @@ CREATE SEQUENCE maker.flop_bid_tic_id_seq
 
 
 --
--- Name: flop_bid_tic_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_ilk_art_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_bid_tic_id_seq OWNED BY maker.flop_bid_tic.id;
+ALTER SEQUENCE maker.end_ilk_art_id_seq OWNED BY maker.end_ilk_art.id;
 
 
 --
--- Name: flop_gem; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_ilk_fix; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_gem (
+CREATE TABLE maker.end_ilk_fix (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    gem text
+    ilk_id integer NOT NULL,
+    fix numeric NOT NULL
 );
 
 
 --
--- Name: flop_gem_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_ilk_fix_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_gem_id_seq
+CREATE SEQUENCE maker.end_ilk_fix_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6017,17 +6823,30 @@ CREATE SEQUENCE maker.flop_gem_id_seq
 
 
 --
--- Name: flop_gem_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_ilk_fix_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_gem_id_seq OWNED BY maker.flop_gem.id;
+ALTER SEQUENCE maker.end_ilk_fix_id_seq OWNED BY maker.end_ilk_fix.id;
 
 
 --
--- Name: flop_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_ilk_gap; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_id_seq
+CREATE TABLE maker.end_ilk_gap (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    gap numeric NOT NULL
+);
+
+
+--
+-- Name: end_ilk_gap_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.end_ilk_gap_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6037,40 +6856,30 @@ CREATE SEQUENCE maker.flop_id_seq
 
 
 --
--- Name: flop_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_ilk_gap_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_id_seq OWNED BY maker.flop.id;
+ALTER SEQUENCE maker.end_ilk_gap_id_seq OWNED BY maker.end_ilk_gap.id;
 
 
 --
--- Name: flop_kick; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_ilk_tag; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_kick (
+CREATE TABLE maker.end_ilk_tag (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric NOT NULL,
-    bid numeric NOT NULL,
-    gal text,
-    address_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    tag numeric NOT NULL
 );
 
 
 --
--- Name: TABLE flop_kick; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON TABLE maker.flop_kick IS '@name flopKickEvent';
-
-
---
--- Name: flop_kick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_ilk_tag_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_kick_id_seq
+CREATE SEQUENCE maker.end_ilk_tag_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6080,37 +6889,29 @@ CREATE SEQUENCE maker.flop_kick_id_seq
 
 
 --
--- Name: flop_kick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_ilk_tag_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_kick_id_seq OWNED BY maker.flop_kick.id;
+ALTER SEQUENCE maker.end_ilk_tag_id_seq OWNED BY maker.end_ilk_tag.id;
 
 
 --
--- Name: flop_kicks; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_live; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_kicks (
+CREATE TABLE maker.end_live (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    kicks numeric NOT NULL
+    live numeric NOT NULL
 );
 
 
 --
--- Name: TABLE flop_kicks; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON TABLE maker.flop_kicks IS '@name flopKicksStorage';
-
-
---
--- Name: flop_kicks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_kicks_id_seq
+CREATE SEQUENCE maker.end_live_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6120,30 +6921,31 @@ CREATE SEQUENCE maker.flop_kicks_id_seq
 
 
 --
--- Name: flop_kicks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_kicks_id_seq OWNED BY maker.flop_kicks.id;
+ALTER SEQUENCE maker.end_live_id_seq OWNED BY maker.end_live.id;
 
 
 --
--- Name: flop_live; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_out; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_live (
+CREATE TABLE maker.end_out (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    live numeric NOT NULL
+    ilk_id integer NOT NULL,
+    msg_sender text,
+    "out" numeric NOT NULL
 );
 
 
 --
--- Name: flop_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_out_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_live_id_seq
+CREATE SEQUENCE maker.end_out_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6153,30 +6955,30 @@ CREATE SEQUENCE maker.flop_live_id_seq
 
 
 --
--- Name: flop_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_out_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_live_id_seq OWNED BY maker.flop_live.id;
+ALTER SEQUENCE maker.end_out_id_seq OWNED BY maker.end_out.id;
 
 
 --
--- Name: flop_pad; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_pack; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_pad (
+CREATE TABLE maker.end_pack (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    pad numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    wad numeric
 );
 
 
 --
--- Name: flop_pad_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_pack_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_pad_id_seq
+CREATE SEQUENCE maker.end_pack_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6186,30 +6988,29 @@ CREATE SEQUENCE maker.flop_pad_id_seq
 
 
 --
--- Name: flop_pad_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_pack_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_pad_id_seq OWNED BY maker.flop_pad.id;
+ALTER SEQUENCE maker.end_pack_id_seq OWNED BY maker.end_pack.id;
 
 
 --
--- Name: flop_tau; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_skim; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_tau (
+CREATE TABLE maker.end_skim (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    tau numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    urn_id integer NOT NULL
 );
 
 
 --
--- Name: flop_tau_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_skim_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_tau_id_seq
+CREATE SEQUENCE maker.end_skim_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6219,30 +7020,28 @@ CREATE SEQUENCE maker.flop_tau_id_seq
 
 
 --
--- Name: flop_tau_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_skim_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_tau_id_seq OWNED BY maker.flop_tau.id;
+ALTER SEQUENCE maker.end_skim_id_seq OWNED BY maker.end_skim.id;
 
 
 --
--- Name: flop_ttl; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_thaw; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_ttl (
+CREATE TABLE maker.end_thaw (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    address_id integer NOT NULL,
-    ttl numeric NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL
 );
 
 
 --
--- Name: flop_ttl_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_thaw_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_ttl_id_seq
+CREATE SEQUENCE maker.end_thaw_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6252,30 +7051,29 @@ CREATE SEQUENCE maker.flop_ttl_id_seq
 
 
 --
--- Name: flop_ttl_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_thaw_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_ttl_id_seq OWNED BY maker.flop_ttl.id;
+ALTER SEQUENCE maker.end_thaw_id_seq OWNED BY maker.end_thaw.id;
 
 
 --
--- Name: flop_vat; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_wait; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.flop_vat (
+CREATE TABLE maker.end_wait (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    address_id integer NOT NULL,
-    vat text
+    wait numeric NOT NULL
 );
 
 
 --
--- Name: flop_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_wait_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.flop_vat_id_seq
+CREATE SEQUENCE maker.end_wait_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6285,35 +7083,29 @@ CREATE SEQUENCE maker.flop_vat_id_seq
 
 
 --
--- Name: flop_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_wait_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.flop_vat_id_seq OWNED BY maker.flop_vat.id;
+ALTER SEQUENCE maker.end_wait_id_seq OWNED BY maker.end_wait.id;
 
 
 --
--- Name: ilks; Type: TABLE; Schema: maker; Owner: -
+-- Name: end_when; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.ilks (
+CREATE TABLE maker.end_when (
     id integer NOT NULL,
-    ilk text NOT NULL,
-    identifier text NOT NULL
+    block_number bigint,
+    block_hash text,
+    "when" numeric NOT NULL
 );
 
 
 --
--- Name: TABLE ilks; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON TABLE maker.ilks IS '@name raw_ilks';
-
-
---
--- Name: ilks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: end_when_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.ilks_id_seq
+CREATE SEQUENCE maker.end_when_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6323,29 +7115,29 @@ CREATE SEQUENCE maker.ilks_id_seq
 
 
 --
--- Name: ilks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: end_when_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.ilks_id_seq OWNED BY maker.ilks.id;
+ALTER SEQUENCE maker.end_when_id_seq OWNED BY maker.end_when.id;
 
 
 --
--- Name: jug_base; Type: TABLE; Schema: maker; Owner: -
+-- Name: esm_fire; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_base (
+CREATE TABLE maker.esm_fire (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    base text
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text
 );
 
 
 --
--- Name: jug_base_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: esm_fire_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_base_id_seq
+CREATE SEQUENCE maker.esm_fire_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6355,29 +7147,29 @@ CREATE SEQUENCE maker.jug_base_id_seq
 
 
 --
--- Name: jug_base_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: esm_fire_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_base_id_seq OWNED BY maker.jug_base.id;
+ALTER SEQUENCE maker.esm_fire_id_seq OWNED BY maker.esm_fire.id;
 
 
 --
--- Name: jug_drip; Type: TABLE; Schema: maker; Owner: -
+-- Name: esm_fired; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_drip (
+CREATE TABLE maker.esm_fired (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    fired numeric NOT NULL
 );
 
 
 --
--- Name: jug_drip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: esm_fired_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_drip_id_seq
+CREATE SEQUENCE maker.esm_fired_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6387,30 +7179,30 @@ CREATE SEQUENCE maker.jug_drip_id_seq
 
 
 --
--- Name: jug_drip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: esm_fired_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_drip_id_seq OWNED BY maker.jug_drip.id;
+ALTER SEQUENCE maker.esm_fired_id_seq OWNED BY maker.esm_fired.id;
 
 
 --
--- Name: jug_file_base; Type: TABLE; Schema: maker; Owner: -
+-- Name: esm_join; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_file_base (
+CREATE TABLE maker.esm_join (
     id integer NOT NULL,
     header_id integer NOT NULL,
     log_id bigint NOT NULL,
-    what text,
-    data numeric
+    msg_sender text,
+    wad numeric
 );
 
 
 --
--- Name: jug_file_base_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: esm_join_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_file_base_id_seq
+CREATE SEQUENCE maker.esm_join_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6420,31 +7212,29 @@ CREATE SEQUENCE maker.jug_file_base_id_seq
 
 
 --
--- Name: jug_file_base_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: esm_join_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_file_base_id_seq OWNED BY maker.jug_file_base.id;
+ALTER SEQUENCE maker.esm_join_id_seq OWNED BY maker.esm_join.id;
 
 
 --
--- Name: jug_file_ilk; Type: TABLE; Schema: maker; Owner: -
+-- Name: esm_min; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_file_ilk (
+CREATE TABLE maker.esm_min (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    what text,
-    data numeric
+    block_number bigint,
+    block_hash text,
+    min numeric NOT NULL
 );
 
 
 --
--- Name: jug_file_ilk_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: esm_min_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_file_ilk_id_seq
+CREATE SEQUENCE maker.esm_min_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6454,30 +7244,29 @@ CREATE SEQUENCE maker.jug_file_ilk_id_seq
 
 
 --
--- Name: jug_file_ilk_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: esm_min_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_file_ilk_id_seq OWNED BY maker.jug_file_ilk.id;
+ALTER SEQUENCE maker.esm_min_id_seq OWNED BY maker.esm_min.id;
 
 
 --
--- Name: jug_file_vow; Type: TABLE; Schema: maker; Owner: -
+-- Name: esm_sum; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_file_vow (
+CREATE TABLE maker.esm_sum (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    what text,
-    data text
+    block_number bigint,
+    block_hash text,
+    sum numeric NOT NULL
 );
 
 
 --
--- Name: jug_file_vow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: esm_sum_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_file_vow_id_seq
+CREATE SEQUENCE maker.esm_sum_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6487,30 +7276,30 @@ CREATE SEQUENCE maker.jug_file_vow_id_seq
 
 
 --
--- Name: jug_file_vow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: esm_sum_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_file_vow_id_seq OWNED BY maker.jug_file_vow.id;
+ALTER SEQUENCE maker.esm_sum_id_seq OWNED BY maker.esm_sum.id;
 
 
 --
--- Name: jug_ilk_duty; Type: TABLE; Schema: maker; Owner: -
+-- Name: esm_user_sum; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_ilk_duty (
+CREATE TABLE maker.esm_user_sum (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ilk_id integer NOT NULL,
-    duty numeric NOT NULL
+    msg_sender text,
+    sum numeric NOT NULL
 );
 
 
 --
--- Name: jug_ilk_duty_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: esm_user_sum_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_ilk_duty_id_seq
+CREATE SEQUENCE maker.esm_user_sum_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6520,30 +7309,50 @@ CREATE SEQUENCE maker.jug_ilk_duty_id_seq
 
 
 --
--- Name: jug_ilk_duty_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: esm_user_sum_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_ilk_duty_id_seq OWNED BY maker.jug_ilk_duty.id;
+ALTER SEQUENCE maker.esm_user_sum_id_seq OWNED BY maker.esm_user_sum.id;
 
 
 --
--- Name: jug_ilk_rho; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_ilk_rho (
+CREATE TABLE maker.flap (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ilk_id integer NOT NULL,
-    rho numeric NOT NULL
+    address_id integer NOT NULL,
+    bid_id numeric,
+    guy text,
+    tic bigint,
+    "end" bigint,
+    lot numeric,
+    bid numeric,
+    created timestamp without time zone,
+    updated timestamp without time zone
 );
 
 
 --
--- Name: jug_ilk_rho_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_beg; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_ilk_rho_id_seq
+CREATE TABLE maker.flap_beg (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    beg numeric NOT NULL
+);
+
+
+--
+-- Name: flap_beg_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flap_beg_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6553,29 +7362,31 @@ CREATE SEQUENCE maker.jug_ilk_rho_id_seq
 
 
 --
--- Name: jug_ilk_rho_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_beg_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_ilk_rho_id_seq OWNED BY maker.jug_ilk_rho.id;
+ALTER SEQUENCE maker.flap_beg_id_seq OWNED BY maker.flap_beg.id;
 
 
 --
--- Name: jug_init; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_bid_bid; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_init (
+CREATE TABLE maker.flap_bid_bid (
     id integer NOT NULL,
-    log_id bigint NOT NULL,
-    header_id integer NOT NULL,
-    ilk_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    bid numeric NOT NULL
 );
 
 
 --
--- Name: jug_init_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_bid_bid_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_init_id_seq
+CREATE SEQUENCE maker.flap_bid_bid_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6585,29 +7396,31 @@ CREATE SEQUENCE maker.jug_init_id_seq
 
 
 --
--- Name: jug_init_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_bid_bid_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_init_id_seq OWNED BY maker.jug_init.id;
+ALTER SEQUENCE maker.flap_bid_bid_id_seq OWNED BY maker.flap_bid_bid.id;
 
 
 --
--- Name: jug_vat; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_bid_end; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_vat (
+CREATE TABLE maker.flap_bid_end (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    vat text
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    "end" bigint NOT NULL
 );
 
 
 --
--- Name: jug_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_bid_end_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_vat_id_seq
+CREATE SEQUENCE maker.flap_bid_end_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6617,29 +7430,31 @@ CREATE SEQUENCE maker.jug_vat_id_seq
 
 
 --
--- Name: jug_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_bid_end_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_vat_id_seq OWNED BY maker.jug_vat.id;
+ALTER SEQUENCE maker.flap_bid_end_id_seq OWNED BY maker.flap_bid_end.id;
 
 
 --
--- Name: jug_vow; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_bid_guy; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.jug_vow (
+CREATE TABLE maker.flap_bid_guy (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    vow text
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    guy text NOT NULL
 );
 
 
 --
--- Name: jug_vow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_bid_guy_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.jug_vow_id_seq
+CREATE SEQUENCE maker.flap_bid_guy_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6649,38 +7464,31 @@ CREATE SEQUENCE maker.jug_vow_id_seq
 
 
 --
--- Name: jug_vow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_bid_guy_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.jug_vow_id_seq OWNED BY maker.jug_vow.id;
+ALTER SEQUENCE maker.flap_bid_guy_id_seq OWNED BY maker.flap_bid_guy.id;
 
 
 --
--- Name: new_cdp; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_bid_lot; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.new_cdp (
+CREATE TABLE maker.flap_bid_lot (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    usr text,
-    own text,
-    cdp numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    lot numeric NOT NULL
 );
 
 
 --
--- Name: COLUMN new_cdp.id; Type: COMMENT; Schema: maker; Owner: -
---
-
-COMMENT ON COLUMN maker.new_cdp.id IS '@omit';
-
-
---
--- Name: new_cdp_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_bid_lot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.new_cdp_id_seq
+CREATE SEQUENCE maker.flap_bid_lot_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6690,31 +7498,31 @@ CREATE SEQUENCE maker.new_cdp_id_seq
 
 
 --
--- Name: new_cdp_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_bid_lot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.new_cdp_id_seq OWNED BY maker.new_cdp.id;
+ALTER SEQUENCE maker.flap_bid_lot_id_seq OWNED BY maker.flap_bid_lot.id;
 
 
 --
--- Name: spot_file_mat; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_bid_tic; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.spot_file_mat (
+CREATE TABLE maker.flap_bid_tic (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    what text,
-    data numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    tic bigint NOT NULL
 );
 
 
 --
--- Name: spot_file_mat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_bid_tic_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.spot_file_mat_id_seq
+CREATE SEQUENCE maker.flap_bid_tic_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6724,31 +7532,30 @@ CREATE SEQUENCE maker.spot_file_mat_id_seq
 
 
 --
--- Name: spot_file_mat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_bid_tic_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.spot_file_mat_id_seq OWNED BY maker.spot_file_mat.id;
+ALTER SEQUENCE maker.flap_bid_tic_id_seq OWNED BY maker.flap_bid_tic.id;
 
 
 --
--- Name: spot_file_pip; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_gem; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.spot_file_pip (
+CREATE TABLE maker.flap_gem (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    what text,
-    pip text
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    gem text NOT NULL
 );
 
 
 --
--- Name: spot_file_pip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_gem_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.spot_file_pip_id_seq
+CREATE SEQUENCE maker.flap_gem_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6758,30 +7565,17 @@ CREATE SEQUENCE maker.spot_file_pip_id_seq
 
 
 --
--- Name: spot_file_pip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
---
-
-ALTER SEQUENCE maker.spot_file_pip_id_seq OWNED BY maker.spot_file_pip.id;
-
-
---
--- Name: spot_ilk_mat; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_gem_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.spot_ilk_mat (
-    id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    ilk_id integer NOT NULL,
-    mat numeric NOT NULL
-);
+ALTER SEQUENCE maker.flap_gem_id_seq OWNED BY maker.flap_gem.id;
 
 
 --
--- Name: spot_ilk_mat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.spot_ilk_mat_id_seq
+CREATE SEQUENCE maker.flap_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6791,30 +7585,39 @@ CREATE SEQUENCE maker.spot_ilk_mat_id_seq
 
 
 --
--- Name: spot_ilk_mat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.spot_ilk_mat_id_seq OWNED BY maker.spot_ilk_mat.id;
+ALTER SEQUENCE maker.flap_id_seq OWNED BY maker.flap.id;
 
 
 --
--- Name: spot_ilk_pip; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_kick; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.spot_ilk_pip (
+CREATE TABLE maker.flap_kick (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    ilk_id integer NOT NULL,
-    pip text
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    bid_id numeric NOT NULL,
+    lot numeric NOT NULL,
+    bid numeric NOT NULL,
+    address_id integer NOT NULL
 );
 
 
 --
--- Name: spot_ilk_pip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE flap_kick; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.spot_ilk_pip_id_seq
+COMMENT ON TABLE maker.flap_kick IS '@name flapKickEvent';
+
+
+--
+-- Name: flap_kick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flap_kick_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6824,63 +7627,70 @@ CREATE SEQUENCE maker.spot_ilk_pip_id_seq
 
 
 --
--- Name: spot_ilk_pip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_kick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.spot_ilk_pip_id_seq OWNED BY maker.spot_ilk_pip.id;
+ALTER SEQUENCE maker.flap_kick_id_seq OWNED BY maker.flap_kick.id;
 
 
 --
--- Name: spot_par; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_kicks; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.spot_par (
+CREATE TABLE maker.flap_kicks (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    par numeric NOT NULL
+    address_id integer NOT NULL,
+    kicks numeric NOT NULL
 );
 
 
 --
--- Name: spot_par_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE flap_kicks; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.spot_par_id_seq
-    AS integer
-    START WITH 1
-    INCREMENT BY 1
+COMMENT ON TABLE maker.flap_kicks IS '@name flapKicksStorage';
+
+
+--
+-- Name: flap_kicks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flap_kicks_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
     NO MINVALUE
     NO MAXVALUE
     CACHE 1;
 
 
 --
--- Name: spot_par_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_kicks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.spot_par_id_seq OWNED BY maker.spot_par.id;
+ALTER SEQUENCE maker.flap_kicks_id_seq OWNED BY maker.flap_kicks.id;
 
 
 --
--- Name: spot_poke; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_live; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.spot_poke (
+CREATE TABLE maker.flap_live (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    value numeric,
-    spot numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    live numeric NOT NULL
 );
 
 
 --
--- Name: spot_poke_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.spot_poke_id_seq
+CREATE SEQUENCE maker.flap_live_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6890,29 +7700,30 @@ CREATE SEQUENCE maker.spot_poke_id_seq
 
 
 --
--- Name: spot_poke_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.spot_poke_id_seq OWNED BY maker.spot_poke.id;
+ALTER SEQUENCE maker.flap_live_id_seq OWNED BY maker.flap_live.id;
 
 
 --
--- Name: spot_vat; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_tau; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.spot_vat (
+CREATE TABLE maker.flap_tau (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    vat text
+    address_id integer NOT NULL,
+    tau integer NOT NULL
 );
 
 
 --
--- Name: spot_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_tau_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.spot_vat_id_seq
+CREATE SEQUENCE maker.flap_tau_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6922,32 +7733,30 @@ CREATE SEQUENCE maker.spot_vat_id_seq
 
 
 --
--- Name: spot_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_tau_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.spot_vat_id_seq OWNED BY maker.spot_vat.id;
+ALTER SEQUENCE maker.flap_tau_id_seq OWNED BY maker.flap_tau.id;
 
 
 --
--- Name: tend; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_ttl; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.tend (
+CREATE TABLE maker.flap_ttl (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    bid_id numeric NOT NULL,
-    lot numeric,
-    bid numeric,
-    address_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    ttl integer NOT NULL
 );
 
 
 --
--- Name: tend_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_ttl_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.tend_id_seq
+CREATE SEQUENCE maker.flap_ttl_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6957,30 +7766,30 @@ CREATE SEQUENCE maker.tend_id_seq
 
 
 --
--- Name: tend_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_ttl_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.tend_id_seq OWNED BY maker.tend.id;
+ALTER SEQUENCE maker.flap_ttl_id_seq OWNED BY maker.flap_ttl.id;
 
 
 --
--- Name: tick; Type: TABLE; Schema: maker; Owner: -
+-- Name: flap_vat; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.tick (
+CREATE TABLE maker.flap_vat (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    bid_id numeric NOT NULL,
-    address_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    vat text NOT NULL
 );
 
 
 --
--- Name: tick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flap_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.tick_id_seq
+CREATE SEQUENCE maker.flap_vat_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -6990,35 +7799,52 @@ CREATE SEQUENCE maker.tick_id_seq
 
 
 --
--- Name: tick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flap_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.tick_id_seq OWNED BY maker.tick.id;
+ALTER SEQUENCE maker.flap_vat_id_seq OWNED BY maker.flap_vat.id;
 
 
 --
--- Name: urns; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.urns (
+CREATE TABLE maker.flip (
     id integer NOT NULL,
-    ilk_id integer NOT NULL,
-    identifier public.citext
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric,
+    guy text,
+    tic bigint,
+    "end" bigint,
+    lot numeric,
+    bid numeric,
+    gal text,
+    tab numeric,
+    created timestamp without time zone,
+    updated timestamp without time zone
 );
 
 
 --
--- Name: TABLE urns; Type: COMMENT; Schema: maker; Owner: -
+-- Name: flip_beg; Type: TABLE; Schema: maker; Owner: -
 --
 
-COMMENT ON TABLE maker.urns IS '@name raw_urns';
+CREATE TABLE maker.flip_beg (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    beg numeric NOT NULL
+);
 
 
 --
--- Name: urns_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_beg_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.urns_id_seq
+CREATE SEQUENCE maker.flip_beg_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7028,30 +7854,31 @@ CREATE SEQUENCE maker.urns_id_seq
 
 
 --
--- Name: urns_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_beg_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.urns_id_seq OWNED BY maker.urns.id;
+ALTER SEQUENCE maker.flip_beg_id_seq OWNED BY maker.flip_beg.id;
 
 
 --
--- Name: vat_dai; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_bid; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_dai (
+CREATE TABLE maker.flip_bid_bid (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    guy text,
-    dai numeric NOT NULL
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    bid numeric NOT NULL
 );
 
 
 --
--- Name: vat_dai_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_bid_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_dai_id_seq
+CREATE SEQUENCE maker.flip_bid_bid_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7061,29 +7888,31 @@ CREATE SEQUENCE maker.vat_dai_id_seq
 
 
 --
--- Name: vat_dai_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_bid_bid_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_dai_id_seq OWNED BY maker.vat_dai.id;
+ALTER SEQUENCE maker.flip_bid_bid_id_seq OWNED BY maker.flip_bid_bid.id;
 
 
 --
--- Name: vat_debt; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_end; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_debt (
+CREATE TABLE maker.flip_bid_end (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    debt numeric NOT NULL
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    "end" bigint NOT NULL
 );
 
 
 --
--- Name: vat_debt_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_end_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_debt_id_seq
+CREATE SEQUENCE maker.flip_bid_end_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7093,30 +7922,31 @@ CREATE SEQUENCE maker.vat_debt_id_seq
 
 
 --
--- Name: vat_debt_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_bid_end_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_debt_id_seq OWNED BY maker.vat_debt.id;
+ALTER SEQUENCE maker.flip_bid_end_id_seq OWNED BY maker.flip_bid_end.id;
 
 
 --
--- Name: vat_file_debt_ceiling; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_gal; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_file_debt_ceiling (
+CREATE TABLE maker.flip_bid_gal (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    what text,
-    data numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    gal text
 );
 
 
 --
--- Name: vat_file_debt_ceiling_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_gal_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_file_debt_ceiling_id_seq
+CREATE SEQUENCE maker.flip_bid_gal_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7126,31 +7956,31 @@ CREATE SEQUENCE maker.vat_file_debt_ceiling_id_seq
 
 
 --
--- Name: vat_file_debt_ceiling_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_bid_gal_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_file_debt_ceiling_id_seq OWNED BY maker.vat_file_debt_ceiling.id;
+ALTER SEQUENCE maker.flip_bid_gal_id_seq OWNED BY maker.flip_bid_gal.id;
 
 
 --
--- Name: vat_file_ilk; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_guy; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_file_ilk (
+CREATE TABLE maker.flip_bid_guy (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    what text,
-    data numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    guy text
 );
 
 
 --
--- Name: vat_file_ilk_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_guy_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_file_ilk_id_seq
+CREATE SEQUENCE maker.flip_bid_guy_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7160,32 +7990,31 @@ CREATE SEQUENCE maker.vat_file_ilk_id_seq
 
 
 --
--- Name: vat_file_ilk_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_bid_guy_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_file_ilk_id_seq OWNED BY maker.vat_file_ilk.id;
+ALTER SEQUENCE maker.flip_bid_guy_id_seq OWNED BY maker.flip_bid_guy.id;
 
 
 --
--- Name: vat_flux; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_lot; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_flux (
+CREATE TABLE maker.flip_bid_lot (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    src text,
-    dst text,
-    wad numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    lot numeric NOT NULL
 );
 
 
 --
--- Name: vat_flux_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_lot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_flux_id_seq
+CREATE SEQUENCE maker.flip_bid_lot_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7195,30 +8024,31 @@ CREATE SEQUENCE maker.vat_flux_id_seq
 
 
 --
--- Name: vat_flux_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_bid_lot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_flux_id_seq OWNED BY maker.vat_flux.id;
+ALTER SEQUENCE maker.flip_bid_lot_id_seq OWNED BY maker.flip_bid_lot.id;
 
 
 --
--- Name: vat_fold; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_tab; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_fold (
+CREATE TABLE maker.flip_bid_tab (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    urn_id integer NOT NULL,
-    rate numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    tab numeric NOT NULL
 );
 
 
 --
--- Name: vat_fold_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_tab_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_fold_id_seq
+CREATE SEQUENCE maker.flip_bid_tab_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7228,33 +8058,31 @@ CREATE SEQUENCE maker.vat_fold_id_seq
 
 
 --
--- Name: vat_fold_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_bid_tab_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_fold_id_seq OWNED BY maker.vat_fold.id;
+ALTER SEQUENCE maker.flip_bid_tab_id_seq OWNED BY maker.flip_bid_tab.id;
 
 
 --
--- Name: vat_fork; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_tic; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_fork (
+CREATE TABLE maker.flip_bid_tic (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    src text,
-    dst text,
-    dink numeric,
-    dart numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    tic bigint NOT NULL
 );
 
 
 --
--- Name: vat_fork_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_tic_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_fork_id_seq
+CREATE SEQUENCE maker.flip_bid_tic_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7264,33 +8092,31 @@ CREATE SEQUENCE maker.vat_fork_id_seq
 
 
 --
--- Name: vat_fork_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_bid_tic_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_fork_id_seq OWNED BY maker.vat_fork.id;
+ALTER SEQUENCE maker.flip_bid_tic_id_seq OWNED BY maker.flip_bid_tic.id;
 
 
 --
--- Name: vat_frob; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_usr; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_frob (
+CREATE TABLE maker.flip_bid_usr (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    urn_id integer NOT NULL,
-    v text,
-    w text,
-    dink numeric,
-    dart numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    usr text
 );
 
 
 --
--- Name: vat_frob_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_bid_usr_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_frob_id_seq
+CREATE SEQUENCE maker.flip_bid_usr_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7300,31 +8126,17 @@ CREATE SEQUENCE maker.vat_frob_id_seq
 
 
 --
--- Name: vat_frob_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
---
-
-ALTER SEQUENCE maker.vat_frob_id_seq OWNED BY maker.vat_frob.id;
-
-
---
--- Name: vat_gem; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_bid_usr_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_gem (
-    id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    ilk_id integer NOT NULL,
-    guy text,
-    gem numeric NOT NULL
-);
+ALTER SEQUENCE maker.flip_bid_usr_id_seq OWNED BY maker.flip_bid_usr.id;
 
 
 --
--- Name: vat_gem_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_gem_id_seq
+CREATE SEQUENCE maker.flip_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7334,33 +8146,30 @@ CREATE SEQUENCE maker.vat_gem_id_seq
 
 
 --
--- Name: vat_gem_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_gem_id_seq OWNED BY maker.vat_gem.id;
+ALTER SEQUENCE maker.flip_id_seq OWNED BY maker.flip.id;
 
 
 --
--- Name: vat_grab; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_ilk; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_grab (
+CREATE TABLE maker.flip_ilk (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    urn_id integer NOT NULL,
-    v text,
-    w text,
-    dink numeric,
-    dart numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    ilk_id integer NOT NULL
 );
 
 
 --
--- Name: vat_grab_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_ilk_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_grab_id_seq
+CREATE SEQUENCE maker.flip_ilk_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7370,29 +8179,42 @@ CREATE SEQUENCE maker.vat_grab_id_seq
 
 
 --
--- Name: vat_grab_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_ilk_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_grab_id_seq OWNED BY maker.vat_grab.id;
+ALTER SEQUENCE maker.flip_ilk_id_seq OWNED BY maker.flip_ilk.id;
 
 
 --
--- Name: vat_heal; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_kick; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_heal (
+CREATE TABLE maker.flip_kick (
     id integer NOT NULL,
     header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    rad numeric
+    bid_id numeric NOT NULL,
+    lot numeric,
+    bid numeric,
+    tab numeric,
+    usr text,
+    gal text,
+    address_id integer NOT NULL,
+    log_id bigint NOT NULL
 );
 
 
 --
--- Name: vat_heal_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE flip_kick; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_heal_id_seq
+COMMENT ON TABLE maker.flip_kick IS '@name flipKickEvent';
+
+
+--
+-- Name: flip_kick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flip_kick_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7402,30 +8224,37 @@ CREATE SEQUENCE maker.vat_heal_id_seq
 
 
 --
--- Name: vat_heal_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_kick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_heal_id_seq OWNED BY maker.vat_heal.id;
+ALTER SEQUENCE maker.flip_kick_id_seq OWNED BY maker.flip_kick.id;
 
 
 --
--- Name: vat_ilk_art; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_kicks; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_ilk_art (
+CREATE TABLE maker.flip_kicks (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ilk_id integer NOT NULL,
-    art numeric NOT NULL
+    address_id integer NOT NULL,
+    kicks numeric NOT NULL
 );
 
 
 --
--- Name: vat_ilk_art_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE flip_kicks; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_ilk_art_id_seq
+COMMENT ON TABLE maker.flip_kicks IS '@name flipKicksStorage';
+
+
+--
+-- Name: flip_kicks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flip_kicks_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7435,30 +8264,30 @@ CREATE SEQUENCE maker.vat_ilk_art_id_seq
 
 
 --
--- Name: vat_ilk_art_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_kicks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_ilk_art_id_seq OWNED BY maker.vat_ilk_art.id;
+ALTER SEQUENCE maker.flip_kicks_id_seq OWNED BY maker.flip_kicks.id;
 
 
 --
--- Name: vat_ilk_dust; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_tau; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_ilk_dust (
+CREATE TABLE maker.flip_tau (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ilk_id integer NOT NULL,
-    dust numeric NOT NULL
+    address_id integer NOT NULL,
+    tau numeric NOT NULL
 );
 
 
 --
--- Name: vat_ilk_dust_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_tau_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_ilk_dust_id_seq
+CREATE SEQUENCE maker.flip_tau_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7468,30 +8297,30 @@ CREATE SEQUENCE maker.vat_ilk_dust_id_seq
 
 
 --
--- Name: vat_ilk_dust_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_tau_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_ilk_dust_id_seq OWNED BY maker.vat_ilk_dust.id;
+ALTER SEQUENCE maker.flip_tau_id_seq OWNED BY maker.flip_tau.id;
 
 
 --
--- Name: vat_ilk_line; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_ttl; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_ilk_line (
+CREATE TABLE maker.flip_ttl (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ilk_id integer NOT NULL,
-    line numeric NOT NULL
+    address_id integer NOT NULL,
+    ttl numeric NOT NULL
 );
 
 
 --
--- Name: vat_ilk_line_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_ttl_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_ilk_line_id_seq
+CREATE SEQUENCE maker.flip_ttl_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7501,30 +8330,30 @@ CREATE SEQUENCE maker.vat_ilk_line_id_seq
 
 
 --
--- Name: vat_ilk_line_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_ttl_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_ilk_line_id_seq OWNED BY maker.vat_ilk_line.id;
+ALTER SEQUENCE maker.flip_ttl_id_seq OWNED BY maker.flip_ttl.id;
 
 
 --
--- Name: vat_ilk_rate; Type: TABLE; Schema: maker; Owner: -
+-- Name: flip_vat; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_ilk_rate (
+CREATE TABLE maker.flip_vat (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ilk_id integer NOT NULL,
-    rate numeric NOT NULL
+    address_id integer NOT NULL,
+    vat text
 );
 
 
 --
--- Name: vat_ilk_rate_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flip_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_ilk_rate_id_seq
+CREATE SEQUENCE maker.flip_vat_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7534,30 +8363,50 @@ CREATE SEQUENCE maker.vat_ilk_rate_id_seq
 
 
 --
--- Name: vat_ilk_rate_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flip_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_ilk_rate_id_seq OWNED BY maker.vat_ilk_rate.id;
+ALTER SEQUENCE maker.flip_vat_id_seq OWNED BY maker.flip_vat.id;
 
 
 --
--- Name: vat_ilk_spot; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_ilk_spot (
+CREATE TABLE maker.flop (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ilk_id integer NOT NULL,
-    spot numeric NOT NULL
+    address_id integer NOT NULL,
+    bid_id numeric,
+    guy text,
+    tic bigint,
+    "end" bigint,
+    lot numeric,
+    bid numeric,
+    created timestamp without time zone,
+    updated timestamp without time zone
 );
 
 
 --
--- Name: vat_ilk_spot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_beg; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_ilk_spot_id_seq
+CREATE TABLE maker.flop_beg (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    beg numeric NOT NULL
+);
+
+
+--
+-- Name: flop_beg_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flop_beg_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7567,29 +8416,31 @@ CREATE SEQUENCE maker.vat_ilk_spot_id_seq
 
 
 --
--- Name: vat_ilk_spot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_beg_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_ilk_spot_id_seq OWNED BY maker.vat_ilk_spot.id;
+ALTER SEQUENCE maker.flop_beg_id_seq OWNED BY maker.flop_beg.id;
 
 
 --
--- Name: vat_init; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_bid_bid; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_init (
+CREATE TABLE maker.flop_bid_bid (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    bid numeric NOT NULL
 );
 
 
 --
--- Name: vat_init_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_bid_bid_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_init_id_seq
+CREATE SEQUENCE maker.flop_bid_bid_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7599,29 +8450,31 @@ CREATE SEQUENCE maker.vat_init_id_seq
 
 
 --
--- Name: vat_init_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_bid_bid_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_init_id_seq OWNED BY maker.vat_init.id;
+ALTER SEQUENCE maker.flop_bid_bid_id_seq OWNED BY maker.flop_bid_bid.id;
 
 
 --
--- Name: vat_line; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_bid_end; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_line (
+CREATE TABLE maker.flop_bid_end (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    line numeric NOT NULL
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    "end" bigint NOT NULL
 );
 
 
 --
--- Name: vat_line_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_bid_end_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_line_id_seq
+CREATE SEQUENCE maker.flop_bid_end_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7631,29 +8484,31 @@ CREATE SEQUENCE maker.vat_line_id_seq
 
 
 --
--- Name: vat_line_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_bid_end_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_line_id_seq OWNED BY maker.vat_line.id;
+ALTER SEQUENCE maker.flop_bid_end_id_seq OWNED BY maker.flop_bid_end.id;
 
 
 --
--- Name: vat_live; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_bid_guy; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_live (
+CREATE TABLE maker.flop_bid_guy (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    live numeric NOT NULL
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    guy text
 );
 
 
 --
--- Name: vat_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_bid_guy_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_live_id_seq
+CREATE SEQUENCE maker.flop_bid_guy_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7663,31 +8518,31 @@ CREATE SEQUENCE maker.vat_live_id_seq
 
 
 --
--- Name: vat_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_bid_guy_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_live_id_seq OWNED BY maker.vat_live.id;
+ALTER SEQUENCE maker.flop_bid_guy_id_seq OWNED BY maker.flop_bid_guy.id;
 
 
 --
--- Name: vat_move; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_bid_lot; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_move (
+CREATE TABLE maker.flop_bid_lot (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    src text NOT NULL,
-    dst text NOT NULL,
-    rad numeric NOT NULL
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    lot numeric NOT NULL
 );
 
 
 --
--- Name: vat_move_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_bid_lot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_move_id_seq
+CREATE SEQUENCE maker.flop_bid_lot_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7697,30 +8552,31 @@ CREATE SEQUENCE maker.vat_move_id_seq
 
 
 --
--- Name: vat_move_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_bid_lot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_move_id_seq OWNED BY maker.vat_move.id;
+ALTER SEQUENCE maker.flop_bid_lot_id_seq OWNED BY maker.flop_bid_lot.id;
 
 
 --
--- Name: vat_sin; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_bid_tic; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_sin (
+CREATE TABLE maker.flop_bid_tic (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    guy text,
-    sin numeric NOT NULL
+    address_id integer NOT NULL,
+    bid_id numeric NOT NULL,
+    tic bigint NOT NULL
 );
 
 
 --
--- Name: vat_sin_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_bid_tic_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_sin_id_seq
+CREATE SEQUENCE maker.flop_bid_tic_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7730,31 +8586,30 @@ CREATE SEQUENCE maker.vat_sin_id_seq
 
 
 --
--- Name: vat_sin_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_bid_tic_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_sin_id_seq OWNED BY maker.vat_sin.id;
+ALTER SEQUENCE maker.flop_bid_tic_id_seq OWNED BY maker.flop_bid_tic.id;
 
 
 --
--- Name: vat_slip; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_gem; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_slip (
+CREATE TABLE maker.flop_gem (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    ilk_id integer NOT NULL,
-    usr text,
-    wad numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    gem text
 );
 
 
 --
--- Name: vat_slip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_gem_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_slip_id_seq
+CREATE SEQUENCE maker.flop_gem_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7764,31 +8619,60 @@ CREATE SEQUENCE maker.vat_slip_id_seq
 
 
 --
--- Name: vat_slip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_gem_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_slip_id_seq OWNED BY maker.vat_slip.id;
+ALTER SEQUENCE maker.flop_gem_id_seq OWNED BY maker.flop_gem.id;
 
 
 --
--- Name: vat_suck; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_suck (
+CREATE SEQUENCE maker.flop_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: flop_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.flop_id_seq OWNED BY maker.flop.id;
+
+
+--
+-- Name: flop_kick; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.flop_kick (
     id integer NOT NULL,
     header_id integer NOT NULL,
     log_id bigint NOT NULL,
-    u text,
-    v text,
-    rad numeric
+    bid_id numeric NOT NULL,
+    lot numeric NOT NULL,
+    bid numeric NOT NULL,
+    gal text,
+    address_id integer NOT NULL
 );
 
 
 --
--- Name: vat_suck_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE flop_kick; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_suck_id_seq
+COMMENT ON TABLE maker.flop_kick IS '@name flopKickEvent';
+
+
+--
+-- Name: flop_kick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flop_kick_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7798,30 +8682,37 @@ CREATE SEQUENCE maker.vat_suck_id_seq
 
 
 --
--- Name: vat_suck_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_kick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_suck_id_seq OWNED BY maker.vat_suck.id;
+ALTER SEQUENCE maker.flop_kick_id_seq OWNED BY maker.flop_kick.id;
 
 
 --
--- Name: vat_urn_art; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_kicks; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_urn_art (
+CREATE TABLE maker.flop_kicks (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    urn_id integer NOT NULL,
-    art numeric NOT NULL
+    address_id integer NOT NULL,
+    kicks numeric NOT NULL
 );
 
 
 --
--- Name: vat_urn_art_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE flop_kicks; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_urn_art_id_seq
+COMMENT ON TABLE maker.flop_kicks IS '@name flopKicksStorage';
+
+
+--
+-- Name: flop_kicks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.flop_kicks_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7831,30 +8722,30 @@ CREATE SEQUENCE maker.vat_urn_art_id_seq
 
 
 --
--- Name: vat_urn_art_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_kicks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_urn_art_id_seq OWNED BY maker.vat_urn_art.id;
+ALTER SEQUENCE maker.flop_kicks_id_seq OWNED BY maker.flop_kicks.id;
 
 
 --
--- Name: vat_urn_ink; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_live; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_urn_ink (
+CREATE TABLE maker.flop_live (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    urn_id integer NOT NULL,
-    ink numeric NOT NULL
+    address_id integer NOT NULL,
+    live numeric NOT NULL
 );
 
 
 --
--- Name: vat_urn_ink_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_urn_ink_id_seq
+CREATE SEQUENCE maker.flop_live_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7864,29 +8755,30 @@ CREATE SEQUENCE maker.vat_urn_ink_id_seq
 
 
 --
--- Name: vat_urn_ink_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_urn_ink_id_seq OWNED BY maker.vat_urn_ink.id;
+ALTER SEQUENCE maker.flop_live_id_seq OWNED BY maker.flop_live.id;
 
 
 --
--- Name: vat_vice; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_pad; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vat_vice (
+CREATE TABLE maker.flop_pad (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    vice numeric NOT NULL
+    address_id integer NOT NULL,
+    pad numeric NOT NULL
 );
 
 
 --
--- Name: vat_vice_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_pad_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vat_vice_id_seq
+CREATE SEQUENCE maker.flop_pad_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7896,29 +8788,30 @@ CREATE SEQUENCE maker.vat_vice_id_seq
 
 
 --
--- Name: vat_vice_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_pad_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vat_vice_id_seq OWNED BY maker.vat_vice.id;
+ALTER SEQUENCE maker.flop_pad_id_seq OWNED BY maker.flop_pad.id;
 
 
 --
--- Name: vow_ash; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_tau; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_ash (
+CREATE TABLE maker.flop_tau (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    ash numeric
+    address_id integer NOT NULL,
+    tau numeric NOT NULL
 );
 
 
 --
--- Name: vow_ash_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_tau_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_ash_id_seq
+CREATE SEQUENCE maker.flop_tau_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7928,29 +8821,30 @@ CREATE SEQUENCE maker.vow_ash_id_seq
 
 
 --
--- Name: vow_ash_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_tau_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_ash_id_seq OWNED BY maker.vow_ash.id;
+ALTER SEQUENCE maker.flop_tau_id_seq OWNED BY maker.flop_tau.id;
 
 
 --
--- Name: vow_bump; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_ttl; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_bump (
+CREATE TABLE maker.flop_ttl (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    bump numeric
+    address_id integer NOT NULL,
+    ttl numeric NOT NULL
 );
 
 
 --
--- Name: vow_bump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_ttl_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_bump_id_seq
+CREATE SEQUENCE maker.flop_ttl_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7960,29 +8854,30 @@ CREATE SEQUENCE maker.vow_bump_id_seq
 
 
 --
--- Name: vow_bump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_ttl_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_bump_id_seq OWNED BY maker.vow_bump.id;
+ALTER SEQUENCE maker.flop_ttl_id_seq OWNED BY maker.flop_ttl.id;
 
 
 --
--- Name: vow_dump; Type: TABLE; Schema: maker; Owner: -
+-- Name: flop_vat; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_dump (
+CREATE TABLE maker.flop_vat (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    dump numeric
+    address_id integer NOT NULL,
+    vat text
 );
 
 
 --
--- Name: vow_dump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: flop_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_dump_id_seq
+CREATE SEQUENCE maker.flop_vat_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -7992,29 +8887,33 @@ CREATE SEQUENCE maker.vow_dump_id_seq
 
 
 --
--- Name: vow_dump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: flop_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_dump_id_seq OWNED BY maker.vow_dump.id;
+ALTER SEQUENCE maker.flop_vat_id_seq OWNED BY maker.flop_vat.id;
 
 
 --
--- Name: vow_fess; Type: TABLE; Schema: maker; Owner: -
+-- Name: gem_exit; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_fess (
+CREATE TABLE maker.gem_exit (
     id integer NOT NULL,
     header_id integer NOT NULL,
     log_id bigint NOT NULL,
-    tab numeric NOT NULL
+    address_id integer NOT NULL,
+    ilk_id integer NOT NULL,
+    msg_sender text,
+    usr text,
+    wad numeric
 );
 
 
 --
--- Name: vow_fess_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: gem_exit_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_fess_id_seq
+CREATE SEQUENCE maker.gem_exit_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8024,30 +8923,33 @@ CREATE SEQUENCE maker.vow_fess_id_seq
 
 
 --
--- Name: vow_fess_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: gem_exit_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_fess_id_seq OWNED BY maker.vow_fess.id;
+ALTER SEQUENCE maker.gem_exit_id_seq OWNED BY maker.gem_exit.id;
 
 
 --
--- Name: vow_file; Type: TABLE; Schema: maker; Owner: -
+-- Name: gem_join; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_file (
+CREATE TABLE maker.gem_join (
     id integer NOT NULL,
     header_id integer NOT NULL,
     log_id bigint NOT NULL,
-    what text,
-    data numeric
+    address_id integer NOT NULL,
+    ilk_id integer NOT NULL,
+    msg_sender text,
+    usr text,
+    wad numeric
 );
 
 
 --
--- Name: vow_file_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: gem_join_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_file_id_seq
+CREATE SEQUENCE maker.gem_join_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8057,29 +8959,40 @@ CREATE SEQUENCE maker.vow_file_id_seq
 
 
 --
--- Name: vow_file_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: gem_join_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_file_id_seq OWNED BY maker.vow_file.id;
+ALTER SEQUENCE maker.gem_join_id_seq OWNED BY maker.gem_join.id;
 
 
 --
--- Name: vow_flapper; Type: TABLE; Schema: maker; Owner: -
+-- Name: generic_file; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_flapper (
+CREATE TABLE maker.generic_file (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    flapper text
-);
-
-
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    address_id integer NOT NULL,
+    ilk_id integer,
+    signature text,
+    what text,
+    data text
+);
+
+
 --
--- Name: vow_flapper_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE generic_file; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_flapper_id_seq
+COMMENT ON TABLE maker.generic_file IS 'File events without a dedicated transformer. Data holds the raw 32 byte argument as hex.';
+
+
+--
+-- Name: generic_file_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.generic_file_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8089,29 +9002,35 @@ CREATE SEQUENCE maker.vow_flapper_id_seq
 
 
 --
--- Name: vow_flapper_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: generic_file_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_flapper_id_seq OWNED BY maker.vow_flapper.id;
+ALTER SEQUENCE maker.generic_file_id_seq OWNED BY maker.generic_file.id;
 
 
 --
--- Name: vow_flog; Type: TABLE; Schema: maker; Owner: -
+-- Name: ilks; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_flog (
+CREATE TABLE maker.ilks (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    log_id bigint NOT NULL,
-    era integer NOT NULL
+    ilk text NOT NULL,
+    identifier text NOT NULL
 );
 
 
 --
--- Name: vow_flog_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: TABLE ilks; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_flog_id_seq
+COMMENT ON TABLE maker.ilks IS '@name raw_ilks';
+
+
+--
+-- Name: ilks_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.ilks_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8121,29 +9040,29 @@ CREATE SEQUENCE maker.vow_flog_id_seq
 
 
 --
--- Name: vow_flog_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: ilks_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_flog_id_seq OWNED BY maker.vow_flog.id;
+ALTER SEQUENCE maker.ilks_id_seq OWNED BY maker.ilks.id;
 
 
 --
--- Name: vow_flopper; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_base; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_flopper (
+CREATE TABLE maker.jug_base (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    flopper text
+    base text
 );
 
 
 --
--- Name: vow_flopper_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_base_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_flopper_id_seq
+CREATE SEQUENCE maker.jug_base_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8153,29 +9072,29 @@ CREATE SEQUENCE maker.vow_flopper_id_seq
 
 
 --
--- Name: vow_flopper_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_base_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_flopper_id_seq OWNED BY maker.vow_flopper.id;
+ALTER SEQUENCE maker.jug_base_id_seq OWNED BY maker.jug_base.id;
 
 
 --
--- Name: vow_hump; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_drip; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_hump (
+CREATE TABLE maker.jug_drip (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    hump numeric
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL
 );
 
 
 --
--- Name: vow_hump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_drip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_hump_id_seq
+CREATE SEQUENCE maker.jug_drip_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8185,29 +9104,30 @@ CREATE SEQUENCE maker.vow_hump_id_seq
 
 
 --
--- Name: vow_hump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_drip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_hump_id_seq OWNED BY maker.vow_hump.id;
+ALTER SEQUENCE maker.jug_drip_id_seq OWNED BY maker.jug_drip.id;
 
 
 --
--- Name: vow_sin_integer; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_file_base; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_sin_integer (
+CREATE TABLE maker.jug_file_base (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    sin numeric
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    what text,
+    data numeric
 );
 
 
 --
--- Name: vow_sin_integer_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_file_base_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_sin_integer_id_seq
+CREATE SEQUENCE maker.jug_file_base_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8217,30 +9137,31 @@ CREATE SEQUENCE maker.vow_sin_integer_id_seq
 
 
 --
--- Name: vow_sin_integer_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_file_base_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_sin_integer_id_seq OWNED BY maker.vow_sin_integer.id;
+ALTER SEQUENCE maker.jug_file_base_id_seq OWNED BY maker.jug_file_base.id;
 
 
 --
--- Name: vow_sin_mapping; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_file_ilk; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_sin_mapping (
+CREATE TABLE maker.jug_file_ilk (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    era numeric,
-    tab numeric
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    what text,
+    data numeric
 );
 
 
 --
--- Name: vow_sin_mapping_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_file_ilk_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_sin_mapping_id_seq
+CREATE SEQUENCE maker.jug_file_ilk_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8250,29 +9171,30 @@ CREATE SEQUENCE maker.vow_sin_mapping_id_seq
 
 
 --
--- Name: vow_sin_mapping_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_file_ilk_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_sin_mapping_id_seq OWNED BY maker.vow_sin_mapping.id;
+ALTER SEQUENCE maker.jug_file_ilk_id_seq OWNED BY maker.jug_file_ilk.id;
 
 
 --
--- Name: vow_sump; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_file_vow; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_sump (
+CREATE TABLE maker.jug_file_vow (
     id integer NOT NULL,
-    block_number bigint,
-    block_hash text,
-    sump numeric
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    what text,
+    data text
 );
 
 
 --
--- Name: vow_sump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_file_vow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_sump_id_seq
+CREATE SEQUENCE maker.jug_file_vow_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8282,29 +9204,30 @@ CREATE SEQUENCE maker.vow_sump_id_seq
 
 
 --
--- Name: vow_sump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_file_vow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_sump_id_seq OWNED BY maker.vow_sump.id;
+ALTER SEQUENCE maker.jug_file_vow_id_seq OWNED BY maker.jug_file_vow.id;
 
 
 --
--- Name: vow_vat; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_ilk_duty; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_vat (
+CREATE TABLE maker.jug_ilk_duty (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    vat text
+    ilk_id integer NOT NULL,
+    duty numeric NOT NULL
 );
 
 
 --
--- Name: vow_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_ilk_duty_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_vat_id_seq
+CREATE SEQUENCE maker.jug_ilk_duty_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8314,29 +9237,30 @@ CREATE SEQUENCE maker.vow_vat_id_seq
 
 
 --
--- Name: vow_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_ilk_duty_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_vat_id_seq OWNED BY maker.vow_vat.id;
+ALTER SEQUENCE maker.jug_ilk_duty_id_seq OWNED BY maker.jug_ilk_duty.id;
 
 
 --
--- Name: vow_wait; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_ilk_rho; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.vow_wait (
+CREATE TABLE maker.jug_ilk_rho (
     id integer NOT NULL,
     block_number bigint,
     block_hash text,
-    wait numeric
+    ilk_id integer NOT NULL,
+    rho numeric NOT NULL
 );
 
 
 --
--- Name: vow_wait_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_ilk_rho_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.vow_wait_id_seq
+CREATE SEQUENCE maker.jug_ilk_rho_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8346,30 +9270,29 @@ CREATE SEQUENCE maker.vow_wait_id_seq
 
 
 --
--- Name: vow_wait_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_ilk_rho_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.vow_wait_id_seq OWNED BY maker.vow_wait.id;
+ALTER SEQUENCE maker.jug_ilk_rho_id_seq OWNED BY maker.jug_ilk_rho.id;
 
 
 --
--- Name: yank; Type: TABLE; Schema: maker; Owner: -
+-- Name: jug_init; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE maker.yank (
+CREATE TABLE maker.jug_init (
     id integer NOT NULL,
-    header_id integer NOT NULL,
     log_id bigint NOT NULL,
-    bid_id numeric NOT NULL,
-    address_id integer NOT NULL
+    header_id integer NOT NULL,
+    ilk_id integer NOT NULL
 );
 
 
 --
--- Name: yank_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+-- Name: jug_init_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE maker.yank_id_seq
+CREATE SEQUENCE maker.jug_init_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8379,27 +9302,29 @@ CREATE SEQUENCE maker.yank_id_seq
 
 
 --
--- Name: yank_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+-- Name: jug_init_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE maker.yank_id_seq OWNED BY maker.yank.id;
+ALTER SEQUENCE maker.jug_init_id_seq OWNED BY maker.jug_init.id;
 
 
 --
--- Name: addresses; Type: TABLE; Schema: public; Owner: -
+-- Name: jug_vat; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.addresses (
+CREATE TABLE maker.jug_vat (
     id integer NOT NULL,
-    address character varying(42)
+    block_number bigint,
+    block_hash text,
+    vat text
 );
 
 
 --
--- Name: addresses_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: jug_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.addresses_id_seq
+CREATE SEQUENCE maker.jug_vat_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8409,72 +9334,64 @@ CREATE SEQUENCE public.addresses_id_seq
 
 
 --
--- Name: addresses_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: jug_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.addresses_id_seq OWNED BY public.addresses.id;
+ALTER SEQUENCE maker.jug_vat_id_seq OWNED BY maker.jug_vat.id;
 
 
 --
--- Name: full_sync_logs; Type: TABLE; Schema: public; Owner: -
+-- Name: jug_vow; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.full_sync_logs (
+CREATE TABLE maker.jug_vow (
     id integer NOT NULL,
     block_number bigint,
-    address character varying(66),
-    tx_hash character varying(66),
-    index bigint,
-    topic0 character varying(66),
-    topic1 character varying(66),
-    topic2 character varying(66),
-    topic3 character varying(66),
-    data text,
-    receipt_id integer
+    block_hash text,
+    vow text
 );
 
 
 --
--- Name: block_stats; Type: VIEW; Schema: public; Owner: -
+-- Name: jug_vow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE VIEW public.block_stats AS
- SELECT max(full_sync_logs.block_number) AS max_block,
-    min(full_sync_logs.block_number) AS min_block
-   FROM public.full_sync_logs;
+CREATE SEQUENCE maker.jug_vow_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: blocks; Type: TABLE; Schema: public; Owner: -
+-- Name: jug_vow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.blocks (
+ALTER SEQUENCE maker.jug_vow_id_seq OWNED BY maker.jug_vow.id;
+
+
+--
+-- Name: log_median_price; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.log_median_price (
     id integer NOT NULL,
-    difficulty bigint,
-    extra_data character varying,
-    gas_limit bigint,
-    gas_used bigint,
-    hash character varying(66),
-    miner character varying(42),
-    nonce character varying(20),
-    number bigint,
-    parent_hash character varying(66),
-    reward numeric,
-    uncles_reward numeric,
-    size character varying,
-    "time" bigint,
-    is_final boolean,
-    uncle_hash character varying(66),
-    eth_node_id integer NOT NULL,
-    eth_node_fingerprint character varying(128) NOT NULL
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    address_id integer NOT NULL,
+    ilk_id integer NOT NULL,
+    val numeric,
+    age numeric
 );
 
 
 --
--- Name: blocks_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: log_median_price_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.blocks_id_seq
+CREATE SEQUENCE maker.log_median_price_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8484,29 +9401,30 @@ CREATE SEQUENCE public.blocks_id_seq
 
 
 --
--- Name: blocks_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: log_median_price_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.blocks_id_seq OWNED BY public.blocks.id;
+ALTER SEQUENCE maker.log_median_price_id_seq OWNED BY maker.log_median_price.id;
 
 
 --
--- Name: checked_headers; Type: TABLE; Schema: public; Owner: -
+-- Name: log_value; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.checked_headers (
+CREATE TABLE maker.log_value (
     id integer NOT NULL,
     header_id integer NOT NULL,
-    yank integer DEFAULT 0 NOT NULL,
-    new_cdp integer DEFAULT 0 NOT NULL
+    log_id bigint NOT NULL,
+    address_id integer NOT NULL,
+    val numeric
 );
 
 
 --
--- Name: checked_headers_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: log_value_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.checked_headers_id_seq
+CREATE SEQUENCE maker.log_value_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8516,30 +9434,38 @@ CREATE SEQUENCE public.checked_headers_id_seq
 
 
 --
--- Name: checked_headers_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: log_value_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.checked_headers_id_seq OWNED BY public.checked_headers.id;
+ALTER SEQUENCE maker.log_value_id_seq OWNED BY maker.log_value.id;
 
 
 --
--- Name: eth_nodes; Type: TABLE; Schema: public; Owner: -
+-- Name: new_cdp; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.eth_nodes (
+CREATE TABLE maker.new_cdp (
     id integer NOT NULL,
-    client_name character varying,
-    genesis_block character varying(66),
-    network_id numeric,
-    eth_node_id character varying(128)
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    usr text,
+    own text,
+    cdp numeric
 );
 
 
 --
--- Name: full_sync_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: COLUMN new_cdp.id; Type: COMMENT; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.full_sync_logs_id_seq
+COMMENT ON COLUMN maker.new_cdp.id IS '@omit';
+
+
+--
+-- Name: new_cdp_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.new_cdp_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8549,33 +9475,30 @@ CREATE SEQUENCE public.full_sync_logs_id_seq
 
 
 --
--- Name: full_sync_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: new_cdp_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.full_sync_logs_id_seq OWNED BY public.full_sync_logs.id;
+ALTER SEQUENCE maker.new_cdp_id_seq OWNED BY maker.new_cdp.id;
 
 
 --
--- Name: full_sync_receipts; Type: TABLE; Schema: public; Owner: -
+-- Name: osm_cur_has; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.full_sync_receipts (
+CREATE TABLE maker.osm_cur_has (
     id integer NOT NULL,
-    contract_address_id integer NOT NULL,
-    cumulative_gas_used numeric,
-    gas_used numeric,
-    state_root character varying(66),
-    status integer,
-    tx_hash character varying(66),
-    block_id integer NOT NULL
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    has numeric NOT NULL
 );
 
 
 --
--- Name: full_sync_receipts_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: osm_cur_has_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.full_sync_receipts_id_seq
+CREATE SEQUENCE maker.osm_cur_has_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8585,37 +9508,30 @@ CREATE SEQUENCE public.full_sync_receipts_id_seq
 
 
 --
--- Name: full_sync_receipts_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: osm_cur_has_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.full_sync_receipts_id_seq OWNED BY public.full_sync_receipts.id;
+ALTER SEQUENCE maker.osm_cur_has_id_seq OWNED BY maker.osm_cur_has.id;
 
 
 --
--- Name: full_sync_transactions; Type: TABLE; Schema: public; Owner: -
+-- Name: osm_cur_val; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.full_sync_transactions (
+CREATE TABLE maker.osm_cur_val (
     id integer NOT NULL,
-    block_id integer NOT NULL,
-    gas_limit numeric,
-    gas_price numeric,
-    hash character varying(66),
-    input_data bytea,
-    nonce numeric,
-    raw bytea,
-    tx_from character varying(66),
-    tx_index integer,
-    tx_to character varying(66),
-    value numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    val numeric NOT NULL
 );
 
 
 --
--- Name: full_sync_transactions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: osm_cur_val_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.full_sync_transactions_id_seq
+CREATE SEQUENCE maker.osm_cur_val_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8625,29 +9541,30 @@ CREATE SEQUENCE public.full_sync_transactions_id_seq
 
 
 --
--- Name: full_sync_transactions_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: osm_cur_val_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.full_sync_transactions_id_seq OWNED BY public.full_sync_transactions.id;
+ALTER SEQUENCE maker.osm_cur_val_id_seq OWNED BY maker.osm_cur_val.id;
 
 
 --
--- Name: goose_db_version; Type: TABLE; Schema: public; Owner: -
+-- Name: osm_hop; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.goose_db_version (
+CREATE TABLE maker.osm_hop (
     id integer NOT NULL,
-    version_id bigint NOT NULL,
-    is_applied boolean NOT NULL,
-    tstamp timestamp without time zone DEFAULT now()
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    hop numeric NOT NULL
 );
 
 
 --
--- Name: goose_db_version_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: osm_hop_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.goose_db_version_id_seq
+CREATE SEQUENCE maker.osm_hop_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8657,37 +9574,30 @@ CREATE SEQUENCE public.goose_db_version_id_seq
 
 
 --
--- Name: goose_db_version_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: osm_hop_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.goose_db_version_id_seq OWNED BY public.goose_db_version.id;
+ALTER SEQUENCE maker.osm_hop_id_seq OWNED BY maker.osm_hop.id;
 
 
 --
--- Name: header_sync_logs; Type: TABLE; Schema: public; Owner: -
+-- Name: osm_nxt_has; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.header_sync_logs (
+CREATE TABLE maker.osm_nxt_has (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    address integer NOT NULL,
-    topics bytea[],
-    data bytea,
     block_number bigint,
-    block_hash character varying(66),
-    tx_hash character varying(66),
-    tx_index integer,
-    log_index integer,
-    raw jsonb,
-    transformed boolean DEFAULT false NOT NULL
+    block_hash text,
+    address_id integer NOT NULL,
+    has numeric NOT NULL
 );
 
 
 --
--- Name: header_sync_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: osm_nxt_has_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.header_sync_logs_id_seq
+CREATE SEQUENCE maker.osm_nxt_has_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8697,35 +9607,30 @@ CREATE SEQUENCE public.header_sync_logs_id_seq
 
 
 --
--- Name: header_sync_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: osm_nxt_has_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.header_sync_logs_id_seq OWNED BY public.header_sync_logs.id;
+ALTER SEQUENCE maker.osm_nxt_has_id_seq OWNED BY maker.osm_nxt_has.id;
 
 
 --
--- Name: header_sync_receipts; Type: TABLE; Schema: public; Owner: -
+-- Name: osm_nxt_val; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.header_sync_receipts (
+CREATE TABLE maker.osm_nxt_val (
     id integer NOT NULL,
-    transaction_id integer NOT NULL,
-    header_id integer NOT NULL,
-    contract_address_id integer NOT NULL,
-    cumulative_gas_used numeric,
-    gas_used numeric,
-    state_root character varying(66),
-    status integer,
-    tx_hash character varying(66),
-    rlp bytea
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    val numeric NOT NULL
 );
 
 
 --
--- Name: header_sync_receipts_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: osm_nxt_val_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.header_sync_receipts_id_seq
+CREATE SEQUENCE maker.osm_nxt_val_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8735,37 +9640,30 @@ CREATE SEQUENCE public.header_sync_receipts_id_seq
 
 
 --
--- Name: header_sync_receipts_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: osm_nxt_val_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.header_sync_receipts_id_seq OWNED BY public.header_sync_receipts.id;
+ALTER SEQUENCE maker.osm_nxt_val_id_seq OWNED BY maker.osm_nxt_val.id;
 
 
 --
--- Name: header_sync_transactions; Type: TABLE; Schema: public; Owner: -
+-- Name: osm_stopped; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.header_sync_transactions (
+CREATE TABLE maker.osm_stopped (
     id integer NOT NULL,
-    header_id integer NOT NULL,
-    hash character varying(66),
-    gas_limit numeric,
-    gas_price numeric,
-    input_data bytea,
-    nonce numeric,
-    raw bytea,
-    tx_from character varying(44),
-    tx_index integer,
-    tx_to character varying(44),
-    value numeric
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    stopped numeric NOT NULL
 );
 
 
 --
--- Name: header_sync_transactions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: osm_stopped_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.header_sync_transactions_id_seq
+CREATE SEQUENCE maker.osm_stopped_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8775,33 +9673,30 @@ CREATE SEQUENCE public.header_sync_transactions_id_seq
 
 
 --
--- Name: header_sync_transactions_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: osm_stopped_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.header_sync_transactions_id_seq OWNED BY public.header_sync_transactions.id;
+ALTER SEQUENCE maker.osm_stopped_id_seq OWNED BY maker.osm_stopped.id;
 
 
 --
--- Name: headers; Type: TABLE; Schema: public; Owner: -
+-- Name: osm_zzz; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.headers (
+CREATE TABLE maker.osm_zzz (
     id integer NOT NULL,
-    hash character varying(66),
     block_number bigint,
-    raw jsonb,
-    block_timestamp numeric,
-    check_count integer DEFAULT 0 NOT NULL,
-    eth_node_id integer NOT NULL,
-    eth_node_fingerprint character varying(128)
+    block_hash text,
+    address_id integer NOT NULL,
+    zzz numeric NOT NULL
 );
 
 
 --
--- Name: headers_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: osm_zzz_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.headers_id_seq
+CREATE SEQUENCE maker.osm_zzz_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8811,37 +9706,33 @@ CREATE SEQUENCE public.headers_id_seq
 
 
 --
--- Name: headers_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: osm_zzz_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.headers_id_seq OWNED BY public.headers.id;
+ALTER SEQUENCE maker.osm_zzz_id_seq OWNED BY maker.osm_zzz.id;
 
 
 --
--- Name: log_filters; Type: TABLE; Schema: public; Owner: -
+-- Name: pause_drop; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.log_filters (
+CREATE TABLE maker.pause_drop (
     id integer NOT NULL,
-    name character varying NOT NULL,
-    from_block bigint,
-    to_block bigint,
-    address character varying(66),
-    topic0 character varying(66),
-    topic1 character varying(66),
-    topic2 character varying(66),
-    topic3 character varying(66),
-    CONSTRAINT log_filters_from_block_check CHECK ((from_block >= 0)),
-    CONSTRAINT log_filters_name_check CHECK (((name)::text <> ''::text)),
-    CONSTRAINT log_filters_to_block_check CHECK ((to_block >= 0))
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    usr text,
+    tag text,
+    fax text,
+    eta numeric
 );
 
 
 --
--- Name: log_filters_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: pause_drop_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.log_filters_id_seq
+CREATE SEQUENCE maker.pause_drop_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8851,17 +9742,33 @@ CREATE SEQUENCE public.log_filters_id_seq
 
 
 --
--- Name: log_filters_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: pause_drop_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.log_filters_id_seq OWNED BY public.log_filters.id;
+ALTER SEQUENCE maker.pause_drop_id_seq OWNED BY maker.pause_drop.id;
 
 
 --
--- Name: nodes_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: pause_exec; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.nodes_id_seq
+CREATE TABLE maker.pause_exec (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    usr text,
+    tag text,
+    fax text,
+    eta numeric
+);
+
+
+--
+-- Name: pause_exec_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.pause_exec_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8871,31 +9778,33 @@ CREATE SEQUENCE public.nodes_id_seq
 
 
 --
--- Name: nodes_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: pause_exec_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.nodes_id_seq OWNED BY public.eth_nodes.id;
+ALTER SEQUENCE maker.pause_exec_id_seq OWNED BY maker.pause_exec.id;
 
 
 --
--- Name: queued_storage; Type: TABLE; Schema: public; Owner: -
+-- Name: pause_plot; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.queued_storage (
+CREATE TABLE maker.pause_plot (
     id integer NOT NULL,
-    block_height bigint,
-    block_hash bytea,
-    contract bytea,
-    storage_key bytea,
-    storage_value bytea
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    usr text,
+    tag text,
+    fax text,
+    eta numeric
 );
 
 
 --
--- Name: queued_storage_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: pause_plot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.queued_storage_id_seq
+CREATE SEQUENCE maker.pause_plot_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8905,34 +9814,29 @@ CREATE SEQUENCE public.queued_storage_id_seq
 
 
 --
--- Name: queued_storage_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: pause_plot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.queued_storage_id_seq OWNED BY public.queued_storage.id;
+ALTER SEQUENCE maker.pause_plot_id_seq OWNED BY maker.pause_plot.id;
 
 
 --
--- Name: uncles; Type: TABLE; Schema: public; Owner: -
+-- Name: pot_chi; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.uncles (
+CREATE TABLE maker.pot_chi (
     id integer NOT NULL,
-    hash character varying(66) NOT NULL,
-    block_id integer NOT NULL,
-    reward numeric NOT NULL,
-    miner character varying(42) NOT NULL,
-    raw jsonb,
-    block_timestamp numeric,
-    eth_node_id integer NOT NULL,
-    eth_node_fingerprint character varying(128)
+    block_number bigint,
+    block_hash text,
+    chi numeric NOT NULL
 );
 
 
 --
--- Name: uncles_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: pot_chi_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.uncles_id_seq
+CREATE SEQUENCE maker.pot_chi_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8942,28 +9846,28 @@ CREATE SEQUENCE public.uncles_id_seq
 
 
 --
--- Name: uncles_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: pot_chi_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.uncles_id_seq OWNED BY public.uncles.id;
+ALTER SEQUENCE maker.pot_chi_id_seq OWNED BY maker.pot_chi.id;
 
 
 --
--- Name: watched_contracts; Type: TABLE; Schema: public; Owner: -
+-- Name: pot_drip; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.watched_contracts (
-    contract_id integer NOT NULL,
-    contract_abi json,
-    contract_hash character varying(66)
+CREATE TABLE maker.pot_drip (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL
 );
 
 
 --
--- Name: watched_contracts_contract_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: pot_drip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE SEQUENCE public.watched_contracts_contract_id_seq
+CREATE SEQUENCE maker.pot_drip_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -8973,51 +9877,29 @@ CREATE SEQUENCE public.watched_contracts_contract_id_seq
 
 
 --
--- Name: watched_contracts_contract_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: pot_drip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.watched_contracts_contract_id_seq OWNED BY public.watched_contracts.contract_id;
+ALTER SEQUENCE maker.pot_drip_id_seq OWNED BY maker.pot_drip.id;
 
 
 --
--- Name: watched_event_logs; Type: VIEW; Schema: public; Owner: -
+-- Name: pot_dsr; Type: TABLE; Schema: maker; Owner: -
 --
 
-CREATE VIEW public.watched_event_logs AS
- SELECT log_filters.name,
-    full_sync_logs.id,
-    full_sync_logs.block_number,
-    full_sync_logs.address,
-    full_sync_logs.tx_hash,
-    full_sync_logs.index,
-    full_sync_logs.topic0,
-    full_sync_logs.topic1,
-    full_sync_logs.topic2,
-    full_sync_logs.topic3,
-    full_sync_logs.data,
-    full_sync_logs.receipt_id
-   FROM ((public.log_filters
-     CROSS JOIN public.block_stats)
-     JOIN public.full_sync_logs ON ((((full_sync_logs.address)::text = (log_filters.address)::text) AND (full_sync_logs.block_number >= COALESCE(log_filters.from_block, block_stats.min_block)) AND (full_sync_logs.block_number <= COALESCE(log_filters.to_block, block_stats.max_block)))))
-  WHERE ((((log_filters.topic0)::text = (full_sync_logs.topic0)::text) OR (log_filters.topic0 IS NULL)) AND (((log_filters.topic1)::text = (full_sync_logs.topic1)::text) OR (log_filters.topic1 IS NULL)) AND (((log_filters.topic2)::text = (full_sync_logs.topic2)::text) OR (log_filters.topic2 IS NULL)) AND (((log_filters.topic3)::text = (full_sync_logs.topic3)::text) OR (log_filters.topic3 IS NULL)));
+CREATE TABLE maker.pot_dsr (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    dsr numeric NOT NULL
+);
 
 
 --
--- Name: watched_logs; Type: TABLE; Schema: public; Owner: -
+-- Name: pot_dsr_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-CREATE TABLE public.watched_logs (
-    id integer NOT NULL,
-    contract_address character varying(42),
-    topic_zero character varying(66)
-);
-
-
---
--- Name: watched_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
---
-
-CREATE SEQUENCE public.watched_logs_id_seq
+CREATE SEQUENCE maker.pot_dsr_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -9027,5543 +9909,12800 @@ CREATE SEQUENCE public.watched_logs_id_seq
 
 
 --
--- Name: watched_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: pot_dsr_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER SEQUENCE public.watched_logs_id_seq OWNED BY public.watched_logs.id;
+ALTER SEQUENCE maker.pot_dsr_id_seq OWNED BY maker.pot_dsr.id;
 
 
 --
--- Name: managed_cdp id; Type: DEFAULT; Schema: api; Owner: -
+-- Name: pot_exit; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY api.managed_cdp ALTER COLUMN id SET DEFAULT nextval('api.managed_cdp_id_seq'::regclass);
+CREATE TABLE maker.pot_exit (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    wad numeric
+);
 
 
 --
--- Name: bite id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_exit_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.bite ALTER COLUMN id SET DEFAULT nextval('maker.bite_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_exit_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: cat_file_chop_lump id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_exit_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_chop_lump ALTER COLUMN id SET DEFAULT nextval('maker.cat_file_chop_lump_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_exit_id_seq OWNED BY maker.pot_exit.id;
 
 
 --
--- Name: cat_file_flip id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_file_dsr; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_flip ALTER COLUMN id SET DEFAULT nextval('maker.cat_file_flip_id_seq'::regclass);
+CREATE TABLE maker.pot_file_dsr (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    what text,
+    data numeric
+);
 
 
 --
--- Name: cat_file_vow id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_file_dsr_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_vow ALTER COLUMN id SET DEFAULT nextval('maker.cat_file_vow_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_file_dsr_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: cat_ilk_chop id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_file_dsr_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_chop ALTER COLUMN id SET DEFAULT nextval('maker.cat_ilk_chop_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_file_dsr_id_seq OWNED BY maker.pot_file_dsr.id;
 
 
 --
--- Name: cat_ilk_flip id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_file_vow; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_flip ALTER COLUMN id SET DEFAULT nextval('maker.cat_ilk_flip_id_seq'::regclass);
+CREATE TABLE maker.pot_file_vow (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    what text,
+    data text
+);
 
 
 --
--- Name: cat_ilk_lump id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_file_vow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_lump ALTER COLUMN id SET DEFAULT nextval('maker.cat_ilk_lump_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_file_vow_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: cat_live id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_file_vow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_live ALTER COLUMN id SET DEFAULT nextval('maker.cat_live_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_file_vow_id_seq OWNED BY maker.pot_file_vow.id;
 
 
 --
--- Name: cat_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_join; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_vat ALTER COLUMN id SET DEFAULT nextval('maker.cat_vat_id_seq'::regclass);
+CREATE TABLE maker.pot_join (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text,
+    wad numeric
+);
 
 
 --
--- Name: cat_vow id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_join_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_vow ALTER COLUMN id SET DEFAULT nextval('maker.cat_vow_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_join_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: cdp_manager_cdpi id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_join_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_cdpi ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_cdpi_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_join_id_seq OWNED BY maker.pot_join.id;
 
 
 --
--- Name: cdp_manager_count id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_live; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_count ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_count_id_seq'::regclass);
+CREATE TABLE maker.pot_live (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    live numeric NOT NULL
+);
 
 
 --
--- Name: cdp_manager_first id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_first ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_first_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_live_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: cdp_manager_ilks id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_ilks ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_ilks_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_live_id_seq OWNED BY maker.pot_live.id;
 
 
 --
--- Name: cdp_manager_last id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_pie; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_last ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_last_id_seq'::regclass);
+CREATE TABLE maker.pot_pie (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    pie numeric NOT NULL
+);
 
 
 --
--- Name: cdp_manager_list_next id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_pie_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_list_next ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_list_next_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_pie_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: cdp_manager_list_prev id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_pie_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_list_prev ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_list_prev_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_pie_id_seq OWNED BY maker.pot_pie.id;
 
 
 --
--- Name: cdp_manager_owns id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_rho; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_owns ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_owns_id_seq'::regclass);
+CREATE TABLE maker.pot_rho (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    rho numeric NOT NULL
+);
 
 
 --
--- Name: cdp_manager_urns id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_rho_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_urns ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_urns_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_rho_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: cdp_manager_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_rho_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_vat ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_vat_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_rho_id_seq OWNED BY maker.pot_rho.id;
 
 
 --
--- Name: deal id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_user_pie; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.deal ALTER COLUMN id SET DEFAULT nextval('maker.deal_id_seq'::regclass);
+CREATE TABLE maker.pot_user_pie (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    msg_sender text,
+    pie numeric NOT NULL
+);
 
 
 --
--- Name: dent id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_user_pie_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.dent ALTER COLUMN id SET DEFAULT nextval('maker.dent_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_user_pie_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: flap id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_user_pie_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap ALTER COLUMN id SET DEFAULT nextval('maker.flap_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_user_pie_id_seq OWNED BY maker.pot_user_pie.id;
 
 
 --
--- Name: flap_beg id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_vow; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_beg ALTER COLUMN id SET DEFAULT nextval('maker.flap_beg_id_seq'::regclass);
+CREATE TABLE maker.pot_vow (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    vow text
+);
 
 
 --
--- Name: flap_bid_bid id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_vow_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_bid ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_bid_id_seq'::regclass);
+CREATE SEQUENCE maker.pot_vow_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: flap_bid_end id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: pot_vow_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_end ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_end_id_seq'::regclass);
+ALTER SEQUENCE maker.pot_vow_id_seq OWNED BY maker.pot_vow.id;
 
 
 --
--- Name: flap_bid_guy id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: proxies; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_guy ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_guy_id_seq'::regclass);
+CREATE TABLE maker.proxies (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    sender text,
+    owner text,
+    proxy text,
+    cache text
+);
 
 
 --
--- Name: flap_bid_lot id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: COLUMN proxies.id; Type: COMMENT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_lot ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_lot_id_seq'::regclass);
+COMMENT ON COLUMN maker.proxies.id IS '@omit';
 
 
 --
--- Name: flap_bid_tic id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: proxies_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_tic ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_tic_id_seq'::regclass);
+CREATE SEQUENCE maker.proxies_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: flap_gem id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: proxies_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_gem ALTER COLUMN id SET DEFAULT nextval('maker.flap_gem_id_seq'::regclass);
+ALTER SEQUENCE maker.proxies_id_seq OWNED BY maker.proxies.id;
 
 
 --
--- Name: flap_kick id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_mat; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kick ALTER COLUMN id SET DEFAULT nextval('maker.flap_kick_id_seq'::regclass);
+CREATE TABLE maker.spot_file_mat (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    what text,
+    data numeric
+);
 
 
 --
--- Name: flap_kicks id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_mat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kicks ALTER COLUMN id SET DEFAULT nextval('maker.flap_kicks_id_seq'::regclass);
+CREATE SEQUENCE maker.spot_file_mat_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: flap_live id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_mat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_live ALTER COLUMN id SET DEFAULT nextval('maker.flap_live_id_seq'::regclass);
+ALTER SEQUENCE maker.spot_file_mat_id_seq OWNED BY maker.spot_file_mat.id;
 
 
 --
--- Name: flap_tau id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_par; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_tau ALTER COLUMN id SET DEFAULT nextval('maker.flap_tau_id_seq'::regclass);
+CREATE TABLE maker.spot_file_par (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    what text,
+    data numeric
+);
 
 
 --
--- Name: flap_ttl id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_par_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_ttl ALTER COLUMN id SET DEFAULT nextval('maker.flap_ttl_id_seq'::regclass);
+CREATE SEQUENCE maker.spot_file_par_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: flap_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_par_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_vat ALTER COLUMN id SET DEFAULT nextval('maker.flap_vat_id_seq'::regclass);
+ALTER SEQUENCE maker.spot_file_par_id_seq OWNED BY maker.spot_file_par.id;
 
 
 --
--- Name: flip id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_pip; Type: TABLE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip ALTER COLUMN id SET DEFAULT nextval('maker.flip_id_seq'::regclass);
+CREATE TABLE maker.spot_file_pip (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    what text,
+    pip text
+);
 
 
 --
--- Name: flip_beg id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_pip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_beg ALTER COLUMN id SET DEFAULT nextval('maker.flip_beg_id_seq'::regclass);
+CREATE SEQUENCE maker.spot_file_pip_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
 --
--- Name: flip_bid_bid id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_file_pip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_bid ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_bid_id_seq'::regclass);
+ALTER SEQUENCE maker.spot_file_pip_id_seq OWNED BY maker.spot_file_pip.id;
 
 
 --
--- Name: flip_bid_end id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: spot_ilk_mat; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.spot_ilk_mat (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    mat numeric NOT NULL
+);
+
+
+--
+-- Name: spot_ilk_mat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.spot_ilk_mat_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: spot_ilk_mat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.spot_ilk_mat_id_seq OWNED BY maker.spot_ilk_mat.id;
+
+
+--
+-- Name: spot_ilk_pip; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.spot_ilk_pip (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    pip text
+);
+
+
+--
+-- Name: spot_ilk_pip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.spot_ilk_pip_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: spot_ilk_pip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.spot_ilk_pip_id_seq OWNED BY maker.spot_ilk_pip.id;
+
+
+--
+-- Name: spot_par; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.spot_par (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    par numeric NOT NULL
+);
+
+
+--
+-- Name: spot_par_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.spot_par_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: spot_par_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.spot_par_id_seq OWNED BY maker.spot_par.id;
+
+
+--
+-- Name: spot_poke; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.spot_poke (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    value numeric,
+    spot numeric
+);
+
+
+--
+-- Name: spot_poke_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.spot_poke_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: spot_poke_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.spot_poke_id_seq OWNED BY maker.spot_poke.id;
+
+
+--
+-- Name: spot_vat; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.spot_vat (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    vat text
+);
+
+
+--
+-- Name: spot_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.spot_vat_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: spot_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.spot_vat_id_seq OWNED BY maker.spot_vat.id;
+
+
+--
+-- Name: tend; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.tend (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    bid_id numeric NOT NULL,
+    lot numeric,
+    bid numeric,
+    address_id integer NOT NULL
+);
+
+
+--
+-- Name: tend_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.tend_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: tend_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.tend_id_seq OWNED BY maker.tend.id;
+
+
+--
+-- Name: tick; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.tick (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    bid_id numeric NOT NULL,
+    address_id integer NOT NULL
+);
+
+
+--
+-- Name: tick_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.tick_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: tick_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.tick_id_seq OWNED BY maker.tick.id;
+
+
+--
+-- Name: urns; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.urns (
+    id integer NOT NULL,
+    ilk_id integer NOT NULL,
+    identifier public.citext
+);
+
+
+--
+-- Name: TABLE urns; Type: COMMENT; Schema: maker; Owner: -
+--
+
+COMMENT ON TABLE maker.urns IS '@name raw_urns';
+
+
+--
+-- Name: urns_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.urns_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: urns_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.urns_id_seq OWNED BY maker.urns.id;
+
+
+--
+-- Name: vat_can; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_can (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    "bit" text,
+    usr text,
+    can numeric NOT NULL
+);
+
+
+--
+-- Name: vat_can_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_can_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_can_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_can_id_seq OWNED BY maker.vat_can.id;
+
+
+--
+-- Name: vat_dai; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_dai (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    guy text,
+    dai numeric NOT NULL
+);
+
+
+--
+-- Name: vat_dai_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_dai_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_dai_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_dai_id_seq OWNED BY maker.vat_dai.id;
+
+
+--
+-- Name: vat_debt; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_debt (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    debt numeric NOT NULL
+);
+
+
+--
+-- Name: vat_debt_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_debt_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_debt_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_debt_id_seq OWNED BY maker.vat_debt.id;
+
+
+--
+-- Name: vat_file_debt_ceiling; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_file_debt_ceiling (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    what text,
+    data numeric
+);
+
+
+--
+-- Name: vat_file_debt_ceiling_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_file_debt_ceiling_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_file_debt_ceiling_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_file_debt_ceiling_id_seq OWNED BY maker.vat_file_debt_ceiling.id;
+
+
+--
+-- Name: vat_file_ilk; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_file_ilk (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    what text,
+    data numeric
+);
+
+
+--
+-- Name: vat_file_ilk_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_file_ilk_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_file_ilk_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_file_ilk_id_seq OWNED BY maker.vat_file_ilk.id;
+
+
+--
+-- Name: vat_flux; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_flux (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    src text,
+    dst text,
+    wad numeric
+);
+
+
+--
+-- Name: vat_flux_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_flux_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_flux_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_flux_id_seq OWNED BY maker.vat_flux.id;
+
+
+--
+-- Name: vat_fold; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_fold (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    urn_id integer NOT NULL,
+    rate numeric
+);
+
+
+--
+-- Name: vat_fold_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_fold_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_fold_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_fold_id_seq OWNED BY maker.vat_fold.id;
+
+
+--
+-- Name: vat_fork; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_fork (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    src text,
+    dst text,
+    dink numeric,
+    dart numeric
+);
+
+
+--
+-- Name: vat_fork_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_fork_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_fork_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_fork_id_seq OWNED BY maker.vat_fork.id;
+
+
+--
+-- Name: vat_frob; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_frob (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    urn_id integer NOT NULL,
+    v text,
+    w text,
+    dink numeric,
+    dart numeric
+);
+
+
+--
+-- Name: vat_frob_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_frob_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_frob_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_frob_id_seq OWNED BY maker.vat_frob.id;
+
+
+--
+-- Name: vat_gem; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_gem (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    guy text,
+    gem numeric NOT NULL
+);
+
+
+--
+-- Name: vat_gem_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_gem_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_gem_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_gem_id_seq OWNED BY maker.vat_gem.id;
+
+
+--
+-- Name: vat_grab; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_grab (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    urn_id integer NOT NULL,
+    v text,
+    w text,
+    dink numeric,
+    dart numeric
+);
+
+
+--
+-- Name: vat_grab_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_grab_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_grab_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_grab_id_seq OWNED BY maker.vat_grab.id;
+
+
+--
+-- Name: vat_heal; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_heal (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    rad numeric
+);
+
+
+--
+-- Name: vat_heal_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_heal_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_heal_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_heal_id_seq OWNED BY maker.vat_heal.id;
+
+
+--
+-- Name: vat_hope; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_hope (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    usr text
+);
+
+
+--
+-- Name: vat_hope_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_hope_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_hope_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_hope_id_seq OWNED BY maker.vat_hope.id;
+
+
+--
+-- Name: vat_ilk_art; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_ilk_art (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    art numeric NOT NULL
+);
+
+
+--
+-- Name: vat_ilk_art_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_ilk_art_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_ilk_art_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_ilk_art_id_seq OWNED BY maker.vat_ilk_art.id;
+
+
+--
+-- Name: vat_ilk_dust; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_ilk_dust (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    dust numeric NOT NULL
+);
+
+
+--
+-- Name: vat_ilk_dust_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_ilk_dust_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_ilk_dust_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_ilk_dust_id_seq OWNED BY maker.vat_ilk_dust.id;
+
+
+--
+-- Name: vat_ilk_line; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_ilk_line (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    line numeric NOT NULL
+);
+
+
+--
+-- Name: vat_ilk_line_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_ilk_line_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_ilk_line_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_ilk_line_id_seq OWNED BY maker.vat_ilk_line.id;
+
+
+--
+-- Name: vat_ilk_rate; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_ilk_rate (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    rate numeric NOT NULL
+);
+
+
+--
+-- Name: vat_ilk_rate_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_ilk_rate_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_ilk_rate_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_ilk_rate_id_seq OWNED BY maker.vat_ilk_rate.id;
+
+
+--
+-- Name: vat_ilk_spot; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_ilk_spot (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ilk_id integer NOT NULL,
+    spot numeric NOT NULL
+);
+
+
+--
+-- Name: vat_ilk_spot_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_ilk_spot_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_ilk_spot_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_ilk_spot_id_seq OWNED BY maker.vat_ilk_spot.id;
+
+
+--
+-- Name: vat_init; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_init (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL
+);
+
+
+--
+-- Name: vat_init_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_init_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_init_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_init_id_seq OWNED BY maker.vat_init.id;
+
+
+--
+-- Name: vat_line; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_line (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    line numeric NOT NULL
+);
+
+
+--
+-- Name: vat_line_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_line_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_line_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_line_id_seq OWNED BY maker.vat_line.id;
+
+
+--
+-- Name: vat_live; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_live (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    live numeric NOT NULL
+);
+
+
+--
+-- Name: vat_live_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_live_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_live_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_live_id_seq OWNED BY maker.vat_live.id;
+
+
+--
+-- Name: vat_move; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_move (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    src text NOT NULL,
+    dst text NOT NULL,
+    rad numeric NOT NULL
+);
+
+
+--
+-- Name: vat_move_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_move_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_move_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_move_id_seq OWNED BY maker.vat_move.id;
+
+
+--
+-- Name: vat_nope; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_nope (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    usr text
+);
+
+
+--
+-- Name: vat_nope_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_nope_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_nope_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_nope_id_seq OWNED BY maker.vat_nope.id;
+
+
+--
+-- Name: vat_sin; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_sin (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    guy text,
+    sin numeric NOT NULL
+);
+
+
+--
+-- Name: vat_sin_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_sin_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_sin_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_sin_id_seq OWNED BY maker.vat_sin.id;
+
+
+--
+-- Name: vat_slip; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_slip (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    ilk_id integer NOT NULL,
+    usr text,
+    wad numeric
+);
+
+
+--
+-- Name: vat_slip_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_slip_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_slip_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_slip_id_seq OWNED BY maker.vat_slip.id;
+
+
+--
+-- Name: vat_suck; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_suck (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    u text,
+    v text,
+    rad numeric
+);
+
+
+--
+-- Name: vat_suck_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_suck_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_suck_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_suck_id_seq OWNED BY maker.vat_suck.id;
+
+
+--
+-- Name: vat_urn_art; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_urn_art (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    urn_id integer NOT NULL,
+    art numeric NOT NULL
+);
+
+
+--
+-- Name: vat_urn_art_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_urn_art_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_urn_art_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_urn_art_id_seq OWNED BY maker.vat_urn_art.id;
+
+
+--
+-- Name: vat_urn_ink; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_urn_ink (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    urn_id integer NOT NULL,
+    ink numeric NOT NULL
+);
+
+
+--
+-- Name: vat_urn_ink_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_urn_ink_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_urn_ink_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_urn_ink_id_seq OWNED BY maker.vat_urn_ink.id;
+
+
+--
+-- Name: vat_vice; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vat_vice (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    vice numeric NOT NULL
+);
+
+
+--
+-- Name: vat_vice_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vat_vice_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vat_vice_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vat_vice_id_seq OWNED BY maker.vat_vice.id;
+
+
+--
+-- Name: vow_ash; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_ash (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    ash numeric
+);
+
+
+--
+-- Name: vow_ash_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_ash_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_ash_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_ash_id_seq OWNED BY maker.vow_ash.id;
+
+
+--
+-- Name: vow_bump; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_bump (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    bump numeric
+);
+
+
+--
+-- Name: vow_bump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_bump_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_bump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_bump_id_seq OWNED BY maker.vow_bump.id;
+
+
+--
+-- Name: vow_dump; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_dump (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    dump numeric
+);
+
+
+--
+-- Name: vow_dump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_dump_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_dump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_dump_id_seq OWNED BY maker.vow_dump.id;
+
+
+--
+-- Name: vow_fess; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_fess (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    tab numeric NOT NULL
+);
+
+
+--
+-- Name: vow_fess_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_fess_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_fess_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_fess_id_seq OWNED BY maker.vow_fess.id;
+
+
+--
+-- Name: vow_file; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_file (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    what text,
+    data numeric
+);
+
+
+--
+-- Name: vow_file_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_file_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_file_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_file_id_seq OWNED BY maker.vow_file.id;
+
+
+--
+-- Name: vow_flap; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_flap (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text
+);
+
+
+--
+-- Name: vow_flap_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_flap_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_flap_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_flap_id_seq OWNED BY maker.vow_flap.id;
+
+
+--
+-- Name: vow_flapper; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_flapper (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    flapper text
+);
+
+
+--
+-- Name: vow_flapper_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_flapper_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_flapper_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_flapper_id_seq OWNED BY maker.vow_flapper.id;
+
+
+--
+-- Name: vow_flog; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_flog (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    era integer NOT NULL
+);
+
+
+--
+-- Name: vow_flog_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_flog_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_flog_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_flog_id_seq OWNED BY maker.vow_flog.id;
+
+
+--
+-- Name: vow_flop; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_flop (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    msg_sender text
+);
+
+
+--
+-- Name: vow_flop_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_flop_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_flop_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_flop_id_seq OWNED BY maker.vow_flop.id;
+
+
+--
+-- Name: vow_flopper; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_flopper (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    flopper text
+);
+
+
+--
+-- Name: vow_flopper_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_flopper_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_flopper_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_flopper_id_seq OWNED BY maker.vow_flopper.id;
+
+
+--
+-- Name: vow_heal; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_heal (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    rad numeric
+);
+
+
+--
+-- Name: vow_heal_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_heal_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_heal_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_heal_id_seq OWNED BY maker.vow_heal.id;
+
+
+--
+-- Name: vow_hump; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_hump (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    hump numeric
+);
+
+
+--
+-- Name: vow_hump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_hump_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_hump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_hump_id_seq OWNED BY maker.vow_hump.id;
+
+
+--
+-- Name: vow_kiss; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_kiss (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    rad numeric
+);
+
+
+--
+-- Name: vow_kiss_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_kiss_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_kiss_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_kiss_id_seq OWNED BY maker.vow_kiss.id;
+
+
+--
+-- Name: vow_sin_integer; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_sin_integer (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    sin numeric
+);
+
+
+--
+-- Name: vow_sin_integer_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_sin_integer_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_sin_integer_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_sin_integer_id_seq OWNED BY maker.vow_sin_integer.id;
+
+
+--
+-- Name: vow_sin_mapping; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_sin_mapping (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    era numeric,
+    tab numeric
+);
+
+
+--
+-- Name: vow_sin_mapping_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_sin_mapping_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_sin_mapping_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_sin_mapping_id_seq OWNED BY maker.vow_sin_mapping.id;
+
+
+--
+-- Name: vow_sump; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_sump (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    sump numeric
+);
+
+
+--
+-- Name: vow_sump_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_sump_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_sump_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_sump_id_seq OWNED BY maker.vow_sump.id;
+
+
+--
+-- Name: vow_vat; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_vat (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    vat text
+);
+
+
+--
+-- Name: vow_vat_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_vat_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_vat_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_vat_id_seq OWNED BY maker.vow_vat.id;
+
+
+--
+-- Name: vow_wait; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.vow_wait (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    wait numeric
+);
+
+
+--
+-- Name: vow_wait_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.vow_wait_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: vow_wait_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.vow_wait_id_seq OWNED BY maker.vow_wait.id;
+
+
+--
+-- Name: wards; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.wards (
+    id integer NOT NULL,
+    block_number bigint,
+    block_hash text,
+    address_id integer NOT NULL,
+    usr text,
+    wards numeric NOT NULL
+);
+
+
+--
+-- Name: wards_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.wards_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: wards_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.wards_id_seq OWNED BY maker.wards.id;
+
+
+--
+-- Name: yank; Type: TABLE; Schema: maker; Owner: -
+--
+
+CREATE TABLE maker.yank (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    log_id bigint NOT NULL,
+    bid_id numeric NOT NULL,
+    address_id integer NOT NULL
+);
+
+
+--
+-- Name: yank_id_seq; Type: SEQUENCE; Schema: maker; Owner: -
+--
+
+CREATE SEQUENCE maker.yank_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: yank_id_seq; Type: SEQUENCE OWNED BY; Schema: maker; Owner: -
+--
+
+ALTER SEQUENCE maker.yank_id_seq OWNED BY maker.yank.id;
+
+
+--
+-- Name: addresses; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.addresses (
+    id integer NOT NULL,
+    address character varying(42)
+);
+
+
+--
+-- Name: addresses_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.addresses_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: addresses_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.addresses_id_seq OWNED BY public.addresses.id;
+
+
+--
+-- Name: full_sync_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.full_sync_logs (
+    id integer NOT NULL,
+    block_number bigint,
+    address character varying(66),
+    tx_hash character varying(66),
+    index bigint,
+    topic0 character varying(66),
+    topic1 character varying(66),
+    topic2 character varying(66),
+    topic3 character varying(66),
+    data text,
+    receipt_id integer
+);
+
+
+--
+-- Name: block_stats; Type: VIEW; Schema: public; Owner: -
+--
+
+CREATE VIEW public.block_stats AS
+ SELECT max(full_sync_logs.block_number) AS max_block,
+    min(full_sync_logs.block_number) AS min_block
+   FROM public.full_sync_logs;
+
+
+--
+-- Name: blocks; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.blocks (
+    id integer NOT NULL,
+    difficulty bigint,
+    extra_data character varying,
+    gas_limit bigint,
+    gas_used bigint,
+    hash character varying(66),
+    miner character varying(42),
+    nonce character varying(20),
+    number bigint,
+    parent_hash character varying(66),
+    reward numeric,
+    uncles_reward numeric,
+    size character varying,
+    "time" bigint,
+    is_final boolean,
+    uncle_hash character varying(66),
+    eth_node_id integer NOT NULL,
+    eth_node_fingerprint character varying(128) NOT NULL
+);
+
+
+--
+-- Name: blocks_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.blocks_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: blocks_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.blocks_id_seq OWNED BY public.blocks.id;
+
+
+--
+-- Name: checked_headers; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.checked_headers (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    yank integer DEFAULT 0 NOT NULL,
+    new_cdp integer DEFAULT 0 NOT NULL
+);
+
+
+--
+-- Name: checked_headers_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.checked_headers_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: checked_headers_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.checked_headers_id_seq OWNED BY public.checked_headers.id;
+
+
+--
+-- Name: eth_nodes; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.eth_nodes (
+    id integer NOT NULL,
+    client_name character varying,
+    genesis_block character varying(66),
+    network_id numeric,
+    eth_node_id character varying(128)
+);
+
+
+--
+-- Name: full_sync_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.full_sync_logs_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: full_sync_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.full_sync_logs_id_seq OWNED BY public.full_sync_logs.id;
+
+
+--
+-- Name: full_sync_receipts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.full_sync_receipts (
+    id integer NOT NULL,
+    contract_address_id integer NOT NULL,
+    cumulative_gas_used numeric,
+    gas_used numeric,
+    state_root character varying(66),
+    status integer,
+    tx_hash character varying(66),
+    block_id integer NOT NULL
+);
+
+
+--
+-- Name: full_sync_receipts_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.full_sync_receipts_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: full_sync_receipts_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.full_sync_receipts_id_seq OWNED BY public.full_sync_receipts.id;
+
+
+--
+-- Name: full_sync_transactions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.full_sync_transactions (
+    id integer NOT NULL,
+    block_id integer NOT NULL,
+    gas_limit numeric,
+    gas_price numeric,
+    hash character varying(66),
+    input_data bytea,
+    nonce numeric,
+    raw bytea,
+    tx_from character varying(66),
+    tx_index integer,
+    tx_to character varying(66),
+    value numeric
+);
+
+
+--
+-- Name: full_sync_transactions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.full_sync_transactions_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: full_sync_transactions_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.full_sync_transactions_id_seq OWNED BY public.full_sync_transactions.id;
+
+
+--
+-- Name: goose_db_version; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.goose_db_version (
+    id integer NOT NULL,
+    version_id bigint NOT NULL,
+    is_applied boolean NOT NULL,
+    tstamp timestamp without time zone DEFAULT now()
+);
+
+
+--
+-- Name: goose_db_version_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.goose_db_version_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: goose_db_version_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.goose_db_version_id_seq OWNED BY public.goose_db_version.id;
+
+
+--
+-- Name: header_sync_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.header_sync_logs (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    address integer NOT NULL,
+    topics bytea[],
+    data bytea,
+    block_number bigint,
+    block_hash character varying(66),
+    tx_hash character varying(66),
+    tx_index integer,
+    log_index integer,
+    raw jsonb,
+    transformed boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: header_sync_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.header_sync_logs_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: header_sync_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.header_sync_logs_id_seq OWNED BY public.header_sync_logs.id;
+
+
+--
+-- Name: header_sync_receipts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.header_sync_receipts (
+    id integer NOT NULL,
+    transaction_id integer NOT NULL,
+    header_id integer NOT NULL,
+    contract_address_id integer NOT NULL,
+    cumulative_gas_used numeric,
+    gas_used numeric,
+    state_root character varying(66),
+    status integer,
+    tx_hash character varying(66),
+    rlp bytea
+);
+
+
+--
+-- Name: header_sync_receipts_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.header_sync_receipts_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: header_sync_receipts_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.header_sync_receipts_id_seq OWNED BY public.header_sync_receipts.id;
+
+
+--
+-- Name: header_sync_transactions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.header_sync_transactions (
+    id integer NOT NULL,
+    header_id integer NOT NULL,
+    hash character varying(66),
+    gas_limit numeric,
+    gas_price numeric,
+    input_data bytea,
+    nonce numeric,
+    raw bytea,
+    tx_from character varying(44),
+    tx_index integer,
+    tx_to character varying(44),
+    value numeric
+);
+
+
+--
+-- Name: header_sync_transactions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.header_sync_transactions_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: header_sync_transactions_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.header_sync_transactions_id_seq OWNED BY public.header_sync_transactions.id;
+
+
+--
+-- Name: headers; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.headers (
+    id integer NOT NULL,
+    hash character varying(66),
+    block_number bigint,
+    raw jsonb,
+    block_timestamp numeric,
+    check_count integer DEFAULT 0 NOT NULL,
+    eth_node_id integer NOT NULL,
+    eth_node_fingerprint character varying(128)
+);
+
+
+--
+-- Name: headers_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.headers_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: headers_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.headers_id_seq OWNED BY public.headers.id;
+
+
+--
+-- Name: log_filters; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.log_filters (
+    id integer NOT NULL,
+    name character varying NOT NULL,
+    from_block bigint,
+    to_block bigint,
+    address character varying(66),
+    topic0 character varying(66),
+    topic1 character varying(66),
+    topic2 character varying(66),
+    topic3 character varying(66),
+    CONSTRAINT log_filters_from_block_check CHECK ((from_block >= 0)),
+    CONSTRAINT log_filters_name_check CHECK (((name)::text <> ''::text)),
+    CONSTRAINT log_filters_to_block_check CHECK ((to_block >= 0))
+);
+
+
+--
+-- Name: log_filters_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.log_filters_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: log_filters_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.log_filters_id_seq OWNED BY public.log_filters.id;
+
+
+--
+-- Name: nodes_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.nodes_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: nodes_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.nodes_id_seq OWNED BY public.eth_nodes.id;
+
+
+--
+-- Name: queued_storage; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.queued_storage (
+    id integer NOT NULL,
+    block_height bigint,
+    block_hash bytea,
+    contract bytea,
+    storage_key bytea,
+    storage_value bytea
+);
+
+
+--
+-- Name: queued_storage_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.queued_storage_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: queued_storage_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.queued_storage_id_seq OWNED BY public.queued_storage.id;
+
+
+--
+-- Name: uncles; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.uncles (
+    id integer NOT NULL,
+    hash character varying(66) NOT NULL,
+    block_id integer NOT NULL,
+    reward numeric NOT NULL,
+    miner character varying(42) NOT NULL,
+    raw jsonb,
+    block_timestamp numeric,
+    eth_node_id integer NOT NULL,
+    eth_node_fingerprint character varying(128)
+);
+
+
+--
+-- Name: uncles_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.uncles_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: uncles_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.uncles_id_seq OWNED BY public.uncles.id;
+
+
+--
+-- Name: watched_contracts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.watched_contracts (
+    contract_id integer NOT NULL,
+    contract_abi json,
+    contract_hash character varying(66)
+);
+
+
+--
+-- Name: watched_contracts_contract_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.watched_contracts_contract_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: watched_contracts_contract_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.watched_contracts_contract_id_seq OWNED BY public.watched_contracts.contract_id;
+
+
+--
+-- Name: watched_event_logs; Type: VIEW; Schema: public; Owner: -
+--
+
+CREATE VIEW public.watched_event_logs AS
+ SELECT log_filters.name,
+    full_sync_logs.id,
+    full_sync_logs.block_number,
+    full_sync_logs.address,
+    full_sync_logs.tx_hash,
+    full_sync_logs.index,
+    full_sync_logs.topic0,
+    full_sync_logs.topic1,
+    full_sync_logs.topic2,
+    full_sync_logs.topic3,
+    full_sync_logs.data,
+    full_sync_logs.receipt_id
+   FROM ((public.log_filters
+     CROSS JOIN public.block_stats)
+     JOIN public.full_sync_logs ON ((((full_sync_logs.address)::text = (log_filters.address)::text) AND (full_sync_logs.block_number >= COALESCE(log_filters.from_block, block_stats.min_block)) AND (full_sync_logs.block_number <= COALESCE(log_filters.to_block, block_stats.max_block)))))
+  WHERE ((((log_filters.topic0)::text = (full_sync_logs.topic0)::text) OR (log_filters.topic0 IS NULL)) AND (((log_filters.topic1)::text = (full_sync_logs.topic1)::text) OR (log_filters.topic1 IS NULL)) AND (((log_filters.topic2)::text = (full_sync_logs.topic2)::text) OR (log_filters.topic2 IS NULL)) AND (((log_filters.topic3)::text = (full_sync_logs.topic3)::text) OR (log_filters.topic3 IS NULL)));
+
+
+--
+-- Name: watched_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.watched_logs (
+    id integer NOT NULL,
+    contract_address character varying(42),
+    topic_zero character varying(66)
+);
+
+
+--
+-- Name: watched_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.watched_logs_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: watched_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.watched_logs_id_seq OWNED BY public.watched_logs.id;
+
+
+--
+-- Name: managed_cdp id; Type: DEFAULT; Schema: api; Owner: -
+--
+
+ALTER TABLE ONLY api.managed_cdp ALTER COLUMN id SET DEFAULT nextval('api.managed_cdp_id_seq'::regclass);
+
+
+--
+-- Name: auction_file id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.auction_file ALTER COLUMN id SET DEFAULT nextval('maker.auction_file_id_seq'::regclass);
+
+
+--
+-- Name: auth id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.auth ALTER COLUMN id SET DEFAULT nextval('maker.auth_id_seq'::regclass);
+
+
+--
+-- Name: bite id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.bite ALTER COLUMN id SET DEFAULT nextval('maker.bite_id_seq'::regclass);
+
+
+--
+-- Name: cage id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cage ALTER COLUMN id SET DEFAULT nextval('maker.cage_id_seq'::regclass);
+
+
+--
+-- Name: cat_file_chop_lump id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_chop_lump ALTER COLUMN id SET DEFAULT nextval('maker.cat_file_chop_lump_id_seq'::regclass);
+
+
+--
+-- Name: cat_file_flip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_flip ALTER COLUMN id SET DEFAULT nextval('maker.cat_file_flip_id_seq'::regclass);
+
+
+--
+-- Name: cat_file_vow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_vow ALTER COLUMN id SET DEFAULT nextval('maker.cat_file_vow_id_seq'::regclass);
+
+
+--
+-- Name: cat_ilk_chop id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_chop ALTER COLUMN id SET DEFAULT nextval('maker.cat_ilk_chop_id_seq'::regclass);
+
+
+--
+-- Name: cat_ilk_flip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_flip ALTER COLUMN id SET DEFAULT nextval('maker.cat_ilk_flip_id_seq'::regclass);
+
+
+--
+-- Name: cat_ilk_lump id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_lump ALTER COLUMN id SET DEFAULT nextval('maker.cat_ilk_lump_id_seq'::regclass);
+
+
+--
+-- Name: cat_live id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_live ALTER COLUMN id SET DEFAULT nextval('maker.cat_live_id_seq'::regclass);
+
+
+--
+-- Name: cat_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_vat ALTER COLUMN id SET DEFAULT nextval('maker.cat_vat_id_seq'::regclass);
+
+
+--
+-- Name: cat_vow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_vow ALTER COLUMN id SET DEFAULT nextval('maker.cat_vow_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_cdp_allow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdp_allow ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_cdp_allow_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_cdp_can id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdp_can ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_cdp_can_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_cdpi id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdpi ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_cdpi_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_count id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_count ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_count_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_enter id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_enter ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_enter_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_first id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_first ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_first_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_flux id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_flux ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_flux_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_frob id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_frob ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_frob_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_give id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_give ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_give_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_ilks id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_ilks ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_ilks_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_last id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_last ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_last_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_list_next id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_list_next ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_list_next_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_list_prev id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_list_prev ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_list_prev_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_move id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_move ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_move_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_owns id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_owns ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_owns_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_quit id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_quit ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_quit_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_shift id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_shift ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_shift_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_urn_allow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urn_allow ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_urn_allow_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_urn_can id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urn_can ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_urn_can_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_urns id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urns ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_urns_id_seq'::regclass);
+
+
+--
+-- Name: cdp_manager_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_vat ALTER COLUMN id SET DEFAULT nextval('maker.cdp_manager_vat_id_seq'::regclass);
+
+
+--
+-- Name: chief_approvals id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_approvals ALTER COLUMN id SET DEFAULT nextval('maker.chief_approvals_id_seq'::regclass);
+
+
+--
+-- Name: chief_deposits id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_deposits ALTER COLUMN id SET DEFAULT nextval('maker.chief_deposits_id_seq'::regclass);
+
+
+--
+-- Name: chief_etch id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_etch ALTER COLUMN id SET DEFAULT nextval('maker.chief_etch_id_seq'::regclass);
+
+
+--
+-- Name: chief_free id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_free ALTER COLUMN id SET DEFAULT nextval('maker.chief_free_id_seq'::regclass);
+
+
+--
+-- Name: chief_hat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_hat ALTER COLUMN id SET DEFAULT nextval('maker.chief_hat_id_seq'::regclass);
+
+
+--
+-- Name: chief_lift id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_lift ALTER COLUMN id SET DEFAULT nextval('maker.chief_lift_id_seq'::regclass);
+
+
+--
+-- Name: chief_lock id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_lock ALTER COLUMN id SET DEFAULT nextval('maker.chief_lock_id_seq'::regclass);
+
+
+--
+-- Name: chief_vote id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_vote ALTER COLUMN id SET DEFAULT nextval('maker.chief_vote_id_seq'::regclass);
+
+
+--
+-- Name: chief_vote_yays id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_vote_yays ALTER COLUMN id SET DEFAULT nextval('maker.chief_vote_yays_id_seq'::regclass);
+
+
+--
+-- Name: chief_votes id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_votes ALTER COLUMN id SET DEFAULT nextval('maker.chief_votes_id_seq'::regclass);
+
+
+--
+-- Name: dai_allowance id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_allowance ALTER COLUMN id SET DEFAULT nextval('maker.dai_allowance_id_seq'::regclass);
+
+
+--
+-- Name: dai_approval id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_approval ALTER COLUMN id SET DEFAULT nextval('maker.dai_approval_id_seq'::regclass);
+
+
+--
+-- Name: dai_balance_of id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_balance_of ALTER COLUMN id SET DEFAULT nextval('maker.dai_balance_of_id_seq'::regclass);
+
+
+--
+-- Name: dai_exit id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_exit ALTER COLUMN id SET DEFAULT nextval('maker.dai_exit_id_seq'::regclass);
+
+
+--
+-- Name: dai_join id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_join ALTER COLUMN id SET DEFAULT nextval('maker.dai_join_id_seq'::regclass);
+
+
+--
+-- Name: dai_nonces id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_nonces ALTER COLUMN id SET DEFAULT nextval('maker.dai_nonces_id_seq'::regclass);
+
+
+--
+-- Name: dai_total_supply id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_total_supply ALTER COLUMN id SET DEFAULT nextval('maker.dai_total_supply_id_seq'::regclass);
+
+
+--
+-- Name: dai_transfer id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_transfer ALTER COLUMN id SET DEFAULT nextval('maker.dai_transfer_id_seq'::regclass);
+
+
+--
+-- Name: deal id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.deal ALTER COLUMN id SET DEFAULT nextval('maker.deal_id_seq'::regclass);
+
+
+--
+-- Name: dent id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dent ALTER COLUMN id SET DEFAULT nextval('maker.dent_id_seq'::regclass);
+
+
+--
+-- Name: end_bag id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_bag ALTER COLUMN id SET DEFAULT nextval('maker.end_bag_id_seq'::regclass);
+
+
+--
+-- Name: end_cage id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cage ALTER COLUMN id SET DEFAULT nextval('maker.end_cage_id_seq'::regclass);
+
+
+--
+-- Name: end_cage_ilk id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cage_ilk ALTER COLUMN id SET DEFAULT nextval('maker.end_cage_ilk_id_seq'::regclass);
+
+
+--
+-- Name: end_cash id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cash ALTER COLUMN id SET DEFAULT nextval('maker.end_cash_id_seq'::regclass);
+
+
+--
+-- Name: end_debt id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_debt ALTER COLUMN id SET DEFAULT nextval('maker.end_debt_id_seq'::regclass);
+
+
+--
+-- Name: end_flow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_flow ALTER COLUMN id SET DEFAULT nextval('maker.end_flow_id_seq'::regclass);
+
+
+--
+-- Name: end_free id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_free ALTER COLUMN id SET DEFAULT nextval('maker.end_free_id_seq'::regclass);
+
+
+--
+-- Name: end_ilk_art id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_art ALTER COLUMN id SET DEFAULT nextval('maker.end_ilk_art_id_seq'::regclass);
+
+
+--
+-- Name: end_ilk_fix id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_fix ALTER COLUMN id SET DEFAULT nextval('maker.end_ilk_fix_id_seq'::regclass);
+
+
+--
+-- Name: end_ilk_gap id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_gap ALTER COLUMN id SET DEFAULT nextval('maker.end_ilk_gap_id_seq'::regclass);
+
+
+--
+-- Name: end_ilk_tag id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_tag ALTER COLUMN id SET DEFAULT nextval('maker.end_ilk_tag_id_seq'::regclass);
+
+
+--
+-- Name: end_live id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_live ALTER COLUMN id SET DEFAULT nextval('maker.end_live_id_seq'::regclass);
+
+
+--
+-- Name: end_out id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_out ALTER COLUMN id SET DEFAULT nextval('maker.end_out_id_seq'::regclass);
+
+
+--
+-- Name: end_pack id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_pack ALTER COLUMN id SET DEFAULT nextval('maker.end_pack_id_seq'::regclass);
+
+
+--
+-- Name: end_skim id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_skim ALTER COLUMN id SET DEFAULT nextval('maker.end_skim_id_seq'::regclass);
+
+
+--
+-- Name: end_thaw id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_thaw ALTER COLUMN id SET DEFAULT nextval('maker.end_thaw_id_seq'::regclass);
+
+
+--
+-- Name: end_wait id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_wait ALTER COLUMN id SET DEFAULT nextval('maker.end_wait_id_seq'::regclass);
+
+
+--
+-- Name: end_when id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_when ALTER COLUMN id SET DEFAULT nextval('maker.end_when_id_seq'::regclass);
+
+
+--
+-- Name: esm_fire id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_fire ALTER COLUMN id SET DEFAULT nextval('maker.esm_fire_id_seq'::regclass);
+
+
+--
+-- Name: esm_fired id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_fired ALTER COLUMN id SET DEFAULT nextval('maker.esm_fired_id_seq'::regclass);
+
+
+--
+-- Name: esm_join id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_join ALTER COLUMN id SET DEFAULT nextval('maker.esm_join_id_seq'::regclass);
+
+
+--
+-- Name: esm_min id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_min ALTER COLUMN id SET DEFAULT nextval('maker.esm_min_id_seq'::regclass);
+
+
+--
+-- Name: esm_sum id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_sum ALTER COLUMN id SET DEFAULT nextval('maker.esm_sum_id_seq'::regclass);
+
+
+--
+-- Name: esm_user_sum id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_user_sum ALTER COLUMN id SET DEFAULT nextval('maker.esm_user_sum_id_seq'::regclass);
+
+
+--
+-- Name: flap id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap ALTER COLUMN id SET DEFAULT nextval('maker.flap_id_seq'::regclass);
+
+
+--
+-- Name: flap_beg id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_beg ALTER COLUMN id SET DEFAULT nextval('maker.flap_beg_id_seq'::regclass);
+
+
+--
+-- Name: flap_bid_bid id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_bid ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_bid_id_seq'::regclass);
+
+
+--
+-- Name: flap_bid_end id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_end ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_end_id_seq'::regclass);
+
+
+--
+-- Name: flap_bid_guy id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_guy ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_guy_id_seq'::regclass);
+
+
+--
+-- Name: flap_bid_lot id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_lot ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_lot_id_seq'::regclass);
+
+
+--
+-- Name: flap_bid_tic id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_tic ALTER COLUMN id SET DEFAULT nextval('maker.flap_bid_tic_id_seq'::regclass);
+
+
+--
+-- Name: flap_gem id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_gem ALTER COLUMN id SET DEFAULT nextval('maker.flap_gem_id_seq'::regclass);
+
+
+--
+-- Name: flap_kick id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_kick ALTER COLUMN id SET DEFAULT nextval('maker.flap_kick_id_seq'::regclass);
+
+
+--
+-- Name: flap_kicks id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_kicks ALTER COLUMN id SET DEFAULT nextval('maker.flap_kicks_id_seq'::regclass);
+
+
+--
+-- Name: flap_live id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_live ALTER COLUMN id SET DEFAULT nextval('maker.flap_live_id_seq'::regclass);
+
+
+--
+-- Name: flap_tau id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_tau ALTER COLUMN id SET DEFAULT nextval('maker.flap_tau_id_seq'::regclass);
+
+
+--
+-- Name: flap_ttl id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_ttl ALTER COLUMN id SET DEFAULT nextval('maker.flap_ttl_id_seq'::regclass);
+
+
+--
+-- Name: flap_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_vat ALTER COLUMN id SET DEFAULT nextval('maker.flap_vat_id_seq'::regclass);
+
+
+--
+-- Name: flip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip ALTER COLUMN id SET DEFAULT nextval('maker.flip_id_seq'::regclass);
+
+
+--
+-- Name: flip_beg id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_beg ALTER COLUMN id SET DEFAULT nextval('maker.flip_beg_id_seq'::regclass);
+
+
+--
+-- Name: flip_bid_bid id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_bid ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_bid_id_seq'::regclass);
+
+
+--
+-- Name: flip_bid_end id; Type: DEFAULT; Schema: maker; Owner: -
 --
 
 ALTER TABLE ONLY maker.flip_bid_end ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_end_id_seq'::regclass);
 
 
 --
--- Name: flip_bid_gal id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: flip_bid_gal id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_gal ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_gal_id_seq'::regclass);
+
+
+--
+-- Name: flip_bid_guy id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_guy ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_guy_id_seq'::regclass);
+
+
+--
+-- Name: flip_bid_lot id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_lot ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_lot_id_seq'::regclass);
+
+
+--
+-- Name: flip_bid_tab id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_tab ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_tab_id_seq'::regclass);
+
+
+--
+-- Name: flip_bid_tic id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_tic ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_tic_id_seq'::regclass);
+
+
+--
+-- Name: flip_bid_usr id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_usr ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_usr_id_seq'::regclass);
+
+
+--
+-- Name: flip_ilk id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_ilk ALTER COLUMN id SET DEFAULT nextval('maker.flip_ilk_id_seq'::regclass);
+
+
+--
+-- Name: flip_kick id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_kick ALTER COLUMN id SET DEFAULT nextval('maker.flip_kick_id_seq'::regclass);
+
+
+--
+-- Name: flip_kicks id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_kicks ALTER COLUMN id SET DEFAULT nextval('maker.flip_kicks_id_seq'::regclass);
+
+
+--
+-- Name: flip_tau id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_tau ALTER COLUMN id SET DEFAULT nextval('maker.flip_tau_id_seq'::regclass);
+
+
+--
+-- Name: flip_ttl id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_ttl ALTER COLUMN id SET DEFAULT nextval('maker.flip_ttl_id_seq'::regclass);
+
+
+--
+-- Name: flip_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_vat ALTER COLUMN id SET DEFAULT nextval('maker.flip_vat_id_seq'::regclass);
+
+
+--
+-- Name: flop id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop ALTER COLUMN id SET DEFAULT nextval('maker.flop_id_seq'::regclass);
+
+
+--
+-- Name: flop_beg id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_beg ALTER COLUMN id SET DEFAULT nextval('maker.flop_beg_id_seq'::regclass);
+
+
+--
+-- Name: flop_bid_bid id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_bid ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_bid_id_seq'::regclass);
+
+
+--
+-- Name: flop_bid_end id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_end ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_end_id_seq'::regclass);
+
+
+--
+-- Name: flop_bid_guy id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_guy ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_guy_id_seq'::regclass);
+
+
+--
+-- Name: flop_bid_lot id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_lot ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_lot_id_seq'::regclass);
+
+
+--
+-- Name: flop_bid_tic id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_tic ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_tic_id_seq'::regclass);
+
+
+--
+-- Name: flop_gem id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_gem ALTER COLUMN id SET DEFAULT nextval('maker.flop_gem_id_seq'::regclass);
+
+
+--
+-- Name: flop_kick id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_kick ALTER COLUMN id SET DEFAULT nextval('maker.flop_kick_id_seq'::regclass);
+
+
+--
+-- Name: flop_kicks id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_kicks ALTER COLUMN id SET DEFAULT nextval('maker.flop_kicks_id_seq'::regclass);
+
+
+--
+-- Name: flop_live id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_live ALTER COLUMN id SET DEFAULT nextval('maker.flop_live_id_seq'::regclass);
+
+
+--
+-- Name: flop_pad id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_pad ALTER COLUMN id SET DEFAULT nextval('maker.flop_pad_id_seq'::regclass);
+
+
+--
+-- Name: flop_tau id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_tau ALTER COLUMN id SET DEFAULT nextval('maker.flop_tau_id_seq'::regclass);
+
+
+--
+-- Name: flop_ttl id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_ttl ALTER COLUMN id SET DEFAULT nextval('maker.flop_ttl_id_seq'::regclass);
+
+
+--
+-- Name: flop_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_vat ALTER COLUMN id SET DEFAULT nextval('maker.flop_vat_id_seq'::regclass);
+
+
+--
+-- Name: gem_exit id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.gem_exit ALTER COLUMN id SET DEFAULT nextval('maker.gem_exit_id_seq'::regclass);
+
+
+--
+-- Name: gem_join id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.gem_join ALTER COLUMN id SET DEFAULT nextval('maker.gem_join_id_seq'::regclass);
+
+
+--
+-- Name: generic_file id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.generic_file ALTER COLUMN id SET DEFAULT nextval('maker.generic_file_id_seq'::regclass);
+
+
+--
+-- Name: ilks id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.ilks ALTER COLUMN id SET DEFAULT nextval('maker.ilks_id_seq'::regclass);
+
+
+--
+-- Name: jug_base id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_base ALTER COLUMN id SET DEFAULT nextval('maker.jug_base_id_seq'::regclass);
+
+
+--
+-- Name: jug_drip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_drip ALTER COLUMN id SET DEFAULT nextval('maker.jug_drip_id_seq'::regclass);
+
+
+--
+-- Name: jug_file_base id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_base ALTER COLUMN id SET DEFAULT nextval('maker.jug_file_base_id_seq'::regclass);
+
+
+--
+-- Name: jug_file_ilk id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_ilk ALTER COLUMN id SET DEFAULT nextval('maker.jug_file_ilk_id_seq'::regclass);
+
+
+--
+-- Name: jug_file_vow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_vow ALTER COLUMN id SET DEFAULT nextval('maker.jug_file_vow_id_seq'::regclass);
+
+
+--
+-- Name: jug_ilk_duty id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_ilk_duty ALTER COLUMN id SET DEFAULT nextval('maker.jug_ilk_duty_id_seq'::regclass);
+
+
+--
+-- Name: jug_ilk_rho id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_ilk_rho ALTER COLUMN id SET DEFAULT nextval('maker.jug_ilk_rho_id_seq'::regclass);
+
+
+--
+-- Name: jug_init id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_init ALTER COLUMN id SET DEFAULT nextval('maker.jug_init_id_seq'::regclass);
+
+
+--
+-- Name: jug_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_vat ALTER COLUMN id SET DEFAULT nextval('maker.jug_vat_id_seq'::regclass);
+
+
+--
+-- Name: jug_vow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_vow ALTER COLUMN id SET DEFAULT nextval('maker.jug_vow_id_seq'::regclass);
+
+
+--
+-- Name: log_median_price id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.log_median_price ALTER COLUMN id SET DEFAULT nextval('maker.log_median_price_id_seq'::regclass);
+
+
+--
+-- Name: log_value id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.log_value ALTER COLUMN id SET DEFAULT nextval('maker.log_value_id_seq'::regclass);
+
+
+--
+-- Name: new_cdp id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.new_cdp ALTER COLUMN id SET DEFAULT nextval('maker.new_cdp_id_seq'::regclass);
+
+
+--
+-- Name: osm_cur_has id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_cur_has ALTER COLUMN id SET DEFAULT nextval('maker.osm_cur_has_id_seq'::regclass);
+
+
+--
+-- Name: osm_cur_val id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_cur_val ALTER COLUMN id SET DEFAULT nextval('maker.osm_cur_val_id_seq'::regclass);
+
+
+--
+-- Name: osm_hop id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_hop ALTER COLUMN id SET DEFAULT nextval('maker.osm_hop_id_seq'::regclass);
+
+
+--
+-- Name: osm_nxt_has id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_nxt_has ALTER COLUMN id SET DEFAULT nextval('maker.osm_nxt_has_id_seq'::regclass);
+
+
+--
+-- Name: osm_nxt_val id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_nxt_val ALTER COLUMN id SET DEFAULT nextval('maker.osm_nxt_val_id_seq'::regclass);
+
+
+--
+-- Name: osm_stopped id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_stopped ALTER COLUMN id SET DEFAULT nextval('maker.osm_stopped_id_seq'::regclass);
+
+
+--
+-- Name: osm_zzz id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_zzz ALTER COLUMN id SET DEFAULT nextval('maker.osm_zzz_id_seq'::regclass);
+
+
+--
+-- Name: pause_drop id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_drop ALTER COLUMN id SET DEFAULT nextval('maker.pause_drop_id_seq'::regclass);
+
+
+--
+-- Name: pause_exec id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_exec ALTER COLUMN id SET DEFAULT nextval('maker.pause_exec_id_seq'::regclass);
+
+
+--
+-- Name: pause_plot id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_plot ALTER COLUMN id SET DEFAULT nextval('maker.pause_plot_id_seq'::regclass);
+
+
+--
+-- Name: pot_chi id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_chi ALTER COLUMN id SET DEFAULT nextval('maker.pot_chi_id_seq'::regclass);
+
+
+--
+-- Name: pot_drip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_drip ALTER COLUMN id SET DEFAULT nextval('maker.pot_drip_id_seq'::regclass);
+
+
+--
+-- Name: pot_dsr id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_dsr ALTER COLUMN id SET DEFAULT nextval('maker.pot_dsr_id_seq'::regclass);
+
+
+--
+-- Name: pot_exit id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_exit ALTER COLUMN id SET DEFAULT nextval('maker.pot_exit_id_seq'::regclass);
+
+
+--
+-- Name: pot_file_dsr id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_file_dsr ALTER COLUMN id SET DEFAULT nextval('maker.pot_file_dsr_id_seq'::regclass);
+
+
+--
+-- Name: pot_file_vow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_file_vow ALTER COLUMN id SET DEFAULT nextval('maker.pot_file_vow_id_seq'::regclass);
+
+
+--
+-- Name: pot_join id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_join ALTER COLUMN id SET DEFAULT nextval('maker.pot_join_id_seq'::regclass);
+
+
+--
+-- Name: pot_live id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_live ALTER COLUMN id SET DEFAULT nextval('maker.pot_live_id_seq'::regclass);
+
+
+--
+-- Name: pot_pie id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_pie ALTER COLUMN id SET DEFAULT nextval('maker.pot_pie_id_seq'::regclass);
+
+
+--
+-- Name: pot_rho id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_rho ALTER COLUMN id SET DEFAULT nextval('maker.pot_rho_id_seq'::regclass);
+
+
+--
+-- Name: pot_user_pie id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_user_pie ALTER COLUMN id SET DEFAULT nextval('maker.pot_user_pie_id_seq'::regclass);
+
+
+--
+-- Name: pot_vow id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_vow ALTER COLUMN id SET DEFAULT nextval('maker.pot_vow_id_seq'::regclass);
+
+
+--
+-- Name: proxies id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.proxies ALTER COLUMN id SET DEFAULT nextval('maker.proxies_id_seq'::regclass);
+
+
+--
+-- Name: spot_file_mat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_mat ALTER COLUMN id SET DEFAULT nextval('maker.spot_file_mat_id_seq'::regclass);
+
+
+--
+-- Name: spot_file_par id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_par ALTER COLUMN id SET DEFAULT nextval('maker.spot_file_par_id_seq'::regclass);
+
+
+--
+-- Name: spot_file_pip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_pip ALTER COLUMN id SET DEFAULT nextval('maker.spot_file_pip_id_seq'::regclass);
+
+
+--
+-- Name: spot_ilk_mat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_ilk_mat ALTER COLUMN id SET DEFAULT nextval('maker.spot_ilk_mat_id_seq'::regclass);
+
+
+--
+-- Name: spot_ilk_pip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_ilk_pip ALTER COLUMN id SET DEFAULT nextval('maker.spot_ilk_pip_id_seq'::regclass);
+
+
+--
+-- Name: spot_par id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_par ALTER COLUMN id SET DEFAULT nextval('maker.spot_par_id_seq'::regclass);
+
+
+--
+-- Name: spot_poke id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_poke ALTER COLUMN id SET DEFAULT nextval('maker.spot_poke_id_seq'::regclass);
+
+
+--
+-- Name: spot_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_vat ALTER COLUMN id SET DEFAULT nextval('maker.spot_vat_id_seq'::regclass);
+
+
+--
+-- Name: tend id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.tend ALTER COLUMN id SET DEFAULT nextval('maker.tend_id_seq'::regclass);
+
+
+--
+-- Name: tick id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.tick ALTER COLUMN id SET DEFAULT nextval('maker.tick_id_seq'::regclass);
+
+
+--
+-- Name: urns id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.urns ALTER COLUMN id SET DEFAULT nextval('maker.urns_id_seq'::regclass);
+
+
+--
+-- Name: vat_can id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_can ALTER COLUMN id SET DEFAULT nextval('maker.vat_can_id_seq'::regclass);
+
+
+--
+-- Name: vat_dai id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_dai ALTER COLUMN id SET DEFAULT nextval('maker.vat_dai_id_seq'::regclass);
+
+
+--
+-- Name: vat_debt id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_debt ALTER COLUMN id SET DEFAULT nextval('maker.vat_debt_id_seq'::regclass);
+
+
+--
+-- Name: vat_file_debt_ceiling id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_file_debt_ceiling ALTER COLUMN id SET DEFAULT nextval('maker.vat_file_debt_ceiling_id_seq'::regclass);
+
+
+--
+-- Name: vat_file_ilk id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_file_ilk ALTER COLUMN id SET DEFAULT nextval('maker.vat_file_ilk_id_seq'::regclass);
+
+
+--
+-- Name: vat_flux id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_flux ALTER COLUMN id SET DEFAULT nextval('maker.vat_flux_id_seq'::regclass);
+
+
+--
+-- Name: vat_fold id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_fold ALTER COLUMN id SET DEFAULT nextval('maker.vat_fold_id_seq'::regclass);
+
+
+--
+-- Name: vat_fork id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_fork ALTER COLUMN id SET DEFAULT nextval('maker.vat_fork_id_seq'::regclass);
+
+
+--
+-- Name: vat_frob id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_frob ALTER COLUMN id SET DEFAULT nextval('maker.vat_frob_id_seq'::regclass);
+
+
+--
+-- Name: vat_gem id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_gem ALTER COLUMN id SET DEFAULT nextval('maker.vat_gem_id_seq'::regclass);
+
+
+--
+-- Name: vat_grab id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_grab ALTER COLUMN id SET DEFAULT nextval('maker.vat_grab_id_seq'::regclass);
+
+
+--
+-- Name: vat_heal id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_heal ALTER COLUMN id SET DEFAULT nextval('maker.vat_heal_id_seq'::regclass);
+
+
+--
+-- Name: vat_hope id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_hope ALTER COLUMN id SET DEFAULT nextval('maker.vat_hope_id_seq'::regclass);
+
+
+--
+-- Name: vat_ilk_art id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_ilk_art ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_art_id_seq'::regclass);
+
+
+--
+-- Name: vat_ilk_dust id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_ilk_dust ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_dust_id_seq'::regclass);
+
+
+--
+-- Name: vat_ilk_line id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_ilk_line ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_line_id_seq'::regclass);
+
+
+--
+-- Name: vat_ilk_rate id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_ilk_rate ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_rate_id_seq'::regclass);
+
+
+--
+-- Name: vat_ilk_spot id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_ilk_spot ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_spot_id_seq'::regclass);
+
+
+--
+-- Name: vat_init id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_init ALTER COLUMN id SET DEFAULT nextval('maker.vat_init_id_seq'::regclass);
+
+
+--
+-- Name: vat_line id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_line ALTER COLUMN id SET DEFAULT nextval('maker.vat_line_id_seq'::regclass);
+
+
+--
+-- Name: vat_live id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_live ALTER COLUMN id SET DEFAULT nextval('maker.vat_live_id_seq'::regclass);
+
+
+--
+-- Name: vat_move id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_move ALTER COLUMN id SET DEFAULT nextval('maker.vat_move_id_seq'::regclass);
+
+
+--
+-- Name: vat_nope id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_nope ALTER COLUMN id SET DEFAULT nextval('maker.vat_nope_id_seq'::regclass);
+
+
+--
+-- Name: vat_sin id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_sin ALTER COLUMN id SET DEFAULT nextval('maker.vat_sin_id_seq'::regclass);
+
+
+--
+-- Name: vat_slip id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_slip ALTER COLUMN id SET DEFAULT nextval('maker.vat_slip_id_seq'::regclass);
+
+
+--
+-- Name: vat_suck id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_suck ALTER COLUMN id SET DEFAULT nextval('maker.vat_suck_id_seq'::regclass);
+
+
+--
+-- Name: vat_urn_art id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_urn_art ALTER COLUMN id SET DEFAULT nextval('maker.vat_urn_art_id_seq'::regclass);
+
+
+--
+-- Name: vat_urn_ink id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_urn_ink ALTER COLUMN id SET DEFAULT nextval('maker.vat_urn_ink_id_seq'::regclass);
+
+
+--
+-- Name: vat_vice id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_vice ALTER COLUMN id SET DEFAULT nextval('maker.vat_vice_id_seq'::regclass);
+
+
+--
+-- Name: vow_ash id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_ash ALTER COLUMN id SET DEFAULT nextval('maker.vow_ash_id_seq'::regclass);
+
+
+--
+-- Name: vow_bump id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_bump ALTER COLUMN id SET DEFAULT nextval('maker.vow_bump_id_seq'::regclass);
+
+
+--
+-- Name: vow_dump id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_dump ALTER COLUMN id SET DEFAULT nextval('maker.vow_dump_id_seq'::regclass);
+
+
+--
+-- Name: vow_fess id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_fess ALTER COLUMN id SET DEFAULT nextval('maker.vow_fess_id_seq'::regclass);
+
+
+--
+-- Name: vow_file id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_file ALTER COLUMN id SET DEFAULT nextval('maker.vow_file_id_seq'::regclass);
+
+
+--
+-- Name: vow_flap id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flap ALTER COLUMN id SET DEFAULT nextval('maker.vow_flap_id_seq'::regclass);
+
+
+--
+-- Name: vow_flapper id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flapper ALTER COLUMN id SET DEFAULT nextval('maker.vow_flapper_id_seq'::regclass);
+
+
+--
+-- Name: vow_flog id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flog ALTER COLUMN id SET DEFAULT nextval('maker.vow_flog_id_seq'::regclass);
+
+
+--
+-- Name: vow_flop id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flop ALTER COLUMN id SET DEFAULT nextval('maker.vow_flop_id_seq'::regclass);
+
+
+--
+-- Name: vow_flopper id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flopper ALTER COLUMN id SET DEFAULT nextval('maker.vow_flopper_id_seq'::regclass);
+
+
+--
+-- Name: vow_heal id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_heal ALTER COLUMN id SET DEFAULT nextval('maker.vow_heal_id_seq'::regclass);
+
+
+--
+-- Name: vow_hump id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_hump ALTER COLUMN id SET DEFAULT nextval('maker.vow_hump_id_seq'::regclass);
+
+
+--
+-- Name: vow_kiss id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_kiss ALTER COLUMN id SET DEFAULT nextval('maker.vow_kiss_id_seq'::regclass);
+
+
+--
+-- Name: vow_sin_integer id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_sin_integer ALTER COLUMN id SET DEFAULT nextval('maker.vow_sin_integer_id_seq'::regclass);
+
+
+--
+-- Name: vow_sin_mapping id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_sin_mapping ALTER COLUMN id SET DEFAULT nextval('maker.vow_sin_mapping_id_seq'::regclass);
+
+
+--
+-- Name: vow_sump id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_sump ALTER COLUMN id SET DEFAULT nextval('maker.vow_sump_id_seq'::regclass);
+
+
+--
+-- Name: vow_vat id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_vat ALTER COLUMN id SET DEFAULT nextval('maker.vow_vat_id_seq'::regclass);
+
+
+--
+-- Name: vow_wait id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_wait ALTER COLUMN id SET DEFAULT nextval('maker.vow_wait_id_seq'::regclass);
+
+
+--
+-- Name: wards id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.wards ALTER COLUMN id SET DEFAULT nextval('maker.wards_id_seq'::regclass);
+
+
+--
+-- Name: yank id; Type: DEFAULT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.yank ALTER COLUMN id SET DEFAULT nextval('maker.yank_id_seq'::regclass);
+
+
+--
+-- Name: addresses id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.addresses ALTER COLUMN id SET DEFAULT nextval('public.addresses_id_seq'::regclass);
+
+
+--
+-- Name: blocks id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.blocks ALTER COLUMN id SET DEFAULT nextval('public.blocks_id_seq'::regclass);
+
+
+--
+-- Name: checked_headers id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.checked_headers ALTER COLUMN id SET DEFAULT nextval('public.checked_headers_id_seq'::regclass);
+
+
+--
+-- Name: eth_nodes id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.eth_nodes ALTER COLUMN id SET DEFAULT nextval('public.nodes_id_seq'::regclass);
+
+
+--
+-- Name: full_sync_logs id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.full_sync_logs ALTER COLUMN id SET DEFAULT nextval('public.full_sync_logs_id_seq'::regclass);
+
+
+--
+-- Name: full_sync_receipts id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.full_sync_receipts ALTER COLUMN id SET DEFAULT nextval('public.full_sync_receipts_id_seq'::regclass);
+
+
+--
+-- Name: full_sync_transactions id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.full_sync_transactions ALTER COLUMN id SET DEFAULT nextval('public.full_sync_transactions_id_seq'::regclass);
+
+
+--
+-- Name: goose_db_version id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.goose_db_version ALTER COLUMN id SET DEFAULT nextval('public.goose_db_version_id_seq'::regclass);
+
+
+--
+-- Name: header_sync_logs id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.header_sync_logs ALTER COLUMN id SET DEFAULT nextval('public.header_sync_logs_id_seq'::regclass);
+
+
+--
+-- Name: header_sync_receipts id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.header_sync_receipts ALTER COLUMN id SET DEFAULT nextval('public.header_sync_receipts_id_seq'::regclass);
+
+
+--
+-- Name: header_sync_transactions id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.header_sync_transactions ALTER COLUMN id SET DEFAULT nextval('public.header_sync_transactions_id_seq'::regclass);
+
+
+--
+-- Name: headers id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.headers ALTER COLUMN id SET DEFAULT nextval('public.headers_id_seq'::regclass);
+
+
+--
+-- Name: log_filters id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.log_filters ALTER COLUMN id SET DEFAULT nextval('public.log_filters_id_seq'::regclass);
+
+
+--
+-- Name: queued_storage id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.queued_storage ALTER COLUMN id SET DEFAULT nextval('public.queued_storage_id_seq'::regclass);
+
+
+--
+-- Name: uncles id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.uncles ALTER COLUMN id SET DEFAULT nextval('public.uncles_id_seq'::regclass);
+
+
+--
+-- Name: watched_contracts contract_id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.watched_contracts ALTER COLUMN contract_id SET DEFAULT nextval('public.watched_contracts_contract_id_seq'::regclass);
+
+
+--
+-- Name: watched_logs id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.watched_logs ALTER COLUMN id SET DEFAULT nextval('public.watched_logs_id_seq'::regclass);
+
+
+--
+-- Name: current_ilk_state current_ilk_state_pkey; Type: CONSTRAINT; Schema: api; Owner: -
+--
+
+ALTER TABLE ONLY api.current_ilk_state
+    ADD CONSTRAINT current_ilk_state_pkey PRIMARY KEY (ilk_identifier);
+
+
+--
+-- Name: managed_cdp managed_cdp_cdpi_key; Type: CONSTRAINT; Schema: api; Owner: -
+--
+
+ALTER TABLE ONLY api.managed_cdp
+    ADD CONSTRAINT managed_cdp_cdpi_key UNIQUE (cdpi);
+
+
+--
+-- Name: managed_cdp managed_cdp_pkey; Type: CONSTRAINT; Schema: api; Owner: -
+--
+
+ALTER TABLE ONLY api.managed_cdp
+    ADD CONSTRAINT managed_cdp_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: auction_file auction_file_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.auction_file
+    ADD CONSTRAINT auction_file_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: auction_file auction_file_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.auction_file
+    ADD CONSTRAINT auction_file_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: auth auth_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.auth
+    ADD CONSTRAINT auth_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: auth auth_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.auth
+    ADD CONSTRAINT auth_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: bite bite_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.bite
+    ADD CONSTRAINT bite_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: bite bite_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.bite
+    ADD CONSTRAINT bite_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cage cage_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cage
+    ADD CONSTRAINT cage_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cage cage_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cage
+    ADD CONSTRAINT cage_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_file_chop_lump cat_file_chop_lump_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_chop_lump
+    ADD CONSTRAINT cat_file_chop_lump_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cat_file_chop_lump cat_file_chop_lump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_chop_lump
+    ADD CONSTRAINT cat_file_chop_lump_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_file_flip cat_file_flip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_flip
+    ADD CONSTRAINT cat_file_flip_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cat_file_flip cat_file_flip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_flip
+    ADD CONSTRAINT cat_file_flip_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_file_vow cat_file_vow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_vow
+    ADD CONSTRAINT cat_file_vow_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cat_file_vow cat_file_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_file_vow
+    ADD CONSTRAINT cat_file_vow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_ilk_chop cat_ilk_chop_block_number_block_hash_ilk_id_chop_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_chop
+    ADD CONSTRAINT cat_ilk_chop_block_number_block_hash_ilk_id_chop_key UNIQUE (block_number, block_hash, ilk_id, chop);
+
+
+--
+-- Name: cat_ilk_chop cat_ilk_chop_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_chop
+    ADD CONSTRAINT cat_ilk_chop_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_ilk_flip cat_ilk_flip_block_number_block_hash_ilk_id_flip_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_flip
+    ADD CONSTRAINT cat_ilk_flip_block_number_block_hash_ilk_id_flip_key UNIQUE (block_number, block_hash, ilk_id, flip);
+
+
+--
+-- Name: cat_ilk_flip cat_ilk_flip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_flip
+    ADD CONSTRAINT cat_ilk_flip_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_ilk_lump cat_ilk_lump_block_number_block_hash_ilk_id_lump_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_lump
+    ADD CONSTRAINT cat_ilk_lump_block_number_block_hash_ilk_id_lump_key UNIQUE (block_number, block_hash, ilk_id, lump);
+
+
+--
+-- Name: cat_ilk_lump cat_ilk_lump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_ilk_lump
+    ADD CONSTRAINT cat_ilk_lump_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_live cat_live_block_number_block_hash_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_live
+    ADD CONSTRAINT cat_live_block_number_block_hash_live_key UNIQUE (block_number, block_hash, live);
+
+
+--
+-- Name: cat_live cat_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_live
+    ADD CONSTRAINT cat_live_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_vat cat_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_vat
+    ADD CONSTRAINT cat_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+
+
+--
+-- Name: cat_vat cat_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_vat
+    ADD CONSTRAINT cat_vat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cat_vow cat_vow_block_number_block_hash_vow_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_vow
+    ADD CONSTRAINT cat_vow_block_number_block_hash_vow_key UNIQUE (block_number, block_hash, vow);
+
+
+--
+-- Name: cat_vow cat_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cat_vow
+    ADD CONSTRAINT cat_vow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_cdp_allow cdp_manager_cdp_allow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdp_allow
+    ADD CONSTRAINT cdp_manager_cdp_allow_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_cdp_allow cdp_manager_cdp_allow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdp_allow
+    ADD CONSTRAINT cdp_manager_cdp_allow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_cdp_can cdp_manager_cdp_can_block_number_block_hash_owner_cdpi_usr__key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdp_can
+    ADD CONSTRAINT cdp_manager_cdp_can_block_number_block_hash_owner_cdpi_usr__key UNIQUE (block_number, block_hash, owner, cdpi, usr, can);
+
+
+--
+-- Name: cdp_manager_cdp_can cdp_manager_cdp_can_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdp_can
+    ADD CONSTRAINT cdp_manager_cdp_can_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_cdpi cdp_manager_cdpi_block_number_block_hash_cdpi_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdpi
+    ADD CONSTRAINT cdp_manager_cdpi_block_number_block_hash_cdpi_key UNIQUE (block_number, block_hash, cdpi);
+
+
+--
+-- Name: cdp_manager_cdpi cdp_manager_cdpi_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_cdpi
+    ADD CONSTRAINT cdp_manager_cdpi_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_count cdp_manager_count_block_number_block_hash_owner_count_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_count
+    ADD CONSTRAINT cdp_manager_count_block_number_block_hash_owner_count_key UNIQUE (block_number, block_hash, owner, count);
+
+
+--
+-- Name: cdp_manager_count cdp_manager_count_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_count
+    ADD CONSTRAINT cdp_manager_count_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_enter cdp_manager_enter_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_enter
+    ADD CONSTRAINT cdp_manager_enter_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_enter cdp_manager_enter_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_enter
+    ADD CONSTRAINT cdp_manager_enter_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_first cdp_manager_first_block_number_block_hash_owner_first_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_first
+    ADD CONSTRAINT cdp_manager_first_block_number_block_hash_owner_first_key UNIQUE (block_number, block_hash, owner, first);
+
+
+--
+-- Name: cdp_manager_first cdp_manager_first_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_first
+    ADD CONSTRAINT cdp_manager_first_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_flux cdp_manager_flux_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_flux
+    ADD CONSTRAINT cdp_manager_flux_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_flux cdp_manager_flux_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_flux
+    ADD CONSTRAINT cdp_manager_flux_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_frob cdp_manager_frob_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_frob
+    ADD CONSTRAINT cdp_manager_frob_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_frob cdp_manager_frob_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_frob
+    ADD CONSTRAINT cdp_manager_frob_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_give cdp_manager_give_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_give
+    ADD CONSTRAINT cdp_manager_give_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_give cdp_manager_give_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_give
+    ADD CONSTRAINT cdp_manager_give_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_ilks cdp_manager_ilks_block_number_block_hash_cdpi_ilk_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_ilks
+    ADD CONSTRAINT cdp_manager_ilks_block_number_block_hash_cdpi_ilk_id_key UNIQUE (block_number, block_hash, cdpi, ilk_id);
+
+
+--
+-- Name: cdp_manager_ilks cdp_manager_ilks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_ilks
+    ADD CONSTRAINT cdp_manager_ilks_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_last cdp_manager_last_block_number_block_hash_owner_last_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_last
+    ADD CONSTRAINT cdp_manager_last_block_number_block_hash_owner_last_key UNIQUE (block_number, block_hash, owner, last);
+
+
+--
+-- Name: cdp_manager_last cdp_manager_last_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_last
+    ADD CONSTRAINT cdp_manager_last_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_list_next cdp_manager_list_next_block_number_block_hash_cdpi_next_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_list_next
+    ADD CONSTRAINT cdp_manager_list_next_block_number_block_hash_cdpi_next_key UNIQUE (block_number, block_hash, cdpi, next);
+
+
+--
+-- Name: cdp_manager_list_next cdp_manager_list_next_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_list_next
+    ADD CONSTRAINT cdp_manager_list_next_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_list_prev cdp_manager_list_prev_block_number_block_hash_cdpi_prev_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_list_prev
+    ADD CONSTRAINT cdp_manager_list_prev_block_number_block_hash_cdpi_prev_key UNIQUE (block_number, block_hash, cdpi, prev);
+
+
+--
+-- Name: cdp_manager_list_prev cdp_manager_list_prev_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_list_prev
+    ADD CONSTRAINT cdp_manager_list_prev_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_move cdp_manager_move_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_move
+    ADD CONSTRAINT cdp_manager_move_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_move cdp_manager_move_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_move
+    ADD CONSTRAINT cdp_manager_move_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_owns cdp_manager_owns_block_number_block_hash_cdpi_owner_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_owns
+    ADD CONSTRAINT cdp_manager_owns_block_number_block_hash_cdpi_owner_key UNIQUE (block_number, block_hash, cdpi, owner);
+
+
+--
+-- Name: cdp_manager_owns cdp_manager_owns_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_owns
+    ADD CONSTRAINT cdp_manager_owns_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_quit cdp_manager_quit_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_quit
+    ADD CONSTRAINT cdp_manager_quit_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_quit cdp_manager_quit_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_quit
+    ADD CONSTRAINT cdp_manager_quit_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_shift cdp_manager_shift_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_shift
+    ADD CONSTRAINT cdp_manager_shift_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_shift cdp_manager_shift_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_shift
+    ADD CONSTRAINT cdp_manager_shift_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_urn_allow cdp_manager_urn_allow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urn_allow
+    ADD CONSTRAINT cdp_manager_urn_allow_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: cdp_manager_urn_allow cdp_manager_urn_allow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urn_allow
+    ADD CONSTRAINT cdp_manager_urn_allow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_urn_can cdp_manager_urn_can_block_number_block_hash_owner_usr_can_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urn_can
+    ADD CONSTRAINT cdp_manager_urn_can_block_number_block_hash_owner_usr_can_key UNIQUE (block_number, block_hash, owner, usr, can);
+
+
+--
+-- Name: cdp_manager_urn_can cdp_manager_urn_can_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urn_can
+    ADD CONSTRAINT cdp_manager_urn_can_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_urns cdp_manager_urns_block_number_block_hash_cdpi_urn_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urns
+    ADD CONSTRAINT cdp_manager_urns_block_number_block_hash_cdpi_urn_key UNIQUE (block_number, block_hash, cdpi, urn);
+
+
+--
+-- Name: cdp_manager_urns cdp_manager_urns_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_urns
+    ADD CONSTRAINT cdp_manager_urns_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cdp_manager_vat cdp_manager_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_vat
+    ADD CONSTRAINT cdp_manager_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+
+
+--
+-- Name: cdp_manager_vat cdp_manager_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.cdp_manager_vat
+    ADD CONSTRAINT cdp_manager_vat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_approvals chief_approvals_block_number_block_hash_candidate_approvals_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_approvals
+    ADD CONSTRAINT chief_approvals_block_number_block_hash_candidate_approvals_key UNIQUE (block_number, block_hash, candidate, approvals);
+
+
+--
+-- Name: chief_approvals chief_approvals_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_approvals
+    ADD CONSTRAINT chief_approvals_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_deposits chief_deposits_block_number_block_hash_msg_sender_deposits_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_deposits
+    ADD CONSTRAINT chief_deposits_block_number_block_hash_msg_sender_deposits_key UNIQUE (block_number, block_hash, msg_sender, deposits);
+
+
+--
+-- Name: chief_deposits chief_deposits_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_deposits
+    ADD CONSTRAINT chief_deposits_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_etch chief_etch_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_etch
+    ADD CONSTRAINT chief_etch_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: chief_etch chief_etch_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_etch
+    ADD CONSTRAINT chief_etch_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_free chief_free_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_free
+    ADD CONSTRAINT chief_free_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: chief_free chief_free_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_free
+    ADD CONSTRAINT chief_free_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_hat chief_hat_block_number_block_hash_hat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_hat
+    ADD CONSTRAINT chief_hat_block_number_block_hash_hat_key UNIQUE (block_number, block_hash, hat);
+
+
+--
+-- Name: chief_hat chief_hat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_hat
+    ADD CONSTRAINT chief_hat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_lift chief_lift_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_lift
+    ADD CONSTRAINT chief_lift_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: chief_lift chief_lift_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_lift
+    ADD CONSTRAINT chief_lift_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_lock chief_lock_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_lock
+    ADD CONSTRAINT chief_lock_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: chief_lock chief_lock_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_lock
+    ADD CONSTRAINT chief_lock_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_vote chief_vote_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_vote
+    ADD CONSTRAINT chief_vote_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: chief_vote chief_vote_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_vote
+    ADD CONSTRAINT chief_vote_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_vote_yays chief_vote_yays_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_vote_yays
+    ADD CONSTRAINT chief_vote_yays_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: chief_vote_yays chief_vote_yays_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_vote_yays
+    ADD CONSTRAINT chief_vote_yays_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chief_votes chief_votes_block_number_block_hash_msg_sender_slate_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_votes
+    ADD CONSTRAINT chief_votes_block_number_block_hash_msg_sender_slate_key UNIQUE (block_number, block_hash, msg_sender, slate);
+
+
+--
+-- Name: chief_votes chief_votes_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.chief_votes
+    ADD CONSTRAINT chief_votes_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_allowance dai_allowance_block_number_block_hash_owner_spender_allowan_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_allowance
+    ADD CONSTRAINT dai_allowance_block_number_block_hash_owner_spender_allowan_key UNIQUE (block_number, block_hash, owner, spender, allowance);
+
+
+--
+-- Name: dai_allowance dai_allowance_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_allowance
+    ADD CONSTRAINT dai_allowance_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_approval dai_approval_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_approval
+    ADD CONSTRAINT dai_approval_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: dai_approval dai_approval_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_approval
+    ADD CONSTRAINT dai_approval_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_balance_of dai_balance_of_block_number_block_hash_guy_balance_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_balance_of
+    ADD CONSTRAINT dai_balance_of_block_number_block_hash_guy_balance_key UNIQUE (block_number, block_hash, guy, balance);
+
+
+--
+-- Name: dai_balance_of dai_balance_of_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_balance_of
+    ADD CONSTRAINT dai_balance_of_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_exit dai_exit_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_exit
+    ADD CONSTRAINT dai_exit_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: dai_exit dai_exit_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_exit
+    ADD CONSTRAINT dai_exit_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_join dai_join_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_join
+    ADD CONSTRAINT dai_join_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: dai_join dai_join_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_join
+    ADD CONSTRAINT dai_join_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_nonces dai_nonces_block_number_block_hash_guy_nonce_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_nonces
+    ADD CONSTRAINT dai_nonces_block_number_block_hash_guy_nonce_key UNIQUE (block_number, block_hash, guy, nonce);
+
+
+--
+-- Name: dai_nonces dai_nonces_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_nonces
+    ADD CONSTRAINT dai_nonces_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_total_supply dai_total_supply_block_number_block_hash_total_supply_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_total_supply
+    ADD CONSTRAINT dai_total_supply_block_number_block_hash_total_supply_key UNIQUE (block_number, block_hash, total_supply);
+
+
+--
+-- Name: dai_total_supply dai_total_supply_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_total_supply
+    ADD CONSTRAINT dai_total_supply_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dai_transfer dai_transfer_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_transfer
+    ADD CONSTRAINT dai_transfer_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: dai_transfer dai_transfer_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dai_transfer
+    ADD CONSTRAINT dai_transfer_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: deal deal_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.deal
+    ADD CONSTRAINT deal_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: deal deal_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.deal
+    ADD CONSTRAINT deal_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: dent dent_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dent
+    ADD CONSTRAINT dent_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: dent dent_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.dent
+    ADD CONSTRAINT dent_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_bag end_bag_block_number_block_hash_msg_sender_bag_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_bag
+    ADD CONSTRAINT end_bag_block_number_block_hash_msg_sender_bag_key UNIQUE (block_number, block_hash, msg_sender, bag);
+
+
+--
+-- Name: end_bag end_bag_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_bag
+    ADD CONSTRAINT end_bag_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_cage end_cage_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cage
+    ADD CONSTRAINT end_cage_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_cage_ilk end_cage_ilk_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cage_ilk
+    ADD CONSTRAINT end_cage_ilk_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_cage_ilk end_cage_ilk_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cage_ilk
+    ADD CONSTRAINT end_cage_ilk_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_cage end_cage_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cage
+    ADD CONSTRAINT end_cage_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_cash end_cash_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cash
+    ADD CONSTRAINT end_cash_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_cash end_cash_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_cash
+    ADD CONSTRAINT end_cash_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_debt end_debt_block_number_block_hash_debt_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_debt
+    ADD CONSTRAINT end_debt_block_number_block_hash_debt_key UNIQUE (block_number, block_hash, debt);
+
+
+--
+-- Name: end_debt end_debt_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_debt
+    ADD CONSTRAINT end_debt_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_flow end_flow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_flow
+    ADD CONSTRAINT end_flow_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_flow end_flow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_flow
+    ADD CONSTRAINT end_flow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_free end_free_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_free
+    ADD CONSTRAINT end_free_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_free end_free_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_free
+    ADD CONSTRAINT end_free_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_ilk_art end_ilk_art_block_number_block_hash_ilk_id_art_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_art
+    ADD CONSTRAINT end_ilk_art_block_number_block_hash_ilk_id_art_key UNIQUE (block_number, block_hash, ilk_id, art);
+
+
+--
+-- Name: end_ilk_art end_ilk_art_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_art
+    ADD CONSTRAINT end_ilk_art_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_ilk_fix end_ilk_fix_block_number_block_hash_ilk_id_fix_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_fix
+    ADD CONSTRAINT end_ilk_fix_block_number_block_hash_ilk_id_fix_key UNIQUE (block_number, block_hash, ilk_id, fix);
+
+
+--
+-- Name: end_ilk_fix end_ilk_fix_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_fix
+    ADD CONSTRAINT end_ilk_fix_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_ilk_gap end_ilk_gap_block_number_block_hash_ilk_id_gap_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_gap
+    ADD CONSTRAINT end_ilk_gap_block_number_block_hash_ilk_id_gap_key UNIQUE (block_number, block_hash, ilk_id, gap);
+
+
+--
+-- Name: end_ilk_gap end_ilk_gap_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_gap
+    ADD CONSTRAINT end_ilk_gap_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_ilk_tag end_ilk_tag_block_number_block_hash_ilk_id_tag_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_tag
+    ADD CONSTRAINT end_ilk_tag_block_number_block_hash_ilk_id_tag_key UNIQUE (block_number, block_hash, ilk_id, tag);
+
+
+--
+-- Name: end_ilk_tag end_ilk_tag_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_ilk_tag
+    ADD CONSTRAINT end_ilk_tag_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_live end_live_block_number_block_hash_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_live
+    ADD CONSTRAINT end_live_block_number_block_hash_live_key UNIQUE (block_number, block_hash, live);
+
+
+--
+-- Name: end_live end_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_live
+    ADD CONSTRAINT end_live_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_out end_out_block_number_block_hash_ilk_id_msg_sender_out_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_out
+    ADD CONSTRAINT end_out_block_number_block_hash_ilk_id_msg_sender_out_key UNIQUE (block_number, block_hash, ilk_id, msg_sender, "out");
+
+
+--
+-- Name: end_out end_out_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_out
+    ADD CONSTRAINT end_out_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_pack end_pack_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_pack
+    ADD CONSTRAINT end_pack_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_pack end_pack_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_pack
+    ADD CONSTRAINT end_pack_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_skim end_skim_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_skim
+    ADD CONSTRAINT end_skim_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_skim end_skim_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_skim
+    ADD CONSTRAINT end_skim_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_thaw end_thaw_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_thaw
+    ADD CONSTRAINT end_thaw_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: end_thaw end_thaw_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_thaw
+    ADD CONSTRAINT end_thaw_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_wait end_wait_block_number_block_hash_wait_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_wait
+    ADD CONSTRAINT end_wait_block_number_block_hash_wait_key UNIQUE (block_number, block_hash, wait);
+
+
+--
+-- Name: end_wait end_wait_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_wait
+    ADD CONSTRAINT end_wait_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: end_when end_when_block_number_block_hash_when_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_when
+    ADD CONSTRAINT end_when_block_number_block_hash_when_key UNIQUE (block_number, block_hash, "when");
+
+
+--
+-- Name: end_when end_when_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.end_when
+    ADD CONSTRAINT end_when_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: esm_fire esm_fire_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_fire
+    ADD CONSTRAINT esm_fire_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: esm_fire esm_fire_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_fire
+    ADD CONSTRAINT esm_fire_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: esm_fired esm_fired_block_number_block_hash_fired_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_fired
+    ADD CONSTRAINT esm_fired_block_number_block_hash_fired_key UNIQUE (block_number, block_hash, fired);
+
+
+--
+-- Name: esm_fired esm_fired_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_fired
+    ADD CONSTRAINT esm_fired_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: esm_join esm_join_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_join
+    ADD CONSTRAINT esm_join_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: esm_join esm_join_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_join
+    ADD CONSTRAINT esm_join_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: esm_min esm_min_block_number_block_hash_min_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_min
+    ADD CONSTRAINT esm_min_block_number_block_hash_min_key UNIQUE (block_number, block_hash, min);
+
+
+--
+-- Name: esm_min esm_min_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_min
+    ADD CONSTRAINT esm_min_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: esm_sum esm_sum_block_number_block_hash_sum_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_sum
+    ADD CONSTRAINT esm_sum_block_number_block_hash_sum_key UNIQUE (block_number, block_hash, sum);
+
+
+--
+-- Name: esm_sum esm_sum_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_sum
+    ADD CONSTRAINT esm_sum_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: esm_user_sum esm_user_sum_block_number_block_hash_msg_sender_sum_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_user_sum
+    ADD CONSTRAINT esm_user_sum_block_number_block_hash_msg_sender_sum_key UNIQUE (block_number, block_hash, msg_sender, sum);
+
+
+--
+-- Name: esm_user_sum esm_user_sum_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.esm_user_sum
+    ADD CONSTRAINT esm_user_sum_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_beg flap_beg_block_number_block_hash_address_id_beg_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_beg
+    ADD CONSTRAINT flap_beg_block_number_block_hash_address_id_beg_key UNIQUE (block_number, block_hash, address_id, beg);
+
+
+--
+-- Name: flap_beg flap_beg_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_beg
+    ADD CONSTRAINT flap_beg_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_bid_bid flap_bid_bid_block_number_block_hash_address_id_bid_id_bid_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_bid
+    ADD CONSTRAINT flap_bid_bid_block_number_block_hash_address_id_bid_id_bid_key UNIQUE (block_number, block_hash, address_id, bid_id, bid);
+
+
+--
+-- Name: flap_bid_bid flap_bid_bid_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_bid
+    ADD CONSTRAINT flap_bid_bid_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_bid_end flap_bid_end_block_number_block_hash_address_id_bid_id_end_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_end
+    ADD CONSTRAINT flap_bid_end_block_number_block_hash_address_id_bid_id_end_key UNIQUE (block_number, block_hash, address_id, bid_id, "end");
+
+
+--
+-- Name: flap_bid_end flap_bid_end_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_end
+    ADD CONSTRAINT flap_bid_end_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_bid_guy flap_bid_guy_block_number_block_hash_address_id_bid_id_guy_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_guy
+    ADD CONSTRAINT flap_bid_guy_block_number_block_hash_address_id_bid_id_guy_key UNIQUE (block_number, block_hash, address_id, bid_id, guy);
+
+
+--
+-- Name: flap_bid_guy flap_bid_guy_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_guy
+    ADD CONSTRAINT flap_bid_guy_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_bid_lot flap_bid_lot_block_number_block_hash_address_id_bid_id_lot_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_lot
+    ADD CONSTRAINT flap_bid_lot_block_number_block_hash_address_id_bid_id_lot_key UNIQUE (block_number, block_hash, address_id, bid_id, lot);
+
+
+--
+-- Name: flap_bid_lot flap_bid_lot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_lot
+    ADD CONSTRAINT flap_bid_lot_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_bid_tic flap_bid_tic_block_number_block_hash_address_id_bid_id_tic_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_tic
+    ADD CONSTRAINT flap_bid_tic_block_number_block_hash_address_id_bid_id_tic_key UNIQUE (block_number, block_hash, address_id, bid_id, tic);
+
+
+--
+-- Name: flap_bid_tic flap_bid_tic_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_bid_tic
+    ADD CONSTRAINT flap_bid_tic_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap flap_block_number_bid_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap
+    ADD CONSTRAINT flap_block_number_bid_id_key UNIQUE (block_number, bid_id);
+
+
+--
+-- Name: flap_gem flap_gem_block_number_block_hash_address_id_gem_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_gem
+    ADD CONSTRAINT flap_gem_block_number_block_hash_address_id_gem_key UNIQUE (block_number, block_hash, address_id, gem);
+
+
+--
+-- Name: flap_gem flap_gem_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_gem
+    ADD CONSTRAINT flap_gem_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_kick flap_kick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_kick
+    ADD CONSTRAINT flap_kick_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: flap_kick flap_kick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_kick
+    ADD CONSTRAINT flap_kick_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_kicks flap_kicks_block_number_block_hash_address_id_kicks_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_kicks
+    ADD CONSTRAINT flap_kicks_block_number_block_hash_address_id_kicks_key UNIQUE (block_number, block_hash, address_id, kicks);
+
+
+--
+-- Name: flap_kicks flap_kicks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_kicks
+    ADD CONSTRAINT flap_kicks_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_live flap_live_block_number_block_hash_address_id_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_live
+    ADD CONSTRAINT flap_live_block_number_block_hash_address_id_live_key UNIQUE (block_number, block_hash, address_id, live);
+
+
+--
+-- Name: flap_live flap_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_live
+    ADD CONSTRAINT flap_live_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap flap_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap
+    ADD CONSTRAINT flap_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_tau flap_tau_block_number_block_hash_address_id_tau_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_tau
+    ADD CONSTRAINT flap_tau_block_number_block_hash_address_id_tau_key UNIQUE (block_number, block_hash, address_id, tau);
+
+
+--
+-- Name: flap_tau flap_tau_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_tau
+    ADD CONSTRAINT flap_tau_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_ttl flap_ttl_block_number_block_hash_address_id_ttl_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_ttl
+    ADD CONSTRAINT flap_ttl_block_number_block_hash_address_id_ttl_key UNIQUE (block_number, block_hash, address_id, ttl);
+
+
+--
+-- Name: flap_ttl flap_ttl_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_ttl
+    ADD CONSTRAINT flap_ttl_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flap_vat flap_vat_block_number_block_hash_address_id_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_vat
+    ADD CONSTRAINT flap_vat_block_number_block_hash_address_id_vat_key UNIQUE (block_number, block_hash, address_id, vat);
+
+
+--
+-- Name: flap_vat flap_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flap_vat
+    ADD CONSTRAINT flap_vat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_beg flip_beg_block_number_block_hash_address_id_beg_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_beg
+    ADD CONSTRAINT flip_beg_block_number_block_hash_address_id_beg_key UNIQUE (block_number, block_hash, address_id, beg);
+
+
+--
+-- Name: flip_beg flip_beg_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_beg
+    ADD CONSTRAINT flip_beg_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_bid flip_bid_bid_block_number_block_hash_bid_id_address_id_bid_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_bid
+    ADD CONSTRAINT flip_bid_bid_block_number_block_hash_bid_id_address_id_bid_key UNIQUE (block_number, block_hash, bid_id, address_id, bid);
+
+
+--
+-- Name: flip_bid_bid flip_bid_bid_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_bid
+    ADD CONSTRAINT flip_bid_bid_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_end flip_bid_end_block_number_block_hash_bid_id_address_id_end_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_end
+    ADD CONSTRAINT flip_bid_end_block_number_block_hash_bid_id_address_id_end_key UNIQUE (block_number, block_hash, bid_id, address_id, "end");
+
+
+--
+-- Name: flip_bid_end flip_bid_end_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_end
+    ADD CONSTRAINT flip_bid_end_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_gal flip_bid_gal_block_number_block_hash_bid_id_address_id_gal_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_gal
+    ADD CONSTRAINT flip_bid_gal_block_number_block_hash_bid_id_address_id_gal_key UNIQUE (block_number, block_hash, bid_id, address_id, gal);
+
+
+--
+-- Name: flip_bid_gal flip_bid_gal_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_gal
+    ADD CONSTRAINT flip_bid_gal_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_guy flip_bid_guy_block_number_block_hash_bid_id_address_id_guy_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_guy
+    ADD CONSTRAINT flip_bid_guy_block_number_block_hash_bid_id_address_id_guy_key UNIQUE (block_number, block_hash, bid_id, address_id, guy);
+
+
+--
+-- Name: flip_bid_guy flip_bid_guy_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_guy
+    ADD CONSTRAINT flip_bid_guy_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_lot flip_bid_lot_block_number_block_hash_bid_id_address_id_lot_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_lot
+    ADD CONSTRAINT flip_bid_lot_block_number_block_hash_bid_id_address_id_lot_key UNIQUE (block_number, block_hash, bid_id, address_id, lot);
+
+
+--
+-- Name: flip_bid_lot flip_bid_lot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_lot
+    ADD CONSTRAINT flip_bid_lot_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_tab flip_bid_tab_block_number_block_hash_bid_id_address_id_tab_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_tab
+    ADD CONSTRAINT flip_bid_tab_block_number_block_hash_bid_id_address_id_tab_key UNIQUE (block_number, block_hash, bid_id, address_id, tab);
+
+
+--
+-- Name: flip_bid_tab flip_bid_tab_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_tab
+    ADD CONSTRAINT flip_bid_tab_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_tic flip_bid_tic_block_number_block_hash_bid_id_address_id_tic_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_tic
+    ADD CONSTRAINT flip_bid_tic_block_number_block_hash_bid_id_address_id_tic_key UNIQUE (block_number, block_hash, bid_id, address_id, tic);
+
+
+--
+-- Name: flip_bid_tic flip_bid_tic_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_tic
+    ADD CONSTRAINT flip_bid_tic_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_bid_usr flip_bid_usr_block_number_block_hash_bid_id_address_id_usr_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_usr
+    ADD CONSTRAINT flip_bid_usr_block_number_block_hash_bid_id_address_id_usr_key UNIQUE (block_number, block_hash, bid_id, address_id, usr);
+
+
+--
+-- Name: flip_bid_usr flip_bid_usr_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_bid_usr
+    ADD CONSTRAINT flip_bid_usr_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip flip_block_number_bid_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip
+    ADD CONSTRAINT flip_block_number_bid_id_key UNIQUE (block_number, bid_id);
+
+
+--
+-- Name: flip_ilk flip_ilk_block_number_block_hash_address_id_ilk_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_ilk
+    ADD CONSTRAINT flip_ilk_block_number_block_hash_address_id_ilk_id_key UNIQUE (block_number, block_hash, address_id, ilk_id);
+
+
+--
+-- Name: flip_ilk flip_ilk_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_ilk
+    ADD CONSTRAINT flip_ilk_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_kick flip_kick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_kick
+    ADD CONSTRAINT flip_kick_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: flip_kick flip_kick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_kick
+    ADD CONSTRAINT flip_kick_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_kicks flip_kicks_block_number_block_hash_address_id_kicks_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_kicks
+    ADD CONSTRAINT flip_kicks_block_number_block_hash_address_id_kicks_key UNIQUE (block_number, block_hash, address_id, kicks);
+
+
+--
+-- Name: flip_kicks flip_kicks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_kicks
+    ADD CONSTRAINT flip_kicks_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip flip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip
+    ADD CONSTRAINT flip_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_tau flip_tau_block_number_block_hash_address_id_tau_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_tau
+    ADD CONSTRAINT flip_tau_block_number_block_hash_address_id_tau_key UNIQUE (block_number, block_hash, address_id, tau);
+
+
+--
+-- Name: flip_tau flip_tau_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_tau
+    ADD CONSTRAINT flip_tau_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_ttl flip_ttl_block_number_block_hash_address_id_ttl_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_ttl
+    ADD CONSTRAINT flip_ttl_block_number_block_hash_address_id_ttl_key UNIQUE (block_number, block_hash, address_id, ttl);
+
+
+--
+-- Name: flip_ttl flip_ttl_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_ttl
+    ADD CONSTRAINT flip_ttl_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flip_vat flip_vat_block_number_block_hash_address_id_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_vat
+    ADD CONSTRAINT flip_vat_block_number_block_hash_address_id_vat_key UNIQUE (block_number, block_hash, address_id, vat);
+
+
+--
+-- Name: flip_vat flip_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flip_vat
+    ADD CONSTRAINT flip_vat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_beg flop_beg_block_number_block_hash_address_id_beg_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_beg
+    ADD CONSTRAINT flop_beg_block_number_block_hash_address_id_beg_key UNIQUE (block_number, block_hash, address_id, beg);
+
+
+--
+-- Name: flop_beg flop_beg_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_beg
+    ADD CONSTRAINT flop_beg_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_bid_bid flop_bid_bid_block_number_block_hash_bid_id_address_id_bid_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_bid
+    ADD CONSTRAINT flop_bid_bid_block_number_block_hash_bid_id_address_id_bid_key UNIQUE (block_number, block_hash, bid_id, address_id, bid);
+
+
+--
+-- Name: flop_bid_bid flop_bid_bid_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_bid
+    ADD CONSTRAINT flop_bid_bid_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_bid_end flop_bid_end_block_number_block_hash_bid_id_address_id_end_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_end
+    ADD CONSTRAINT flop_bid_end_block_number_block_hash_bid_id_address_id_end_key UNIQUE (block_number, block_hash, bid_id, address_id, "end");
+
+
+--
+-- Name: flop_bid_end flop_bid_end_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_end
+    ADD CONSTRAINT flop_bid_end_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_bid_guy flop_bid_guy_block_number_block_hash_bid_id_address_id_guy_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_guy
+    ADD CONSTRAINT flop_bid_guy_block_number_block_hash_bid_id_address_id_guy_key UNIQUE (block_number, block_hash, bid_id, address_id, guy);
+
+
+--
+-- Name: flop_bid_guy flop_bid_guy_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_guy
+    ADD CONSTRAINT flop_bid_guy_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_bid_lot flop_bid_lot_block_number_block_hash_bid_id_address_id_lot_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_lot
+    ADD CONSTRAINT flop_bid_lot_block_number_block_hash_bid_id_address_id_lot_key UNIQUE (block_number, block_hash, bid_id, address_id, lot);
+
+
+--
+-- Name: flop_bid_lot flop_bid_lot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_lot
+    ADD CONSTRAINT flop_bid_lot_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_bid_tic flop_bid_tic_block_number_block_hash_bid_id_address_id_tic_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_tic
+    ADD CONSTRAINT flop_bid_tic_block_number_block_hash_bid_id_address_id_tic_key UNIQUE (block_number, block_hash, bid_id, address_id, tic);
+
+
+--
+-- Name: flop_bid_tic flop_bid_tic_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_bid_tic
+    ADD CONSTRAINT flop_bid_tic_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop flop_block_number_bid_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop
+    ADD CONSTRAINT flop_block_number_bid_id_key UNIQUE (block_number, bid_id);
+
+
+--
+-- Name: flop_gem flop_gem_block_number_block_hash_address_id_gem_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_gem
+    ADD CONSTRAINT flop_gem_block_number_block_hash_address_id_gem_key UNIQUE (block_number, block_hash, address_id, gem);
+
+
+--
+-- Name: flop_gem flop_gem_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_gem
+    ADD CONSTRAINT flop_gem_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_kick flop_kick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_kick
+    ADD CONSTRAINT flop_kick_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: flop_kick flop_kick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_kick
+    ADD CONSTRAINT flop_kick_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_kicks flop_kicks_block_number_block_hash_address_id_kicks_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_kicks
+    ADD CONSTRAINT flop_kicks_block_number_block_hash_address_id_kicks_key UNIQUE (block_number, block_hash, address_id, kicks);
+
+
+--
+-- Name: flop_kicks flop_kicks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_kicks
+    ADD CONSTRAINT flop_kicks_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_live flop_live_block_number_block_hash_address_id_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_live
+    ADD CONSTRAINT flop_live_block_number_block_hash_address_id_live_key UNIQUE (block_number, block_hash, address_id, live);
+
+
+--
+-- Name: flop_live flop_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_live
+    ADD CONSTRAINT flop_live_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_pad flop_pad_block_number_block_hash_address_id_pad_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_pad
+    ADD CONSTRAINT flop_pad_block_number_block_hash_address_id_pad_key UNIQUE (block_number, block_hash, address_id, pad);
+
+
+--
+-- Name: flop_pad flop_pad_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_pad
+    ADD CONSTRAINT flop_pad_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop flop_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop
+    ADD CONSTRAINT flop_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_tau flop_tau_block_number_block_hash_address_id_tau_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_tau
+    ADD CONSTRAINT flop_tau_block_number_block_hash_address_id_tau_key UNIQUE (block_number, block_hash, address_id, tau);
+
+
+--
+-- Name: flop_tau flop_tau_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_tau
+    ADD CONSTRAINT flop_tau_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_ttl flop_ttl_block_number_block_hash_address_id_ttl_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_ttl
+    ADD CONSTRAINT flop_ttl_block_number_block_hash_address_id_ttl_key UNIQUE (block_number, block_hash, address_id, ttl);
+
+
+--
+-- Name: flop_ttl flop_ttl_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_ttl
+    ADD CONSTRAINT flop_ttl_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flop_vat flop_vat_block_number_block_hash_address_id_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_vat
+    ADD CONSTRAINT flop_vat_block_number_block_hash_address_id_vat_key UNIQUE (block_number, block_hash, address_id, vat);
+
+
+--
+-- Name: flop_vat flop_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.flop_vat
+    ADD CONSTRAINT flop_vat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: gem_exit gem_exit_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.gem_exit
+    ADD CONSTRAINT gem_exit_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: gem_exit gem_exit_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.gem_exit
+    ADD CONSTRAINT gem_exit_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: gem_join gem_join_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.gem_join
+    ADD CONSTRAINT gem_join_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: gem_join gem_join_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.gem_join
+    ADD CONSTRAINT gem_join_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: generic_file generic_file_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.generic_file
+    ADD CONSTRAINT generic_file_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: generic_file generic_file_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.generic_file
+    ADD CONSTRAINT generic_file_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: ilks ilks_identifier_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.ilks
+    ADD CONSTRAINT ilks_identifier_key UNIQUE (identifier);
+
+
+--
+-- Name: ilks ilks_ilk_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.ilks
+    ADD CONSTRAINT ilks_ilk_key UNIQUE (ilk);
+
+
+--
+-- Name: ilks ilks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.ilks
+    ADD CONSTRAINT ilks_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_base jug_base_block_number_block_hash_base_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_base
+    ADD CONSTRAINT jug_base_block_number_block_hash_base_key UNIQUE (block_number, block_hash, base);
+
+
+--
+-- Name: jug_base jug_base_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_base
+    ADD CONSTRAINT jug_base_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_drip jug_drip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_drip
+    ADD CONSTRAINT jug_drip_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: jug_drip jug_drip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_drip
+    ADD CONSTRAINT jug_drip_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_file_base jug_file_base_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_base
+    ADD CONSTRAINT jug_file_base_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: jug_file_base jug_file_base_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_base
+    ADD CONSTRAINT jug_file_base_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_file_ilk jug_file_ilk_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_ilk
+    ADD CONSTRAINT jug_file_ilk_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: jug_file_ilk jug_file_ilk_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_ilk
+    ADD CONSTRAINT jug_file_ilk_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_file_vow jug_file_vow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_vow
+    ADD CONSTRAINT jug_file_vow_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: jug_file_vow jug_file_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_file_vow
+    ADD CONSTRAINT jug_file_vow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_ilk_duty jug_ilk_duty_block_number_block_hash_ilk_id_duty_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_ilk_duty
+    ADD CONSTRAINT jug_ilk_duty_block_number_block_hash_ilk_id_duty_key UNIQUE (block_number, block_hash, ilk_id, duty);
+
+
+--
+-- Name: jug_ilk_duty jug_ilk_duty_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_ilk_duty
+    ADD CONSTRAINT jug_ilk_duty_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_ilk_rho jug_ilk_rho_block_number_block_hash_ilk_id_rho_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_ilk_rho
+    ADD CONSTRAINT jug_ilk_rho_block_number_block_hash_ilk_id_rho_key UNIQUE (block_number, block_hash, ilk_id, rho);
+
+
+--
+-- Name: jug_ilk_rho jug_ilk_rho_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_ilk_rho
+    ADD CONSTRAINT jug_ilk_rho_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_init jug_init_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_init
+    ADD CONSTRAINT jug_init_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: jug_init jug_init_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_init
+    ADD CONSTRAINT jug_init_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_vat jug_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_vat
+    ADD CONSTRAINT jug_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+
+
+--
+-- Name: jug_vat jug_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_vat
+    ADD CONSTRAINT jug_vat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: jug_vow jug_vow_block_number_block_hash_vow_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_vow
+    ADD CONSTRAINT jug_vow_block_number_block_hash_vow_key UNIQUE (block_number, block_hash, vow);
+
+
+--
+-- Name: jug_vow jug_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.jug_vow
+    ADD CONSTRAINT jug_vow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: log_median_price log_median_price_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.log_median_price
+    ADD CONSTRAINT log_median_price_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: log_median_price log_median_price_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.log_median_price
+    ADD CONSTRAINT log_median_price_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: log_value log_value_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.log_value
+    ADD CONSTRAINT log_value_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: log_value log_value_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.log_value
+    ADD CONSTRAINT log_value_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: new_cdp new_cdp_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.new_cdp
+    ADD CONSTRAINT new_cdp_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: new_cdp new_cdp_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.new_cdp
+    ADD CONSTRAINT new_cdp_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: osm_cur_has osm_cur_has_block_number_block_hash_address_id_has_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_cur_has
+    ADD CONSTRAINT osm_cur_has_block_number_block_hash_address_id_has_key UNIQUE (block_number, block_hash, address_id, has);
+
+
+--
+-- Name: osm_cur_has osm_cur_has_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_cur_has
+    ADD CONSTRAINT osm_cur_has_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: osm_cur_val osm_cur_val_block_number_block_hash_address_id_val_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_cur_val
+    ADD CONSTRAINT osm_cur_val_block_number_block_hash_address_id_val_key UNIQUE (block_number, block_hash, address_id, val);
+
+
+--
+-- Name: osm_cur_val osm_cur_val_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_cur_val
+    ADD CONSTRAINT osm_cur_val_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: osm_hop osm_hop_block_number_block_hash_address_id_hop_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_hop
+    ADD CONSTRAINT osm_hop_block_number_block_hash_address_id_hop_key UNIQUE (block_number, block_hash, address_id, hop);
+
+
+--
+-- Name: osm_hop osm_hop_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_hop
+    ADD CONSTRAINT osm_hop_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: osm_nxt_has osm_nxt_has_block_number_block_hash_address_id_has_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_nxt_has
+    ADD CONSTRAINT osm_nxt_has_block_number_block_hash_address_id_has_key UNIQUE (block_number, block_hash, address_id, has);
+
+
+--
+-- Name: osm_nxt_has osm_nxt_has_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_nxt_has
+    ADD CONSTRAINT osm_nxt_has_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: osm_nxt_val osm_nxt_val_block_number_block_hash_address_id_val_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_nxt_val
+    ADD CONSTRAINT osm_nxt_val_block_number_block_hash_address_id_val_key UNIQUE (block_number, block_hash, address_id, val);
+
+
+--
+-- Name: osm_nxt_val osm_nxt_val_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_nxt_val
+    ADD CONSTRAINT osm_nxt_val_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: osm_stopped osm_stopped_block_number_block_hash_address_id_stopped_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_stopped
+    ADD CONSTRAINT osm_stopped_block_number_block_hash_address_id_stopped_key UNIQUE (block_number, block_hash, address_id, stopped);
+
+
+--
+-- Name: osm_stopped osm_stopped_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_stopped
+    ADD CONSTRAINT osm_stopped_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: osm_zzz osm_zzz_block_number_block_hash_address_id_zzz_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_zzz
+    ADD CONSTRAINT osm_zzz_block_number_block_hash_address_id_zzz_key UNIQUE (block_number, block_hash, address_id, zzz);
+
+
+--
+-- Name: osm_zzz osm_zzz_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.osm_zzz
+    ADD CONSTRAINT osm_zzz_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pause_drop pause_drop_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_drop
+    ADD CONSTRAINT pause_drop_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pause_drop pause_drop_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_drop
+    ADD CONSTRAINT pause_drop_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pause_exec pause_exec_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_exec
+    ADD CONSTRAINT pause_exec_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pause_exec pause_exec_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_exec
+    ADD CONSTRAINT pause_exec_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pause_plot pause_plot_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_plot
+    ADD CONSTRAINT pause_plot_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pause_plot pause_plot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pause_plot
+    ADD CONSTRAINT pause_plot_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_chi pot_chi_block_number_block_hash_chi_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_chi
+    ADD CONSTRAINT pot_chi_block_number_block_hash_chi_key UNIQUE (block_number, block_hash, chi);
+
+
+--
+-- Name: pot_chi pot_chi_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_chi
+    ADD CONSTRAINT pot_chi_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_drip pot_drip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_drip
+    ADD CONSTRAINT pot_drip_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pot_drip pot_drip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_drip
+    ADD CONSTRAINT pot_drip_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_dsr pot_dsr_block_number_block_hash_dsr_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_dsr
+    ADD CONSTRAINT pot_dsr_block_number_block_hash_dsr_key UNIQUE (block_number, block_hash, dsr);
+
+
+--
+-- Name: pot_dsr pot_dsr_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_dsr
+    ADD CONSTRAINT pot_dsr_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_exit pot_exit_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_exit
+    ADD CONSTRAINT pot_exit_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pot_exit pot_exit_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_exit
+    ADD CONSTRAINT pot_exit_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_file_dsr pot_file_dsr_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_file_dsr
+    ADD CONSTRAINT pot_file_dsr_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pot_file_dsr pot_file_dsr_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_file_dsr
+    ADD CONSTRAINT pot_file_dsr_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_file_vow pot_file_vow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_file_vow
+    ADD CONSTRAINT pot_file_vow_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pot_file_vow pot_file_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_file_vow
+    ADD CONSTRAINT pot_file_vow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_join pot_join_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_join
+    ADD CONSTRAINT pot_join_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: pot_join pot_join_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_join
+    ADD CONSTRAINT pot_join_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_live pot_live_block_number_block_hash_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_live
+    ADD CONSTRAINT pot_live_block_number_block_hash_live_key UNIQUE (block_number, block_hash, live);
+
+
+--
+-- Name: pot_live pot_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_live
+    ADD CONSTRAINT pot_live_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_pie pot_pie_block_number_block_hash_pie_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_pie
+    ADD CONSTRAINT pot_pie_block_number_block_hash_pie_key UNIQUE (block_number, block_hash, pie);
+
+
+--
+-- Name: pot_pie pot_pie_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_pie
+    ADD CONSTRAINT pot_pie_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_rho pot_rho_block_number_block_hash_rho_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_rho
+    ADD CONSTRAINT pot_rho_block_number_block_hash_rho_key UNIQUE (block_number, block_hash, rho);
+
+
+--
+-- Name: pot_rho pot_rho_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_rho
+    ADD CONSTRAINT pot_rho_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_user_pie pot_user_pie_block_number_block_hash_msg_sender_pie_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_user_pie
+    ADD CONSTRAINT pot_user_pie_block_number_block_hash_msg_sender_pie_key UNIQUE (block_number, block_hash, msg_sender, pie);
+
+
+--
+-- Name: pot_user_pie pot_user_pie_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_user_pie
+    ADD CONSTRAINT pot_user_pie_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: pot_vow pot_vow_block_number_block_hash_vow_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_vow
+    ADD CONSTRAINT pot_vow_block_number_block_hash_vow_key UNIQUE (block_number, block_hash, vow);
+
+
+--
+-- Name: pot_vow pot_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.pot_vow
+    ADD CONSTRAINT pot_vow_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: proxies proxies_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.proxies
+    ADD CONSTRAINT proxies_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: proxies proxies_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.proxies
+    ADD CONSTRAINT proxies_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_file_mat spot_file_mat_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_mat
+    ADD CONSTRAINT spot_file_mat_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: spot_file_mat spot_file_mat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_mat
+    ADD CONSTRAINT spot_file_mat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_file_par spot_file_par_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_par
+    ADD CONSTRAINT spot_file_par_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: spot_file_par spot_file_par_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_par
+    ADD CONSTRAINT spot_file_par_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_file_pip spot_file_pip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_pip
+    ADD CONSTRAINT spot_file_pip_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: spot_file_pip spot_file_pip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_pip
+    ADD CONSTRAINT spot_file_pip_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_ilk_mat spot_ilk_mat_block_number_block_hash_ilk_id_mat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_ilk_mat
+    ADD CONSTRAINT spot_ilk_mat_block_number_block_hash_ilk_id_mat_key UNIQUE (block_number, block_hash, ilk_id, mat);
+
+
+--
+-- Name: spot_ilk_mat spot_ilk_mat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_ilk_mat
+    ADD CONSTRAINT spot_ilk_mat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_ilk_pip spot_ilk_pip_block_number_block_hash_ilk_id_pip_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_ilk_pip
+    ADD CONSTRAINT spot_ilk_pip_block_number_block_hash_ilk_id_pip_key UNIQUE (block_number, block_hash, ilk_id, pip);
+
+
+--
+-- Name: spot_ilk_pip spot_ilk_pip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_ilk_pip
+    ADD CONSTRAINT spot_ilk_pip_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_par spot_par_block_number_block_hash_par_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_par
+    ADD CONSTRAINT spot_par_block_number_block_hash_par_key UNIQUE (block_number, block_hash, par);
+
+
+--
+-- Name: spot_par spot_par_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_par
+    ADD CONSTRAINT spot_par_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_poke spot_poke_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_poke
+    ADD CONSTRAINT spot_poke_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: spot_poke spot_poke_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_poke
+    ADD CONSTRAINT spot_poke_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spot_vat spot_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_vat
+    ADD CONSTRAINT spot_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+
+
+--
+-- Name: spot_vat spot_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_vat
+    ADD CONSTRAINT spot_vat_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: tend tend_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.tend
+    ADD CONSTRAINT tend_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: tend tend_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.tend
+    ADD CONSTRAINT tend_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: tick tick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.tick
+    ADD CONSTRAINT tick_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: tick tick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.tick
+    ADD CONSTRAINT tick_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: urns urns_ilk_id_identifier_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.urns
+    ADD CONSTRAINT urns_ilk_id_identifier_key UNIQUE (ilk_id, identifier);
+
+
+--
+-- Name: urns urns_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.urns
+    ADD CONSTRAINT urns_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_can vat_can_block_number_block_hash_bit_usr_can_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_can
+    ADD CONSTRAINT vat_can_block_number_block_hash_bit_usr_can_key UNIQUE (block_number, block_hash, "bit", usr, can);
+
+
+--
+-- Name: vat_can vat_can_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_can
+    ADD CONSTRAINT vat_can_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_dai vat_dai_block_number_block_hash_guy_dai_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_dai
+    ADD CONSTRAINT vat_dai_block_number_block_hash_guy_dai_key UNIQUE (block_number, block_hash, guy, dai);
+
+
+--
+-- Name: vat_dai vat_dai_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_dai
+    ADD CONSTRAINT vat_dai_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_debt vat_debt_block_number_block_hash_debt_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_debt
+    ADD CONSTRAINT vat_debt_block_number_block_hash_debt_key UNIQUE (block_number, block_hash, debt);
+
+
+--
+-- Name: vat_debt vat_debt_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_debt
+    ADD CONSTRAINT vat_debt_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_file_debt_ceiling vat_file_debt_ceiling_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_file_debt_ceiling
+    ADD CONSTRAINT vat_file_debt_ceiling_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: vat_file_debt_ceiling vat_file_debt_ceiling_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_file_debt_ceiling
+    ADD CONSTRAINT vat_file_debt_ceiling_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_file_ilk vat_file_ilk_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_file_ilk
+    ADD CONSTRAINT vat_file_ilk_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: vat_file_ilk vat_file_ilk_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_file_ilk
+    ADD CONSTRAINT vat_file_ilk_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_flux vat_flux_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_flux
+    ADD CONSTRAINT vat_flux_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: vat_flux vat_flux_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_flux
+    ADD CONSTRAINT vat_flux_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_fold vat_fold_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_fold
+    ADD CONSTRAINT vat_fold_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: vat_fold vat_fold_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_fold
+    ADD CONSTRAINT vat_fold_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_fork vat_fork_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_fork
+    ADD CONSTRAINT vat_fork_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: vat_fork vat_fork_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_fork
+    ADD CONSTRAINT vat_fork_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: vat_frob vat_frob_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_frob
+    ADD CONSTRAINT vat_frob_header_id_log_id_key UNIQUE (header_id, log_id);
+
+
+--
+-- Name: vat_frob vat_frob_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_gal ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_gal_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_frob
+    ADD CONSTRAINT vat_frob_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flip_bid_guy id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_gem vat_gem_block_number_block_hash_ilk_id_guy_gem_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_guy ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_guy_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_gem
+    ADD CONSTRAINT vat_gem_block_number_block_hash_ilk_id_guy_gem_key UNIQUE (block_number, block_hash, ilk_id, guy, gem);
 
 
 --
--- Name: flip_bid_lot id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_gem vat_gem_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_lot ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_lot_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_gem
+    ADD CONSTRAINT vat_gem_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flip_bid_tab id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_grab vat_grab_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tab ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_tab_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_grab
+    ADD CONSTRAINT vat_grab_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: flip_bid_tic id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_grab vat_grab_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tic ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_tic_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_grab
+    ADD CONSTRAINT vat_grab_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flip_bid_usr id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_heal vat_heal_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_usr ALTER COLUMN id SET DEFAULT nextval('maker.flip_bid_usr_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_heal
+    ADD CONSTRAINT vat_heal_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: flip_ilk id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_heal vat_heal_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ilk ALTER COLUMN id SET DEFAULT nextval('maker.flip_ilk_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_heal
+    ADD CONSTRAINT vat_heal_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flip_kick id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_hope vat_hope_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kick ALTER COLUMN id SET DEFAULT nextval('maker.flip_kick_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_hope
+    ADD CONSTRAINT vat_hope_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: flip_kicks id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_hope vat_hope_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kicks ALTER COLUMN id SET DEFAULT nextval('maker.flip_kicks_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_hope
+    ADD CONSTRAINT vat_hope_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flip_tau id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_art vat_ilk_art_block_number_block_hash_ilk_id_art_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_tau ALTER COLUMN id SET DEFAULT nextval('maker.flip_tau_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_art
+    ADD CONSTRAINT vat_ilk_art_block_number_block_hash_ilk_id_art_key UNIQUE (block_number, block_hash, ilk_id, art);
 
 
 --
--- Name: flip_ttl id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_art vat_ilk_art_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ttl ALTER COLUMN id SET DEFAULT nextval('maker.flip_ttl_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_art
+    ADD CONSTRAINT vat_ilk_art_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flip_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_dust vat_ilk_dust_block_number_block_hash_ilk_id_dust_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_vat ALTER COLUMN id SET DEFAULT nextval('maker.flip_vat_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_dust
+    ADD CONSTRAINT vat_ilk_dust_block_number_block_hash_ilk_id_dust_key UNIQUE (block_number, block_hash, ilk_id, dust);
 
 
 --
--- Name: flop id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_dust vat_ilk_dust_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop ALTER COLUMN id SET DEFAULT nextval('maker.flop_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_dust
+    ADD CONSTRAINT vat_ilk_dust_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flop_beg id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_line vat_ilk_line_block_number_block_hash_ilk_id_line_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_beg ALTER COLUMN id SET DEFAULT nextval('maker.flop_beg_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_line
+    ADD CONSTRAINT vat_ilk_line_block_number_block_hash_ilk_id_line_key UNIQUE (block_number, block_hash, ilk_id, line);
 
 
 --
--- Name: flop_bid_bid id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_line vat_ilk_line_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_bid ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_bid_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_line
+    ADD CONSTRAINT vat_ilk_line_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flop_bid_end id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_rate vat_ilk_rate_block_number_block_hash_ilk_id_rate_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_end ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_end_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_rate
+    ADD CONSTRAINT vat_ilk_rate_block_number_block_hash_ilk_id_rate_key UNIQUE (block_number, block_hash, ilk_id, rate);
 
 
 --
--- Name: flop_bid_guy id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_rate vat_ilk_rate_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_guy ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_guy_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_rate
+    ADD CONSTRAINT vat_ilk_rate_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flop_bid_lot id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_spot vat_ilk_spot_block_number_block_hash_ilk_id_spot_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_lot ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_lot_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_spot
+    ADD CONSTRAINT vat_ilk_spot_block_number_block_hash_ilk_id_spot_key UNIQUE (block_number, block_hash, ilk_id, spot);
 
 
 --
--- Name: flop_bid_tic id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_ilk_spot vat_ilk_spot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_tic ALTER COLUMN id SET DEFAULT nextval('maker.flop_bid_tic_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_ilk_spot
+    ADD CONSTRAINT vat_ilk_spot_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flop_gem id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_init vat_init_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_gem ALTER COLUMN id SET DEFAULT nextval('maker.flop_gem_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_init
+    ADD CONSTRAINT vat_init_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: flop_kick id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_init vat_init_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kick ALTER COLUMN id SET DEFAULT nextval('maker.flop_kick_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_init
+    ADD CONSTRAINT vat_init_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flop_kicks id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_line vat_line_block_number_block_hash_line_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kicks ALTER COLUMN id SET DEFAULT nextval('maker.flop_kicks_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_line
+    ADD CONSTRAINT vat_line_block_number_block_hash_line_key UNIQUE (block_number, block_hash, line);
 
 
 --
--- Name: flop_live id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_line vat_line_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_live ALTER COLUMN id SET DEFAULT nextval('maker.flop_live_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_line
+    ADD CONSTRAINT vat_line_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flop_pad id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_live vat_live_block_number_block_hash_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_pad ALTER COLUMN id SET DEFAULT nextval('maker.flop_pad_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_live
+    ADD CONSTRAINT vat_live_block_number_block_hash_live_key UNIQUE (block_number, block_hash, live);
 
 
 --
--- Name: flop_tau id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_live vat_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_tau ALTER COLUMN id SET DEFAULT nextval('maker.flop_tau_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_live
+    ADD CONSTRAINT vat_live_pkey PRIMARY KEY (id);
 
 
 --
--- Name: flop_ttl id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_move vat_move_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_ttl ALTER COLUMN id SET DEFAULT nextval('maker.flop_ttl_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_move
+    ADD CONSTRAINT vat_move_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: flop_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_move vat_move_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_vat ALTER COLUMN id SET DEFAULT nextval('maker.flop_vat_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_move
+    ADD CONSTRAINT vat_move_pkey PRIMARY KEY (id);
 
 
 --
--- Name: ilks id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_nope vat_nope_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.ilks ALTER COLUMN id SET DEFAULT nextval('maker.ilks_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_nope
+    ADD CONSTRAINT vat_nope_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: jug_base id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_nope vat_nope_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_base ALTER COLUMN id SET DEFAULT nextval('maker.jug_base_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_nope
+    ADD CONSTRAINT vat_nope_pkey PRIMARY KEY (id);
 
 
 --
--- Name: jug_drip id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_sin vat_sin_block_number_block_hash_guy_sin_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_drip ALTER COLUMN id SET DEFAULT nextval('maker.jug_drip_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_sin
+    ADD CONSTRAINT vat_sin_block_number_block_hash_guy_sin_key UNIQUE (block_number, block_hash, guy, sin);
 
 
 --
--- Name: jug_file_base id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_sin vat_sin_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_base ALTER COLUMN id SET DEFAULT nextval('maker.jug_file_base_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_sin
+    ADD CONSTRAINT vat_sin_pkey PRIMARY KEY (id);
 
 
 --
--- Name: jug_file_ilk id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_slip vat_slip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_ilk ALTER COLUMN id SET DEFAULT nextval('maker.jug_file_ilk_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_slip
+    ADD CONSTRAINT vat_slip_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: jug_file_vow id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_slip vat_slip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_vow ALTER COLUMN id SET DEFAULT nextval('maker.jug_file_vow_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_slip
+    ADD CONSTRAINT vat_slip_pkey PRIMARY KEY (id);
 
 
 --
--- Name: jug_ilk_duty id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_suck vat_suck_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_duty ALTER COLUMN id SET DEFAULT nextval('maker.jug_ilk_duty_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_suck
+    ADD CONSTRAINT vat_suck_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: jug_ilk_rho id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_suck vat_suck_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_rho ALTER COLUMN id SET DEFAULT nextval('maker.jug_ilk_rho_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_suck
+    ADD CONSTRAINT vat_suck_pkey PRIMARY KEY (id);
 
 
 --
--- Name: jug_init id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_urn_art vat_urn_art_block_number_block_hash_urn_id_art_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_init ALTER COLUMN id SET DEFAULT nextval('maker.jug_init_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_urn_art
+    ADD CONSTRAINT vat_urn_art_block_number_block_hash_urn_id_art_key UNIQUE (block_number, block_hash, urn_id, art);
 
 
 --
--- Name: jug_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_urn_art vat_urn_art_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_vat ALTER COLUMN id SET DEFAULT nextval('maker.jug_vat_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_urn_art
+    ADD CONSTRAINT vat_urn_art_pkey PRIMARY KEY (id);
 
 
 --
--- Name: jug_vow id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_urn_ink vat_urn_ink_block_number_block_hash_urn_id_ink_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_vow ALTER COLUMN id SET DEFAULT nextval('maker.jug_vow_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_urn_ink
+    ADD CONSTRAINT vat_urn_ink_block_number_block_hash_urn_id_ink_key UNIQUE (block_number, block_hash, urn_id, ink);
 
 
 --
--- Name: new_cdp id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_urn_ink vat_urn_ink_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.new_cdp ALTER COLUMN id SET DEFAULT nextval('maker.new_cdp_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_urn_ink
+    ADD CONSTRAINT vat_urn_ink_pkey PRIMARY KEY (id);
 
 
 --
--- Name: spot_file_mat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_vice vat_vice_block_number_block_hash_vice_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_file_mat ALTER COLUMN id SET DEFAULT nextval('maker.spot_file_mat_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_vice
+    ADD CONSTRAINT vat_vice_block_number_block_hash_vice_key UNIQUE (block_number, block_hash, vice);
 
 
 --
--- Name: spot_file_pip id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vat_vice vat_vice_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_file_pip ALTER COLUMN id SET DEFAULT nextval('maker.spot_file_pip_id_seq'::regclass);
+ALTER TABLE ONLY maker.vat_vice
+    ADD CONSTRAINT vat_vice_pkey PRIMARY KEY (id);
 
 
 --
--- Name: spot_ilk_mat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_ash vow_ash_block_number_block_hash_ash_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_ilk_mat ALTER COLUMN id SET DEFAULT nextval('maker.spot_ilk_mat_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_ash
+    ADD CONSTRAINT vow_ash_block_number_block_hash_ash_key UNIQUE (block_number, block_hash, ash);
 
 
 --
--- Name: spot_ilk_pip id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_ash vow_ash_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_ilk_pip ALTER COLUMN id SET DEFAULT nextval('maker.spot_ilk_pip_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_ash
+    ADD CONSTRAINT vow_ash_pkey PRIMARY KEY (id);
 
 
 --
--- Name: spot_par id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_bump vow_bump_block_number_block_hash_bump_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_par ALTER COLUMN id SET DEFAULT nextval('maker.spot_par_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_bump
+    ADD CONSTRAINT vow_bump_block_number_block_hash_bump_key UNIQUE (block_number, block_hash, bump);
 
 
 --
--- Name: spot_poke id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_bump vow_bump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_poke ALTER COLUMN id SET DEFAULT nextval('maker.spot_poke_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_bump
+    ADD CONSTRAINT vow_bump_pkey PRIMARY KEY (id);
 
 
 --
--- Name: spot_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_dump vow_dump_block_number_block_hash_dump_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_vat ALTER COLUMN id SET DEFAULT nextval('maker.spot_vat_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_dump
+    ADD CONSTRAINT vow_dump_block_number_block_hash_dump_key UNIQUE (block_number, block_hash, dump);
 
 
 --
--- Name: tend id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_dump vow_dump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.tend ALTER COLUMN id SET DEFAULT nextval('maker.tend_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_dump
+    ADD CONSTRAINT vow_dump_pkey PRIMARY KEY (id);
 
 
 --
--- Name: tick id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_fess vow_fess_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.tick ALTER COLUMN id SET DEFAULT nextval('maker.tick_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_fess
+    ADD CONSTRAINT vow_fess_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: urns id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_fess vow_fess_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.urns ALTER COLUMN id SET DEFAULT nextval('maker.urns_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_fess
+    ADD CONSTRAINT vow_fess_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_dai id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_file vow_file_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_dai ALTER COLUMN id SET DEFAULT nextval('maker.vat_dai_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_file
+    ADD CONSTRAINT vow_file_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: vat_debt id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_file vow_file_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_debt ALTER COLUMN id SET DEFAULT nextval('maker.vat_debt_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_file
+    ADD CONSTRAINT vow_file_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_file_debt_ceiling id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flap vow_flap_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_file_debt_ceiling ALTER COLUMN id SET DEFAULT nextval('maker.vat_file_debt_ceiling_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flap
+    ADD CONSTRAINT vow_flap_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: vat_file_ilk id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flap vow_flap_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_file_ilk ALTER COLUMN id SET DEFAULT nextval('maker.vat_file_ilk_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flap
+    ADD CONSTRAINT vow_flap_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_flux id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flapper vow_flapper_block_number_block_hash_flapper_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_flux ALTER COLUMN id SET DEFAULT nextval('maker.vat_flux_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flapper
+    ADD CONSTRAINT vow_flapper_block_number_block_hash_flapper_key UNIQUE (block_number, block_hash, flapper);
 
 
 --
--- Name: vat_fold id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flapper vow_flapper_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_fold ALTER COLUMN id SET DEFAULT nextval('maker.vat_fold_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flapper
+    ADD CONSTRAINT vow_flapper_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_fork id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flog vow_flog_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_fork ALTER COLUMN id SET DEFAULT nextval('maker.vat_fork_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flog
+    ADD CONSTRAINT vow_flog_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: vat_frob id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flog vow_flog_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_frob ALTER COLUMN id SET DEFAULT nextval('maker.vat_frob_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flog
+    ADD CONSTRAINT vow_flog_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_gem id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flop vow_flop_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_gem ALTER COLUMN id SET DEFAULT nextval('maker.vat_gem_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flop
+    ADD CONSTRAINT vow_flop_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: vat_grab id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flop vow_flop_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_grab ALTER COLUMN id SET DEFAULT nextval('maker.vat_grab_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flop
+    ADD CONSTRAINT vow_flop_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_heal id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flopper vow_flopper_block_number_block_hash_flopper_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_heal ALTER COLUMN id SET DEFAULT nextval('maker.vat_heal_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flopper
+    ADD CONSTRAINT vow_flopper_block_number_block_hash_flopper_key UNIQUE (block_number, block_hash, flopper);
 
 
 --
--- Name: vat_ilk_art id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_flopper vow_flopper_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_art ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_art_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_flopper
+    ADD CONSTRAINT vow_flopper_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_ilk_dust id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_heal vow_heal_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_dust ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_dust_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_heal
+    ADD CONSTRAINT vow_heal_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: vat_ilk_line id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_heal vow_heal_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_line ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_line_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_heal
+    ADD CONSTRAINT vow_heal_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_ilk_rate id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_hump vow_hump_block_number_block_hash_hump_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_rate ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_rate_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_hump
+    ADD CONSTRAINT vow_hump_block_number_block_hash_hump_key UNIQUE (block_number, block_hash, hump);
 
 
 --
--- Name: vat_ilk_spot id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_hump vow_hump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_spot ALTER COLUMN id SET DEFAULT nextval('maker.vat_ilk_spot_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_hump
+    ADD CONSTRAINT vow_hump_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_init id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_kiss vow_kiss_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_init ALTER COLUMN id SET DEFAULT nextval('maker.vat_init_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_kiss
+    ADD CONSTRAINT vow_kiss_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: vat_line id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_kiss vow_kiss_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_line ALTER COLUMN id SET DEFAULT nextval('maker.vat_line_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_kiss
+    ADD CONSTRAINT vow_kiss_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_live id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_sin_integer vow_sin_integer_block_number_block_hash_sin_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_live ALTER COLUMN id SET DEFAULT nextval('maker.vat_live_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_sin_integer
+    ADD CONSTRAINT vow_sin_integer_block_number_block_hash_sin_key UNIQUE (block_number, block_hash, sin);
 
 
 --
--- Name: vat_move id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_sin_integer vow_sin_integer_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_move ALTER COLUMN id SET DEFAULT nextval('maker.vat_move_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_sin_integer
+    ADD CONSTRAINT vow_sin_integer_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_sin id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_sin_mapping vow_sin_mapping_block_number_block_hash_era_tab_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_sin ALTER COLUMN id SET DEFAULT nextval('maker.vat_sin_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_sin_mapping
+    ADD CONSTRAINT vow_sin_mapping_block_number_block_hash_era_tab_key UNIQUE (block_number, block_hash, era, tab);
 
 
 --
--- Name: vat_slip id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_sin_mapping vow_sin_mapping_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_slip ALTER COLUMN id SET DEFAULT nextval('maker.vat_slip_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_sin_mapping
+    ADD CONSTRAINT vow_sin_mapping_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_suck id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_sump vow_sump_block_number_block_hash_sump_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_suck ALTER COLUMN id SET DEFAULT nextval('maker.vat_suck_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_sump
+    ADD CONSTRAINT vow_sump_block_number_block_hash_sump_key UNIQUE (block_number, block_hash, sump);
 
 
 --
--- Name: vat_urn_art id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_sump vow_sump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_urn_art ALTER COLUMN id SET DEFAULT nextval('maker.vat_urn_art_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_sump
+    ADD CONSTRAINT vow_sump_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vat_urn_ink id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_vat vow_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_urn_ink ALTER COLUMN id SET DEFAULT nextval('maker.vat_urn_ink_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_vat
+    ADD CONSTRAINT vow_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
 
 
 --
--- Name: vat_vice id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_vat vow_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_vice ALTER COLUMN id SET DEFAULT nextval('maker.vat_vice_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_vat
+    ADD CONSTRAINT vow_vat_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vow_ash id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_wait vow_wait_block_number_block_hash_wait_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_ash ALTER COLUMN id SET DEFAULT nextval('maker.vow_ash_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_wait
+    ADD CONSTRAINT vow_wait_block_number_block_hash_wait_key UNIQUE (block_number, block_hash, wait);
 
 
 --
--- Name: vow_bump id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: vow_wait vow_wait_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_bump ALTER COLUMN id SET DEFAULT nextval('maker.vow_bump_id_seq'::regclass);
+ALTER TABLE ONLY maker.vow_wait
+    ADD CONSTRAINT vow_wait_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vow_dump id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: wards wards_block_number_block_hash_address_id_usr_wards_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_dump ALTER COLUMN id SET DEFAULT nextval('maker.vow_dump_id_seq'::regclass);
+ALTER TABLE ONLY maker.wards
+    ADD CONSTRAINT wards_block_number_block_hash_address_id_usr_wards_key UNIQUE (block_number, block_hash, address_id, usr, wards);
 
 
 --
--- Name: vow_fess id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: wards wards_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_fess ALTER COLUMN id SET DEFAULT nextval('maker.vow_fess_id_seq'::regclass);
+ALTER TABLE ONLY maker.wards
+    ADD CONSTRAINT wards_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vow_file id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: yank yank_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_file ALTER COLUMN id SET DEFAULT nextval('maker.vow_file_id_seq'::regclass);
+ALTER TABLE ONLY maker.yank
+    ADD CONSTRAINT yank_header_id_log_id_key UNIQUE (header_id, log_id);
 
 
 --
--- Name: vow_flapper id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: yank yank_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flapper ALTER COLUMN id SET DEFAULT nextval('maker.vow_flapper_id_seq'::regclass);
+ALTER TABLE ONLY maker.yank
+    ADD CONSTRAINT yank_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vow_flog id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: addresses addresses_address_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flog ALTER COLUMN id SET DEFAULT nextval('maker.vow_flog_id_seq'::regclass);
+ALTER TABLE ONLY public.addresses
+    ADD CONSTRAINT addresses_address_key UNIQUE (address);
 
 
 --
--- Name: vow_flopper id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: addresses addresses_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flopper ALTER COLUMN id SET DEFAULT nextval('maker.vow_flopper_id_seq'::regclass);
+ALTER TABLE ONLY public.addresses
+    ADD CONSTRAINT addresses_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vow_hump id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: blocks blocks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_hump ALTER COLUMN id SET DEFAULT nextval('maker.vow_hump_id_seq'::regclass);
+ALTER TABLE ONLY public.blocks
+    ADD CONSTRAINT blocks_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vow_sin_integer id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: checked_headers checked_headers_header_id_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sin_integer ALTER COLUMN id SET DEFAULT nextval('maker.vow_sin_integer_id_seq'::regclass);
+ALTER TABLE ONLY public.checked_headers
+    ADD CONSTRAINT checked_headers_header_id_key UNIQUE (header_id);
 
 
 --
--- Name: vow_sin_mapping id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: checked_headers checked_headers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sin_mapping ALTER COLUMN id SET DEFAULT nextval('maker.vow_sin_mapping_id_seq'::regclass);
+ALTER TABLE ONLY public.checked_headers
+    ADD CONSTRAINT checked_headers_pkey PRIMARY KEY (id);
 
 
 --
--- Name: vow_sump id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: blocks eth_node_id_block_number_uc; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sump ALTER COLUMN id SET DEFAULT nextval('maker.vow_sump_id_seq'::regclass);
+ALTER TABLE ONLY public.blocks
+    ADD CONSTRAINT eth_node_id_block_number_uc UNIQUE (number, eth_node_id);
 
 
 --
--- Name: vow_vat id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: eth_nodes eth_node_uc; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_vat ALTER COLUMN id SET DEFAULT nextval('maker.vow_vat_id_seq'::regclass);
+ALTER TABLE ONLY public.eth_nodes
+    ADD CONSTRAINT eth_node_uc UNIQUE (genesis_block, network_id, eth_node_id);
 
 
 --
--- Name: vow_wait id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: full_sync_logs full_sync_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_wait ALTER COLUMN id SET DEFAULT nextval('maker.vow_wait_id_seq'::regclass);
+ALTER TABLE ONLY public.full_sync_logs
+    ADD CONSTRAINT full_sync_logs_pkey PRIMARY KEY (id);
 
 
 --
--- Name: yank id; Type: DEFAULT; Schema: maker; Owner: -
+-- Name: full_sync_receipts full_sync_receipts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY maker.yank ALTER COLUMN id SET DEFAULT nextval('maker.yank_id_seq'::regclass);
+ALTER TABLE ONLY public.full_sync_receipts
+    ADD CONSTRAINT full_sync_receipts_pkey PRIMARY KEY (id);
 
 
 --
--- Name: addresses id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: full_sync_transactions full_sync_transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.addresses ALTER COLUMN id SET DEFAULT nextval('public.addresses_id_seq'::regclass);
+ALTER TABLE ONLY public.full_sync_transactions
+    ADD CONSTRAINT full_sync_transactions_pkey PRIMARY KEY (id);
 
 
 --
--- Name: blocks id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: goose_db_version goose_db_version_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.blocks ALTER COLUMN id SET DEFAULT nextval('public.blocks_id_seq'::regclass);
+ALTER TABLE ONLY public.goose_db_version
+    ADD CONSTRAINT goose_db_version_pkey PRIMARY KEY (id);
 
 
 --
--- Name: checked_headers id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: header_sync_logs header_sync_logs_header_id_tx_index_log_index_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.checked_headers ALTER COLUMN id SET DEFAULT nextval('public.checked_headers_id_seq'::regclass);
+ALTER TABLE ONLY public.header_sync_logs
+    ADD CONSTRAINT header_sync_logs_header_id_tx_index_log_index_key UNIQUE (header_id, tx_index, log_index);
 
 
 --
--- Name: eth_nodes id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: header_sync_logs header_sync_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.eth_nodes ALTER COLUMN id SET DEFAULT nextval('public.nodes_id_seq'::regclass);
+ALTER TABLE ONLY public.header_sync_logs
+    ADD CONSTRAINT header_sync_logs_pkey PRIMARY KEY (id);
 
 
 --
--- Name: full_sync_logs id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: header_sync_receipts header_sync_receipts_header_id_transaction_id_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.full_sync_logs ALTER COLUMN id SET DEFAULT nextval('public.full_sync_logs_id_seq'::regclass);
+ALTER TABLE ONLY public.header_sync_receipts
+    ADD CONSTRAINT header_sync_receipts_header_id_transaction_id_key UNIQUE (header_id, transaction_id);
 
 
 --
--- Name: full_sync_receipts id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: header_sync_receipts header_sync_receipts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.full_sync_receipts ALTER COLUMN id SET DEFAULT nextval('public.full_sync_receipts_id_seq'::regclass);
+ALTER TABLE ONLY public.header_sync_receipts
+    ADD CONSTRAINT header_sync_receipts_pkey PRIMARY KEY (id);
 
 
 --
--- Name: full_sync_transactions id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: header_sync_transactions header_sync_transactions_header_id_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.full_sync_transactions ALTER COLUMN id SET DEFAULT nextval('public.full_sync_transactions_id_seq'::regclass);
+ALTER TABLE ONLY public.header_sync_transactions
+    ADD CONSTRAINT header_sync_transactions_header_id_hash_key UNIQUE (header_id, hash);
 
 
 --
--- Name: goose_db_version id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: header_sync_transactions header_sync_transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.goose_db_version ALTER COLUMN id SET DEFAULT nextval('public.goose_db_version_id_seq'::regclass);
+ALTER TABLE ONLY public.header_sync_transactions
+    ADD CONSTRAINT header_sync_transactions_pkey PRIMARY KEY (id);
 
 
 --
--- Name: header_sync_logs id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: headers headers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_logs ALTER COLUMN id SET DEFAULT nextval('public.header_sync_logs_id_seq'::regclass);
+ALTER TABLE ONLY public.headers
+    ADD CONSTRAINT headers_pkey PRIMARY KEY (id);
 
 
 --
--- Name: header_sync_receipts id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: log_filters name_uc; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_receipts ALTER COLUMN id SET DEFAULT nextval('public.header_sync_receipts_id_seq'::regclass);
+ALTER TABLE ONLY public.log_filters
+    ADD CONSTRAINT name_uc UNIQUE (name);
 
 
 --
--- Name: header_sync_transactions id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: eth_nodes nodes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_transactions ALTER COLUMN id SET DEFAULT nextval('public.header_sync_transactions_id_seq'::regclass);
+ALTER TABLE ONLY public.eth_nodes
+    ADD CONSTRAINT nodes_pkey PRIMARY KEY (id);
 
 
 --
--- Name: headers id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: queued_storage queued_storage_block_height_block_hash_contract_storage_key_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.headers ALTER COLUMN id SET DEFAULT nextval('public.headers_id_seq'::regclass);
+ALTER TABLE ONLY public.queued_storage
+    ADD CONSTRAINT queued_storage_block_height_block_hash_contract_storage_key_key UNIQUE (block_height, block_hash, contract, storage_key, storage_value);
 
 
 --
--- Name: log_filters id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: queued_storage queued_storage_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.log_filters ALTER COLUMN id SET DEFAULT nextval('public.log_filters_id_seq'::regclass);
+ALTER TABLE ONLY public.queued_storage
+    ADD CONSTRAINT queued_storage_pkey PRIMARY KEY (id);
 
 
 --
--- Name: queued_storage id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: uncles uncles_block_id_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.queued_storage ALTER COLUMN id SET DEFAULT nextval('public.queued_storage_id_seq'::regclass);
+ALTER TABLE ONLY public.uncles
+    ADD CONSTRAINT uncles_block_id_hash_key UNIQUE (block_id, hash);
 
 
 --
--- Name: uncles id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: uncles uncles_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.uncles ALTER COLUMN id SET DEFAULT nextval('public.uncles_id_seq'::regclass);
+ALTER TABLE ONLY public.uncles
+    ADD CONSTRAINT uncles_pkey PRIMARY KEY (id);
 
 
 --
--- Name: watched_contracts contract_id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: watched_contracts watched_contracts_contract_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.watched_contracts ALTER COLUMN contract_id SET DEFAULT nextval('public.watched_contracts_contract_id_seq'::regclass);
+ALTER TABLE ONLY public.watched_contracts
+    ADD CONSTRAINT watched_contracts_contract_hash_key UNIQUE (contract_hash);
 
 
 --
--- Name: watched_logs id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: watched_contracts watched_contracts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.watched_logs ALTER COLUMN id SET DEFAULT nextval('public.watched_logs_id_seq'::regclass);
+ALTER TABLE ONLY public.watched_contracts
+    ADD CONSTRAINT watched_contracts_pkey PRIMARY KEY (contract_id);
 
 
 --
--- Name: current_ilk_state current_ilk_state_pkey; Type: CONSTRAINT; Schema: api; Owner: -
+-- Name: watched_logs watched_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY api.current_ilk_state
-    ADD CONSTRAINT current_ilk_state_pkey PRIMARY KEY (ilk_identifier);
+ALTER TABLE ONLY public.watched_logs
+    ADD CONSTRAINT watched_logs_pkey PRIMARY KEY (id);
 
 
 --
--- Name: managed_cdp managed_cdp_cdpi_key; Type: CONSTRAINT; Schema: api; Owner: -
+-- Name: auction_file_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY api.managed_cdp
-    ADD CONSTRAINT managed_cdp_cdpi_key UNIQUE (cdpi);
+CREATE INDEX auction_file_address_index ON maker.auction_file USING btree (address_id);
 
 
 --
--- Name: managed_cdp managed_cdp_pkey; Type: CONSTRAINT; Schema: api; Owner: -
+-- Name: auction_file_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY api.managed_cdp
-    ADD CONSTRAINT managed_cdp_pkey PRIMARY KEY (id);
+CREATE INDEX auction_file_header_index ON maker.auction_file USING btree (header_id);
 
 
 --
--- Name: bite bite_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: auth_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.bite
-    ADD CONSTRAINT bite_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX auth_address_index ON maker.auth USING btree (address_id);
 
 
 --
--- Name: bite bite_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: auth_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.bite
-    ADD CONSTRAINT bite_pkey PRIMARY KEY (id);
-
+CREATE INDEX auth_header_index ON maker.auth USING btree (header_id);
+
 
 --
--- Name: cat_file_chop_lump cat_file_chop_lump_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: auth_usr_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_chop_lump
-    ADD CONSTRAINT cat_file_chop_lump_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX auth_usr_index ON maker.auth USING btree (usr);
 
 
 --
--- Name: cat_file_chop_lump cat_file_chop_lump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: bite_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_chop_lump
-    ADD CONSTRAINT cat_file_chop_lump_pkey PRIMARY KEY (id);
+CREATE INDEX bite_header_index ON maker.bite USING btree (header_id);
 
 
 --
--- Name: cat_file_flip cat_file_flip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: bite_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_flip
-    ADD CONSTRAINT cat_file_flip_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX bite_urn_index ON maker.bite USING btree (urn_id);
 
 
 --
--- Name: cat_file_flip cat_file_flip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cage_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_flip
-    ADD CONSTRAINT cat_file_flip_pkey PRIMARY KEY (id);
+CREATE INDEX cage_address_index ON maker.cage USING btree (address_id);
 
 
 --
--- Name: cat_file_vow cat_file_vow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cage_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_vow
-    ADD CONSTRAINT cat_file_vow_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX cage_header_index ON maker.cage USING btree (header_id);
 
 
 --
--- Name: cat_file_vow cat_file_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cage_module_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_vow
-    ADD CONSTRAINT cat_file_vow_pkey PRIMARY KEY (id);
+CREATE INDEX cage_module_index ON maker.cage USING btree (module);
 
 
 --
--- Name: cat_ilk_chop cat_ilk_chop_block_number_block_hash_ilk_id_chop_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_file_chop_lump_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_chop
-    ADD CONSTRAINT cat_ilk_chop_block_number_block_hash_ilk_id_chop_key UNIQUE (block_number, block_hash, ilk_id, chop);
+CREATE INDEX cat_file_chop_lump_header_index ON maker.cat_file_chop_lump USING btree (header_id);
 
 
 --
--- Name: cat_ilk_chop cat_ilk_chop_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_file_chop_lump_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_chop
-    ADD CONSTRAINT cat_ilk_chop_pkey PRIMARY KEY (id);
+CREATE INDEX cat_file_chop_lump_ilk_index ON maker.cat_file_chop_lump USING btree (ilk_id);
 
 
 --
--- Name: cat_ilk_flip cat_ilk_flip_block_number_block_hash_ilk_id_flip_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_file_flip_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_flip
-    ADD CONSTRAINT cat_ilk_flip_block_number_block_hash_ilk_id_flip_key UNIQUE (block_number, block_hash, ilk_id, flip);
+CREATE INDEX cat_file_flip_header_index ON maker.cat_file_flip USING btree (header_id);
 
 
 --
--- Name: cat_ilk_flip cat_ilk_flip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_file_flip_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_flip
-    ADD CONSTRAINT cat_ilk_flip_pkey PRIMARY KEY (id);
+CREATE INDEX cat_file_flip_ilk_index ON maker.cat_file_flip USING btree (ilk_id);
 
 
 --
--- Name: cat_ilk_lump cat_ilk_lump_block_number_block_hash_ilk_id_lump_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_file_vow_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_lump
-    ADD CONSTRAINT cat_ilk_lump_block_number_block_hash_ilk_id_lump_key UNIQUE (block_number, block_hash, ilk_id, lump);
+CREATE INDEX cat_file_vow_header_index ON maker.cat_file_vow USING btree (header_id);
 
 
 --
--- Name: cat_ilk_lump cat_ilk_lump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_ilk_chop_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_lump
-    ADD CONSTRAINT cat_ilk_lump_pkey PRIMARY KEY (id);
+CREATE INDEX cat_ilk_chop_block_number_index ON maker.cat_ilk_chop USING btree (block_number);
 
 
 --
--- Name: cat_live cat_live_block_number_block_hash_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_ilk_chop_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_live
-    ADD CONSTRAINT cat_live_block_number_block_hash_live_key UNIQUE (block_number, block_hash, live);
+CREATE INDEX cat_ilk_chop_ilk_index ON maker.cat_ilk_chop USING btree (ilk_id);
 
 
 --
--- Name: cat_live cat_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_ilk_flip_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_live
-    ADD CONSTRAINT cat_live_pkey PRIMARY KEY (id);
+CREATE INDEX cat_ilk_flip_block_number_index ON maker.cat_ilk_flip USING btree (block_number);
 
 
 --
--- Name: cat_vat cat_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_ilk_flip_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_vat
-    ADD CONSTRAINT cat_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+CREATE INDEX cat_ilk_flip_ilk_index ON maker.cat_ilk_flip USING btree (ilk_id);
 
 
 --
--- Name: cat_vat cat_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_ilk_lump_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_vat
-    ADD CONSTRAINT cat_vat_pkey PRIMARY KEY (id);
+CREATE INDEX cat_ilk_lump_block_number_index ON maker.cat_ilk_lump USING btree (block_number);
 
 
 --
--- Name: cat_vow cat_vow_block_number_block_hash_vow_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cat_ilk_lump_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_vow
-    ADD CONSTRAINT cat_vow_block_number_block_hash_vow_key UNIQUE (block_number, block_hash, vow);
+CREATE INDEX cat_ilk_lump_ilk_index ON maker.cat_ilk_lump USING btree (ilk_id);
 
 
 --
--- Name: cat_vow cat_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_allow_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_vow
-    ADD CONSTRAINT cat_vow_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_cdp_allow_cdpi_index ON maker.cdp_manager_cdp_allow USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_cdpi cdp_manager_cdpi_block_number_block_hash_cdpi_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_allow_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_cdpi
-    ADD CONSTRAINT cdp_manager_cdpi_block_number_block_hash_cdpi_key UNIQUE (block_number, block_hash, cdpi);
+CREATE INDEX cdp_manager_cdp_allow_header_index ON maker.cdp_manager_cdp_allow USING btree (header_id);
 
 
 --
--- Name: cdp_manager_cdpi cdp_manager_cdpi_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_can_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_cdpi
-    ADD CONSTRAINT cdp_manager_cdpi_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_cdp_can_block_number_index ON maker.cdp_manager_cdp_can USING btree (block_number);
 
 
 --
--- Name: cdp_manager_count cdp_manager_count_block_number_block_hash_owner_count_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_can_owner_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_count
-    ADD CONSTRAINT cdp_manager_count_block_number_block_hash_owner_count_key UNIQUE (block_number, block_hash, owner, count);
+CREATE INDEX cdp_manager_cdp_can_owner_cdpi_index ON maker.cdp_manager_cdp_can USING btree (owner, cdpi);
 
 
 --
--- Name: cdp_manager_count cdp_manager_count_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_cdpi_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_count
-    ADD CONSTRAINT cdp_manager_count_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_cdpi_block_number_index ON maker.cdp_manager_cdpi USING btree (block_number);
 
 
 --
--- Name: cdp_manager_first cdp_manager_first_block_number_block_hash_owner_first_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_cdpi_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_first
-    ADD CONSTRAINT cdp_manager_first_block_number_block_hash_owner_first_key UNIQUE (block_number, block_hash, owner, first);
+CREATE INDEX cdp_manager_cdpi_cdpi_index ON maker.cdp_manager_cdpi USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_first cdp_manager_first_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_enter_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_first
-    ADD CONSTRAINT cdp_manager_first_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_enter_cdpi_index ON maker.cdp_manager_enter USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_ilks cdp_manager_ilks_block_number_block_hash_cdpi_ilk_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_enter_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_ilks
-    ADD CONSTRAINT cdp_manager_ilks_block_number_block_hash_cdpi_ilk_id_key UNIQUE (block_number, block_hash, cdpi, ilk_id);
+CREATE INDEX cdp_manager_enter_header_index ON maker.cdp_manager_enter USING btree (header_id);
 
 
 --
--- Name: cdp_manager_ilks cdp_manager_ilks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_flux_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_ilks
-    ADD CONSTRAINT cdp_manager_ilks_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_flux_cdpi_index ON maker.cdp_manager_flux USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_last cdp_manager_last_block_number_block_hash_owner_last_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_flux_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_last
-    ADD CONSTRAINT cdp_manager_last_block_number_block_hash_owner_last_key UNIQUE (block_number, block_hash, owner, last);
+CREATE INDEX cdp_manager_flux_header_index ON maker.cdp_manager_flux USING btree (header_id);
 
 
 --
--- Name: cdp_manager_last cdp_manager_last_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_frob_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_last
-    ADD CONSTRAINT cdp_manager_last_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_frob_cdpi_index ON maker.cdp_manager_frob USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_list_next cdp_manager_list_next_block_number_block_hash_cdpi_next_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_frob_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_list_next
-    ADD CONSTRAINT cdp_manager_list_next_block_number_block_hash_cdpi_next_key UNIQUE (block_number, block_hash, cdpi, next);
+CREATE INDEX cdp_manager_frob_header_index ON maker.cdp_manager_frob USING btree (header_id);
 
 
 --
--- Name: cdp_manager_list_next cdp_manager_list_next_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_give_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_list_next
-    ADD CONSTRAINT cdp_manager_list_next_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_give_cdpi_index ON maker.cdp_manager_give USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_list_prev cdp_manager_list_prev_block_number_block_hash_cdpi_prev_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_give_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_list_prev
-    ADD CONSTRAINT cdp_manager_list_prev_block_number_block_hash_cdpi_prev_key UNIQUE (block_number, block_hash, cdpi, prev);
+CREATE INDEX cdp_manager_give_header_index ON maker.cdp_manager_give USING btree (header_id);
 
 
 --
--- Name: cdp_manager_list_prev cdp_manager_list_prev_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_ilks_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_list_prev
-    ADD CONSTRAINT cdp_manager_list_prev_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_ilks_cdpi_index ON maker.cdp_manager_ilks USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_owns cdp_manager_owns_block_number_block_hash_cdpi_owner_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_ilks_ilk_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_owns
-    ADD CONSTRAINT cdp_manager_owns_block_number_block_hash_cdpi_owner_key UNIQUE (block_number, block_hash, cdpi, owner);
+CREATE INDEX cdp_manager_ilks_ilk_id_index ON maker.cdp_manager_ilks USING btree (ilk_id);
 
 
 --
--- Name: cdp_manager_owns cdp_manager_owns_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_move_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_owns
-    ADD CONSTRAINT cdp_manager_owns_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_move_cdpi_index ON maker.cdp_manager_move USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_urns cdp_manager_urns_block_number_block_hash_cdpi_urn_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_move_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_urns
-    ADD CONSTRAINT cdp_manager_urns_block_number_block_hash_cdpi_urn_key UNIQUE (block_number, block_hash, cdpi, urn);
+CREATE INDEX cdp_manager_move_header_index ON maker.cdp_manager_move USING btree (header_id);
 
 
 --
--- Name: cdp_manager_urns cdp_manager_urns_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_owns_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_urns
-    ADD CONSTRAINT cdp_manager_urns_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_owns_block_number_index ON maker.cdp_manager_owns USING btree (block_number);
 
 
 --
--- Name: cdp_manager_vat cdp_manager_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_owns_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_vat
-    ADD CONSTRAINT cdp_manager_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+CREATE INDEX cdp_manager_owns_cdpi_index ON maker.cdp_manager_owns USING btree (cdpi);
 
 
 --
--- Name: cdp_manager_vat cdp_manager_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_owns_owner_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_vat
-    ADD CONSTRAINT cdp_manager_vat_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_owns_owner_index ON maker.cdp_manager_owns USING btree (owner);
 
 
 --
--- Name: deal deal_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_quit_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.deal
-    ADD CONSTRAINT deal_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX cdp_manager_quit_cdpi_index ON maker.cdp_manager_quit USING btree (cdpi);
 
 
 --
--- Name: deal deal_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_quit_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.deal
-    ADD CONSTRAINT deal_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_quit_header_index ON maker.cdp_manager_quit USING btree (header_id);
 
 
 --
--- Name: dent dent_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_shift_cdp_dst_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.dent
-    ADD CONSTRAINT dent_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX cdp_manager_shift_cdp_dst_index ON maker.cdp_manager_shift USING btree (cdp_dst);
 
 
 --
--- Name: dent dent_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_shift_cdp_src_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.dent
-    ADD CONSTRAINT dent_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_shift_cdp_src_index ON maker.cdp_manager_shift USING btree (cdp_src);
 
 
 --
--- Name: flap_beg flap_beg_block_number_block_hash_address_id_beg_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_shift_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_beg
-    ADD CONSTRAINT flap_beg_block_number_block_hash_address_id_beg_key UNIQUE (block_number, block_hash, address_id, beg);
+CREATE INDEX cdp_manager_shift_header_index ON maker.cdp_manager_shift USING btree (header_id);
 
 
 --
--- Name: flap_beg flap_beg_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_allow_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_beg
-    ADD CONSTRAINT flap_beg_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_urn_allow_header_index ON maker.cdp_manager_urn_allow USING btree (header_id);
 
 
 --
--- Name: flap_bid_bid flap_bid_bid_block_number_block_hash_address_id_bid_id_bid_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_allow_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_bid
-    ADD CONSTRAINT flap_bid_bid_block_number_block_hash_address_id_bid_id_bid_key UNIQUE (block_number, block_hash, address_id, bid_id, bid);
+CREATE INDEX cdp_manager_urn_allow_msg_sender_index ON maker.cdp_manager_urn_allow USING btree (msg_sender);
 
 
 --
--- Name: flap_bid_bid flap_bid_bid_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_can_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_bid
-    ADD CONSTRAINT flap_bid_bid_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_urn_can_block_number_index ON maker.cdp_manager_urn_can USING btree (block_number);
 
 
 --
--- Name: flap_bid_end flap_bid_end_block_number_block_hash_address_id_bid_id_end_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_can_owner_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_end
-    ADD CONSTRAINT flap_bid_end_block_number_block_hash_address_id_bid_id_end_key UNIQUE (block_number, block_hash, address_id, bid_id, "end");
+CREATE INDEX cdp_manager_urn_can_owner_index ON maker.cdp_manager_urn_can USING btree (owner);
 
 
 --
--- Name: flap_bid_end flap_bid_end_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_urns_cdpi_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_end
-    ADD CONSTRAINT flap_bid_end_pkey PRIMARY KEY (id);
+CREATE INDEX cdp_manager_urns_cdpi_index ON maker.cdp_manager_urns USING btree (cdpi);
 
 
 --
--- Name: flap_bid_guy flap_bid_guy_block_number_block_hash_address_id_bid_id_guy_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: cdp_manager_urns_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_guy
-    ADD CONSTRAINT flap_bid_guy_block_number_block_hash_address_id_bid_id_guy_key UNIQUE (block_number, block_hash, address_id, bid_id, guy);
+CREATE INDEX cdp_manager_urns_urn_index ON maker.cdp_manager_urns USING btree (urn);
 
 
 --
--- Name: flap_bid_guy flap_bid_guy_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_approvals_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_guy
-    ADD CONSTRAINT flap_bid_guy_pkey PRIMARY KEY (id);
+CREATE INDEX chief_approvals_block_number_index ON maker.chief_approvals USING btree (block_number);
 
 
 --
--- Name: flap_bid_lot flap_bid_lot_block_number_block_hash_address_id_bid_id_lot_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_deposits_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_lot
-    ADD CONSTRAINT flap_bid_lot_block_number_block_hash_address_id_bid_id_lot_key UNIQUE (block_number, block_hash, address_id, bid_id, lot);
+CREATE INDEX chief_deposits_block_number_index ON maker.chief_deposits USING btree (block_number);
 
 
 --
--- Name: flap_bid_lot flap_bid_lot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_etch_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_lot
-    ADD CONSTRAINT flap_bid_lot_pkey PRIMARY KEY (id);
+CREATE INDEX chief_etch_header_index ON maker.chief_etch USING btree (header_id);
 
 
 --
--- Name: flap_bid_tic flap_bid_tic_block_number_block_hash_address_id_bid_id_tic_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_etch_slate_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_tic
-    ADD CONSTRAINT flap_bid_tic_block_number_block_hash_address_id_bid_id_tic_key UNIQUE (block_number, block_hash, address_id, bid_id, tic);
+CREATE INDEX chief_etch_slate_index ON maker.chief_etch USING btree (slate);
 
 
 --
--- Name: flap_bid_tic flap_bid_tic_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_free_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_tic
-    ADD CONSTRAINT flap_bid_tic_pkey PRIMARY KEY (id);
+CREATE INDEX chief_free_header_index ON maker.chief_free USING btree (header_id);
 
 
 --
--- Name: flap flap_block_number_bid_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_free_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap
-    ADD CONSTRAINT flap_block_number_bid_id_key UNIQUE (block_number, bid_id);
+CREATE INDEX chief_free_msg_sender_index ON maker.chief_free USING btree (msg_sender);
 
 
 --
--- Name: flap_gem flap_gem_block_number_block_hash_address_id_gem_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_hat_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_gem
-    ADD CONSTRAINT flap_gem_block_number_block_hash_address_id_gem_key UNIQUE (block_number, block_hash, address_id, gem);
+CREATE INDEX chief_hat_block_number_index ON maker.chief_hat USING btree (block_number);
 
 
 --
--- Name: flap_gem flap_gem_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_lift_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_gem
-    ADD CONSTRAINT flap_gem_pkey PRIMARY KEY (id);
+CREATE INDEX chief_lift_header_index ON maker.chief_lift USING btree (header_id);
 
 
 --
--- Name: flap_kick flap_kick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_lift_whom_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kick
-    ADD CONSTRAINT flap_kick_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX chief_lift_whom_index ON maker.chief_lift USING btree (whom);
 
 
 --
--- Name: flap_kick flap_kick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_lock_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kick
-    ADD CONSTRAINT flap_kick_pkey PRIMARY KEY (id);
+CREATE INDEX chief_lock_header_index ON maker.chief_lock USING btree (header_id);
 
 
 --
--- Name: flap_kicks flap_kicks_block_number_block_hash_address_id_kicks_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_lock_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kicks
-    ADD CONSTRAINT flap_kicks_block_number_block_hash_address_id_kicks_key UNIQUE (block_number, block_hash, address_id, kicks);
+CREATE INDEX chief_lock_msg_sender_index ON maker.chief_lock USING btree (msg_sender);
 
 
 --
--- Name: flap_kicks flap_kicks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_vote_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kicks
-    ADD CONSTRAINT flap_kicks_pkey PRIMARY KEY (id);
+CREATE INDEX chief_vote_header_index ON maker.chief_vote USING btree (header_id);
 
 
 --
--- Name: flap_live flap_live_block_number_block_hash_address_id_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_vote_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_live
-    ADD CONSTRAINT flap_live_block_number_block_hash_address_id_live_key UNIQUE (block_number, block_hash, address_id, live);
+CREATE INDEX chief_vote_msg_sender_index ON maker.chief_vote USING btree (msg_sender);
 
 
 --
--- Name: flap_live flap_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_vote_slate_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_live
-    ADD CONSTRAINT flap_live_pkey PRIMARY KEY (id);
+CREATE INDEX chief_vote_slate_index ON maker.chief_vote USING btree (slate);
 
 
 --
--- Name: flap flap_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_vote_yays_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap
-    ADD CONSTRAINT flap_pkey PRIMARY KEY (id);
+CREATE INDEX chief_vote_yays_header_index ON maker.chief_vote_yays USING btree (header_id);
 
 
 --
--- Name: flap_tau flap_tau_block_number_block_hash_address_id_tau_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_vote_yays_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_tau
-    ADD CONSTRAINT flap_tau_block_number_block_hash_address_id_tau_key UNIQUE (block_number, block_hash, address_id, tau);
+CREATE INDEX chief_vote_yays_msg_sender_index ON maker.chief_vote_yays USING btree (msg_sender);
 
 
 --
--- Name: flap_tau flap_tau_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_vote_yays_slate_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_tau
-    ADD CONSTRAINT flap_tau_pkey PRIMARY KEY (id);
+CREATE INDEX chief_vote_yays_slate_index ON maker.chief_vote_yays USING btree (slate);
 
 
 --
--- Name: flap_ttl flap_ttl_block_number_block_hash_address_id_ttl_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: chief_votes_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_ttl
-    ADD CONSTRAINT flap_ttl_block_number_block_hash_address_id_ttl_key UNIQUE (block_number, block_hash, address_id, ttl);
+CREATE INDEX chief_votes_block_number_index ON maker.chief_votes USING btree (block_number);
 
 
 --
--- Name: flap_ttl flap_ttl_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_allowance_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_ttl
-    ADD CONSTRAINT flap_ttl_pkey PRIMARY KEY (id);
+CREATE INDEX dai_allowance_block_number_index ON maker.dai_allowance USING btree (block_number);
 
 
 --
--- Name: flap_vat flap_vat_block_number_block_hash_address_id_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_approval_guy_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_vat
-    ADD CONSTRAINT flap_vat_block_number_block_hash_address_id_vat_key UNIQUE (block_number, block_hash, address_id, vat);
+CREATE INDEX dai_approval_guy_index ON maker.dai_approval USING btree (guy);
 
 
 --
--- Name: flap_vat flap_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_approval_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_vat
-    ADD CONSTRAINT flap_vat_pkey PRIMARY KEY (id);
+CREATE INDEX dai_approval_header_index ON maker.dai_approval USING btree (header_id);
 
 
 --
--- Name: flip_beg flip_beg_block_number_block_hash_address_id_beg_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_approval_src_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_beg
-    ADD CONSTRAINT flip_beg_block_number_block_hash_address_id_beg_key UNIQUE (block_number, block_hash, address_id, beg);
+CREATE INDEX dai_approval_src_index ON maker.dai_approval USING btree (src);
 
 
 --
--- Name: flip_beg flip_beg_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_balance_of_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_beg
-    ADD CONSTRAINT flip_beg_pkey PRIMARY KEY (id);
+CREATE INDEX dai_balance_of_block_number_index ON maker.dai_balance_of USING btree (block_number);
 
 
 --
--- Name: flip_bid_bid flip_bid_bid_block_number_block_hash_bid_id_address_id_bid_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_exit_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_bid
-    ADD CONSTRAINT flip_bid_bid_block_number_block_hash_bid_id_address_id_bid_key UNIQUE (block_number, block_hash, bid_id, address_id, bid);
+CREATE INDEX dai_exit_address_index ON maker.dai_exit USING btree (address_id);
 
 
 --
--- Name: flip_bid_bid flip_bid_bid_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_exit_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_bid
-    ADD CONSTRAINT flip_bid_bid_pkey PRIMARY KEY (id);
+CREATE INDEX dai_exit_header_index ON maker.dai_exit USING btree (header_id);
 
 
 --
--- Name: flip_bid_end flip_bid_end_block_number_block_hash_bid_id_address_id_end_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_exit_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_end
-    ADD CONSTRAINT flip_bid_end_block_number_block_hash_bid_id_address_id_end_key UNIQUE (block_number, block_hash, bid_id, address_id, "end");
+CREATE INDEX dai_exit_msg_sender_index ON maker.dai_exit USING btree (msg_sender);
 
 
 --
--- Name: flip_bid_end flip_bid_end_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_join_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_end
-    ADD CONSTRAINT flip_bid_end_pkey PRIMARY KEY (id);
+CREATE INDEX dai_join_address_index ON maker.dai_join USING btree (address_id);
 
 
 --
--- Name: flip_bid_gal flip_bid_gal_block_number_block_hash_bid_id_address_id_gal_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_join_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_gal
-    ADD CONSTRAINT flip_bid_gal_block_number_block_hash_bid_id_address_id_gal_key UNIQUE (block_number, block_hash, bid_id, address_id, gal);
+CREATE INDEX dai_join_header_index ON maker.dai_join USING btree (header_id);
 
 
 --
--- Name: flip_bid_gal flip_bid_gal_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_join_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_gal
-    ADD CONSTRAINT flip_bid_gal_pkey PRIMARY KEY (id);
+CREATE INDEX dai_join_msg_sender_index ON maker.dai_join USING btree (msg_sender);
 
 
 --
--- Name: flip_bid_guy flip_bid_guy_block_number_block_hash_bid_id_address_id_guy_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_nonces_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_guy
-    ADD CONSTRAINT flip_bid_guy_block_number_block_hash_bid_id_address_id_guy_key UNIQUE (block_number, block_hash, bid_id, address_id, guy);
+CREATE INDEX dai_nonces_block_number_index ON maker.dai_nonces USING btree (block_number);
 
 
 --
--- Name: flip_bid_guy flip_bid_guy_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_total_supply_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_guy
-    ADD CONSTRAINT flip_bid_guy_pkey PRIMARY KEY (id);
+CREATE INDEX dai_total_supply_block_number_index ON maker.dai_total_supply USING btree (block_number);
 
 
 --
--- Name: flip_bid_lot flip_bid_lot_block_number_block_hash_bid_id_address_id_lot_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_transfer_dst_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_lot
-    ADD CONSTRAINT flip_bid_lot_block_number_block_hash_bid_id_address_id_lot_key UNIQUE (block_number, block_hash, bid_id, address_id, lot);
+CREATE INDEX dai_transfer_dst_index ON maker.dai_transfer USING btree (dst);
 
 
 --
--- Name: flip_bid_lot flip_bid_lot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_transfer_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_lot
-    ADD CONSTRAINT flip_bid_lot_pkey PRIMARY KEY (id);
+CREATE INDEX dai_transfer_header_index ON maker.dai_transfer USING btree (header_id);
 
 
 --
--- Name: flip_bid_tab flip_bid_tab_block_number_block_hash_bid_id_address_id_tab_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dai_transfer_src_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tab
-    ADD CONSTRAINT flip_bid_tab_block_number_block_hash_bid_id_address_id_tab_key UNIQUE (block_number, block_hash, bid_id, address_id, tab);
+CREATE INDEX dai_transfer_src_index ON maker.dai_transfer USING btree (src);
 
 
 --
--- Name: flip_bid_tab flip_bid_tab_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: deal_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tab
-    ADD CONSTRAINT flip_bid_tab_pkey PRIMARY KEY (id);
+CREATE INDEX deal_address_id_index ON maker.deal USING btree (address_id);
 
 
 --
--- Name: flip_bid_tic flip_bid_tic_block_number_block_hash_bid_id_address_id_tic_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: deal_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tic
-    ADD CONSTRAINT flip_bid_tic_block_number_block_hash_bid_id_address_id_tic_key UNIQUE (block_number, block_hash, bid_id, address_id, tic);
+CREATE INDEX deal_bid_id_index ON maker.deal USING btree (bid_id);
 
 
 --
--- Name: flip_bid_tic flip_bid_tic_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: deal_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tic
-    ADD CONSTRAINT flip_bid_tic_pkey PRIMARY KEY (id);
+CREATE INDEX deal_header_index ON maker.deal USING btree (header_id);
 
 
 --
--- Name: flip_bid_usr flip_bid_usr_block_number_block_hash_bid_id_address_id_usr_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: dent_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_usr
-    ADD CONSTRAINT flip_bid_usr_block_number_block_hash_bid_id_address_id_usr_key UNIQUE (block_number, block_hash, bid_id, address_id, usr);
+CREATE INDEX dent_header_index ON maker.dent USING btree (header_id);
 
 
 --
--- Name: flip_bid_usr flip_bid_usr_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_bag_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_usr
-    ADD CONSTRAINT flip_bid_usr_pkey PRIMARY KEY (id);
+CREATE INDEX end_bag_block_number_index ON maker.end_bag USING btree (block_number);
 
 
 --
--- Name: flip flip_block_number_bid_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_cage_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip
-    ADD CONSTRAINT flip_block_number_bid_id_key UNIQUE (block_number, bid_id);
+CREATE INDEX end_cage_header_index ON maker.end_cage USING btree (header_id);
 
 
 --
--- Name: flip_ilk flip_ilk_block_number_block_hash_address_id_ilk_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_cage_ilk_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ilk
-    ADD CONSTRAINT flip_ilk_block_number_block_hash_address_id_ilk_id_key UNIQUE (block_number, block_hash, address_id, ilk_id);
+CREATE INDEX end_cage_ilk_header_index ON maker.end_cage_ilk USING btree (header_id);
 
 
 --
--- Name: flip_ilk flip_ilk_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_cage_ilk_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ilk
-    ADD CONSTRAINT flip_ilk_pkey PRIMARY KEY (id);
+CREATE INDEX end_cage_ilk_ilk_index ON maker.end_cage_ilk USING btree (ilk_id);
 
 
 --
--- Name: flip_kick flip_kick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_cash_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kick
-    ADD CONSTRAINT flip_kick_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX end_cash_header_index ON maker.end_cash USING btree (header_id);
 
 
 --
--- Name: flip_kick flip_kick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_cash_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kick
-    ADD CONSTRAINT flip_kick_pkey PRIMARY KEY (id);
+CREATE INDEX end_cash_ilk_index ON maker.end_cash USING btree (ilk_id);
 
 
 --
--- Name: flip_kicks flip_kicks_block_number_block_hash_address_id_kicks_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_debt_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kicks
-    ADD CONSTRAINT flip_kicks_block_number_block_hash_address_id_kicks_key UNIQUE (block_number, block_hash, address_id, kicks);
+CREATE INDEX end_debt_block_number_index ON maker.end_debt USING btree (block_number);
 
 
 --
--- Name: flip_kicks flip_kicks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_flow_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kicks
-    ADD CONSTRAINT flip_kicks_pkey PRIMARY KEY (id);
+CREATE INDEX end_flow_header_index ON maker.end_flow USING btree (header_id);
 
 
 --
--- Name: flip flip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_flow_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip
-    ADD CONSTRAINT flip_pkey PRIMARY KEY (id);
+CREATE INDEX end_flow_ilk_index ON maker.end_flow USING btree (ilk_id);
 
 
 --
--- Name: flip_tau flip_tau_block_number_block_hash_address_id_tau_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_free_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_tau
-    ADD CONSTRAINT flip_tau_block_number_block_hash_address_id_tau_key UNIQUE (block_number, block_hash, address_id, tau);
+CREATE INDEX end_free_header_index ON maker.end_free USING btree (header_id);
 
 
 --
--- Name: flip_tau flip_tau_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_free_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_tau
-    ADD CONSTRAINT flip_tau_pkey PRIMARY KEY (id);
+CREATE INDEX end_free_urn_index ON maker.end_free USING btree (urn_id);
 
 
 --
--- Name: flip_ttl flip_ttl_block_number_block_hash_address_id_ttl_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_art_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ttl
-    ADD CONSTRAINT flip_ttl_block_number_block_hash_address_id_ttl_key UNIQUE (block_number, block_hash, address_id, ttl);
+CREATE INDEX end_ilk_art_block_number_index ON maker.end_ilk_art USING btree (block_number);
 
 
 --
--- Name: flip_ttl flip_ttl_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_art_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ttl
-    ADD CONSTRAINT flip_ttl_pkey PRIMARY KEY (id);
+CREATE INDEX end_ilk_art_ilk_index ON maker.end_ilk_art USING btree (ilk_id);
 
 
 --
--- Name: flip_vat flip_vat_block_number_block_hash_address_id_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_fix_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_vat
-    ADD CONSTRAINT flip_vat_block_number_block_hash_address_id_vat_key UNIQUE (block_number, block_hash, address_id, vat);
+CREATE INDEX end_ilk_fix_block_number_index ON maker.end_ilk_fix USING btree (block_number);
 
 
 --
--- Name: flip_vat flip_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_fix_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_vat
-    ADD CONSTRAINT flip_vat_pkey PRIMARY KEY (id);
+CREATE INDEX end_ilk_fix_ilk_index ON maker.end_ilk_fix USING btree (ilk_id);
 
 
 --
--- Name: flop_beg flop_beg_block_number_block_hash_address_id_beg_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_gap_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_beg
-    ADD CONSTRAINT flop_beg_block_number_block_hash_address_id_beg_key UNIQUE (block_number, block_hash, address_id, beg);
+CREATE INDEX end_ilk_gap_block_number_index ON maker.end_ilk_gap USING btree (block_number);
 
 
 --
--- Name: flop_beg flop_beg_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_gap_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_beg
-    ADD CONSTRAINT flop_beg_pkey PRIMARY KEY (id);
+CREATE INDEX end_ilk_gap_ilk_index ON maker.end_ilk_gap USING btree (ilk_id);
 
 
 --
--- Name: flop_bid_bid flop_bid_bid_block_number_block_hash_bid_id_address_id_bid_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_tag_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_bid
-    ADD CONSTRAINT flop_bid_bid_block_number_block_hash_bid_id_address_id_bid_key UNIQUE (block_number, block_hash, bid_id, address_id, bid);
+CREATE INDEX end_ilk_tag_block_number_index ON maker.end_ilk_tag USING btree (block_number);
 
 
 --
--- Name: flop_bid_bid flop_bid_bid_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_ilk_tag_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_bid
-    ADD CONSTRAINT flop_bid_bid_pkey PRIMARY KEY (id);
+CREATE INDEX end_ilk_tag_ilk_index ON maker.end_ilk_tag USING btree (ilk_id);
 
 
 --
--- Name: flop_bid_end flop_bid_end_block_number_block_hash_bid_id_address_id_end_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_live_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_end
-    ADD CONSTRAINT flop_bid_end_block_number_block_hash_bid_id_address_id_end_key UNIQUE (block_number, block_hash, bid_id, address_id, "end");
+CREATE INDEX end_live_block_number_index ON maker.end_live USING btree (block_number);
 
 
 --
--- Name: flop_bid_end flop_bid_end_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_out_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_end
-    ADD CONSTRAINT flop_bid_end_pkey PRIMARY KEY (id);
+CREATE INDEX end_out_block_number_index ON maker.end_out USING btree (block_number);
 
 
 --
--- Name: flop_bid_guy flop_bid_guy_block_number_block_hash_bid_id_address_id_guy_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_out_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_guy
-    ADD CONSTRAINT flop_bid_guy_block_number_block_hash_bid_id_address_id_guy_key UNIQUE (block_number, block_hash, bid_id, address_id, guy);
+CREATE INDEX end_out_ilk_index ON maker.end_out USING btree (ilk_id);
 
 
 --
--- Name: flop_bid_guy flop_bid_guy_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_pack_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_guy
-    ADD CONSTRAINT flop_bid_guy_pkey PRIMARY KEY (id);
+CREATE INDEX end_pack_header_index ON maker.end_pack USING btree (header_id);
 
 
 --
--- Name: flop_bid_lot flop_bid_lot_block_number_block_hash_bid_id_address_id_lot_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_skim_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_lot
-    ADD CONSTRAINT flop_bid_lot_block_number_block_hash_bid_id_address_id_lot_key UNIQUE (block_number, block_hash, bid_id, address_id, lot);
+CREATE INDEX end_skim_header_index ON maker.end_skim USING btree (header_id);
 
 
 --
--- Name: flop_bid_lot flop_bid_lot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_skim_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_lot
-    ADD CONSTRAINT flop_bid_lot_pkey PRIMARY KEY (id);
+CREATE INDEX end_skim_urn_index ON maker.end_skim USING btree (urn_id);
 
 
 --
--- Name: flop_bid_tic flop_bid_tic_block_number_block_hash_bid_id_address_id_tic_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_thaw_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_tic
-    ADD CONSTRAINT flop_bid_tic_block_number_block_hash_bid_id_address_id_tic_key UNIQUE (block_number, block_hash, bid_id, address_id, tic);
+CREATE INDEX end_thaw_header_index ON maker.end_thaw USING btree (header_id);
 
 
 --
--- Name: flop_bid_tic flop_bid_tic_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_wait_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_tic
-    ADD CONSTRAINT flop_bid_tic_pkey PRIMARY KEY (id);
+CREATE INDEX end_wait_block_number_index ON maker.end_wait USING btree (block_number);
 
 
 --
--- Name: flop flop_block_number_bid_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: end_when_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop
-    ADD CONSTRAINT flop_block_number_bid_id_key UNIQUE (block_number, bid_id);
+CREATE INDEX end_when_block_number_index ON maker.end_when USING btree (block_number);
 
 
 --
--- Name: flop_gem flop_gem_block_number_block_hash_address_id_gem_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_fire_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_gem
-    ADD CONSTRAINT flop_gem_block_number_block_hash_address_id_gem_key UNIQUE (block_number, block_hash, address_id, gem);
+CREATE INDEX esm_fire_header_index ON maker.esm_fire USING btree (header_id);
 
 
 --
--- Name: flop_gem flop_gem_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_fire_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_gem
-    ADD CONSTRAINT flop_gem_pkey PRIMARY KEY (id);
+CREATE INDEX esm_fire_msg_sender_index ON maker.esm_fire USING btree (msg_sender);
 
 
 --
--- Name: flop_kick flop_kick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_fired_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kick
-    ADD CONSTRAINT flop_kick_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX esm_fired_block_number_index ON maker.esm_fired USING btree (block_number);
 
 
 --
--- Name: flop_kick flop_kick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_join_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kick
-    ADD CONSTRAINT flop_kick_pkey PRIMARY KEY (id);
+CREATE INDEX esm_join_header_index ON maker.esm_join USING btree (header_id);
 
 
 --
--- Name: flop_kicks flop_kicks_block_number_block_hash_address_id_kicks_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_join_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kicks
-    ADD CONSTRAINT flop_kicks_block_number_block_hash_address_id_kicks_key UNIQUE (block_number, block_hash, address_id, kicks);
+CREATE INDEX esm_join_msg_sender_index ON maker.esm_join USING btree (msg_sender);
 
 
 --
--- Name: flop_kicks flop_kicks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_min_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kicks
-    ADD CONSTRAINT flop_kicks_pkey PRIMARY KEY (id);
+CREATE INDEX esm_min_block_number_index ON maker.esm_min USING btree (block_number);
 
 
 --
--- Name: flop_live flop_live_block_number_block_hash_address_id_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_sum_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_live
-    ADD CONSTRAINT flop_live_block_number_block_hash_address_id_live_key UNIQUE (block_number, block_hash, address_id, live);
+CREATE INDEX esm_sum_block_number_index ON maker.esm_sum USING btree (block_number);
 
 
 --
--- Name: flop_live flop_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: esm_user_sum_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_live
-    ADD CONSTRAINT flop_live_pkey PRIMARY KEY (id);
+CREATE INDEX esm_user_sum_block_number_index ON maker.esm_user_sum USING btree (block_number);
 
 
 --
--- Name: flop_pad flop_pad_block_number_block_hash_address_id_pad_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_pad
-    ADD CONSTRAINT flop_pad_block_number_block_hash_address_id_pad_key UNIQUE (block_number, block_hash, address_id, pad);
+CREATE INDEX flap_bid_bid_address_id_index ON maker.flap_bid_bid USING btree (address_id);
 
 
 --
--- Name: flop_pad flop_pad_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_bid_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_pad
-    ADD CONSTRAINT flop_pad_pkey PRIMARY KEY (id);
+CREATE INDEX flap_bid_bid_bid_id_index ON maker.flap_bid_bid USING btree (bid_id);
 
 
 --
--- Name: flop flop_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_bid_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop
-    ADD CONSTRAINT flop_pkey PRIMARY KEY (id);
+CREATE INDEX flap_bid_bid_block_number_index ON maker.flap_bid_bid USING btree (block_number);
 
 
 --
--- Name: flop_tau flop_tau_block_number_block_hash_address_id_tau_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_end_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_tau
-    ADD CONSTRAINT flop_tau_block_number_block_hash_address_id_tau_key UNIQUE (block_number, block_hash, address_id, tau);
+CREATE INDEX flap_bid_end_bid_address_id_index ON maker.flap_bid_end USING btree (address_id);
 
 
 --
--- Name: flop_tau flop_tau_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_end_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_tau
-    ADD CONSTRAINT flop_tau_pkey PRIMARY KEY (id);
+CREATE INDEX flap_bid_end_bid_id_index ON maker.flap_bid_end USING btree (bid_id);
 
 
 --
--- Name: flop_ttl flop_ttl_block_number_block_hash_address_id_ttl_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_end_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_ttl
-    ADD CONSTRAINT flop_ttl_block_number_block_hash_address_id_ttl_key UNIQUE (block_number, block_hash, address_id, ttl);
+CREATE INDEX flap_bid_end_block_number_index ON maker.flap_bid_end USING btree (block_number);
 
 
 --
--- Name: flop_ttl flop_ttl_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_guy_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_ttl
-    ADD CONSTRAINT flop_ttl_pkey PRIMARY KEY (id);
+CREATE INDEX flap_bid_guy_bid_address_id_index ON maker.flap_bid_guy USING btree (address_id);
 
 
 --
--- Name: flop_vat flop_vat_block_number_block_hash_address_id_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_guy_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_vat
-    ADD CONSTRAINT flop_vat_block_number_block_hash_address_id_vat_key UNIQUE (block_number, block_hash, address_id, vat);
+CREATE INDEX flap_bid_guy_bid_id_index ON maker.flap_bid_guy USING btree (bid_id);
 
 
 --
--- Name: flop_vat flop_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_guy_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_vat
-    ADD CONSTRAINT flop_vat_pkey PRIMARY KEY (id);
+CREATE INDEX flap_bid_guy_block_number_index ON maker.flap_bid_guy USING btree (block_number);
 
 
 --
--- Name: ilks ilks_identifier_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_lot_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.ilks
-    ADD CONSTRAINT ilks_identifier_key UNIQUE (identifier);
+CREATE INDEX flap_bid_lot_bid_address_id_index ON maker.flap_bid_lot USING btree (address_id);
 
 
 --
--- Name: ilks ilks_ilk_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_lot_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.ilks
-    ADD CONSTRAINT ilks_ilk_key UNIQUE (ilk);
+CREATE INDEX flap_bid_lot_bid_id_index ON maker.flap_bid_lot USING btree (bid_id);
 
 
 --
--- Name: ilks ilks_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_lot_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.ilks
-    ADD CONSTRAINT ilks_pkey PRIMARY KEY (id);
+CREATE INDEX flap_bid_lot_block_number_index ON maker.flap_bid_lot USING btree (block_number);
 
 
 --
--- Name: jug_base jug_base_block_number_block_hash_base_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_tic_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_base
-    ADD CONSTRAINT jug_base_block_number_block_hash_base_key UNIQUE (block_number, block_hash, base);
+CREATE INDEX flap_bid_tic_bid_address_id_index ON maker.flap_bid_tic USING btree (address_id);
 
 
 --
--- Name: jug_base jug_base_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_tic_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_base
-    ADD CONSTRAINT jug_base_pkey PRIMARY KEY (id);
+CREATE INDEX flap_bid_tic_bid_id_index ON maker.flap_bid_tic USING btree (bid_id);
 
 
 --
--- Name: jug_drip jug_drip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_bid_tic_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_drip
-    ADD CONSTRAINT jug_drip_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flap_bid_tic_block_number_index ON maker.flap_bid_tic USING btree (block_number);
 
 
 --
--- Name: jug_drip jug_drip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_kick_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_drip
-    ADD CONSTRAINT jug_drip_pkey PRIMARY KEY (id);
+CREATE INDEX flap_kick_header_index ON maker.flap_kick USING btree (header_id);
 
 
 --
--- Name: jug_file_base jug_file_base_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_kicks_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_base
-    ADD CONSTRAINT jug_file_base_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flap_kicks_address_id_index ON maker.flap_kicks USING btree (address_id);
 
 
 --
--- Name: jug_file_base jug_file_base_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_kicks_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_base
-    ADD CONSTRAINT jug_file_base_pkey PRIMARY KEY (id);
+CREATE INDEX flap_kicks_block_number_index ON maker.flap_kicks USING btree (block_number);
 
 
 --
--- Name: jug_file_ilk jug_file_ilk_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flap_kicks_kicks_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_ilk
-    ADD CONSTRAINT jug_file_ilk_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flap_kicks_kicks_index ON maker.flap_kicks USING btree (kicks);
 
 
 --
--- Name: jug_file_ilk jug_file_ilk_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_ilk
-    ADD CONSTRAINT jug_file_ilk_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_bid_address_id_index ON maker.flip_bid_bid USING btree (address_id);
 
 
 --
--- Name: jug_file_vow jug_file_vow_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_bid_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_vow
-    ADD CONSTRAINT jug_file_vow_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_bid_bid_bid_id_index ON maker.flip_bid_bid USING btree (bid_id);
 
 
 --
--- Name: jug_file_vow jug_file_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_bid_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_vow
-    ADD CONSTRAINT jug_file_vow_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_bid_block_number_index ON maker.flip_bid_bid USING btree (block_number);
 
 
 --
--- Name: jug_ilk_duty jug_ilk_duty_block_number_block_hash_ilk_id_duty_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_end_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_duty
-    ADD CONSTRAINT jug_ilk_duty_block_number_block_hash_ilk_id_duty_key UNIQUE (block_number, block_hash, ilk_id, duty);
+CREATE INDEX flip_bid_end_address_id_index ON maker.flip_bid_end USING btree (address_id);
 
 
 --
--- Name: jug_ilk_duty jug_ilk_duty_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_end_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_duty
-    ADD CONSTRAINT jug_ilk_duty_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_end_bid_id_index ON maker.flip_bid_end USING btree (bid_id);
 
 
 --
--- Name: jug_ilk_rho jug_ilk_rho_block_number_block_hash_ilk_id_rho_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_end_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_rho
-    ADD CONSTRAINT jug_ilk_rho_block_number_block_hash_ilk_id_rho_key UNIQUE (block_number, block_hash, ilk_id, rho);
+CREATE INDEX flip_bid_end_block_number_index ON maker.flip_bid_end USING btree (block_number);
 
 
 --
--- Name: jug_ilk_rho jug_ilk_rho_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_gal_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_rho
-    ADD CONSTRAINT jug_ilk_rho_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_gal_address_id_index ON maker.flip_bid_gal USING btree (address_id);
 
 
 --
--- Name: jug_init jug_init_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_gal_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_init
-    ADD CONSTRAINT jug_init_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_bid_gal_bid_id_index ON maker.flip_bid_gal USING btree (bid_id);
 
 
 --
--- Name: jug_init jug_init_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_gal_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_init
-    ADD CONSTRAINT jug_init_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_gal_block_number_index ON maker.flip_bid_gal USING btree (block_number);
 
 
 --
--- Name: jug_vat jug_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_guy_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_vat
-    ADD CONSTRAINT jug_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+CREATE INDEX flip_bid_guy_address_id_index ON maker.flip_bid_guy USING btree (address_id);
 
 
 --
--- Name: jug_vat jug_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_guy_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_vat
-    ADD CONSTRAINT jug_vat_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_guy_bid_id_index ON maker.flip_bid_guy USING btree (bid_id);
 
 
 --
--- Name: jug_vow jug_vow_block_number_block_hash_vow_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_guy_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_vow
-    ADD CONSTRAINT jug_vow_block_number_block_hash_vow_key UNIQUE (block_number, block_hash, vow);
+CREATE INDEX flip_bid_guy_block_number_index ON maker.flip_bid_guy USING btree (block_number);
 
 
 --
--- Name: jug_vow jug_vow_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_lot_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_vow
-    ADD CONSTRAINT jug_vow_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_lot_address_id_index ON maker.flip_bid_lot USING btree (address_id);
 
 
 --
--- Name: new_cdp new_cdp_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_lot_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.new_cdp
-    ADD CONSTRAINT new_cdp_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_bid_lot_bid_id_index ON maker.flip_bid_lot USING btree (bid_id);
 
 
 --
--- Name: new_cdp new_cdp_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_lot_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.new_cdp
-    ADD CONSTRAINT new_cdp_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_lot_block_number_index ON maker.flip_bid_lot USING btree (block_number);
 
 
 --
--- Name: spot_file_mat spot_file_mat_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tab_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_file_mat
-    ADD CONSTRAINT spot_file_mat_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_bid_tab_address_id_index ON maker.flip_bid_tab USING btree (address_id);
 
 
 --
--- Name: spot_file_mat spot_file_mat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tab_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_file_mat
-    ADD CONSTRAINT spot_file_mat_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_tab_bid_id_index ON maker.flip_bid_tab USING btree (bid_id);
 
 
 --
--- Name: spot_file_pip spot_file_pip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tab_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_file_pip
-    ADD CONSTRAINT spot_file_pip_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_bid_tab_block_number_index ON maker.flip_bid_tab USING btree (block_number);
 
 
 --
--- Name: spot_file_pip spot_file_pip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tic_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_file_pip
-    ADD CONSTRAINT spot_file_pip_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_tic_address_id_index ON maker.flip_bid_tic USING btree (address_id);
 
 
 --
--- Name: spot_ilk_mat spot_ilk_mat_block_number_block_hash_ilk_id_mat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tic_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_ilk_mat
-    ADD CONSTRAINT spot_ilk_mat_block_number_block_hash_ilk_id_mat_key UNIQUE (block_number, block_hash, ilk_id, mat);
+CREATE INDEX flip_bid_tic_bid_id_index ON maker.flip_bid_tic USING btree (bid_id);
 
 
 --
--- Name: spot_ilk_mat spot_ilk_mat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tic_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_ilk_mat
-    ADD CONSTRAINT spot_ilk_mat_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_tic_block_number_index ON maker.flip_bid_tic USING btree (block_number);
 
 
 --
--- Name: spot_ilk_pip spot_ilk_pip_block_number_block_hash_ilk_id_pip_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_usr_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_ilk_pip
-    ADD CONSTRAINT spot_ilk_pip_block_number_block_hash_ilk_id_pip_key UNIQUE (block_number, block_hash, ilk_id, pip);
+CREATE INDEX flip_bid_usr_address_id_index ON maker.flip_bid_usr USING btree (address_id);
 
 
 --
--- Name: spot_ilk_pip spot_ilk_pip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_usr_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_ilk_pip
-    ADD CONSTRAINT spot_ilk_pip_pkey PRIMARY KEY (id);
+CREATE INDEX flip_bid_usr_bid_id_index ON maker.flip_bid_usr USING btree (bid_id);
 
 
 --
--- Name: spot_par spot_par_block_number_block_hash_par_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_usr_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_par
-    ADD CONSTRAINT spot_par_block_number_block_hash_par_key UNIQUE (block_number, block_hash, par);
+CREATE INDEX flip_bid_usr_block_number_index ON maker.flip_bid_usr USING btree (block_number);
 
 
 --
--- Name: spot_par spot_par_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_ilk_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_par
-    ADD CONSTRAINT spot_par_pkey PRIMARY KEY (id);
+CREATE INDEX flip_ilk_block_number_index ON maker.flip_ilk USING btree (block_number);
 
 
 --
--- Name: spot_poke spot_poke_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_ilk_ilk_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_poke
-    ADD CONSTRAINT spot_poke_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_ilk_ilk_id_index ON maker.flip_ilk USING btree (ilk_id);
 
 
 --
--- Name: spot_poke spot_poke_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kick_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_poke
-    ADD CONSTRAINT spot_poke_pkey PRIMARY KEY (id);
+CREATE INDEX flip_kick_address_id_index ON maker.flip_kick USING btree (address_id);
 
 
 --
--- Name: spot_vat spot_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kick_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_vat
-    ADD CONSTRAINT spot_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+CREATE INDEX flip_kick_bid_id_index ON maker.flip_kick USING btree (bid_id);
 
 
 --
--- Name: spot_vat spot_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kick_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.spot_vat
-    ADD CONSTRAINT spot_vat_pkey PRIMARY KEY (id);
+CREATE INDEX flip_kick_header_index ON maker.flip_kick USING btree (header_id);
 
 
 --
--- Name: tend tend_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kicks_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.tend
-    ADD CONSTRAINT tend_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_kicks_address_id_index ON maker.flip_kicks USING btree (address_id);
 
 
 --
--- Name: tend tend_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kicks_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.tend
-    ADD CONSTRAINT tend_pkey PRIMARY KEY (id);
+CREATE INDEX flip_kicks_block_number_index ON maker.flip_kicks USING btree (block_number);
 
 
 --
--- Name: tick tick_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kicks_kicks_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.tick
-    ADD CONSTRAINT tick_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flip_kicks_kicks_index ON maker.flip_kicks USING btree (kicks);
 
 
 --
--- Name: tick tick_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.tick
-    ADD CONSTRAINT tick_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_bid_address_id_index ON maker.flop_bid_bid USING btree (address_id);
 
 
 --
--- Name: urns urns_ilk_id_identifier_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_bid_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.urns
-    ADD CONSTRAINT urns_ilk_id_identifier_key UNIQUE (ilk_id, identifier);
+CREATE INDEX flop_bid_bid_bid_id_index ON maker.flop_bid_bid USING btree (bid_id);
 
 
 --
--- Name: urns urns_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_bid_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.urns
-    ADD CONSTRAINT urns_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_bid_block_number_index ON maker.flop_bid_bid USING btree (block_number);
 
 
 --
--- Name: vat_dai vat_dai_block_number_block_hash_guy_dai_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_end_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_dai
-    ADD CONSTRAINT vat_dai_block_number_block_hash_guy_dai_key UNIQUE (block_number, block_hash, guy, dai);
+CREATE INDEX flop_bid_end_bid_address_id_index ON maker.flop_bid_end USING btree (address_id);
 
 
 --
--- Name: vat_dai vat_dai_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_end_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_dai
-    ADD CONSTRAINT vat_dai_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_end_bid_id_index ON maker.flop_bid_end USING btree (bid_id);
 
 
 --
--- Name: vat_debt vat_debt_block_number_block_hash_debt_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_end_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_debt
-    ADD CONSTRAINT vat_debt_block_number_block_hash_debt_key UNIQUE (block_number, block_hash, debt);
+CREATE INDEX flop_bid_end_block_number_index ON maker.flop_bid_end USING btree (block_number);
 
 
 --
--- Name: vat_debt vat_debt_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_guy_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_debt
-    ADD CONSTRAINT vat_debt_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_guy_bid_address_id_index ON maker.flop_bid_guy USING btree (address_id);
 
 
 --
--- Name: vat_file_debt_ceiling vat_file_debt_ceiling_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_guy_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_file_debt_ceiling
-    ADD CONSTRAINT vat_file_debt_ceiling_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flop_bid_guy_bid_id_index ON maker.flop_bid_guy USING btree (bid_id);
 
 
 --
--- Name: vat_file_debt_ceiling vat_file_debt_ceiling_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_guy_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_file_debt_ceiling
-    ADD CONSTRAINT vat_file_debt_ceiling_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_guy_block_number_index ON maker.flop_bid_guy USING btree (block_number);
 
 
 --
--- Name: vat_file_ilk vat_file_ilk_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_lot_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_file_ilk
-    ADD CONSTRAINT vat_file_ilk_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flop_bid_lot_bid_address_id_index ON maker.flop_bid_lot USING btree (address_id);
 
 
 --
--- Name: vat_file_ilk vat_file_ilk_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_lot_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_file_ilk
-    ADD CONSTRAINT vat_file_ilk_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_lot_bid_id_index ON maker.flop_bid_lot USING btree (bid_id);
 
 
 --
--- Name: vat_flux vat_flux_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_lot_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_flux
-    ADD CONSTRAINT vat_flux_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flop_bid_lot_block_number_index ON maker.flop_bid_lot USING btree (block_number);
 
 
 --
--- Name: vat_flux vat_flux_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_tic_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_flux
-    ADD CONSTRAINT vat_flux_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_tic_bid_address_id_index ON maker.flop_bid_tic USING btree (address_id);
 
 
 --
--- Name: vat_fold vat_fold_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_tic_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_fold
-    ADD CONSTRAINT vat_fold_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flop_bid_tic_bid_id_index ON maker.flop_bid_tic USING btree (bid_id);
 
 
 --
--- Name: vat_fold vat_fold_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_tic_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_fold
-    ADD CONSTRAINT vat_fold_pkey PRIMARY KEY (id);
+CREATE INDEX flop_bid_tic_block_number_index ON maker.flop_bid_tic USING btree (block_number);
 
 
 --
--- Name: vat_fork vat_fork_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kick_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_fork
-    ADD CONSTRAINT vat_fork_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flop_kick_header_index ON maker.flop_kick USING btree (header_id);
 
 
 --
--- Name: vat_fork vat_fork_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kicks_address_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_fork
-    ADD CONSTRAINT vat_fork_pkey PRIMARY KEY (id);
+CREATE INDEX flop_kicks_address_id_index ON maker.flop_kicks USING btree (address_id);
 
 
 --
--- Name: vat_frob vat_frob_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kicks_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_frob
-    ADD CONSTRAINT vat_frob_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX flop_kicks_block_number_index ON maker.flop_kicks USING btree (block_number);
 
 
 --
--- Name: vat_frob vat_frob_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kicks_kicks_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_frob
-    ADD CONSTRAINT vat_frob_pkey PRIMARY KEY (id);
+CREATE INDEX flop_kicks_kicks_index ON maker.flop_kicks USING btree (kicks);
 
 
 --
--- Name: vat_gem vat_gem_block_number_block_hash_ilk_id_guy_gem_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_gem
-    ADD CONSTRAINT vat_gem_block_number_block_hash_ilk_id_guy_gem_key UNIQUE (block_number, block_hash, ilk_id, guy, gem);
+CREATE INDEX gem_exit_address_index ON maker.gem_exit USING btree (address_id);
 
 
 --
--- Name: vat_gem vat_gem_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_gem
-    ADD CONSTRAINT vat_gem_pkey PRIMARY KEY (id);
+CREATE INDEX gem_exit_header_index ON maker.gem_exit USING btree (header_id);
 
 
 --
--- Name: vat_grab vat_grab_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_grab
-    ADD CONSTRAINT vat_grab_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX gem_exit_ilk_index ON maker.gem_exit USING btree (ilk_id);
 
 
 --
--- Name: vat_grab vat_grab_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_grab
-    ADD CONSTRAINT vat_grab_pkey PRIMARY KEY (id);
+CREATE INDEX gem_exit_msg_sender_index ON maker.gem_exit USING btree (msg_sender);
 
 
 --
--- Name: vat_heal vat_heal_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_heal
-    ADD CONSTRAINT vat_heal_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX gem_join_address_index ON maker.gem_join USING btree (address_id);
 
 
 --
--- Name: vat_heal vat_heal_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_heal
-    ADD CONSTRAINT vat_heal_pkey PRIMARY KEY (id);
+CREATE INDEX gem_join_header_index ON maker.gem_join USING btree (header_id);
 
 
 --
--- Name: vat_ilk_art vat_ilk_art_block_number_block_hash_ilk_id_art_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_art
-    ADD CONSTRAINT vat_ilk_art_block_number_block_hash_ilk_id_art_key UNIQUE (block_number, block_hash, ilk_id, art);
+CREATE INDEX gem_join_ilk_index ON maker.gem_join USING btree (ilk_id);
 
 
 --
--- Name: vat_ilk_art vat_ilk_art_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_art
-    ADD CONSTRAINT vat_ilk_art_pkey PRIMARY KEY (id);
+CREATE INDEX gem_join_msg_sender_index ON maker.gem_join USING btree (msg_sender);
 
 
 --
--- Name: vat_ilk_dust vat_ilk_dust_block_number_block_hash_ilk_id_dust_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: generic_file_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_dust
-    ADD CONSTRAINT vat_ilk_dust_block_number_block_hash_ilk_id_dust_key UNIQUE (block_number, block_hash, ilk_id, dust);
+CREATE INDEX generic_file_address_index ON maker.generic_file USING btree (address_id);
 
 
 --
--- Name: vat_ilk_dust vat_ilk_dust_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: generic_file_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_dust
-    ADD CONSTRAINT vat_ilk_dust_pkey PRIMARY KEY (id);
+CREATE INDEX generic_file_header_index ON maker.generic_file USING btree (header_id);
 
 
 --
--- Name: vat_ilk_line vat_ilk_line_block_number_block_hash_ilk_id_line_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: generic_file_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_line
-    ADD CONSTRAINT vat_ilk_line_block_number_block_hash_ilk_id_line_key UNIQUE (block_number, block_hash, ilk_id, line);
+CREATE INDEX generic_file_ilk_index ON maker.generic_file USING btree (ilk_id);
 
 
 --
--- Name: vat_ilk_line vat_ilk_line_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_drip_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_line
-    ADD CONSTRAINT vat_ilk_line_pkey PRIMARY KEY (id);
+CREATE INDEX jug_drip_header_index ON maker.jug_drip USING btree (header_id);
 
 
 --
--- Name: vat_ilk_rate vat_ilk_rate_block_number_block_hash_ilk_id_rate_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_drip_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_rate
-    ADD CONSTRAINT vat_ilk_rate_block_number_block_hash_ilk_id_rate_key UNIQUE (block_number, block_hash, ilk_id, rate);
+CREATE INDEX jug_drip_ilk_index ON maker.jug_drip USING btree (ilk_id);
 
 
 --
--- Name: vat_ilk_rate vat_ilk_rate_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_base_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_rate
-    ADD CONSTRAINT vat_ilk_rate_pkey PRIMARY KEY (id);
+CREATE INDEX jug_file_base_header_index ON maker.jug_file_base USING btree (header_id);
 
 
 --
--- Name: vat_ilk_spot vat_ilk_spot_block_number_block_hash_ilk_id_spot_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_ilk_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_spot
-    ADD CONSTRAINT vat_ilk_spot_block_number_block_hash_ilk_id_spot_key UNIQUE (block_number, block_hash, ilk_id, spot);
+CREATE INDEX jug_file_ilk_header_index ON maker.jug_file_ilk USING btree (header_id);
 
 
 --
--- Name: vat_ilk_spot vat_ilk_spot_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_ilk_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_ilk_spot
-    ADD CONSTRAINT vat_ilk_spot_pkey PRIMARY KEY (id);
+CREATE INDEX jug_file_ilk_ilk_index ON maker.jug_file_ilk USING btree (ilk_id);
 
 
 --
--- Name: vat_init vat_init_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_vow_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_init
-    ADD CONSTRAINT vat_init_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX jug_file_vow_header_index ON maker.jug_file_vow USING btree (header_id);
 
 
 --
--- Name: vat_init vat_init_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_ilk_duty_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_init
-    ADD CONSTRAINT vat_init_pkey PRIMARY KEY (id);
+CREATE INDEX jug_ilk_duty_block_number_index ON maker.jug_ilk_duty USING btree (block_number);
 
 
 --
--- Name: vat_line vat_line_block_number_block_hash_line_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_ilk_duty_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_line
-    ADD CONSTRAINT vat_line_block_number_block_hash_line_key UNIQUE (block_number, block_hash, line);
+CREATE INDEX jug_ilk_duty_ilk_index ON maker.jug_ilk_duty USING btree (ilk_id);
 
 
 --
--- Name: vat_line vat_line_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_ilk_rho_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_line
-    ADD CONSTRAINT vat_line_pkey PRIMARY KEY (id);
+CREATE INDEX jug_ilk_rho_block_number_index ON maker.jug_ilk_rho USING btree (block_number);
 
 
 --
--- Name: vat_live vat_live_block_number_block_hash_live_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_ilk_rho_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_live
-    ADD CONSTRAINT vat_live_block_number_block_hash_live_key UNIQUE (block_number, block_hash, live);
+CREATE INDEX jug_ilk_rho_ilk_index ON maker.jug_ilk_rho USING btree (ilk_id);
 
 
 --
--- Name: vat_live vat_live_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_init_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_live
-    ADD CONSTRAINT vat_live_pkey PRIMARY KEY (id);
+CREATE INDEX jug_init_header_index ON maker.jug_init USING btree (header_id);
 
 
 --
--- Name: vat_move vat_move_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_init_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_move
-    ADD CONSTRAINT vat_move_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX jug_init_ilk_index ON maker.jug_init USING btree (ilk_id);
 
 
 --
--- Name: vat_move vat_move_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_median_price_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_move
-    ADD CONSTRAINT vat_move_pkey PRIMARY KEY (id);
+CREATE INDEX log_median_price_address_index ON maker.log_median_price USING btree (address_id);
 
 
 --
--- Name: vat_sin vat_sin_block_number_block_hash_guy_sin_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_median_price_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_sin
-    ADD CONSTRAINT vat_sin_block_number_block_hash_guy_sin_key UNIQUE (block_number, block_hash, guy, sin);
+CREATE INDEX log_median_price_header_index ON maker.log_median_price USING btree (header_id);
 
 
 --
--- Name: vat_sin vat_sin_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_median_price_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_sin
-    ADD CONSTRAINT vat_sin_pkey PRIMARY KEY (id);
+CREATE INDEX log_median_price_ilk_index ON maker.log_median_price USING btree (ilk_id);
 
 
 --
--- Name: vat_slip vat_slip_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_value_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_slip
-    ADD CONSTRAINT vat_slip_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX log_value_address_index ON maker.log_value USING btree (address_id);
 
 
 --
--- Name: vat_slip vat_slip_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_value_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_slip
-    ADD CONSTRAINT vat_slip_pkey PRIMARY KEY (id);
+CREATE INDEX log_value_header_index ON maker.log_value USING btree (header_id);
 
 
 --
--- Name: vat_suck vat_suck_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_cur_has_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_suck
-    ADD CONSTRAINT vat_suck_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX osm_cur_has_address_index ON maker.osm_cur_has USING btree (address_id);
 
 
 --
--- Name: vat_suck vat_suck_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_cur_has_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_suck
-    ADD CONSTRAINT vat_suck_pkey PRIMARY KEY (id);
+CREATE INDEX osm_cur_has_block_number_index ON maker.osm_cur_has USING btree (block_number);
 
 
 --
--- Name: vat_urn_art vat_urn_art_block_number_block_hash_urn_id_art_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_cur_val_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_urn_art
-    ADD CONSTRAINT vat_urn_art_block_number_block_hash_urn_id_art_key UNIQUE (block_number, block_hash, urn_id, art);
+CREATE INDEX osm_cur_val_address_index ON maker.osm_cur_val USING btree (address_id);
 
 
 --
--- Name: vat_urn_art vat_urn_art_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_cur_val_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_urn_art
-    ADD CONSTRAINT vat_urn_art_pkey PRIMARY KEY (id);
+CREATE INDEX osm_cur_val_block_number_index ON maker.osm_cur_val USING btree (block_number);
 
 
 --
--- Name: vat_urn_ink vat_urn_ink_block_number_block_hash_urn_id_ink_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_hop_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_urn_ink
-    ADD CONSTRAINT vat_urn_ink_block_number_block_hash_urn_id_ink_key UNIQUE (block_number, block_hash, urn_id, ink);
+CREATE INDEX osm_hop_address_index ON maker.osm_hop USING btree (address_id);
 
 
 --
--- Name: vat_urn_ink vat_urn_ink_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_hop_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_urn_ink
-    ADD CONSTRAINT vat_urn_ink_pkey PRIMARY KEY (id);
+CREATE INDEX osm_hop_block_number_index ON maker.osm_hop USING btree (block_number);
 
 
 --
--- Name: vat_vice vat_vice_block_number_block_hash_vice_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_nxt_has_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_vice
-    ADD CONSTRAINT vat_vice_block_number_block_hash_vice_key UNIQUE (block_number, block_hash, vice);
+CREATE INDEX osm_nxt_has_address_index ON maker.osm_nxt_has USING btree (address_id);
 
 
 --
--- Name: vat_vice vat_vice_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_nxt_has_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vat_vice
-    ADD CONSTRAINT vat_vice_pkey PRIMARY KEY (id);
+CREATE INDEX osm_nxt_has_block_number_index ON maker.osm_nxt_has USING btree (block_number);
 
 
 --
--- Name: vow_ash vow_ash_block_number_block_hash_ash_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_nxt_val_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_ash
-    ADD CONSTRAINT vow_ash_block_number_block_hash_ash_key UNIQUE (block_number, block_hash, ash);
+CREATE INDEX osm_nxt_val_address_index ON maker.osm_nxt_val USING btree (address_id);
 
 
 --
--- Name: vow_ash vow_ash_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_nxt_val_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_ash
-    ADD CONSTRAINT vow_ash_pkey PRIMARY KEY (id);
+CREATE INDEX osm_nxt_val_block_number_index ON maker.osm_nxt_val USING btree (block_number);
 
 
 --
--- Name: vow_bump vow_bump_block_number_block_hash_bump_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_stopped_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_bump
-    ADD CONSTRAINT vow_bump_block_number_block_hash_bump_key UNIQUE (block_number, block_hash, bump);
+CREATE INDEX osm_stopped_address_index ON maker.osm_stopped USING btree (address_id);
 
 
 --
--- Name: vow_bump vow_bump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_stopped_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_bump
-    ADD CONSTRAINT vow_bump_pkey PRIMARY KEY (id);
+CREATE INDEX osm_stopped_block_number_index ON maker.osm_stopped USING btree (block_number);
 
 
 --
--- Name: vow_dump vow_dump_block_number_block_hash_dump_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_zzz_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_dump
-    ADD CONSTRAINT vow_dump_block_number_block_hash_dump_key UNIQUE (block_number, block_hash, dump);
+CREATE INDEX osm_zzz_address_index ON maker.osm_zzz USING btree (address_id);
 
 
 --
--- Name: vow_dump vow_dump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_zzz_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_dump
-    ADD CONSTRAINT vow_dump_pkey PRIMARY KEY (id);
+CREATE INDEX osm_zzz_block_number_index ON maker.osm_zzz USING btree (block_number);
 
 
 --
--- Name: vow_fess vow_fess_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_drop_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_fess
-    ADD CONSTRAINT vow_fess_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX pause_drop_header_index ON maker.pause_drop USING btree (header_id);
 
 
 --
--- Name: vow_fess vow_fess_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_drop_usr_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_fess
-    ADD CONSTRAINT vow_fess_pkey PRIMARY KEY (id);
+CREATE INDEX pause_drop_usr_index ON maker.pause_drop USING btree (usr);
 
 
 --
--- Name: vow_file vow_file_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_exec_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_file
-    ADD CONSTRAINT vow_file_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX pause_exec_header_index ON maker.pause_exec USING btree (header_id);
 
 
 --
--- Name: vow_file vow_file_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_exec_usr_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_file
-    ADD CONSTRAINT vow_file_pkey PRIMARY KEY (id);
+CREATE INDEX pause_exec_usr_index ON maker.pause_exec USING btree (usr);
 
 
 --
--- Name: vow_flapper vow_flapper_block_number_block_hash_flapper_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_plot_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flapper
-    ADD CONSTRAINT vow_flapper_block_number_block_hash_flapper_key UNIQUE (block_number, block_hash, flapper);
+CREATE INDEX pause_plot_header_index ON maker.pause_plot USING btree (header_id);
 
 
 --
--- Name: vow_flapper vow_flapper_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_plot_usr_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flapper
-    ADD CONSTRAINT vow_flapper_pkey PRIMARY KEY (id);
+CREATE INDEX pause_plot_usr_index ON maker.pause_plot USING btree (usr);
 
 
 --
--- Name: vow_flog vow_flog_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_chi_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flog
-    ADD CONSTRAINT vow_flog_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX pot_chi_block_number_index ON maker.pot_chi USING btree (block_number);
 
 
 --
--- Name: vow_flog vow_flog_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_drip_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flog
-    ADD CONSTRAINT vow_flog_pkey PRIMARY KEY (id);
+CREATE INDEX pot_drip_header_index ON maker.pot_drip USING btree (header_id);
 
 
 --
--- Name: vow_flopper vow_flopper_block_number_block_hash_flopper_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_dsr_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flopper
-    ADD CONSTRAINT vow_flopper_block_number_block_hash_flopper_key UNIQUE (block_number, block_hash, flopper);
+CREATE INDEX pot_dsr_block_number_index ON maker.pot_dsr USING btree (block_number);
 
 
 --
--- Name: vow_flopper vow_flopper_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_exit_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_flopper
-    ADD CONSTRAINT vow_flopper_pkey PRIMARY KEY (id);
+CREATE INDEX pot_exit_header_index ON maker.pot_exit USING btree (header_id);
 
 
 --
--- Name: vow_hump vow_hump_block_number_block_hash_hump_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_exit_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_hump
-    ADD CONSTRAINT vow_hump_block_number_block_hash_hump_key UNIQUE (block_number, block_hash, hump);
+CREATE INDEX pot_exit_msg_sender_index ON maker.pot_exit USING btree (msg_sender);
 
 
 --
--- Name: vow_hump vow_hump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_file_dsr_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_hump
-    ADD CONSTRAINT vow_hump_pkey PRIMARY KEY (id);
+CREATE INDEX pot_file_dsr_header_index ON maker.pot_file_dsr USING btree (header_id);
 
 
 --
--- Name: vow_sin_integer vow_sin_integer_block_number_block_hash_sin_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_file_vow_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sin_integer
-    ADD CONSTRAINT vow_sin_integer_block_number_block_hash_sin_key UNIQUE (block_number, block_hash, sin);
+CREATE INDEX pot_file_vow_header_index ON maker.pot_file_vow USING btree (header_id);
 
 
 --
--- Name: vow_sin_integer vow_sin_integer_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_join_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sin_integer
-    ADD CONSTRAINT vow_sin_integer_pkey PRIMARY KEY (id);
+CREATE INDEX pot_join_header_index ON maker.pot_join USING btree (header_id);
 
 
 --
--- Name: vow_sin_mapping vow_sin_mapping_block_number_block_hash_era_tab_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_join_msg_sender_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sin_mapping
-    ADD CONSTRAINT vow_sin_mapping_block_number_block_hash_era_tab_key UNIQUE (block_number, block_hash, era, tab);
+CREATE INDEX pot_join_msg_sender_index ON maker.pot_join USING btree (msg_sender);
 
 
 --
--- Name: vow_sin_mapping vow_sin_mapping_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_live_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sin_mapping
-    ADD CONSTRAINT vow_sin_mapping_pkey PRIMARY KEY (id);
+CREATE INDEX pot_live_block_number_index ON maker.pot_live USING btree (block_number);
 
 
 --
--- Name: vow_sump vow_sump_block_number_block_hash_sump_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_pie_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sump
-    ADD CONSTRAINT vow_sump_block_number_block_hash_sump_key UNIQUE (block_number, block_hash, sump);
+CREATE INDEX pot_pie_block_number_index ON maker.pot_pie USING btree (block_number);
 
 
 --
--- Name: vow_sump vow_sump_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_rho_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_sump
-    ADD CONSTRAINT vow_sump_pkey PRIMARY KEY (id);
+CREATE INDEX pot_rho_block_number_index ON maker.pot_rho USING btree (block_number);
 
 
 --
--- Name: vow_vat vow_vat_block_number_block_hash_vat_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_user_pie_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_vat
-    ADD CONSTRAINT vow_vat_block_number_block_hash_vat_key UNIQUE (block_number, block_hash, vat);
+CREATE INDEX pot_user_pie_block_number_index ON maker.pot_user_pie USING btree (block_number);
 
 
 --
--- Name: vow_vat vow_vat_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_vow_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_vat
-    ADD CONSTRAINT vow_vat_pkey PRIMARY KEY (id);
+CREATE INDEX pot_vow_block_number_index ON maker.pot_vow USING btree (block_number);
 
 
 --
--- Name: vow_wait vow_wait_block_number_block_hash_wait_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: proxies_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_wait
-    ADD CONSTRAINT vow_wait_block_number_block_hash_wait_key UNIQUE (block_number, block_hash, wait);
+CREATE INDEX proxies_header_index ON maker.proxies USING btree (header_id);
 
 
 --
--- Name: vow_wait vow_wait_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: proxies_owner_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.vow_wait
-    ADD CONSTRAINT vow_wait_pkey PRIMARY KEY (id);
+CREATE INDEX proxies_owner_index ON maker.proxies USING btree (owner);
 
 
 --
--- Name: yank yank_header_id_log_id_key; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: proxies_proxy_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.yank
-    ADD CONSTRAINT yank_header_id_log_id_key UNIQUE (header_id, log_id);
+CREATE INDEX proxies_proxy_index ON maker.proxies USING btree (proxy);
 
 
 --
--- Name: yank yank_pkey; Type: CONSTRAINT; Schema: maker; Owner: -
+-- Name: spot_file_mat_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.yank
-    ADD CONSTRAINT yank_pkey PRIMARY KEY (id);
+CREATE INDEX spot_file_mat_header_index ON maker.spot_file_mat USING btree (header_id);
 
 
 --
--- Name: addresses addresses_address_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_file_mat_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.addresses
-    ADD CONSTRAINT addresses_address_key UNIQUE (address);
+CREATE INDEX spot_file_mat_ilk_index ON maker.spot_file_mat USING btree (ilk_id);
 
 
 --
--- Name: addresses addresses_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_file_par_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.addresses
-    ADD CONSTRAINT addresses_pkey PRIMARY KEY (id);
+CREATE INDEX spot_file_par_header_index ON maker.spot_file_par USING btree (header_id);
 
 
 --
--- Name: blocks blocks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_file_pip_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.blocks
-    ADD CONSTRAINT blocks_pkey PRIMARY KEY (id);
+CREATE INDEX spot_file_pip_header_index ON maker.spot_file_pip USING btree (header_id);
 
 
 --
--- Name: checked_headers checked_headers_header_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_file_pip_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.checked_headers
-    ADD CONSTRAINT checked_headers_header_id_key UNIQUE (header_id);
+CREATE INDEX spot_file_pip_ilk_index ON maker.spot_file_pip USING btree (ilk_id);
 
 
 --
--- Name: checked_headers checked_headers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_ilk_mat_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.checked_headers
-    ADD CONSTRAINT checked_headers_pkey PRIMARY KEY (id);
+CREATE INDEX spot_ilk_mat_block_number_index ON maker.spot_ilk_mat USING btree (block_number);
 
 
 --
--- Name: blocks eth_node_id_block_number_uc; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_ilk_mat_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.blocks
-    ADD CONSTRAINT eth_node_id_block_number_uc UNIQUE (number, eth_node_id);
-
+CREATE INDEX spot_ilk_mat_ilk_index ON maker.spot_ilk_mat USING btree (ilk_id);
+
 
 --
--- Name: eth_nodes eth_node_uc; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_ilk_pip_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.eth_nodes
-    ADD CONSTRAINT eth_node_uc UNIQUE (genesis_block, network_id, eth_node_id);
+CREATE INDEX spot_ilk_pip_block_number_index ON maker.spot_ilk_pip USING btree (block_number);
 
 
 --
--- Name: full_sync_logs full_sync_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_ilk_pip_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.full_sync_logs
-    ADD CONSTRAINT full_sync_logs_pkey PRIMARY KEY (id);
+CREATE INDEX spot_ilk_pip_ilk_index ON maker.spot_ilk_pip USING btree (ilk_id);
 
 
 --
--- Name: full_sync_receipts full_sync_receipts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_poke_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.full_sync_receipts
-    ADD CONSTRAINT full_sync_receipts_pkey PRIMARY KEY (id);
+CREATE INDEX spot_poke_header_index ON maker.spot_poke USING btree (header_id);
 
 
 --
--- Name: full_sync_transactions full_sync_transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: spot_poke_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.full_sync_transactions
-    ADD CONSTRAINT full_sync_transactions_pkey PRIMARY KEY (id);
+CREATE INDEX spot_poke_ilk_index ON maker.spot_poke USING btree (ilk_id);
 
 
 --
--- Name: goose_db_version goose_db_version_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: tend_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.goose_db_version
-    ADD CONSTRAINT goose_db_version_pkey PRIMARY KEY (id);
+CREATE INDEX tend_header_index ON maker.tend USING btree (header_id);
 
 
 --
--- Name: header_sync_logs header_sync_logs_header_id_tx_index_log_index_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: tick_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_logs
-    ADD CONSTRAINT header_sync_logs_header_id_tx_index_log_index_key UNIQUE (header_id, tx_index, log_index);
+CREATE INDEX tick_bid_id_index ON maker.tick USING btree (bid_id);
 
 
 --
--- Name: header_sync_logs header_sync_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: tick_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_logs
-    ADD CONSTRAINT header_sync_logs_pkey PRIMARY KEY (id);
+CREATE INDEX tick_header_index ON maker.tick USING btree (header_id);
 
 
 --
--- Name: header_sync_receipts header_sync_receipts_header_id_transaction_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: urn_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_receipts
-    ADD CONSTRAINT header_sync_receipts_header_id_transaction_id_key UNIQUE (header_id, transaction_id);
+CREATE INDEX urn_ilk_index ON maker.urns USING btree (ilk_id);
 
 
 --
--- Name: header_sync_receipts header_sync_receipts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_can_bit_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_receipts
-    ADD CONSTRAINT header_sync_receipts_pkey PRIMARY KEY (id);
+CREATE INDEX vat_can_bit_index ON maker.vat_can USING btree ("bit");
 
 
 --
--- Name: header_sync_transactions header_sync_transactions_header_id_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_can_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_transactions
-    ADD CONSTRAINT header_sync_transactions_header_id_hash_key UNIQUE (header_id, hash);
+CREATE INDEX vat_can_block_number_index ON maker.vat_can USING btree (block_number);
 
 
 --
--- Name: header_sync_transactions header_sync_transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_file_debt_ceiling_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.header_sync_transactions
-    ADD CONSTRAINT header_sync_transactions_pkey PRIMARY KEY (id);
+CREATE INDEX vat_file_debt_ceiling_header_index ON maker.vat_file_debt_ceiling USING btree (header_id);
 
 
 --
--- Name: headers headers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_file_ilk_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.headers
-    ADD CONSTRAINT headers_pkey PRIMARY KEY (id);
+CREATE INDEX vat_file_ilk_header_index ON maker.vat_file_ilk USING btree (header_id);
 
 
 --
--- Name: log_filters name_uc; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_file_ilk_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.log_filters
-    ADD CONSTRAINT name_uc UNIQUE (name);
+CREATE INDEX vat_file_ilk_ilk_index ON maker.vat_file_ilk USING btree (ilk_id);
 
 
 --
--- Name: eth_nodes nodes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_flux_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.eth_nodes
-    ADD CONSTRAINT nodes_pkey PRIMARY KEY (id);
+CREATE INDEX vat_flux_header_index ON maker.vat_flux USING btree (header_id);
 
 
 --
--- Name: queued_storage queued_storage_block_height_block_hash_contract_storage_key_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_flux_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.queued_storage
-    ADD CONSTRAINT queued_storage_block_height_block_hash_contract_storage_key_key UNIQUE (block_height, block_hash, contract, storage_key, storage_value);
+CREATE INDEX vat_flux_ilk_index ON maker.vat_flux USING btree (ilk_id);
 
 
 --
--- Name: queued_storage queued_storage_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_fold_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.queued_storage
-    ADD CONSTRAINT queued_storage_pkey PRIMARY KEY (id);
+CREATE INDEX vat_fold_header_index ON maker.vat_fold USING btree (header_id);
 
 
 --
--- Name: uncles uncles_block_id_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_fold_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.uncles
-    ADD CONSTRAINT uncles_block_id_hash_key UNIQUE (block_id, hash);
+CREATE INDEX vat_fold_urn_index ON maker.vat_fold USING btree (urn_id);
 
 
 --
--- Name: uncles uncles_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_fork_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.uncles
-    ADD CONSTRAINT uncles_pkey PRIMARY KEY (id);
+CREATE INDEX vat_fork_header_index ON maker.vat_fork USING btree (header_id);
 
 
 --
--- Name: watched_contracts watched_contracts_contract_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_fork_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.watched_contracts
-    ADD CONSTRAINT watched_contracts_contract_hash_key UNIQUE (contract_hash);
+CREATE INDEX vat_fork_ilk_index ON maker.vat_fork USING btree (ilk_id);
 
 
 --
--- Name: watched_contracts watched_contracts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_frob_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.watched_contracts
-    ADD CONSTRAINT watched_contracts_pkey PRIMARY KEY (contract_id);
+CREATE INDEX vat_frob_header_index ON maker.vat_frob USING btree (header_id);
 
 
 --
--- Name: watched_logs watched_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: vat_frob_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY public.watched_logs
-    ADD CONSTRAINT watched_logs_pkey PRIMARY KEY (id);
+CREATE INDEX vat_frob_urn_index ON maker.vat_frob USING btree (urn_id);
 
 
 --
--- Name: bite_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_gem_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX bite_header_index ON maker.bite USING btree (header_id);
+CREATE INDEX vat_gem_ilk_index ON maker.vat_gem USING btree (ilk_id);
 
 
 --
--- Name: bite_urn_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_grab_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX bite_urn_index ON maker.bite USING btree (urn_id);
+CREATE INDEX vat_grab_header_index ON maker.vat_grab USING btree (header_id);
 
 
 --
--- Name: cat_file_chop_lump_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_grab_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_file_chop_lump_header_index ON maker.cat_file_chop_lump USING btree (header_id);
+CREATE INDEX vat_grab_urn_index ON maker.vat_grab USING btree (urn_id);
 
 
 --
--- Name: cat_file_chop_lump_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_heal_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_file_chop_lump_ilk_index ON maker.cat_file_chop_lump USING btree (ilk_id);
+CREATE INDEX vat_heal_header_index ON maker.vat_heal USING btree (header_id);
 
 
 --
--- Name: cat_file_flip_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_hope_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_file_flip_header_index ON maker.cat_file_flip USING btree (header_id);
+CREATE INDEX vat_hope_header_index ON maker.vat_hope USING btree (header_id);
 
 
 --
--- Name: cat_file_flip_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_hope_usr_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_file_flip_ilk_index ON maker.cat_file_flip USING btree (ilk_id);
+CREATE INDEX vat_hope_usr_index ON maker.vat_hope USING btree (usr);
 
 
 --
--- Name: cat_file_vow_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_art_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_file_vow_header_index ON maker.cat_file_vow USING btree (header_id);
+CREATE INDEX vat_ilk_art_block_number_index ON maker.vat_ilk_art USING btree (block_number);
 
 
 --
--- Name: cat_ilk_chop_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_art_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_ilk_chop_block_number_index ON maker.cat_ilk_chop USING btree (block_number);
+CREATE INDEX vat_ilk_art_ilk_index ON maker.vat_ilk_art USING btree (ilk_id);
 
 
 --
--- Name: cat_ilk_chop_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_dust_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_ilk_chop_ilk_index ON maker.cat_ilk_chop USING btree (ilk_id);
+CREATE INDEX vat_ilk_dust_block_number_index ON maker.vat_ilk_dust USING btree (block_number);
 
 
 --
--- Name: cat_ilk_flip_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_dust_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_ilk_flip_block_number_index ON maker.cat_ilk_flip USING btree (block_number);
+CREATE INDEX vat_ilk_dust_ilk_index ON maker.vat_ilk_dust USING btree (ilk_id);
 
 
 --
--- Name: cat_ilk_flip_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_line_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_ilk_flip_ilk_index ON maker.cat_ilk_flip USING btree (ilk_id);
+CREATE INDEX vat_ilk_line_block_number_index ON maker.vat_ilk_line USING btree (block_number);
 
 
 --
--- Name: cat_ilk_lump_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_line_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_ilk_lump_block_number_index ON maker.cat_ilk_lump USING btree (block_number);
+CREATE INDEX vat_ilk_line_ilk_index ON maker.vat_ilk_line USING btree (ilk_id);
 
 
 --
--- Name: cat_ilk_lump_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_rate_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cat_ilk_lump_ilk_index ON maker.cat_ilk_lump USING btree (ilk_id);
+CREATE INDEX vat_ilk_rate_block_number_index ON maker.vat_ilk_rate USING btree (block_number);
 
 
 --
--- Name: cdp_manager_cdpi_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_rate_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_cdpi_block_number_index ON maker.cdp_manager_cdpi USING btree (block_number);
+CREATE INDEX vat_ilk_rate_ilk_index ON maker.vat_ilk_rate USING btree (ilk_id);
 
 
 --
--- Name: cdp_manager_cdpi_cdpi_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_spot_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_cdpi_cdpi_index ON maker.cdp_manager_cdpi USING btree (cdpi);
+CREATE INDEX vat_ilk_spot_block_number_index ON maker.vat_ilk_spot USING btree (block_number);
 
 
 --
--- Name: cdp_manager_ilks_cdpi_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_spot_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_ilks_cdpi_index ON maker.cdp_manager_ilks USING btree (cdpi);
+CREATE INDEX vat_ilk_spot_ilk_index ON maker.vat_ilk_spot USING btree (ilk_id);
 
 
 --
--- Name: cdp_manager_ilks_ilk_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_init_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_ilks_ilk_id_index ON maker.cdp_manager_ilks USING btree (ilk_id);
+CREATE INDEX vat_init_header_index ON maker.vat_init USING btree (header_id);
 
 
 --
--- Name: cdp_manager_owns_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_init_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_owns_block_number_index ON maker.cdp_manager_owns USING btree (block_number);
+CREATE INDEX vat_init_ilk_index ON maker.vat_init USING btree (ilk_id);
 
 
 --
--- Name: cdp_manager_owns_cdpi_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_move_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_owns_cdpi_index ON maker.cdp_manager_owns USING btree (cdpi);
+CREATE INDEX vat_move_header_index ON maker.vat_move USING btree (header_id);
 
 
 --
--- Name: cdp_manager_owns_owner_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_nope_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_owns_owner_index ON maker.cdp_manager_owns USING btree (owner);
+CREATE INDEX vat_nope_header_index ON maker.vat_nope USING btree (header_id);
 
 
 --
--- Name: cdp_manager_urns_cdpi_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_nope_usr_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_urns_cdpi_index ON maker.cdp_manager_urns USING btree (cdpi);
+CREATE INDEX vat_nope_usr_index ON maker.vat_nope USING btree (usr);
 
 
 --
--- Name: cdp_manager_urns_urn_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_slip_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX cdp_manager_urns_urn_index ON maker.cdp_manager_urns USING btree (urn);
+CREATE INDEX vat_slip_header_index ON maker.vat_slip USING btree (header_id);
 
 
 --
--- Name: deal_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_slip_ilk_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX deal_address_id_index ON maker.deal USING btree (address_id);
+CREATE INDEX vat_slip_ilk_index ON maker.vat_slip USING btree (ilk_id);
 
 
 --
--- Name: deal_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_suck_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX deal_bid_id_index ON maker.deal USING btree (bid_id);
+CREATE INDEX vat_suck_header_index ON maker.vat_suck USING btree (header_id);
 
 
 --
--- Name: deal_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_urn_art_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX deal_header_index ON maker.deal USING btree (header_id);
+CREATE INDEX vat_urn_art_block_number_index ON maker.vat_urn_art USING btree (block_number);
 
 
 --
--- Name: dent_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_urn_art_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX dent_header_index ON maker.dent USING btree (header_id);
+CREATE INDEX vat_urn_art_urn_index ON maker.vat_urn_art USING btree (urn_id);
 
 
 --
--- Name: flap_bid_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_urn_ink_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_bid_address_id_index ON maker.flap_bid_bid USING btree (address_id);
+CREATE INDEX vat_urn_ink_block_number_index ON maker.vat_urn_ink USING btree (block_number);
 
 
 --
--- Name: flap_bid_bid_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_urn_ink_urn_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_bid_bid_id_index ON maker.flap_bid_bid USING btree (bid_id);
+CREATE INDEX vat_urn_ink_urn_index ON maker.vat_urn_ink USING btree (urn_id);
 
 
 --
--- Name: flap_bid_bid_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_fess_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_bid_block_number_index ON maker.flap_bid_bid USING btree (block_number);
+CREATE INDEX vow_fess_header_index ON maker.vow_fess USING btree (header_id);
 
 
 --
--- Name: flap_bid_end_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_file_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_end_bid_address_id_index ON maker.flap_bid_end USING btree (address_id);
+CREATE INDEX vow_file_header_index ON maker.vow_file USING btree (header_id);
 
 
 --
--- Name: flap_bid_end_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_flap_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_end_bid_id_index ON maker.flap_bid_end USING btree (bid_id);
+CREATE INDEX vow_flap_header_index ON maker.vow_flap USING btree (header_id);
 
 
 --
--- Name: flap_bid_end_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_flog_era_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_end_block_number_index ON maker.flap_bid_end USING btree (block_number);
+CREATE INDEX vow_flog_era_index ON maker.vow_flog USING btree (era);
 
 
 --
--- Name: flap_bid_guy_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_flog_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_guy_bid_address_id_index ON maker.flap_bid_guy USING btree (address_id);
+CREATE INDEX vow_flog_header_index ON maker.vow_flog USING btree (header_id);
 
 
 --
--- Name: flap_bid_guy_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_flop_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_guy_bid_id_index ON maker.flap_bid_guy USING btree (bid_id);
+CREATE INDEX vow_flop_header_index ON maker.vow_flop USING btree (header_id);
 
 
 --
--- Name: flap_bid_guy_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_heal_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_guy_block_number_index ON maker.flap_bid_guy USING btree (block_number);
+CREATE INDEX vow_heal_header_index ON maker.vow_heal USING btree (header_id);
 
 
 --
--- Name: flap_bid_lot_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_kiss_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_lot_bid_address_id_index ON maker.flap_bid_lot USING btree (address_id);
+CREATE INDEX vow_kiss_header_index ON maker.vow_kiss USING btree (header_id);
 
 
 --
--- Name: flap_bid_lot_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_sin_mapping_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_lot_bid_id_index ON maker.flap_bid_lot USING btree (bid_id);
+CREATE INDEX vow_sin_mapping_block_number_index ON maker.vow_sin_mapping USING btree (block_number);
 
 
 --
--- Name: flap_bid_lot_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vow_sin_mapping_era_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_lot_block_number_index ON maker.flap_bid_lot USING btree (block_number);
+CREATE INDEX vow_sin_mapping_era_index ON maker.vow_sin_mapping USING btree (era);
 
 
 --
--- Name: flap_bid_tic_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: wards_address_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_tic_bid_address_id_index ON maker.flap_bid_tic USING btree (address_id);
+CREATE INDEX wards_address_index ON maker.wards USING btree (address_id);
 
 
 --
--- Name: flap_bid_tic_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: wards_block_number_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_tic_bid_id_index ON maker.flap_bid_tic USING btree (bid_id);
+CREATE INDEX wards_block_number_index ON maker.wards USING btree (block_number);
 
 
 --
--- Name: flap_bid_tic_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: yank_bid_id_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_bid_tic_block_number_index ON maker.flap_bid_tic USING btree (block_number);
+CREATE INDEX yank_bid_id_index ON maker.yank USING btree (bid_id);
 
 
 --
--- Name: flap_kick_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: yank_header_index; Type: INDEX; Schema: maker; Owner: -
 --
 
-CREATE INDEX flap_kick_header_index ON maker.flap_kick USING btree (header_id);
+CREATE INDEX yank_header_index ON maker.yank USING btree (header_id);
 
 
 --
--- Name: flap_kicks_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: block_id_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE INDEX flap_kicks_address_id_index ON maker.flap_kicks USING btree (address_id);
+CREATE INDEX block_id_index ON public.full_sync_transactions USING btree (block_id);
 
 
 --
--- Name: flap_kicks_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: headers_block_number; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE INDEX flap_kicks_block_number_index ON maker.flap_kicks USING btree (block_number);
+CREATE INDEX headers_block_number ON public.headers USING btree (block_number);
 
 
 --
--- Name: flap_kicks_kicks_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: node_id_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE INDEX flap_kicks_kicks_index ON maker.flap_kicks USING btree (kicks);
+CREATE INDEX node_id_index ON public.blocks USING btree (eth_node_id);
 
 
 --
--- Name: flip_bid_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: number_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE INDEX flip_bid_bid_address_id_index ON maker.flip_bid_bid USING btree (address_id);
+CREATE INDEX number_index ON public.blocks USING btree (number);
 
 
 --
--- Name: flip_bid_bid_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: tx_from_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE INDEX flip_bid_bid_bid_id_index ON maker.flip_bid_bid USING btree (bid_id);
+CREATE INDEX tx_from_index ON public.full_sync_transactions USING btree (tx_from);
 
 
 --
--- Name: flip_bid_bid_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: tx_to_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE INDEX flip_bid_bid_block_number_index ON maker.flip_bid_bid USING btree (block_number);
+CREATE INDEX tx_to_index ON public.full_sync_transactions USING btree (tx_to);
 
 
 --
--- Name: flip_bid_end_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flap_bid_bid flap_bid_bid; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_end_address_id_index ON maker.flip_bid_end USING btree (address_id);
+CREATE TRIGGER flap_bid_bid AFTER INSERT OR UPDATE ON maker.flap_bid_bid FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_bid();
 
 
 --
--- Name: flip_bid_end_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flap_bid_end flap_bid_end; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_end_bid_id_index ON maker.flip_bid_end USING btree (bid_id);
+CREATE TRIGGER flap_bid_end AFTER INSERT OR UPDATE ON maker.flap_bid_end FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_end();
 
 
 --
--- Name: flip_bid_end_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flap_bid_guy flap_bid_guy; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_end_block_number_index ON maker.flip_bid_end USING btree (block_number);
+CREATE TRIGGER flap_bid_guy AFTER INSERT OR UPDATE ON maker.flap_bid_guy FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_guy();
 
 
 --
--- Name: flip_bid_gal_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flap_bid_lot flap_bid_lot; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_gal_address_id_index ON maker.flip_bid_gal USING btree (address_id);
+CREATE TRIGGER flap_bid_lot AFTER INSERT OR UPDATE ON maker.flap_bid_lot FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_lot();
 
 
 --
--- Name: flip_bid_gal_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flap_bid_tic flap_bid_tic; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_gal_bid_id_index ON maker.flip_bid_gal USING btree (bid_id);
+CREATE TRIGGER flap_bid_tic AFTER INSERT OR UPDATE ON maker.flap_bid_tic FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_tic();
 
 
 --
--- Name: flip_bid_gal_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flap_kick flap_created_trigger; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_gal_block_number_index ON maker.flip_bid_gal USING btree (block_number);
+CREATE TRIGGER flap_created_trigger AFTER INSERT ON maker.flap_kick FOR EACH ROW EXECUTE PROCEDURE maker.flap_created();
 
 
 --
--- Name: flip_bid_guy_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_bid_bid flip_bid_bid; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_guy_address_id_index ON maker.flip_bid_guy USING btree (address_id);
+CREATE TRIGGER flip_bid_bid AFTER INSERT OR UPDATE ON maker.flip_bid_bid FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_bid();
 
 
 --
--- Name: flip_bid_guy_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_bid_end flip_bid_end; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_guy_bid_id_index ON maker.flip_bid_guy USING btree (bid_id);
+CREATE TRIGGER flip_bid_end AFTER INSERT OR UPDATE ON maker.flip_bid_end FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_end();
 
 
 --
--- Name: flip_bid_guy_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_bid_gal flip_bid_gal; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_guy_block_number_index ON maker.flip_bid_guy USING btree (block_number);
+CREATE TRIGGER flip_bid_gal AFTER INSERT OR UPDATE ON maker.flip_bid_gal FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_gal();
 
 
 --
--- Name: flip_bid_lot_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_bid_guy flip_bid_guy; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_lot_address_id_index ON maker.flip_bid_lot USING btree (address_id);
+CREATE TRIGGER flip_bid_guy AFTER INSERT OR UPDATE ON maker.flip_bid_guy FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_guy();
 
 
 --
--- Name: flip_bid_lot_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_bid_lot flip_bid_lot; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_lot_bid_id_index ON maker.flip_bid_lot USING btree (bid_id);
+CREATE TRIGGER flip_bid_lot AFTER INSERT OR UPDATE ON maker.flip_bid_lot FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_lot();
 
 
 --
--- Name: flip_bid_lot_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_bid_tab flip_bid_tab; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_lot_block_number_index ON maker.flip_bid_lot USING btree (block_number);
+CREATE TRIGGER flip_bid_tab AFTER INSERT OR UPDATE ON maker.flip_bid_tab FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_tab();
 
 
 --
--- Name: flip_bid_tab_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_bid_tic flip_bid_tic; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_tab_address_id_index ON maker.flip_bid_tab USING btree (address_id);
+CREATE TRIGGER flip_bid_tic AFTER INSERT OR UPDATE ON maker.flip_bid_tic FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_tic();
 
 
 --
--- Name: flip_bid_tab_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flip_kick flip_created_trigger; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_tab_bid_id_index ON maker.flip_bid_tab USING btree (bid_id);
+CREATE TRIGGER flip_created_trigger AFTER INSERT ON maker.flip_kick FOR EACH ROW EXECUTE PROCEDURE maker.flip_created();
 
 
 --
--- Name: flip_bid_tab_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flop_bid_bid flop_bid_bid; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_tab_block_number_index ON maker.flip_bid_tab USING btree (block_number);
+CREATE TRIGGER flop_bid_bid AFTER INSERT OR UPDATE ON maker.flop_bid_bid FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_bid();
 
 
 --
--- Name: flip_bid_tic_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flop_bid_end flop_bid_end; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_tic_address_id_index ON maker.flip_bid_tic USING btree (address_id);
+CREATE TRIGGER flop_bid_end AFTER INSERT OR UPDATE ON maker.flop_bid_end FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_end();
 
 
 --
--- Name: flip_bid_tic_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flop_bid_guy flop_bid_guy; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_tic_bid_id_index ON maker.flip_bid_tic USING btree (bid_id);
+CREATE TRIGGER flop_bid_guy AFTER INSERT OR UPDATE ON maker.flop_bid_guy FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_guy();
 
 
 --
--- Name: flip_bid_tic_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flop_bid_lot flop_bid_lot; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_tic_block_number_index ON maker.flip_bid_tic USING btree (block_number);
+CREATE TRIGGER flop_bid_lot AFTER INSERT OR UPDATE ON maker.flop_bid_lot FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_lot();
 
 
 --
--- Name: flip_bid_usr_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flop_bid_tic flop_bid_tic; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_usr_address_id_index ON maker.flip_bid_usr USING btree (address_id);
+CREATE TRIGGER flop_bid_tic AFTER INSERT OR UPDATE ON maker.flop_bid_tic FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_tic();
 
 
 --
--- Name: flip_bid_usr_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: flop_kick flop_created_trigger; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_usr_bid_id_index ON maker.flip_bid_usr USING btree (bid_id);
+CREATE TRIGGER flop_created_trigger AFTER INSERT ON maker.flop_kick FOR EACH ROW EXECUTE PROCEDURE maker.flop_created();
 
 
 --
--- Name: flip_bid_usr_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_art ilk_art; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_bid_usr_block_number_index ON maker.flip_bid_usr USING btree (block_number);
+CREATE TRIGGER ilk_art AFTER INSERT OR UPDATE ON maker.vat_ilk_art FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_art();
 
 
 --
--- Name: flip_ilk_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_ilk_chop ilk_chop; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_ilk_block_number_index ON maker.flip_ilk USING btree (block_number);
+CREATE TRIGGER ilk_chop AFTER INSERT OR UPDATE ON maker.cat_ilk_chop FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_chop();
 
 
 --
--- Name: flip_ilk_ilk_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_dust ilk_dust; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_ilk_ilk_id_index ON maker.flip_ilk USING btree (ilk_id);
+CREATE TRIGGER ilk_dust AFTER INSERT OR UPDATE ON maker.vat_ilk_dust FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_dust();
 
 
 --
--- Name: flip_kick_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: jug_ilk_duty ilk_duty; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_kick_address_id_index ON maker.flip_kick USING btree (address_id);
+CREATE TRIGGER ilk_duty AFTER INSERT OR UPDATE ON maker.jug_ilk_duty FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_duty();
 
 
 --
--- Name: flip_kick_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_ilk_flip ilk_flip; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_kick_bid_id_index ON maker.flip_kick USING btree (bid_id);
+CREATE TRIGGER ilk_flip AFTER INSERT OR UPDATE ON maker.cat_ilk_flip FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_flip();
 
 
 --
--- Name: flip_kick_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_line ilk_line; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_kick_header_index ON maker.flip_kick USING btree (header_id);
+CREATE TRIGGER ilk_line AFTER INSERT OR UPDATE ON maker.vat_ilk_line FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_line();
 
 
 --
--- Name: flip_kicks_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_ilk_lump ilk_lump; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_kicks_address_id_index ON maker.flip_kicks USING btree (address_id);
+CREATE TRIGGER ilk_lump AFTER INSERT OR UPDATE ON maker.cat_ilk_lump FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_lump();
 
 
 --
--- Name: flip_kicks_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: spot_ilk_mat ilk_mat; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_kicks_block_number_index ON maker.flip_kicks USING btree (block_number);
+CREATE TRIGGER ilk_mat AFTER INSERT OR UPDATE ON maker.spot_ilk_mat FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_mat();
 
 
 --
--- Name: flip_kicks_kicks_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: spot_ilk_pip ilk_pip; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flip_kicks_kicks_index ON maker.flip_kicks USING btree (kicks);
+CREATE TRIGGER ilk_pip AFTER INSERT OR UPDATE ON maker.spot_ilk_pip FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_pip();
 
 
 --
--- Name: flop_bid_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_rate ilk_rate; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_bid_address_id_index ON maker.flop_bid_bid USING btree (address_id);
+CREATE TRIGGER ilk_rate AFTER INSERT OR UPDATE ON maker.vat_ilk_rate FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_rate();
 
 
 --
--- Name: flop_bid_bid_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: jug_ilk_rho ilk_rho; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_bid_bid_id_index ON maker.flop_bid_bid USING btree (bid_id);
+CREATE TRIGGER ilk_rho AFTER INSERT OR UPDATE ON maker.jug_ilk_rho FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_rho();
 
 
 --
--- Name: flop_bid_bid_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: vat_ilk_spot ilk_spot; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_bid_block_number_index ON maker.flop_bid_bid USING btree (block_number);
+CREATE TRIGGER ilk_spot AFTER INSERT OR UPDATE ON maker.vat_ilk_spot FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_spot();
 
 
 --
--- Name: flop_bid_end_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_cdpi managed_cdp_cdpi; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_end_bid_address_id_index ON maker.flop_bid_end USING btree (address_id);
+CREATE TRIGGER managed_cdp_cdpi AFTER INSERT OR UPDATE ON maker.cdp_manager_cdpi FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_created();
 
 
 --
--- Name: flop_bid_end_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_ilks managed_cdp_ilk; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_end_bid_id_index ON maker.flop_bid_end USING btree (bid_id);
+CREATE TRIGGER managed_cdp_ilk AFTER INSERT OR UPDATE ON maker.cdp_manager_ilks FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_ilk_identifier();
 
 
 --
--- Name: flop_bid_end_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_urns managed_cdp_urn; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_end_block_number_index ON maker.flop_bid_end USING btree (block_number);
+CREATE TRIGGER managed_cdp_urn AFTER INSERT OR UPDATE ON maker.cdp_manager_urns FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_urn_identifier();
 
 
 --
--- Name: flop_bid_guy_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_owns managed_cdp_usr; Type: TRIGGER; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_guy_bid_address_id_index ON maker.flop_bid_guy USING btree (address_id);
+CREATE TRIGGER managed_cdp_usr AFTER INSERT OR UPDATE ON maker.cdp_manager_owns FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_usr();
 
 
 --
--- Name: flop_bid_guy_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: auction_file auction_file_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_guy_bid_id_index ON maker.flop_bid_guy USING btree (bid_id);
+ALTER TABLE ONLY maker.auction_file
+    ADD CONSTRAINT auction_file_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_guy_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: auction_file auction_file_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_guy_block_number_index ON maker.flop_bid_guy USING btree (block_number);
+ALTER TABLE ONLY maker.auction_file
+    ADD CONSTRAINT auction_file_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_lot_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: auction_file auction_file_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_lot_bid_address_id_index ON maker.flop_bid_lot USING btree (address_id);
+ALTER TABLE ONLY maker.auction_file
+    ADD CONSTRAINT auction_file_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_lot_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: auth auth_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_lot_bid_id_index ON maker.flop_bid_lot USING btree (bid_id);
+ALTER TABLE ONLY maker.auth
+    ADD CONSTRAINT auth_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_lot_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: auth auth_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_lot_block_number_index ON maker.flop_bid_lot USING btree (block_number);
+ALTER TABLE ONLY maker.auth
+    ADD CONSTRAINT auth_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_tic_bid_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: auth auth_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_tic_bid_address_id_index ON maker.flop_bid_tic USING btree (address_id);
+ALTER TABLE ONLY maker.auth
+    ADD CONSTRAINT auth_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_tic_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: bite bite_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_tic_bid_id_index ON maker.flop_bid_tic USING btree (bid_id);
+ALTER TABLE ONLY maker.bite
+    ADD CONSTRAINT bite_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_tic_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: bite bite_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_bid_tic_block_number_index ON maker.flop_bid_tic USING btree (block_number);
+ALTER TABLE ONLY maker.bite
+    ADD CONSTRAINT bite_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kick_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: bite bite_urn_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_kick_header_index ON maker.flop_kick USING btree (header_id);
+ALTER TABLE ONLY maker.bite
+    ADD CONSTRAINT bite_urn_id_fkey FOREIGN KEY (urn_id) REFERENCES maker.urns(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kicks_address_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cage cage_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_kicks_address_id_index ON maker.flop_kicks USING btree (address_id);
+ALTER TABLE ONLY maker.cage
+    ADD CONSTRAINT cage_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kicks_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cage cage_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_kicks_block_number_index ON maker.flop_kicks USING btree (block_number);
+ALTER TABLE ONLY maker.cage
+    ADD CONSTRAINT cage_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kicks_kicks_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cage cage_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX flop_kicks_kicks_index ON maker.flop_kicks USING btree (kicks);
+ALTER TABLE ONLY maker.cage
+    ADD CONSTRAINT cage_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_drip_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_chop_lump cat_file_chop_lump_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_drip_header_index ON maker.jug_drip USING btree (header_id);
+ALTER TABLE ONLY maker.cat_file_chop_lump
+    ADD CONSTRAINT cat_file_chop_lump_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_drip_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_chop_lump cat_file_chop_lump_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_drip_ilk_index ON maker.jug_drip USING btree (ilk_id);
+ALTER TABLE ONLY maker.cat_file_chop_lump
+    ADD CONSTRAINT cat_file_chop_lump_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_base_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_chop_lump cat_file_chop_lump_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_file_base_header_index ON maker.jug_file_base USING btree (header_id);
+ALTER TABLE ONLY maker.cat_file_chop_lump
+    ADD CONSTRAINT cat_file_chop_lump_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_ilk_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_flip cat_file_flip_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_file_ilk_header_index ON maker.jug_file_ilk USING btree (header_id);
+ALTER TABLE ONLY maker.cat_file_flip
+    ADD CONSTRAINT cat_file_flip_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_ilk_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_flip cat_file_flip_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_file_ilk_ilk_index ON maker.jug_file_ilk USING btree (ilk_id);
+ALTER TABLE ONLY maker.cat_file_flip
+    ADD CONSTRAINT cat_file_flip_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_vow_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_flip cat_file_flip_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_file_vow_header_index ON maker.jug_file_vow USING btree (header_id);
+ALTER TABLE ONLY maker.cat_file_flip
+    ADD CONSTRAINT cat_file_flip_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_duty_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_vow cat_file_vow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_ilk_duty_block_number_index ON maker.jug_ilk_duty USING btree (block_number);
+ALTER TABLE ONLY maker.cat_file_vow
+    ADD CONSTRAINT cat_file_vow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_duty_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_file_vow cat_file_vow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_ilk_duty_ilk_index ON maker.jug_ilk_duty USING btree (ilk_id);
+ALTER TABLE ONLY maker.cat_file_vow
+    ADD CONSTRAINT cat_file_vow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_rho_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_ilk_chop cat_ilk_chop_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_ilk_rho_block_number_index ON maker.jug_ilk_rho USING btree (block_number);
+ALTER TABLE ONLY maker.cat_ilk_chop
+    ADD CONSTRAINT cat_ilk_chop_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_rho_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_ilk_flip cat_ilk_flip_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_ilk_rho_ilk_index ON maker.jug_ilk_rho USING btree (ilk_id);
+ALTER TABLE ONLY maker.cat_ilk_flip
+    ADD CONSTRAINT cat_ilk_flip_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_init_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cat_ilk_lump cat_ilk_lump_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_init_header_index ON maker.jug_init USING btree (header_id);
+ALTER TABLE ONLY maker.cat_ilk_lump
+    ADD CONSTRAINT cat_ilk_lump_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_init_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_allow cdp_manager_cdp_allow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX jug_init_ilk_index ON maker.jug_init USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_cdp_allow
+    ADD CONSTRAINT cdp_manager_cdp_allow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_file_mat_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_cdp_allow cdp_manager_cdp_allow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_file_mat_header_index ON maker.spot_file_mat USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_cdp_allow
+    ADD CONSTRAINT cdp_manager_cdp_allow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_file_mat_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_enter cdp_manager_enter_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_file_mat_ilk_index ON maker.spot_file_mat USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_enter
+    ADD CONSTRAINT cdp_manager_enter_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_file_pip_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_enter cdp_manager_enter_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_file_pip_header_index ON maker.spot_file_pip USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_enter
+    ADD CONSTRAINT cdp_manager_enter_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_file_pip_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_flux cdp_manager_flux_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_file_pip_ilk_index ON maker.spot_file_pip USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_flux
+    ADD CONSTRAINT cdp_manager_flux_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_ilk_mat_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_flux cdp_manager_flux_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_ilk_mat_block_number_index ON maker.spot_ilk_mat USING btree (block_number);
+ALTER TABLE ONLY maker.cdp_manager_flux
+    ADD CONSTRAINT cdp_manager_flux_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_ilk_mat_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_frob cdp_manager_frob_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_ilk_mat_ilk_index ON maker.spot_ilk_mat USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_frob
+    ADD CONSTRAINT cdp_manager_frob_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_ilk_pip_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_frob cdp_manager_frob_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_ilk_pip_block_number_index ON maker.spot_ilk_pip USING btree (block_number);
+ALTER TABLE ONLY maker.cdp_manager_frob
+    ADD CONSTRAINT cdp_manager_frob_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_ilk_pip_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_give cdp_manager_give_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_ilk_pip_ilk_index ON maker.spot_ilk_pip USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_give
+    ADD CONSTRAINT cdp_manager_give_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_poke_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_give cdp_manager_give_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_poke_header_index ON maker.spot_poke USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_give
+    ADD CONSTRAINT cdp_manager_give_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_poke_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_ilks cdp_manager_ilks_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX spot_poke_ilk_index ON maker.spot_poke USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_ilks
+    ADD CONSTRAINT cdp_manager_ilks_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: tend_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_move cdp_manager_move_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX tend_header_index ON maker.tend USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_move
+    ADD CONSTRAINT cdp_manager_move_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: tick_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_move cdp_manager_move_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX tick_bid_id_index ON maker.tick USING btree (bid_id);
+ALTER TABLE ONLY maker.cdp_manager_move
+    ADD CONSTRAINT cdp_manager_move_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: tick_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_quit cdp_manager_quit_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX tick_header_index ON maker.tick USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_quit
+    ADD CONSTRAINT cdp_manager_quit_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: urn_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_quit cdp_manager_quit_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX urn_ilk_index ON maker.urns USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_quit
+    ADD CONSTRAINT cdp_manager_quit_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_file_debt_ceiling_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_shift cdp_manager_shift_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_file_debt_ceiling_header_index ON maker.vat_file_debt_ceiling USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_shift
+    ADD CONSTRAINT cdp_manager_shift_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_file_ilk_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_shift cdp_manager_shift_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_file_ilk_header_index ON maker.vat_file_ilk USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_shift
+    ADD CONSTRAINT cdp_manager_shift_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_file_ilk_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_allow cdp_manager_urn_allow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_file_ilk_ilk_index ON maker.vat_file_ilk USING btree (ilk_id);
+ALTER TABLE ONLY maker.cdp_manager_urn_allow
+    ADD CONSTRAINT cdp_manager_urn_allow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_flux_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: cdp_manager_urn_allow cdp_manager_urn_allow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_flux_header_index ON maker.vat_flux USING btree (header_id);
+ALTER TABLE ONLY maker.cdp_manager_urn_allow
+    ADD CONSTRAINT cdp_manager_urn_allow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_flux_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_etch chief_etch_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_flux_ilk_index ON maker.vat_flux USING btree (ilk_id);
+ALTER TABLE ONLY maker.chief_etch
+    ADD CONSTRAINT chief_etch_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_fold_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_etch chief_etch_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_fold_header_index ON maker.vat_fold USING btree (header_id);
+ALTER TABLE ONLY maker.chief_etch
+    ADD CONSTRAINT chief_etch_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_fold_urn_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_free chief_free_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_fold_urn_index ON maker.vat_fold USING btree (urn_id);
+ALTER TABLE ONLY maker.chief_free
+    ADD CONSTRAINT chief_free_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_fork_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_free chief_free_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_fork_header_index ON maker.vat_fork USING btree (header_id);
+ALTER TABLE ONLY maker.chief_free
+    ADD CONSTRAINT chief_free_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_fork_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_lift chief_lift_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_fork_ilk_index ON maker.vat_fork USING btree (ilk_id);
+ALTER TABLE ONLY maker.chief_lift
+    ADD CONSTRAINT chief_lift_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_frob_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_lift chief_lift_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_frob_header_index ON maker.vat_frob USING btree (header_id);
+ALTER TABLE ONLY maker.chief_lift
+    ADD CONSTRAINT chief_lift_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_frob_urn_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_lock chief_lock_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_frob_urn_index ON maker.vat_frob USING btree (urn_id);
+ALTER TABLE ONLY maker.chief_lock
+    ADD CONSTRAINT chief_lock_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_gem_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_lock chief_lock_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_gem_ilk_index ON maker.vat_gem USING btree (ilk_id);
+ALTER TABLE ONLY maker.chief_lock
+    ADD CONSTRAINT chief_lock_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_grab_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_vote chief_vote_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_grab_header_index ON maker.vat_grab USING btree (header_id);
+ALTER TABLE ONLY maker.chief_vote
+    ADD CONSTRAINT chief_vote_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_grab_urn_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_vote chief_vote_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_grab_urn_index ON maker.vat_grab USING btree (urn_id);
+ALTER TABLE ONLY maker.chief_vote
+    ADD CONSTRAINT chief_vote_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_heal_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_vote_yays chief_vote_yays_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_heal_header_index ON maker.vat_heal USING btree (header_id);
+ALTER TABLE ONLY maker.chief_vote_yays
+    ADD CONSTRAINT chief_vote_yays_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_art_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: chief_vote_yays chief_vote_yays_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_art_block_number_index ON maker.vat_ilk_art USING btree (block_number);
+ALTER TABLE ONLY maker.chief_vote_yays
+    ADD CONSTRAINT chief_vote_yays_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_art_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_approval dai_approval_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_art_ilk_index ON maker.vat_ilk_art USING btree (ilk_id);
+ALTER TABLE ONLY maker.dai_approval
+    ADD CONSTRAINT dai_approval_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_dust_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_approval dai_approval_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_dust_block_number_index ON maker.vat_ilk_dust USING btree (block_number);
+ALTER TABLE ONLY maker.dai_approval
+    ADD CONSTRAINT dai_approval_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_dust_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_exit dai_exit_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_dust_ilk_index ON maker.vat_ilk_dust USING btree (ilk_id);
+ALTER TABLE ONLY maker.dai_exit
+    ADD CONSTRAINT dai_exit_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_line_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_exit dai_exit_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_line_block_number_index ON maker.vat_ilk_line USING btree (block_number);
+ALTER TABLE ONLY maker.dai_exit
+    ADD CONSTRAINT dai_exit_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_line_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_exit dai_exit_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_line_ilk_index ON maker.vat_ilk_line USING btree (ilk_id);
+ALTER TABLE ONLY maker.dai_exit
+    ADD CONSTRAINT dai_exit_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_rate_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_join dai_join_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_rate_block_number_index ON maker.vat_ilk_rate USING btree (block_number);
+ALTER TABLE ONLY maker.dai_join
+    ADD CONSTRAINT dai_join_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_rate_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_join dai_join_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_rate_ilk_index ON maker.vat_ilk_rate USING btree (ilk_id);
+ALTER TABLE ONLY maker.dai_join
+    ADD CONSTRAINT dai_join_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_spot_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_join dai_join_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_spot_block_number_index ON maker.vat_ilk_spot USING btree (block_number);
+ALTER TABLE ONLY maker.dai_join
+    ADD CONSTRAINT dai_join_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_spot_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_transfer dai_transfer_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_ilk_spot_ilk_index ON maker.vat_ilk_spot USING btree (ilk_id);
+ALTER TABLE ONLY maker.dai_transfer
+    ADD CONSTRAINT dai_transfer_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_init_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dai_transfer dai_transfer_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_init_header_index ON maker.vat_init USING btree (header_id);
+ALTER TABLE ONLY maker.dai_transfer
+    ADD CONSTRAINT dai_transfer_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_init_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: deal deal_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_init_ilk_index ON maker.vat_init USING btree (ilk_id);
+ALTER TABLE ONLY maker.deal
+    ADD CONSTRAINT deal_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_move_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: deal deal_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_move_header_index ON maker.vat_move USING btree (header_id);
+ALTER TABLE ONLY maker.deal
+    ADD CONSTRAINT deal_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_slip_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: deal deal_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_slip_header_index ON maker.vat_slip USING btree (header_id);
+ALTER TABLE ONLY maker.deal
+    ADD CONSTRAINT deal_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_slip_ilk_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dent dent_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_slip_ilk_index ON maker.vat_slip USING btree (ilk_id);
+ALTER TABLE ONLY maker.dent
+    ADD CONSTRAINT dent_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_suck_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dent dent_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_suck_header_index ON maker.vat_suck USING btree (header_id);
+ALTER TABLE ONLY maker.dent
+    ADD CONSTRAINT dent_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_urn_art_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: dent dent_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_urn_art_block_number_index ON maker.vat_urn_art USING btree (block_number);
+ALTER TABLE ONLY maker.dent
+    ADD CONSTRAINT dent_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_urn_art_urn_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cage end_cage_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_urn_art_urn_index ON maker.vat_urn_art USING btree (urn_id);
+ALTER TABLE ONLY maker.end_cage
+    ADD CONSTRAINT end_cage_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_urn_ink_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cage_ilk end_cage_ilk_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_urn_ink_block_number_index ON maker.vat_urn_ink USING btree (block_number);
+ALTER TABLE ONLY maker.end_cage_ilk
+    ADD CONSTRAINT end_cage_ilk_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_urn_ink_urn_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cage_ilk end_cage_ilk_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vat_urn_ink_urn_index ON maker.vat_urn_ink USING btree (urn_id);
+ALTER TABLE ONLY maker.end_cage_ilk
+    ADD CONSTRAINT end_cage_ilk_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: vow_fess_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cage_ilk end_cage_ilk_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vow_fess_header_index ON maker.vow_fess USING btree (header_id);
+ALTER TABLE ONLY maker.end_cage_ilk
+    ADD CONSTRAINT end_cage_ilk_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vow_file_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cage end_cage_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vow_file_header_index ON maker.vow_file USING btree (header_id);
+ALTER TABLE ONLY maker.end_cage
+    ADD CONSTRAINT end_cage_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vow_flog_era_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cash end_cash_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vow_flog_era_index ON maker.vow_flog USING btree (era);
+ALTER TABLE ONLY maker.end_cash
+    ADD CONSTRAINT end_cash_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: vow_flog_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cash end_cash_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vow_flog_header_index ON maker.vow_flog USING btree (header_id);
+ALTER TABLE ONLY maker.end_cash
+    ADD CONSTRAINT end_cash_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: vow_sin_mapping_block_number_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_cash end_cash_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vow_sin_mapping_block_number_index ON maker.vow_sin_mapping USING btree (block_number);
+ALTER TABLE ONLY maker.end_cash
+    ADD CONSTRAINT end_cash_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: vow_sin_mapping_era_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_flow end_flow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX vow_sin_mapping_era_index ON maker.vow_sin_mapping USING btree (era);
+ALTER TABLE ONLY maker.end_flow
+    ADD CONSTRAINT end_flow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: yank_bid_id_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_flow end_flow_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX yank_bid_id_index ON maker.yank USING btree (bid_id);
+ALTER TABLE ONLY maker.end_flow
+    ADD CONSTRAINT end_flow_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: yank_header_index; Type: INDEX; Schema: maker; Owner: -
+-- Name: end_flow end_flow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX yank_header_index ON maker.yank USING btree (header_id);
+ALTER TABLE ONLY maker.end_flow
+    ADD CONSTRAINT end_flow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: block_id_index; Type: INDEX; Schema: public; Owner: -
+-- Name: end_free end_free_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX block_id_index ON public.full_sync_transactions USING btree (block_id);
+ALTER TABLE ONLY maker.end_free
+    ADD CONSTRAINT end_free_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: headers_block_number; Type: INDEX; Schema: public; Owner: -
+-- Name: end_free end_free_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX headers_block_number ON public.headers USING btree (block_number);
+ALTER TABLE ONLY maker.end_free
+    ADD CONSTRAINT end_free_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: node_id_index; Type: INDEX; Schema: public; Owner: -
+-- Name: end_free end_free_urn_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX node_id_index ON public.blocks USING btree (eth_node_id);
+ALTER TABLE ONLY maker.end_free
+    ADD CONSTRAINT end_free_urn_id_fkey FOREIGN KEY (urn_id) REFERENCES maker.urns(id) ON DELETE CASCADE;
 
 
 --
--- Name: number_index; Type: INDEX; Schema: public; Owner: -
+-- Name: end_ilk_art end_ilk_art_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX number_index ON public.blocks USING btree (number);
+ALTER TABLE ONLY maker.end_ilk_art
+    ADD CONSTRAINT end_ilk_art_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: tx_from_index; Type: INDEX; Schema: public; Owner: -
+-- Name: end_ilk_fix end_ilk_fix_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX tx_from_index ON public.full_sync_transactions USING btree (tx_from);
+ALTER TABLE ONLY maker.end_ilk_fix
+    ADD CONSTRAINT end_ilk_fix_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: tx_to_index; Type: INDEX; Schema: public; Owner: -
+-- Name: end_ilk_gap end_ilk_gap_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE INDEX tx_to_index ON public.full_sync_transactions USING btree (tx_to);
+ALTER TABLE ONLY maker.end_ilk_gap
+    ADD CONSTRAINT end_ilk_gap_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_bid flap_bid_bid; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_ilk_tag end_ilk_tag_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flap_bid_bid AFTER INSERT OR UPDATE ON maker.flap_bid_bid FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_bid();
+ALTER TABLE ONLY maker.end_ilk_tag
+    ADD CONSTRAINT end_ilk_tag_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_end flap_bid_end; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_out end_out_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flap_bid_end AFTER INSERT OR UPDATE ON maker.flap_bid_end FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_end();
+ALTER TABLE ONLY maker.end_out
+    ADD CONSTRAINT end_out_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_guy flap_bid_guy; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_pack end_pack_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flap_bid_guy AFTER INSERT OR UPDATE ON maker.flap_bid_guy FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_guy();
+ALTER TABLE ONLY maker.end_pack
+    ADD CONSTRAINT end_pack_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_lot flap_bid_lot; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_pack end_pack_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flap_bid_lot AFTER INSERT OR UPDATE ON maker.flap_bid_lot FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_lot();
+ALTER TABLE ONLY maker.end_pack
+    ADD CONSTRAINT end_pack_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_tic flap_bid_tic; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_skim end_skim_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flap_bid_tic AFTER INSERT OR UPDATE ON maker.flap_bid_tic FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flap_tic();
+ALTER TABLE ONLY maker.end_skim
+    ADD CONSTRAINT end_skim_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_kick flap_created_trigger; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_skim end_skim_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flap_created_trigger AFTER INSERT ON maker.flap_kick FOR EACH ROW EXECUTE PROCEDURE maker.flap_created();
+ALTER TABLE ONLY maker.end_skim
+    ADD CONSTRAINT end_skim_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_bid flip_bid_bid; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_skim end_skim_urn_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_bid_bid AFTER INSERT OR UPDATE ON maker.flip_bid_bid FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_bid();
+ALTER TABLE ONLY maker.end_skim
+    ADD CONSTRAINT end_skim_urn_id_fkey FOREIGN KEY (urn_id) REFERENCES maker.urns(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_end flip_bid_end; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_thaw end_thaw_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_bid_end AFTER INSERT OR UPDATE ON maker.flip_bid_end FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_end();
+ALTER TABLE ONLY maker.end_thaw
+    ADD CONSTRAINT end_thaw_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_gal flip_bid_gal; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: end_thaw end_thaw_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_bid_gal AFTER INSERT OR UPDATE ON maker.flip_bid_gal FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_gal();
+ALTER TABLE ONLY maker.end_thaw
+    ADD CONSTRAINT end_thaw_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_guy flip_bid_guy; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: esm_fire esm_fire_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_bid_guy AFTER INSERT OR UPDATE ON maker.flip_bid_guy FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_guy();
+ALTER TABLE ONLY maker.esm_fire
+    ADD CONSTRAINT esm_fire_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_lot flip_bid_lot; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: esm_fire esm_fire_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_bid_lot AFTER INSERT OR UPDATE ON maker.flip_bid_lot FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_lot();
+ALTER TABLE ONLY maker.esm_fire
+    ADD CONSTRAINT esm_fire_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_tab flip_bid_tab; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: esm_join esm_join_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_bid_tab AFTER INSERT OR UPDATE ON maker.flip_bid_tab FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_tab();
+ALTER TABLE ONLY maker.esm_join
+    ADD CONSTRAINT esm_join_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_tic flip_bid_tic; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: esm_join esm_join_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_bid_tic AFTER INSERT OR UPDATE ON maker.flip_bid_tic FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flip_tic();
+ALTER TABLE ONLY maker.esm_join
+    ADD CONSTRAINT esm_join_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_kick flip_created_trigger; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap flap_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flip_created_trigger AFTER INSERT ON maker.flip_kick FOR EACH ROW EXECUTE PROCEDURE maker.flip_created();
+ALTER TABLE ONLY maker.flap
+    ADD CONSTRAINT flap_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_bid flop_bid_bid; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_beg flap_beg_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flop_bid_bid AFTER INSERT OR UPDATE ON maker.flop_bid_bid FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_bid();
+ALTER TABLE ONLY maker.flap_beg
+    ADD CONSTRAINT flap_beg_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_end flop_bid_end; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_bid_bid flap_bid_bid_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flop_bid_end AFTER INSERT OR UPDATE ON maker.flop_bid_end FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_end();
+ALTER TABLE ONLY maker.flap_bid_bid
+    ADD CONSTRAINT flap_bid_bid_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_guy flop_bid_guy; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_bid_end flap_bid_end_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flop_bid_guy AFTER INSERT OR UPDATE ON maker.flop_bid_guy FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_guy();
+ALTER TABLE ONLY maker.flap_bid_end
+    ADD CONSTRAINT flap_bid_end_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_lot flop_bid_lot; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_bid_guy flap_bid_guy_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flop_bid_lot AFTER INSERT OR UPDATE ON maker.flop_bid_lot FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_lot();
+ALTER TABLE ONLY maker.flap_bid_guy
+    ADD CONSTRAINT flap_bid_guy_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_tic flop_bid_tic; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_bid_lot flap_bid_lot_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flop_bid_tic AFTER INSERT OR UPDATE ON maker.flop_bid_tic FOR EACH ROW EXECUTE PROCEDURE maker.insert_updated_flop_tic();
+ALTER TABLE ONLY maker.flap_bid_lot
+    ADD CONSTRAINT flap_bid_lot_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kick flop_created_trigger; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_bid_tic flap_bid_tic_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER flop_created_trigger AFTER INSERT ON maker.flop_kick FOR EACH ROW EXECUTE PROCEDURE maker.flop_created();
+ALTER TABLE ONLY maker.flap_bid_tic
+    ADD CONSTRAINT flap_bid_tic_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_art ilk_art; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_gem flap_gem_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_art AFTER INSERT OR UPDATE ON maker.vat_ilk_art FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_art();
+ALTER TABLE ONLY maker.flap_gem
+    ADD CONSTRAINT flap_gem_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_ilk_chop ilk_chop; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_kick flap_kick_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_chop AFTER INSERT OR UPDATE ON maker.cat_ilk_chop FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_chop();
+ALTER TABLE ONLY maker.flap_kick
+    ADD CONSTRAINT flap_kick_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_dust ilk_dust; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_kick flap_kick_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_dust AFTER INSERT OR UPDATE ON maker.vat_ilk_dust FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_dust();
+ALTER TABLE ONLY maker.flap_kick
+    ADD CONSTRAINT flap_kick_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_duty ilk_duty; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_kick flap_kick_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_duty AFTER INSERT OR UPDATE ON maker.jug_ilk_duty FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_duty();
+ALTER TABLE ONLY maker.flap_kick
+    ADD CONSTRAINT flap_kick_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_ilk_flip ilk_flip; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_kicks flap_kicks_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_flip AFTER INSERT OR UPDATE ON maker.cat_ilk_flip FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_flip();
+ALTER TABLE ONLY maker.flap_kicks
+    ADD CONSTRAINT flap_kicks_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_line ilk_line; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_live flap_live_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_line AFTER INSERT OR UPDATE ON maker.vat_ilk_line FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_line();
+ALTER TABLE ONLY maker.flap_live
+    ADD CONSTRAINT flap_live_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_ilk_lump ilk_lump; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_tau flap_tau_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_lump AFTER INSERT OR UPDATE ON maker.cat_ilk_lump FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_lump();
+ALTER TABLE ONLY maker.flap_tau
+    ADD CONSTRAINT flap_tau_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_ilk_mat ilk_mat; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_ttl flap_ttl_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_mat AFTER INSERT OR UPDATE ON maker.spot_ilk_mat FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_mat();
+ALTER TABLE ONLY maker.flap_ttl
+    ADD CONSTRAINT flap_ttl_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: spot_ilk_pip ilk_pip; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flap_vat flap_vat_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_pip AFTER INSERT OR UPDATE ON maker.spot_ilk_pip FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_pip();
+ALTER TABLE ONLY maker.flap_vat
+    ADD CONSTRAINT flap_vat_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_rate ilk_rate; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flip flip_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_rate AFTER INSERT OR UPDATE ON maker.vat_ilk_rate FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_rate();
+ALTER TABLE ONLY maker.flip
+    ADD CONSTRAINT flip_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_rho ilk_rho; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flip_beg flip_beg_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_rho AFTER INSERT OR UPDATE ON maker.jug_ilk_rho FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_rho();
+ALTER TABLE ONLY maker.flip_beg
+    ADD CONSTRAINT flip_beg_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: vat_ilk_spot ilk_spot; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flip_bid_bid flip_bid_bid_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER ilk_spot AFTER INSERT OR UPDATE ON maker.vat_ilk_spot FOR EACH ROW EXECUTE PROCEDURE maker.insert_ilk_spot();
+ALTER TABLE ONLY maker.flip_bid_bid
+    ADD CONSTRAINT flip_bid_bid_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cdp_manager_cdpi managed_cdp_cdpi; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flip_bid_end flip_bid_end_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER managed_cdp_cdpi AFTER INSERT OR UPDATE ON maker.cdp_manager_cdpi FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_created();
+ALTER TABLE ONLY maker.flip_bid_end
+    ADD CONSTRAINT flip_bid_end_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cdp_manager_ilks managed_cdp_ilk; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flip_bid_gal flip_bid_gal_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER managed_cdp_ilk AFTER INSERT OR UPDATE ON maker.cdp_manager_ilks FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_ilk_identifier();
+ALTER TABLE ONLY maker.flip_bid_gal
+    ADD CONSTRAINT flip_bid_gal_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cdp_manager_urns managed_cdp_urn; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flip_bid_guy flip_bid_guy_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER managed_cdp_urn AFTER INSERT OR UPDATE ON maker.cdp_manager_urns FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_urn_identifier();
+ALTER TABLE ONLY maker.flip_bid_guy
+    ADD CONSTRAINT flip_bid_guy_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cdp_manager_owns managed_cdp_usr; Type: TRIGGER; Schema: maker; Owner: -
+-- Name: flip_bid_lot flip_bid_lot_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-CREATE TRIGGER managed_cdp_usr AFTER INSERT OR UPDATE ON maker.cdp_manager_owns FOR EACH ROW EXECUTE PROCEDURE maker.insert_cdp_usr();
+ALTER TABLE ONLY maker.flip_bid_lot
+    ADD CONSTRAINT flip_bid_lot_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: bite bite_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tab flip_bid_tab_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.bite
-    ADD CONSTRAINT bite_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_bid_tab
+    ADD CONSTRAINT flip_bid_tab_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: bite bite_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_tic flip_bid_tic_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.bite
-    ADD CONSTRAINT bite_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_bid_tic
+    ADD CONSTRAINT flip_bid_tic_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: bite bite_urn_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_bid_usr flip_bid_usr_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.bite
-    ADD CONSTRAINT bite_urn_id_fkey FOREIGN KEY (urn_id) REFERENCES maker.urns(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_bid_usr
+    ADD CONSTRAINT flip_bid_usr_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_chop_lump cat_file_chop_lump_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_ilk flip_ilk_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_chop_lump
-    ADD CONSTRAINT cat_file_chop_lump_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_ilk
+    ADD CONSTRAINT flip_ilk_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_chop_lump cat_file_chop_lump_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_ilk flip_ilk_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_chop_lump
-    ADD CONSTRAINT cat_file_chop_lump_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_ilk
+    ADD CONSTRAINT flip_ilk_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_chop_lump cat_file_chop_lump_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kick flip_kick_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_chop_lump
-    ADD CONSTRAINT cat_file_chop_lump_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_kick
+    ADD CONSTRAINT flip_kick_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_flip cat_file_flip_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kick flip_kick_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_flip
-    ADD CONSTRAINT cat_file_flip_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_kick
+    ADD CONSTRAINT flip_kick_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_flip cat_file_flip_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kick flip_kick_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_flip
-    ADD CONSTRAINT cat_file_flip_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_kick
+    ADD CONSTRAINT flip_kick_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_flip cat_file_flip_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_kicks flip_kicks_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_flip
-    ADD CONSTRAINT cat_file_flip_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_kicks
+    ADD CONSTRAINT flip_kicks_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_vow cat_file_vow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_tau flip_tau_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_vow
-    ADD CONSTRAINT cat_file_vow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_tau
+    ADD CONSTRAINT flip_tau_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_file_vow cat_file_vow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_ttl flip_ttl_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_file_vow
-    ADD CONSTRAINT cat_file_vow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_ttl
+    ADD CONSTRAINT flip_ttl_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_ilk_chop cat_ilk_chop_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flip_vat flip_vat_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_chop
-    ADD CONSTRAINT cat_ilk_chop_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flip_vat
+    ADD CONSTRAINT flip_vat_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_ilk_flip cat_ilk_flip_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop flop_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_flip
-    ADD CONSTRAINT cat_ilk_flip_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop
+    ADD CONSTRAINT flop_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cat_ilk_lump cat_ilk_lump_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_beg flop_beg_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cat_ilk_lump
-    ADD CONSTRAINT cat_ilk_lump_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_beg
+    ADD CONSTRAINT flop_beg_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: cdp_manager_ilks cdp_manager_ilks_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_bid flop_bid_bid_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.cdp_manager_ilks
-    ADD CONSTRAINT cdp_manager_ilks_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_bid_bid
+    ADD CONSTRAINT flop_bid_bid_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: deal deal_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_end flop_bid_end_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.deal
-    ADD CONSTRAINT deal_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_bid_end
+    ADD CONSTRAINT flop_bid_end_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: deal deal_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_guy flop_bid_guy_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.deal
-    ADD CONSTRAINT deal_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_bid_guy
+    ADD CONSTRAINT flop_bid_guy_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: deal deal_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_lot flop_bid_lot_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.deal
-    ADD CONSTRAINT deal_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_bid_lot
+    ADD CONSTRAINT flop_bid_lot_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: dent dent_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_bid_tic flop_bid_tic_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.dent
-    ADD CONSTRAINT dent_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_bid_tic
+    ADD CONSTRAINT flop_bid_tic_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: dent dent_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_gem flop_gem_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.dent
-    ADD CONSTRAINT dent_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_gem
+    ADD CONSTRAINT flop_gem_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: dent dent_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kick flop_kick_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.dent
-    ADD CONSTRAINT dent_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_kick
+    ADD CONSTRAINT flop_kick_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap flap_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kick flop_kick_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap
-    ADD CONSTRAINT flap_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_kick
+    ADD CONSTRAINT flop_kick_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_beg flap_beg_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kick flop_kick_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_beg
-    ADD CONSTRAINT flap_beg_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_kick
+    ADD CONSTRAINT flop_kick_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_bid flap_bid_bid_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_kicks flop_kicks_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_bid
-    ADD CONSTRAINT flap_bid_bid_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_kicks
+    ADD CONSTRAINT flop_kicks_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_end flap_bid_end_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_live flop_live_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_end
-    ADD CONSTRAINT flap_bid_end_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_live
+    ADD CONSTRAINT flop_live_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_guy flap_bid_guy_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_pad flop_pad_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_guy
-    ADD CONSTRAINT flap_bid_guy_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_pad
+    ADD CONSTRAINT flop_pad_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_lot flap_bid_lot_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_tau flop_tau_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_lot
-    ADD CONSTRAINT flap_bid_lot_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_tau
+    ADD CONSTRAINT flop_tau_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_bid_tic flap_bid_tic_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_ttl flop_ttl_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_bid_tic
-    ADD CONSTRAINT flap_bid_tic_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_ttl
+    ADD CONSTRAINT flop_ttl_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_gem flap_gem_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: flop_vat flop_vat_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_gem
-    ADD CONSTRAINT flap_gem_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.flop_vat
+    ADD CONSTRAINT flop_vat_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_kick flap_kick_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit gem_exit_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kick
-    ADD CONSTRAINT flap_kick_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_exit
+    ADD CONSTRAINT gem_exit_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_kick flap_kick_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit gem_exit_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kick
-    ADD CONSTRAINT flap_kick_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_exit
+    ADD CONSTRAINT gem_exit_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_kick flap_kick_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit gem_exit_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kick
-    ADD CONSTRAINT flap_kick_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_exit
+    ADD CONSTRAINT gem_exit_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_kicks flap_kicks_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_exit gem_exit_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_kicks
-    ADD CONSTRAINT flap_kicks_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_exit
+    ADD CONSTRAINT gem_exit_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_live flap_live_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join gem_join_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_live
-    ADD CONSTRAINT flap_live_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_join
+    ADD CONSTRAINT gem_join_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_tau flap_tau_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join gem_join_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_tau
-    ADD CONSTRAINT flap_tau_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_join
+    ADD CONSTRAINT gem_join_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_ttl flap_ttl_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join gem_join_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_ttl
-    ADD CONSTRAINT flap_ttl_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_join
+    ADD CONSTRAINT gem_join_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flap_vat flap_vat_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: gem_join gem_join_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flap_vat
-    ADD CONSTRAINT flap_vat_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.gem_join
+    ADD CONSTRAINT gem_join_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip flip_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: generic_file generic_file_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip
-    ADD CONSTRAINT flip_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.generic_file
+    ADD CONSTRAINT generic_file_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_beg flip_beg_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: generic_file generic_file_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_beg
-    ADD CONSTRAINT flip_beg_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.generic_file
+    ADD CONSTRAINT generic_file_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_bid flip_bid_bid_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: generic_file generic_file_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_bid
-    ADD CONSTRAINT flip_bid_bid_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.generic_file
+    ADD CONSTRAINT generic_file_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_end flip_bid_end_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: generic_file generic_file_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_end
-    ADD CONSTRAINT flip_bid_end_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.generic_file
+    ADD CONSTRAINT generic_file_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_gal flip_bid_gal_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_drip jug_drip_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_gal
-    ADD CONSTRAINT flip_bid_gal_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_drip
+    ADD CONSTRAINT jug_drip_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_guy flip_bid_guy_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_drip jug_drip_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_guy
-    ADD CONSTRAINT flip_bid_guy_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_drip
+    ADD CONSTRAINT jug_drip_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_lot flip_bid_lot_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_drip jug_drip_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_lot
-    ADD CONSTRAINT flip_bid_lot_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_drip
+    ADD CONSTRAINT jug_drip_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_tab flip_bid_tab_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_base jug_file_base_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tab
-    ADD CONSTRAINT flip_bid_tab_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_file_base
+    ADD CONSTRAINT jug_file_base_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_tic flip_bid_tic_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_base jug_file_base_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_tic
-    ADD CONSTRAINT flip_bid_tic_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_file_base
+    ADD CONSTRAINT jug_file_base_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_bid_usr flip_bid_usr_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_ilk jug_file_ilk_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_bid_usr
-    ADD CONSTRAINT flip_bid_usr_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_file_ilk
+    ADD CONSTRAINT jug_file_ilk_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_ilk flip_ilk_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_ilk jug_file_ilk_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ilk
-    ADD CONSTRAINT flip_ilk_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_file_ilk
+    ADD CONSTRAINT jug_file_ilk_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_ilk flip_ilk_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_ilk jug_file_ilk_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ilk
-    ADD CONSTRAINT flip_ilk_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_file_ilk
+    ADD CONSTRAINT jug_file_ilk_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_kick flip_kick_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_vow jug_file_vow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kick
-    ADD CONSTRAINT flip_kick_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_file_vow
+    ADD CONSTRAINT jug_file_vow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_kick flip_kick_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_file_vow jug_file_vow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kick
-    ADD CONSTRAINT flip_kick_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_file_vow
+    ADD CONSTRAINT jug_file_vow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_kick flip_kick_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_ilk_duty jug_ilk_duty_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kick
-    ADD CONSTRAINT flip_kick_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_ilk_duty
+    ADD CONSTRAINT jug_ilk_duty_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_kicks flip_kicks_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_ilk_rho jug_ilk_rho_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_kicks
-    ADD CONSTRAINT flip_kicks_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_ilk_rho
+    ADD CONSTRAINT jug_ilk_rho_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_tau flip_tau_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_init jug_init_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_tau
-    ADD CONSTRAINT flip_tau_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_init
+    ADD CONSTRAINT jug_init_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_ttl flip_ttl_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_init jug_init_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_ttl
-    ADD CONSTRAINT flip_ttl_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_init
+    ADD CONSTRAINT jug_init_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flip_vat flip_vat_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: jug_init jug_init_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flip_vat
-    ADD CONSTRAINT flip_vat_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.jug_init
+    ADD CONSTRAINT jug_init_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop flop_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_median_price log_median_price_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop
-    ADD CONSTRAINT flop_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.log_median_price
+    ADD CONSTRAINT log_median_price_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_beg flop_beg_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_median_price log_median_price_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_beg
-    ADD CONSTRAINT flop_beg_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.log_median_price
+    ADD CONSTRAINT log_median_price_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_bid flop_bid_bid_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_median_price log_median_price_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_bid
-    ADD CONSTRAINT flop_bid_bid_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.log_median_price
+    ADD CONSTRAINT log_median_price_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_end flop_bid_end_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_median_price log_median_price_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_end
-    ADD CONSTRAINT flop_bid_end_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.log_median_price
+    ADD CONSTRAINT log_median_price_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_guy flop_bid_guy_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_value log_value_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_guy
-    ADD CONSTRAINT flop_bid_guy_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.log_value
+    ADD CONSTRAINT log_value_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_lot flop_bid_lot_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_value log_value_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_lot
-    ADD CONSTRAINT flop_bid_lot_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.log_value
+    ADD CONSTRAINT log_value_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_bid_tic flop_bid_tic_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: log_value log_value_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_bid_tic
-    ADD CONSTRAINT flop_bid_tic_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.log_value
+    ADD CONSTRAINT log_value_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_gem flop_gem_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: new_cdp new_cdp_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_gem
-    ADD CONSTRAINT flop_gem_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.new_cdp
+    ADD CONSTRAINT new_cdp_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kick flop_kick_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: new_cdp new_cdp_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kick
-    ADD CONSTRAINT flop_kick_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.new_cdp
+    ADD CONSTRAINT new_cdp_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kick flop_kick_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_cur_has osm_cur_has_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kick
-    ADD CONSTRAINT flop_kick_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.osm_cur_has
+    ADD CONSTRAINT osm_cur_has_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kick flop_kick_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_cur_val osm_cur_val_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kick
-    ADD CONSTRAINT flop_kick_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.osm_cur_val
+    ADD CONSTRAINT osm_cur_val_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_kicks flop_kicks_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_hop osm_hop_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_kicks
-    ADD CONSTRAINT flop_kicks_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.osm_hop
+    ADD CONSTRAINT osm_hop_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_live flop_live_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_nxt_has osm_nxt_has_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_live
-    ADD CONSTRAINT flop_live_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.osm_nxt_has
+    ADD CONSTRAINT osm_nxt_has_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_pad flop_pad_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_nxt_val osm_nxt_val_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_pad
-    ADD CONSTRAINT flop_pad_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.osm_nxt_val
+    ADD CONSTRAINT osm_nxt_val_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_tau flop_tau_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_stopped osm_stopped_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_tau
-    ADD CONSTRAINT flop_tau_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.osm_stopped
+    ADD CONSTRAINT osm_stopped_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_ttl flop_ttl_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: osm_zzz osm_zzz_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_ttl
-    ADD CONSTRAINT flop_ttl_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.osm_zzz
+    ADD CONSTRAINT osm_zzz_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
 
 
 --
--- Name: flop_vat flop_vat_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_drop pause_drop_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.flop_vat
-    ADD CONSTRAINT flop_vat_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pause_drop
+    ADD CONSTRAINT pause_drop_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_drip jug_drip_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_drop pause_drop_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_drip
-    ADD CONSTRAINT jug_drip_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pause_drop
+    ADD CONSTRAINT pause_drop_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_drip jug_drip_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_exec pause_exec_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_drip
-    ADD CONSTRAINT jug_drip_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pause_exec
+    ADD CONSTRAINT pause_exec_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_drip jug_drip_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_exec pause_exec_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_drip
-    ADD CONSTRAINT jug_drip_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pause_exec
+    ADD CONSTRAINT pause_exec_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_base jug_file_base_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_plot pause_plot_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_base
-    ADD CONSTRAINT jug_file_base_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pause_plot
+    ADD CONSTRAINT pause_plot_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_base jug_file_base_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pause_plot pause_plot_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_base
-    ADD CONSTRAINT jug_file_base_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pause_plot
+    ADD CONSTRAINT pause_plot_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_ilk jug_file_ilk_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_drip pot_drip_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_ilk
-    ADD CONSTRAINT jug_file_ilk_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_drip
+    ADD CONSTRAINT pot_drip_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_ilk jug_file_ilk_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_drip pot_drip_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_ilk
-    ADD CONSTRAINT jug_file_ilk_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_drip
+    ADD CONSTRAINT pot_drip_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_ilk jug_file_ilk_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_exit pot_exit_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_ilk
-    ADD CONSTRAINT jug_file_ilk_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_exit
+    ADD CONSTRAINT pot_exit_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_vow jug_file_vow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_exit pot_exit_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_vow
-    ADD CONSTRAINT jug_file_vow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_exit
+    ADD CONSTRAINT pot_exit_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_file_vow jug_file_vow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_file_dsr pot_file_dsr_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_file_vow
-    ADD CONSTRAINT jug_file_vow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_file_dsr
+    ADD CONSTRAINT pot_file_dsr_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_duty jug_ilk_duty_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_file_dsr pot_file_dsr_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_duty
-    ADD CONSTRAINT jug_ilk_duty_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_file_dsr
+    ADD CONSTRAINT pot_file_dsr_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_ilk_rho jug_ilk_rho_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_file_vow pot_file_vow_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_ilk_rho
-    ADD CONSTRAINT jug_ilk_rho_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_file_vow
+    ADD CONSTRAINT pot_file_vow_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_init jug_init_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_file_vow pot_file_vow_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_init
-    ADD CONSTRAINT jug_init_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_file_vow
+    ADD CONSTRAINT pot_file_vow_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_init jug_init_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_join pot_join_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_init
-    ADD CONSTRAINT jug_init_ilk_id_fkey FOREIGN KEY (ilk_id) REFERENCES maker.ilks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_join
+    ADD CONSTRAINT pot_join_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: jug_init jug_init_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: pot_join pot_join_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.jug_init
-    ADD CONSTRAINT jug_init_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.pot_join
+    ADD CONSTRAINT pot_join_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
--- Name: new_cdp new_cdp_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: proxies proxies_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.new_cdp
-    ADD CONSTRAINT new_cdp_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.proxies
+    ADD CONSTRAINT proxies_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
 
 
 --
--- Name: new_cdp new_cdp_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+-- Name: proxies proxies_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
 
-ALTER TABLE ONLY maker.new_cdp
-    ADD CONSTRAINT new_cdp_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+ALTER TABLE ONLY maker.proxies
+    ADD CONSTRAINT proxies_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
 --
@@ -14590,6 +22729,22 @@ ALTER TABLE ONLY maker.spot_file_mat
     ADD CONSTRAINT spot_file_mat_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
+--
+-- Name: spot_file_par spot_file_par_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_par
+    ADD CONSTRAINT spot_file_par_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spot_file_par spot_file_par_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.spot_file_par
+    ADD CONSTRAINT spot_file_par_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+
+
 --
 -- Name: spot_file_pip spot_file_pip_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
@@ -14894,6 +23049,22 @@ ALTER TABLE ONLY maker.vat_heal
     ADD CONSTRAINT vat_heal_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
+--
+-- Name: vat_hope vat_hope_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_hope
+    ADD CONSTRAINT vat_hope_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vat_hope vat_hope_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_hope
+    ADD CONSTRAINT vat_hope_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+
+
 --
 -- Name: vat_ilk_art vat_ilk_art_ilk_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
@@ -14974,6 +23145,22 @@ ALTER TABLE ONLY maker.vat_move
     ADD CONSTRAINT vat_move_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
+--
+-- Name: vat_nope vat_nope_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_nope
+    ADD CONSTRAINT vat_nope_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vat_nope vat_nope_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vat_nope
+    ADD CONSTRAINT vat_nope_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+
+
 --
 -- Name: vat_slip vat_slip_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
@@ -15062,6 +23249,22 @@ ALTER TABLE ONLY maker.vow_file
     ADD CONSTRAINT vow_file_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
+--
+-- Name: vow_flap vow_flap_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flap
+    ADD CONSTRAINT vow_flap_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vow_flap vow_flap_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flap
+    ADD CONSTRAINT vow_flap_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+
+
 --
 -- Name: vow_flog vow_flog_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
@@ -15078,6 +23281,62 @@ ALTER TABLE ONLY maker.vow_flog
     ADD CONSTRAINT vow_flog_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
 
 
+--
+-- Name: vow_flop vow_flop_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flop
+    ADD CONSTRAINT vow_flop_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vow_flop vow_flop_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_flop
+    ADD CONSTRAINT vow_flop_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vow_heal vow_heal_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_heal
+    ADD CONSTRAINT vow_heal_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vow_heal vow_heal_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_heal
+    ADD CONSTRAINT vow_heal_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vow_kiss vow_kiss_header_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_kiss
+    ADD CONSTRAINT vow_kiss_header_id_fkey FOREIGN KEY (header_id) REFERENCES public.headers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: vow_kiss vow_kiss_log_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.vow_kiss
+    ADD CONSTRAINT vow_kiss_log_id_fkey FOREIGN KEY (log_id) REFERENCES public.header_sync_logs(id) ON DELETE CASCADE;
+
+
+--
+-- Name: wards wards_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
+--
+
+ALTER TABLE ONLY maker.wards
+    ADD CONSTRAINT wards_address_id_fkey FOREIGN KEY (address_id) REFERENCES public.addresses(id) ON DELETE CASCADE;
+
+
 --
 -- Name: yank yank_address_id_fkey; Type: FK CONSTRAINT; Schema: maker; Owner: -
 --
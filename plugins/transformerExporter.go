@@ -3,22 +3,74 @@
 package main
 
 import (
+	auction_file "github.com/vulcanize/mcd_transformers/transformers/events/auction_file/initializer"
+	deny "github.com/vulcanize/mcd_transformers/transformers/events/auth/deny/initializer"
+	rely "github.com/vulcanize/mcd_transformers/transformers/events/auth/rely/initializer"
 	bite "github.com/vulcanize/mcd_transformers/transformers/events/bite/initializer"
+	flap_cage "github.com/vulcanize/mcd_transformers/transformers/events/cage/flap_cage/initializer"
+	cage "github.com/vulcanize/mcd_transformers/transformers/events/cage/initializer"
 	cat_file_chop_lump "github.com/vulcanize/mcd_transformers/transformers/events/cat_file/chop_lump/initializer"
 	cat_file_flip "github.com/vulcanize/mcd_transformers/transformers/events/cat_file/flip/initializer"
 	cat_file_vow "github.com/vulcanize/mcd_transformers/transformers/events/cat_file/vow/initializer"
+	cdp_manager_cdp_allow "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_cdp_allow/initializer"
+	cdp_manager_enter "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_enter/initializer"
+	cdp_manager_flux "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_flux/initializer"
+	cdp_manager_frob "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_frob/initializer"
+	cdp_manager_give "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_give/initializer"
+	cdp_manager_move "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_move/initializer"
+	cdp_manager_quit "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_quit/initializer"
+	cdp_manager_shift "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_shift/initializer"
+	cdp_manager_urn_allow "github.com/vulcanize/mcd_transformers/transformers/events/cdp_manager_urn_allow/initializer"
+	chief_etch "github.com/vulcanize/mcd_transformers/transformers/events/chief_etch/initializer"
+	chief_free "github.com/vulcanize/mcd_transformers/transformers/events/chief_free/initializer"
+	chief_lift "github.com/vulcanize/mcd_transformers/transformers/events/chief_lift/initializer"
+	chief_lock "github.com/vulcanize/mcd_transformers/transformers/events/chief_lock/initializer"
+	chief_vote "github.com/vulcanize/mcd_transformers/transformers/events/chief_vote/initializer"
+	chief_vote_yays "github.com/vulcanize/mcd_transformers/transformers/events/chief_vote_yays/initializer"
+	dai_approval "github.com/vulcanize/mcd_transformers/transformers/events/dai_approval/initializer"
+	dai_exit "github.com/vulcanize/mcd_transformers/transformers/events/dai_exit/initializer"
+	dai_join "github.com/vulcanize/mcd_transformers/transformers/events/dai_join/initializer"
+	dai_transfer "github.com/vulcanize/mcd_transformers/transformers/events/dai_transfer/initializer"
 	deal "github.com/vulcanize/mcd_transformers/transformers/events/deal/initializer"
 	dent "github.com/vulcanize/mcd_transformers/transformers/events/dent/initializer"
+	end_cage "github.com/vulcanize/mcd_transformers/transformers/events/end_cage/initializer"
+	end_cage_ilk "github.com/vulcanize/mcd_transformers/transformers/events/end_cage_ilk/initializer"
+	end_cash "github.com/vulcanize/mcd_transformers/transformers/events/end_cash/initializer"
+	end_flow "github.com/vulcanize/mcd_transformers/transformers/events/end_flow/initializer"
+	end_free "github.com/vulcanize/mcd_transformers/transformers/events/end_free/initializer"
+	end_pack "github.com/vulcanize/mcd_transformers/transformers/events/end_pack/initializer"
+	end_skim "github.com/vulcanize/mcd_transformers/transformers/events/end_skim/initializer"
+	end_thaw "github.com/vulcanize/mcd_transformers/transformers/events/end_thaw/initializer"
+	esm_fire "github.com/vulcanize/mcd_transformers/transformers/events/esm_fire/initializer"
+	esm_join "github.com/vulcanize/mcd_transformers/transformers/events/esm_join/initializer"
 	flap_kick "github.com/vulcanize/mcd_transformers/transformers/events/flap_kick/initializer"
 	flip_kick "github.com/vulcanize/mcd_transformers/transformers/events/flip_kick/initializer"
 	flop_kick "github.com/vulcanize/mcd_transformers/transformers/events/flop_kick/initializer"
+	gem_exit "github.com/vulcanize/mcd_transformers/transformers/events/gem_exit/initializer"
+	gem_join "github.com/vulcanize/mcd_transformers/transformers/events/gem_join/initializer"
+	generic_file_address "github.com/vulcanize/mcd_transformers/transformers/events/generic_file/address/initializer"
+	generic_file_ilk_address "github.com/vulcanize/mcd_transformers/transformers/events/generic_file/ilk_address/initializer"
+	generic_file_ilk_uint "github.com/vulcanize/mcd_transformers/transformers/events/generic_file/ilk_uint/initializer"
+	generic_file_uint "github.com/vulcanize/mcd_transformers/transformers/events/generic_file/uint/initializer"
 	jug_drip "github.com/vulcanize/mcd_transformers/transformers/events/jug_drip/initializer"
 	jug_file_base "github.com/vulcanize/mcd_transformers/transformers/events/jug_file/base/initializer"
 	jug_file_ilk "github.com/vulcanize/mcd_transformers/transformers/events/jug_file/ilk/initializer"
 	jug_file_vow "github.com/vulcanize/mcd_transformers/transformers/events/jug_file/vow/initializer"
 	jug_init "github.com/vulcanize/mcd_transformers/transformers/events/jug_init/initializer"
+	log_median_price "github.com/vulcanize/mcd_transformers/transformers/events/log_median_price/initializer"
+	log_value "github.com/vulcanize/mcd_transformers/transformers/events/log_value/initializer"
 	new_cdp "github.com/vulcanize/mcd_transformers/transformers/events/new_cdp/initializer"
+	pause_drop "github.com/vulcanize/mcd_transformers/transformers/events/pause_drop/initializer"
+	pause_exec "github.com/vulcanize/mcd_transformers/transformers/events/pause_exec/initializer"
+	pause_plot "github.com/vulcanize/mcd_transformers/transformers/events/pause_plot/initializer"
+	pot_drip "github.com/vulcanize/mcd_transformers/transformers/events/pot_drip/initializer"
+	pot_exit "github.com/vulcanize/mcd_transformers/transformers/events/pot_exit/initializer"
+	pot_file_dsr "github.com/vulcanize/mcd_transformers/transformers/events/pot_file/dsr/initializer"
+	pot_file_vow "github.com/vulcanize/mcd_transformers/transformers/events/pot_file/vow/initializer"
+	pot_join "github.com/vulcanize/mcd_transformers/transformers/events/pot_join/initializer"
+	proxy_created "github.com/vulcanize/mcd_transformers/transformers/events/proxy_created/initializer"
 	spot_file_mat "github.com/vulcanize/mcd_transformers/transformers/events/spot_file/mat/initializer"
+	spot_file_par "github.com/vulcanize/mcd_transformers/transformers/events/spot_file/par/initializer"
 	spot_file_pip "github.com/vulcanize/mcd_transformers/transformers/events/spot_file/pip/initializer"
 	spot_poke "github.com/vulcanize/mcd_transformers/transformers/events/spot_poke/initializer"
 	tend "github.com/vulcanize/mcd_transformers/transformers/events/tend/initializer"
@@ -31,16 +83,26 @@ import (
 	vat_frob "github.com/vulcanize/mcd_transformers/transformers/events/vat_frob/initializer"
 	vat_grab "github.com/vulcanize/mcd_transformers/transformers/events/vat_grab/initializer"
 	vat_heal "github.com/vulcanize/mcd_transformers/transformers/events/vat_heal/initializer"
+	vat_hope "github.com/vulcanize/mcd_transformers/transformers/events/vat_hope/initializer"
 	vat_init "github.com/vulcanize/mcd_transformers/transformers/events/vat_init/initializer"
 	vat_move "github.com/vulcanize/mcd_transformers/transformers/events/vat_move/initializer"
+	vat_nope "github.com/vulcanize/mcd_transformers/transformers/events/vat_nope/initializer"
 	vat_slip "github.com/vulcanize/mcd_transformers/transformers/events/vat_slip/initializer"
 	vat_suck "github.com/vulcanize/mcd_transformers/transformers/events/vat_suck/initializer"
 	vow_fess "github.com/vulcanize/mcd_transformers/transformers/events/vow_fess/initializer"
 	vow_file "github.com/vulcanize/mcd_transformers/transformers/events/vow_file/initializer"
+	vow_flap "github.com/vulcanize/mcd_transformers/transformers/events/vow_flap/initializer"
 	vow_flog "github.com/vulcanize/mcd_transformers/transformers/events/vow_flog/initializer"
+	vow_flop "github.com/vulcanize/mcd_transformers/transformers/events/vow_flop/initializer"
+	vow_heal "github.com/vulcanize/mcd_transformers/transformers/events/vow_heal/initializer"
+	vow_kiss "github.com/vulcanize/mcd_transformers/transformers/events/vow_kiss/initializer"
 	yank "github.com/vulcanize/mcd_transformers/transformers/events/yank/initializer"
 	cat "github.com/vulcanize/mcd_transformers/transformers/storage/cat/initializer"
 	cdp_manager "github.com/vulcanize/mcd_transformers/transformers/storage/cdp_manager/initializer"
+	chief "github.com/vulcanize/mcd_transformers/transformers/storage/chief/initializer"
+	dai "github.com/vulcanize/mcd_transformers/transformers/storage/dai/initializer"
+	end "github.com/vulcanize/mcd_transformers/transformers/storage/end/initializer"
+	esm "github.com/vulcanize/mcd_transformers/transformers/storage/esm/initializer"
 	flap_storage "github.com/vulcanize/mcd_transformers/transformers/storage/flap/initializer"
 	bat_flip "github.com/vulcanize/mcd_transformers/transformers/storage/flip/initializers/bat_flip"
 	dgd_flip "github.com/vulcanize/mcd_transformers/transformers/storage/flip/initializers/dgd_flip"
@@ -53,6 +115,9 @@ import (
 	zrx_flip "github.com/vulcanize/mcd_transformers/transformers/storage/flip/initializers/zrx_flip"
 	flop_storage "github.com/vulcanize/mcd_transformers/transformers/storage/flop/initializer"
 	jug "github.com/vulcanize/mcd_transformers/transformers/storage/jug/initializer"
+	eth_osm "github.com/vulcanize/mcd_transformers/transformers/storage/osm/initializers/eth_osm"
+	pot "github.com/vulcanize/mcd_transformers/transformers/storage/pot/initializer"
+	previous "github.com/vulcanize/mcd_transformers/transformers/storage/previous"
 	spot "github.com/vulcanize/mcd_transformers/transformers/storage/spot/initializer"
 	vat "github.com/vulcanize/mcd_transformers/transformers/storage/vat/initializer"
 	vow "github.com/vulcanize/mcd_transformers/transformers/storage/vow/initializer"
@@ -64,5 +129,5 @@ type exporter string
 var Exporter exporter
 
 func (e exporter) Export() ([]interface1.EventTransformerInitializer, []interface1.StorageTransformerInitializer, []interface1.ContractTransformerInitializer) {
-	return []interface1.EventTransformerInitializer{flap_kick.EventTransformerInitializer, flip_kick.EventTransformerInitializer, jug_file_ilk.EventTransformerInitializer, jug_file_vow.EventTransformerInitializer, yank.EventTransformerInitializer, jug_drip.EventTransformerInitializer, vat_file_debt_ceiling.EventTransformerInitializer, vat_fold.EventTransformerInitializer, vow_file.EventTransformerInitializer, vat_move.EventTransformerInitializer, flop_kick.EventTransformerInitializer, vow_flog.EventTransformerInitializer, vat_file_ilk.EventTransformerInitializer, jug_file_base.EventTransformerInitializer, tick.EventTransformerInitializer, spot_poke.EventTransformerInitializer, vat_suck.EventTransformerInitializer, vat_fork.EventTransformerInitializer, cat_file_flip.EventTransformerInitializer, spot_file_pip.EventTransformerInitializer, vat_flux.EventTransformerInitializer, deal.EventTransformerInitializer, jug_init.EventTransformerInitializer, dent.EventTransformerInitializer, vow_fess.EventTransformerInitializer, vat_heal.EventTransformerInitializer, cat_file_vow.EventTransformerInitializer, new_cdp.EventTransformerInitializer, vat_init.EventTransformerInitializer, bite.EventTransformerInitializer, cat_file_chop_lump.EventTransformerInitializer, vat_slip.EventTransformerInitializer, vat_frob.EventTransformerInitializer, vat_grab.EventTransformerInitializer, spot_file_mat.EventTransformerInitializer, tend.EventTransformerInitializer}, []interface1.StorageTransformerInitializer{zrx_flip.StorageTransformerInitializer, eth_flip_c.StorageTransformerInitializer, gnt_flip.StorageTransformerInitializer, jug.StorageTransformerInitializer, eth_flip_a.StorageTransformerInitializer, eth_flip_b.StorageTransformerInitializer, flap_storage.StorageTransformerInitializer, flop_storage.StorageTransformerInitializer, rep_flip.StorageTransformerInitializer, omg_flip.StorageTransformerInitializer, bat_flip.StorageTransformerInitializer, vow.StorageTransformerInitializer, vat.StorageTransformerInitializer, cdp_manager.StorageTransformerInitializer, cat.StorageTransformerInitializer, spot.StorageTransformerInitializer, dgd_flip.StorageTransformerInitializer}, []interface1.ContractTransformerInitializer{}
+	return []interface1.EventTransformerInitializer{flap_kick.EventTransformerInitializer, flip_kick.EventTransformerInitializer, jug_file_ilk.EventTransformerInitializer, jug_file_vow.EventTransformerInitializer, yank.EventTransformerInitializer, jug_drip.EventTransformerInitializer, vat_file_debt_ceiling.EventTransformerInitializer, vat_fold.EventTransformerInitializer, vow_file.EventTransformerInitializer, vat_move.EventTransformerInitializer, flop_kick.EventTransformerInitializer, vow_flog.EventTransformerInitializer, vat_file_ilk.EventTransformerInitializer, jug_file_base.EventTransformerInitializer, tick.EventTransformerInitializer, spot_poke.EventTransformerInitializer, vat_suck.EventTransformerInitializer, vat_fork.EventTransformerInitializer, cat_file_flip.EventTransformerInitializer, spot_file_pip.EventTransformerInitializer, vat_flux.EventTransformerInitializer, deal.EventTransformerInitializer, jug_init.EventTransformerInitializer, dent.EventTransformerInitializer, vow_fess.EventTransformerInitializer, vat_heal.EventTransformerInitializer, cat_file_vow.EventTransformerInitializer, new_cdp.EventTransformerInitializer, vat_init.EventTransformerInitializer, bite.EventTransformerInitializer, cat_file_chop_lump.EventTransformerInitializer, vat_slip.EventTransformerInitializer, vat_frob.EventTransformerInitializer, vat_grab.EventTransformerInitializer, spot_file_mat.EventTransformerInitializer, tend.EventTransformerInitializer, pot_drip.EventTransformerInitializer, pot_exit.EventTransformerInitializer, pot_file_dsr.EventTransformerInitializer, pot_file_vow.EventTransformerInitializer, pot_join.EventTransformerInitializer, end_cage.EventTransformerInitializer, end_cage_ilk.EventTransformerInitializer, end_cash.EventTransformerInitializer, end_flow.EventTransformerInitializer, end_free.EventTransformerInitializer, end_pack.EventTransformerInitializer, end_skim.EventTransformerInitializer, end_thaw.EventTransformerInitializer, log_median_price.EventTransformerInitializer, log_value.EventTransformerInitializer, dai_exit.EventTransformerInitializer, dai_join.EventTransformerInitializer, gem_exit.EventTransformerInitializer, gem_join.EventTransformerInitializer, dai_approval.EventTransformerInitializer, dai_transfer.EventTransformerInitializer, vat_hope.EventTransformerInitializer, vat_nope.EventTransformerInitializer, deny.EventTransformerInitializer, rely.EventTransformerInitializer, auction_file.EventTransformerInitializer, cage.EventTransformerInitializer, flap_cage.EventTransformerInitializer, vow_flap.EventTransformerInitializer, vow_flop.EventTransformerInitializer, vow_heal.EventTransformerInitializer, vow_kiss.EventTransformerInitializer, cdp_manager_cdp_allow.EventTransformerInitializer, cdp_manager_enter.EventTransformerInitializer, cdp_manager_flux.EventTransformerInitializer, cdp_manager_frob.EventTransformerInitializer, cdp_manager_give.EventTransformerInitializer, cdp_manager_move.EventTransformerInitializer, cdp_manager_quit.EventTransformerInitializer, cdp_manager_shift.EventTransformerInitializer, cdp_manager_urn_allow.EventTransformerInitializer, proxy_created.EventTransformerInitializer, chief_etch.EventTransformerInitializer, chief_free.EventTransformerInitializer, chief_lift.EventTransformerInitializer, chief_lock.EventTransformerInitializer, chief_vote.EventTransformerInitializer, chief_vote_yays.EventTransformerInitializer, pause_drop.EventTransformerInitializer, pause_exec.EventTransformerInitializer, pause_plot.EventTransformerInitializer, esm_fire.EventTransformerInitializer, esm_join.EventTransformerInitializer, spot_file_par.EventTransformerInitializer, generic_file_address.EventTransformerInitializer, generic_file_ilk_address.EventTransformerInitializer, generic_file_ilk_uint.EventTransformerInitializer, generic_file_uint.EventTransformerInitializer}, append([]interface1.StorageTransformerInitializer{zrx_flip.StorageTransformerInitializer, eth_flip_c.StorageTransformerInitializer, gnt_flip.StorageTransformerInitializer, jug.StorageTransformerInitializer, eth_flip_a.StorageTransformerInitializer, eth_flip_b.StorageTransformerInitializer, flap_storage.StorageTransformerInitializer, flop_storage.StorageTransformerInitializer, rep_flip.StorageTransformerInitializer, omg_flip.StorageTransformerInitializer, bat_flip.StorageTransformerInitializer, vow.StorageTransformerInitializer, vat.StorageTransformerInitializer, cdp_manager.StorageTransformerInitializer, cat.StorageTransformerInitializer, spot.StorageTransformerInitializer, dgd_flip.StorageTransformerInitializer, pot.StorageTransformerInitializer, end.StorageTransformerInitializer, eth_osm.StorageTransformerInitializer, dai.StorageTransformerInitializer, chief.StorageTransformerInitializer, esm.StorageTransformerInitializer}, previous.StorageTransformerInitializers()...), []interface1.ContractTransformerInitializer{}
 }
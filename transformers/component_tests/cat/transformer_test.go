@@ -34,9 +34,9 @@ import (
 var _ = Describe("Executing the transformer", func() {
 	var (
 		db                *postgres.DB
-		storageKeysLookup = storage.NewKeysLookup(cat.NewKeysLoader(&mcdStorage.MakerStorageRepository{}))
-		repository        = cat.CatStorageRepository{}
 		contractAddress   = "81f7aa9c1570de564eb511b3a1e57dae558c65b5"
+		storageKeysLookup = storage.NewKeysLookup(cat.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress))
+		repository        = cat.CatStorageRepository{}
 		transformer       = storage.Transformer{
 			HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
 			StorageKeysLookup: storageKeysLookup,
@@ -36,9 +36,9 @@ var _ = Describe("Executing the transformer", func() {
 		db                *postgres.DB
 		err               error
 		ilkID             int64
-		storageKeysLookup = storage.NewKeysLookup(jug.NewKeysLoader(&mcdStorage.MakerStorageRepository{}))
-		repository        = jug.JugStorageRepository{}
 		contractAddress   = "25a008bf942ce6d5b362f91ed7ae3e4104286a12"
+		storageKeysLookup = storage.NewKeysLookup(jug.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress))
+		repository        = jug.JugStorageRepository{}
 		transformer       = storage.Transformer{
 			HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
 			StorageKeysLookup: storageKeysLookup,
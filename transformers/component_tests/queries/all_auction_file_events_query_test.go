@@ -0,0 +1,98 @@
+package queries
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("all auction file events query", func() {
+	var (
+		db              *postgres.DB
+		auctionFileRepo log_note.Repository
+		headerRepo      repositories.HeaderRepository
+		blockOne        int64
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepo = repositories.NewHeaderRepository(db)
+		auctionFileRepo = log_note.Repository{}
+		auctionFileRepo.SetDB(db)
+		rand.Seed(GinkgoRandomSeed())
+		blockOne = rand.Int63()
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createAuctionFile := func(blockNumber int64, contractAddress, what, data string) shared.InsertionModel {
+		headerID, headerErr := headerRepo.CreateOrUpdateHeader(fakes.GetFakeHeader(blockNumber))
+		Expect(headerErr).NotTo(HaveOccurred())
+		auctionFile := test_data.CopyModel(test_data.AuctionFileModel)
+		auctionFile.ColumnValues[constants.HeaderFK] = headerID
+		auctionFile.ColumnValues[constants.LogFK] = test_data.CreateTestLog(headerID, db).ID
+		auctionFile.ColumnValues["what"] = what
+		auctionFile.ColumnValues["data"] = data
+		auctionFile.ForeignKeyValues[constants.AddressFK] = contractAddress
+		createErr := auctionFileRepo.Create([]shared.InsertionModel{auctionFile})
+		Expect(createErr).NotTo(HaveOccurred())
+		return auctionFile
+	}
+
+	It("returns file events for flippers, the flapper and the flopper, newest first", func() {
+		createAuctionFile(blockOne, test_data.EthFlipAddress(), "ttl", "10800")
+		createAuctionFile(blockOne+1, test_data.FlapAddress(), "beg", "1050000000000000000")
+		createAuctionFile(blockOne+2, test_data.FlopAddress(), "tau", "172800")
+
+		var events []test_helpers.AuctionFileEvent
+		err := db.Select(&events, `SELECT contract_address, what, data, block_height FROM api.all_auction_file_events()`)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(Equal([]test_helpers.AuctionFileEvent{
+			{ContractAddress: test_data.FlopAddress(), What: "tau", Data: "172800", BlockHeight: blockOne + 2},
+			{ContractAddress: test_data.FlapAddress(), What: "beg", Data: "1050000000000000000", BlockHeight: blockOne + 1},
+			{ContractAddress: test_data.EthFlipAddress(), What: "ttl", Data: "10800", BlockHeight: blockOne},
+		}))
+	})
+
+	It("limits results if max_results argument is provided", func() {
+		createAuctionFile(blockOne, test_data.EthFlipAddress(), "ttl", "10800")
+		createAuctionFile(blockOne+1, test_data.EthFlipAddress(), "tau", "172800")
+
+		var events []test_helpers.AuctionFileEvent
+		err := db.Select(&events, `SELECT contract_address, what, data, block_height FROM api.all_auction_file_events($1)`, 1)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(ConsistOf(test_helpers.AuctionFileEvent{
+			ContractAddress: test_data.EthFlipAddress(), What: "tau", Data: "172800", BlockHeight: blockOne + 1,
+		}))
+	})
+
+	It("offsets results if offset is provided", func() {
+		createAuctionFile(blockOne, test_data.EthFlipAddress(), "ttl", "10800")
+		createAuctionFile(blockOne+1, test_data.EthFlipAddress(), "tau", "172800")
+
+		var events []test_helpers.AuctionFileEvent
+		err := db.Select(&events, `SELECT contract_address, what, data, block_height FROM api.all_auction_file_events($1, $2)`, 1, 1)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(ConsistOf(test_helpers.AuctionFileEvent{
+			ContractAddress: test_data.EthFlipAddress(), What: "ttl", Data: "10800", BlockHeight: blockOne,
+		}))
+	})
+})
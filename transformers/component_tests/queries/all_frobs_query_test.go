@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -35,7 +35,7 @@ import (
 var _ = Describe("Frobs query", func() {
 	var (
 		db                *postgres.DB
-		frobRepo          vat_frob.VatFrobRepository
+		frobRepo          log_note.Repository
 		headerRepo        repositories.HeaderRepository
 		fakeIlkHex        = test_helpers.FakeIlk.Hex
 		fakeIlkIdentifier = test_helpers.FakeIlk.Identifier
@@ -46,7 +46,7 @@ var _ = Describe("Frobs query", func() {
 		db = test_config.NewTestDB(test_config.NewTestNode())
 		test_config.CleanTestDB(db)
 		headerRepo = repositories.NewHeaderRepository(db)
-		frobRepo = vat_frob.VatFrobRepository{}
+		frobRepo = log_note.Repository{}
 		frobRepo.SetDB(db)
 	})
 
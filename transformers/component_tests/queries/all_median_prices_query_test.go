@@ -0,0 +1,140 @@
+package queries
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/abi_event"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("all median prices query", func() {
+	var (
+		db                 *postgres.DB
+		medianPriceRepo    abi_event.Repository
+		headerRepo         repositories.HeaderRepository
+		beginningTimeRange int64
+		endingTimeRange    int64
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+
+		beginningTimeRange = int64(test_helpers.GetRandomInt(1558710000, 1558720000))
+		endingTimeRange = int64(test_helpers.GetRandomInt(1558720001, 1558730000))
+		headerRepo = repositories.NewHeaderRepository(db)
+		medianPriceRepo = abi_event.Repository{}
+		medianPriceRepo.SetDB(db)
+		rand.Seed(GinkgoRandomSeed())
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	It("returns median prices for the ilk between a time range", func() {
+		fakeHeaderOne := fakes.GetFakeHeaderWithTimestamp(beginningTimeRange, rand.Int63())
+		headerID, headerOneErr := headerRepo.CreateOrUpdateHeader(fakeHeaderOne)
+		Expect(headerOneErr).NotTo(HaveOccurred())
+		logID := test_data.CreateTestLog(headerID, db).ID
+		medianPrice := generateMedianPrice(test_helpers.FakeIlk.Hex, 1, headerID, logID)
+		createErr := medianPriceRepo.Create([]shared.InsertionModel{medianPrice})
+		Expect(createErr).NotTo(HaveOccurred())
+
+		fakeHeaderTwo := fakes.GetFakeHeaderWithTimestamp(endingTimeRange, fakeHeaderOne.BlockNumber+1)
+		anotherHeaderID, headerTwoErr := headerRepo.CreateOrUpdateHeader(fakeHeaderTwo)
+		Expect(headerTwoErr).NotTo(HaveOccurred())
+		anotherLogID := test_data.CreateTestLog(anotherHeaderID, db).ID
+		anotherMedianPrice := generateMedianPrice(test_helpers.FakeIlk.Hex, 2, anotherHeaderID, anotherLogID)
+		anotherCreateErr := medianPriceRepo.Create([]shared.InsertionModel{anotherMedianPrice})
+		Expect(anotherCreateErr).NotTo(HaveOccurred())
+
+		var dbMedianPrices []test_helpers.MedianPrice
+		err := db.Select(&dbMedianPrices, `SELECT ilk_identifier, val, age FROM api.all_median_prices($1, $2, $3)`,
+			test_helpers.FakeIlk.Identifier, beginningTimeRange, endingTimeRange)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dbMedianPrices).To(Equal([]test_helpers.MedianPrice{
+			{
+				IlkIdentifier: test_helpers.FakeIlk.Identifier,
+				Val:           anotherMedianPrice.ColumnValues["val"].(string),
+				Age:           anotherMedianPrice.ColumnValues["age"].(string),
+			},
+			{
+				IlkIdentifier: test_helpers.FakeIlk.Identifier,
+				Val:           medianPrice.ColumnValues["val"].(string),
+				Age:           medianPrice.ColumnValues["age"].(string),
+			},
+		}))
+	})
+
+	It("ignores median prices for other ilks", func() {
+		fakeHeader := fakes.GetFakeHeaderWithTimestamp(beginningTimeRange, rand.Int63())
+		headerID, headerErr := headerRepo.CreateOrUpdateHeader(fakeHeader)
+		Expect(headerErr).NotTo(HaveOccurred())
+		medianPrice := generateMedianPrice(test_helpers.FakeIlk.Hex, 1, headerID, test_data.CreateTestLog(headerID, db).ID)
+		anotherMedianPrice := generateMedianPrice(test_helpers.AnotherFakeIlk.Hex, 2, headerID, test_data.CreateTestLog(headerID, db).ID)
+		createErr := medianPriceRepo.Create([]shared.InsertionModel{medianPrice, anotherMedianPrice})
+		Expect(createErr).NotTo(HaveOccurred())
+
+		var dbMedianPrices []test_helpers.MedianPrice
+		err := db.Select(&dbMedianPrices, `SELECT ilk_identifier, val, age FROM api.all_median_prices($1, $2, $3)`,
+			test_helpers.FakeIlk.Identifier, beginningTimeRange, endingTimeRange)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dbMedianPrices).To(ConsistOf(test_helpers.MedianPrice{
+			IlkIdentifier: test_helpers.FakeIlk.Identifier,
+			Val:           medianPrice.ColumnValues["val"].(string),
+			Age:           medianPrice.ColumnValues["age"].(string),
+		}))
+	})
+
+	It("ignores median prices not in time range", func() {
+		fakeHeaderOne := fakes.GetFakeHeaderWithTimestamp(beginningTimeRange, rand.Int63())
+		headerID, headerOneErr := headerRepo.CreateOrUpdateHeader(fakeHeaderOne)
+		Expect(headerOneErr).NotTo(HaveOccurred())
+		medianPrice := generateMedianPrice(test_helpers.FakeIlk.Hex, 1, headerID, test_data.CreateTestLog(headerID, db).ID)
+		createErr := medianPriceRepo.Create([]shared.InsertionModel{medianPrice})
+		Expect(createErr).NotTo(HaveOccurred())
+
+		fakeHeaderTwo := fakes.GetFakeHeaderWithTimestamp(endingTimeRange+1, fakeHeaderOne.BlockNumber+1)
+		anotherHeaderID, headerTwoErr := headerRepo.CreateOrUpdateHeader(fakeHeaderTwo)
+		Expect(headerTwoErr).NotTo(HaveOccurred())
+		anotherMedianPrice := generateMedianPrice(test_helpers.FakeIlk.Hex, 2, anotherHeaderID, test_data.CreateTestLog(anotherHeaderID, db).ID)
+		anotherCreateErr := medianPriceRepo.Create([]shared.InsertionModel{anotherMedianPrice})
+		Expect(anotherCreateErr).NotTo(HaveOccurred())
+
+		var dbMedianPrices []test_helpers.MedianPrice
+		err := db.Select(&dbMedianPrices, `SELECT ilk_identifier, val, age FROM api.all_median_prices($1, $2, $3)`,
+			test_helpers.FakeIlk.Identifier, beginningTimeRange, endingTimeRange)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dbMedianPrices).To(ConsistOf(test_helpers.MedianPrice{
+			IlkIdentifier: test_helpers.FakeIlk.Identifier,
+			Val:           medianPrice.ColumnValues["val"].(string),
+			Age:           medianPrice.ColumnValues["age"].(string),
+		}))
+	})
+})
+
+func generateMedianPrice(ilk string, seed int, headerID, logID int64) shared.InsertionModel {
+	medianPrice := test_data.CopyModel(test_data.LogMedianPriceModel)
+	medianPrice.ForeignKeyValues[constants.IlkFK] = ilk
+	medianPrice.ColumnValues["val"] = strconv.Itoa(1 + seed)
+	medianPrice.ColumnValues["age"] = strconv.Itoa(2 + seed)
+	medianPrice.ColumnValues[constants.HeaderFK] = headerID
+	medianPrice.ColumnValues[constants.LogFK] = logID
+	return medianPrice
+}
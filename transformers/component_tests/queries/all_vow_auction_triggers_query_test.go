@@ -0,0 +1,191 @@
+package queries
+
+import (
+	"database/sql"
+	"math/rand"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/flap_kick"
+	"github.com/vulcanize/mcd_transformers/transformers/events/flop_kick"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("Vow auction trigger and debt settlement queries", func() {
+	var (
+		db           *postgres.DB
+		headerRepo   repositories.HeaderRepository
+		flapKickRepo flap_kick.FlapKickRepository
+		flopKickRepo flop_kick.FlopKickRepository
+		vowFlapRepo  log_note.Repository
+		vowFlopRepo  log_note.Repository
+		vowHealRepo  log_note.Repository
+		vowKissRepo  log_note.Repository
+		blockNumber  int64
+		headerID     int64
+		logIndex     uint
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepo = repositories.NewHeaderRepository(db)
+		flapKickRepo = flap_kick.FlapKickRepository{}
+		flapKickRepo.SetDB(db)
+		flopKickRepo = flop_kick.FlopKickRepository{}
+		flopKickRepo.SetDB(db)
+		vowFlapRepo = log_note.Repository{}
+		vowFlapRepo.SetDB(db)
+		vowFlopRepo = log_note.Repository{}
+		vowFlopRepo.SetDB(db)
+		vowHealRepo = log_note.Repository{}
+		vowHealRepo.SetDB(db)
+		vowKissRepo = log_note.Repository{}
+		vowKissRepo.SetDB(db)
+
+		rand.Seed(GinkgoRandomSeed())
+		blockNumber = rand.Int63n(1000000)
+		var headerErr error
+		headerID, headerErr = headerRepo.CreateOrUpdateHeader(fakes.GetFakeHeader(blockNumber))
+		Expect(headerErr).NotTo(HaveOccurred())
+		logIndex = 0
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createLogInTransaction := func(txHash common.Hash, txIndex uint) core.HeaderSyncLog {
+		logIndex++
+		log := types.Log{
+			BlockNumber: uint64(blockNumber),
+			TxHash:      txHash,
+			TxIndex:     txIndex,
+			Index:       logIndex,
+		}
+		return test_data.CreateLogs(headerID, []types.Log{log}, db)[0]
+	}
+
+	createModel := func(model shared.InsertionModel, log core.HeaderSyncLog) shared.InsertionModel {
+		copiedModel := test_data.CopyModel(model)
+		copiedModel.ColumnValues[constants.HeaderFK] = headerID
+		copiedModel.ColumnValues[constants.LogFK] = log.ID
+		return copiedModel
+	}
+
+	Describe("all_vow_flap_events", func() {
+		It("links a vow flap call to the flap auction kicked in the same transaction", func() {
+			bidID := rand.Int()
+			triggerTx := common.HexToHash("0x1")
+
+			flapKick := createModel(test_data.FlapKickModel(), createLogInTransaction(triggerTx, 1))
+			flapKick.ColumnValues["bid_id"] = strconv.Itoa(bidID)
+			flapKickErr := flapKickRepo.Create([]shared.InsertionModel{flapKick})
+			Expect(flapKickErr).NotTo(HaveOccurred())
+
+			otherFlapKick := createModel(test_data.FlapKickModel(), createLogInTransaction(common.HexToHash("0x2"), 2))
+			otherFlapKick.ColumnValues["bid_id"] = strconv.Itoa(bidID + 1)
+			otherFlapKickErr := flapKickRepo.Create([]shared.InsertionModel{otherFlapKick})
+			Expect(otherFlapKickErr).NotTo(HaveOccurred())
+
+			vowFlap := createModel(test_data.VowFlapModel, createLogInTransaction(triggerTx, 1))
+			vowFlapErr := vowFlapRepo.Create([]shared.InsertionModel{vowFlap})
+			Expect(vowFlapErr).NotTo(HaveOccurred())
+
+			var actualEvents []test_helpers.VowAuctionTrigger
+			err := db.Select(&actualEvents, `SELECT msg_sender, bid_id, block_height FROM api.all_vow_flap_events()`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualEvents).To(ConsistOf(test_helpers.VowAuctionTrigger{
+				MsgSender:   test_data.VowFlapModel.ColumnValues["msg_sender"].(string),
+				BidId:       sql.NullString{String: strconv.Itoa(bidID), Valid: true},
+				BlockHeight: blockNumber,
+			}))
+		})
+
+		It("returns a null bid id when no flap auction was kicked in the transaction", func() {
+			vowFlap := createModel(test_data.VowFlapModel, createLogInTransaction(common.HexToHash("0x3"), 3))
+			vowFlapErr := vowFlapRepo.Create([]shared.InsertionModel{vowFlap})
+			Expect(vowFlapErr).NotTo(HaveOccurred())
+
+			var actualEvents []test_helpers.VowAuctionTrigger
+			err := db.Select(&actualEvents, `SELECT msg_sender, bid_id, block_height FROM api.all_vow_flap_events()`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualEvents).To(ConsistOf(test_helpers.VowAuctionTrigger{
+				MsgSender:   test_data.VowFlapModel.ColumnValues["msg_sender"].(string),
+				BidId:       sql.NullString{},
+				BlockHeight: blockNumber,
+			}))
+		})
+	})
+
+	Describe("all_vow_flop_events", func() {
+		It("links a vow flop call to the flop auction kicked in the same transaction", func() {
+			bidID := rand.Int()
+			triggerTx := common.HexToHash("0x4")
+
+			flopKick := createModel(test_data.FlopKickModel(), createLogInTransaction(triggerTx, 4))
+			flopKick.ColumnValues["bid_id"] = strconv.Itoa(bidID)
+			flopKickErr := flopKickRepo.Create([]shared.InsertionModel{flopKick})
+			Expect(flopKickErr).NotTo(HaveOccurred())
+
+			vowFlop := createModel(test_data.VowFlopModel, createLogInTransaction(triggerTx, 4))
+			vowFlopErr := vowFlopRepo.Create([]shared.InsertionModel{vowFlop})
+			Expect(vowFlopErr).NotTo(HaveOccurred())
+
+			var actualEvents []test_helpers.VowAuctionTrigger
+			err := db.Select(&actualEvents, `SELECT msg_sender, bid_id, block_height FROM api.all_vow_flop_events()`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualEvents).To(ConsistOf(test_helpers.VowAuctionTrigger{
+				MsgSender:   test_data.VowFlopModel.ColumnValues["msg_sender"].(string),
+				BidId:       sql.NullString{String: strconv.Itoa(bidID), Valid: true},
+				BlockHeight: blockNumber,
+			}))
+		})
+	})
+
+	Describe("all_vow_debt_settlements", func() {
+		It("returns heal and kiss calls with the amount of debt netted", func() {
+			vowHeal := createModel(test_data.VowHealModel, createLogInTransaction(common.HexToHash("0x5"), 5))
+			vowHealErr := vowHealRepo.Create([]shared.InsertionModel{vowHeal})
+			Expect(vowHealErr).NotTo(HaveOccurred())
+
+			vowKiss := createModel(test_data.VowKissModel, createLogInTransaction(common.HexToHash("0x6"), 6))
+			vowKissErr := vowKissRepo.Create([]shared.InsertionModel{vowKiss})
+			Expect(vowKissErr).NotTo(HaveOccurred())
+
+			var actualSettlements []test_helpers.VowDebtSettlement
+			err := db.Select(&actualSettlements, `SELECT act, rad, block_height FROM api.all_vow_debt_settlements()`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualSettlements).To(ConsistOf(
+				test_helpers.VowDebtSettlement{
+					Act:         "heal",
+					Rad:         test_data.VowHealModel.ColumnValues["rad"].(string),
+					BlockHeight: blockNumber,
+				},
+				test_helpers.VowDebtSettlement{
+					Act:         "kiss",
+					Rad:         test_data.VowKissModel.ColumnValues["rad"].(string),
+					BlockHeight: blockNumber,
+				},
+			))
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package queries
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/chief"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("Chief governance queries", func() {
+	var (
+		db                *postgres.DB
+		headerRepository  repositories.HeaderRepository
+		storageRepository chief.ChiefStorageRepository
+		blockOne          int64
+		blockTwo          int64
+		voterOne          = "0xVoterOne"
+		voterTwo          = "0xVoterTwo"
+		slateOne          = "0xSlateOne"
+		slateTwo          = "0xSlateTwo"
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepository = repositories.NewHeaderRepository(db)
+		storageRepository = chief.ChiefStorageRepository{}
+		storageRepository.SetDB(db)
+		blockOne = rand.Int63n(1000000)
+		blockTwo = blockOne + 1
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createStorageValue := func(blockNumber int64, metadata utils.StorageValueMetadata, value string) {
+		createErr := storageRepository.Create(int(blockNumber), fakes.FakeHash.Hex(), metadata, value)
+		Expect(createErr).NotTo(HaveOccurred())
+	}
+
+	voteFor := func(blockNumber int64, voter, slate, deposits string) {
+		keys := map[utils.Key]string{constants.MsgSender: voter}
+		createStorageValue(blockNumber, utils.GetStorageValueMetadata(chief.Votes, keys, utils.Bytes32), slate)
+		createStorageValue(blockNumber, utils.GetStorageValueMetadata(chief.Deposits, keys, utils.Uint256), deposits)
+	}
+
+	Describe("chief_hat", func() {
+		It("returns the hat as of the given block", func() {
+			createStorageValue(blockOne, chief.HatMetadata, "0xSpellOne")
+			createStorageValue(blockTwo, chief.HatMetadata, "0xSpellTwo")
+
+			var hatAtBlockOne, currentHat string
+			errOne := db.Get(&hatAtBlockOne, `SELECT * FROM api.chief_hat($1)`, blockOne)
+			Expect(errOne).NotTo(HaveOccurred())
+			errTwo := db.Get(&currentHat, `SELECT * FROM api.chief_hat($1)`, blockTwo)
+			Expect(errTwo).NotTo(HaveOccurred())
+
+			Expect(hatAtBlockOne).To(Equal("0xSpellOne"))
+			Expect(currentHat).To(Equal("0xSpellTwo"))
+		})
+	})
+
+	Describe("chief_slate_support", func() {
+		It("sums the latest deposits of voters whose latest vote is the slate", func() {
+			voteFor(blockOne, voterOne, slateOne, "100")
+			voteFor(blockOne, voterTwo, slateOne, "50")
+			voteFor(blockTwo, voterTwo, slateTwo, "75")
+
+			var supportAtBlockOne, supportAtBlockTwo string
+			errOne := db.Get(&supportAtBlockOne, `SELECT * FROM api.chief_slate_support($1, $2)`, slateOne, blockOne)
+			Expect(errOne).NotTo(HaveOccurred())
+			errTwo := db.Get(&supportAtBlockTwo, `SELECT * FROM api.chief_slate_support($1, $2)`, slateOne, blockTwo)
+			Expect(errTwo).NotTo(HaveOccurred())
+
+			Expect(supportAtBlockOne).To(Equal("150"))
+			Expect(supportAtBlockTwo).To(Equal("100"))
+		})
+
+		It("returns zero for a slate without support", func() {
+			var support string
+			err := db.Get(&support, `SELECT * FROM api.chief_slate_support($1)`, slateOne)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(support).To(Equal("0"))
+		})
+	})
+
+	Describe("all_chief_slates", func() {
+		It("returns etched slates with their candidates and support", func() {
+			headerID, headerErr := headerRepository.CreateOrUpdateHeader(fakes.GetFakeHeader(blockOne))
+			Expect(headerErr).NotTo(HaveOccurred())
+			etchRepository := log_note.Repository{}
+			etchRepository.SetDB(db)
+			etchModel := test_data.CopyModel(test_data.ChiefEtchModel)
+			etchModel.ColumnValues["slate"] = slateOne
+			etchModel.ColumnValues["yays"] = "{0xSpellOne,0xSpellTwo}"
+			etchModel.ColumnValues[constants.HeaderFK] = headerID
+			etchModel.ColumnValues[constants.LogFK] = test_data.CreateTestLog(headerID, db).ID
+			createErr := etchRepository.Create([]shared.InsertionModel{etchModel})
+			Expect(createErr).NotTo(HaveOccurred())
+			voteFor(blockOne, voterOne, slateOne, "100")
+
+			var slates []ChiefSlate
+			err := db.Select(&slates, `SELECT slate, yays, support FROM api.all_chief_slates($1)`, blockOne)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slates).To(ConsistOf(ChiefSlate{
+				Slate:   slateOne,
+				Yays:    "{0xSpellOne,0xSpellTwo}",
+				Support: "100",
+			}))
+		})
+	})
+})
+
+type ChiefSlate struct {
+	Slate   string
+	Yays    string
+	Support string
+}
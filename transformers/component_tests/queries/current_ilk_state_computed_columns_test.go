@@ -29,9 +29,9 @@ import (
 
 	"github.com/vulcanize/mcd_transformers/test_config"
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/events/spot_file/mat"
 	"github.com/vulcanize/mcd_transformers/transformers/events/vat_file/ilk"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -72,7 +72,7 @@ var _ = Describe("current ilk state computed columns", func() {
 
 	Describe("current_ilk_state_frobs", func() {
 		It("returns relevant frobs for a current_ilk_state", func() {
-			frobRepo := vat_frob.VatFrobRepository{}
+			frobRepo := log_note.Repository{}
 			frobRepo.SetDB(db)
 			frobEvent := test_data.VatFrobModelWithPositiveDart()
 			frobEvent.ForeignKeyValues[constants.UrnFK] = fakeGuy
@@ -106,7 +106,7 @@ var _ = Describe("current ilk state computed columns", func() {
 			)
 
 			BeforeEach(func() {
-				frobRepo := vat_frob.VatFrobRepository{}
+				frobRepo := log_note.Repository{}
 				frobRepo.SetDB(db)
 				oldFrob = test_data.VatFrobModelWithPositiveDart()
 				oldFrob.ForeignKeyValues[constants.UrnFK] = fakeGuy
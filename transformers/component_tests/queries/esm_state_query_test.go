@@ -0,0 +1,122 @@
+package queries
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/esm"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("ESM state query", func() {
+	var (
+		db                *postgres.DB
+		headerRepo        repositories.HeaderRepository
+		storageRepository esm.EsmStorageRepository
+		esmFireRepo       log_note.Repository
+		blockOne          int64
+		blockTwo          int64
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepo = repositories.NewHeaderRepository(db)
+		storageRepository = esm.EsmStorageRepository{}
+		storageRepository.SetDB(db)
+		esmFireRepo = log_note.Repository{}
+		esmFireRepo.SetDB(db)
+		rand.Seed(GinkgoRandomSeed())
+		blockOne = rand.Int63n(1000000)
+		blockTwo = blockOne + 1
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createStorageValue := func(blockNumber int64, metadata utils.StorageValueMetadata, value string) {
+		createErr := storageRepository.Create(int(blockNumber), fakes.FakeHash.Hex(), metadata, value)
+		Expect(createErr).NotTo(HaveOccurred())
+	}
+
+	It("reports how much MKR is still needed to reach the threshold", func() {
+		createStorageValue(blockOne, esm.MinMetadata, "50000")
+		createStorageValue(blockOne, esm.SumMetadata, "20000")
+		createStorageValue(blockTwo, esm.SumMetadata, "35000")
+
+		var stateAtBlockOne, stateAtBlockTwo test_helpers.EsmState
+		errOne := db.Get(&stateAtBlockOne, `SELECT * FROM api.esm_state($1)`, blockOne)
+		errTwo := db.Get(&stateAtBlockTwo, `SELECT * FROM api.esm_state($1)`, blockTwo)
+
+		Expect(errOne).NotTo(HaveOccurred())
+		Expect(stateAtBlockOne).To(Equal(test_helpers.EsmState{
+			BlockHeight: blockOne,
+			Sum:         "20000",
+			Min:         "50000",
+			Remaining:   "30000",
+			Fired:       false,
+		}))
+		Expect(errTwo).NotTo(HaveOccurred())
+		Expect(stateAtBlockTwo.Sum).To(Equal("35000"))
+		Expect(stateAtBlockTwo.Remaining).To(Equal("15000"))
+	})
+
+	It("reports no remaining MKR once the threshold is reached", func() {
+		createStorageValue(blockOne, esm.MinMetadata, "50000")
+		createStorageValue(blockOne, esm.SumMetadata, "60000")
+
+		var state test_helpers.EsmState
+		err := db.Get(&state, `SELECT * FROM api.esm_state($1)`, blockOne)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Remaining).To(Equal("0"))
+	})
+
+	It("reports the ESM as fired from the block of the fire event", func() {
+		createStorageValue(blockOne, esm.MinMetadata, "50000")
+		headerID, headerErr := headerRepo.CreateOrUpdateHeader(fakes.GetFakeHeader(blockTwo))
+		Expect(headerErr).NotTo(HaveOccurred())
+		esmFire := test_data.CopyModel(test_data.EsmFireModel)
+		esmFire.ColumnValues[constants.HeaderFK] = headerID
+		esmFire.ColumnValues[constants.LogFK] = test_data.CreateTestLog(headerID, db).ID
+		fireErr := esmFireRepo.Create([]shared.InsertionModel{esmFire})
+		Expect(fireErr).NotTo(HaveOccurred())
+
+		var stateBeforeFire, stateAfterFire test_helpers.EsmState
+		errBefore := db.Get(&stateBeforeFire, `SELECT * FROM api.esm_state($1)`, blockOne)
+		errAfter := db.Get(&stateAfterFire, `SELECT * FROM api.esm_state($1)`, blockTwo)
+
+		Expect(errBefore).NotTo(HaveOccurred())
+		Expect(stateBeforeFire.Fired).To(BeFalse())
+		Expect(errAfter).NotTo(HaveOccurred())
+		Expect(stateAfterFire.Fired).To(BeTrue())
+	})
+
+	It("extends the system status with the ESM state", func() {
+		createStorageValue(blockOne, esm.MinMetadata, "50000")
+		createStorageValue(blockOne, esm.SumMetadata, "20000")
+
+		var state test_helpers.EsmState
+		err := db.Get(&state, `
+			SELECT * FROM api.system_status_esm(
+				(SELECT (block_height, vat_live, cat_live, vow_live, flap_live, flop_live, spot_live, pot_live,
+				         flip_bids_yanked)::api.system_status
+				 FROM api.system_live_status($1)))`, blockOne)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Remaining).To(Equal("30000"))
+	})
+})
@@ -30,7 +30,7 @@ import (
 
 	"github.com/vulcanize/mcd_transformers/test_config"
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/vat"
@@ -44,7 +44,7 @@ var _ = Describe("Frob event computed columns", func() {
 		fakeGuy          = "fakeAddress"
 		fakeHeader       core.Header
 		frobGethLog      types.Log
-		frobRepo         vat_frob.VatFrobRepository
+		frobRepo         log_note.Repository
 		frobEvent        shared.InsertionModel
 		headerId         int64
 		vatRepository    vat.VatStorageRepository
@@ -65,7 +65,7 @@ var _ = Describe("Frob event computed columns", func() {
 		frobHeaderSyncLog := test_data.CreateTestLog(headerId, db)
 		frobGethLog = frobHeaderSyncLog.Log
 
-		frobRepo = vat_frob.VatFrobRepository{}
+		frobRepo = log_note.Repository{}
 		frobRepo.SetDB(db)
 		frobEvent = test_data.VatFrobModelWithPositiveDart()
 		frobEvent.ForeignKeyValues[constants.UrnFK] = fakeGuy
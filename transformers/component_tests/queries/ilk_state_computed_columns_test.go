@@ -30,9 +30,9 @@ import (
 	"github.com/vulcanize/mcd_transformers/test_config"
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
 	"github.com/vulcanize/mcd_transformers/transformers/events/bite"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/events/spot_file/mat"
 	"github.com/vulcanize/mcd_transformers/transformers/events/vat_file/ilk"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -73,7 +73,7 @@ var _ = Describe("Ilk state computed columns", func() {
 
 	Describe("ilk_state_frobs", func() {
 		It("returns relevant frobs for an ilk_state", func() {
-			frobRepo := vat_frob.VatFrobRepository{}
+			frobRepo := log_note.Repository{}
 			frobRepo.SetDB(db)
 			frobEvent := test_data.VatFrobModelWithPositiveDart()
 			frobEvent.ForeignKeyValues[constants.UrnFK] = fakeGuy
@@ -108,7 +108,7 @@ var _ = Describe("Ilk state computed columns", func() {
 			)
 
 			BeforeEach(func() {
-				frobRepo := vat_frob.VatFrobRepository{}
+				frobRepo := log_note.Repository{}
 				frobRepo.SetDB(db)
 				oldFrob = test_data.VatFrobModelWithPositiveDart()
 				oldFrob.ForeignKeyValues[constants.UrnFK] = fakeGuy
@@ -22,6 +22,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
 	"github.com/vulcanize/vulcanizedb/pkg/core"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
@@ -29,6 +30,8 @@ import (
 
 	"github.com/vulcanize/mcd_transformers/test_config"
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/cdp_manager"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/vat"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
 )
@@ -113,4 +116,54 @@ var _ = Describe("Managed CDP computed columns", func() {
 			test_helpers.AssertUrn(actualUrn, expectedUrn)
 		})
 	})
+
+	Describe("managed_cdp_allowed_operators", func() {
+		It("returns operators the current owner has allowed on the cdp", func() {
+			owner := "address11"
+			allowedUsr := "allowedUsr"
+			revokedUsr := "revokedUsr"
+			cdpManagerRepository := cdp_manager.CdpManagerStorageRepository{}
+			cdpManagerRepository.SetDB(db)
+			insertCdpCan := func(usr, can string, blockNumber int) {
+				metadata := utils.GetStorageValueMetadata(cdp_manager.CdpCan, map[utils.Key]string{
+					constants.Owner: owner, constants.Cdpi: strconv.Itoa(fakeCdpi), constants.Usr: usr}, utils.Uint256)
+				err := cdpManagerRepository.Create(blockNumber, fakeHeader.Hash, metadata, can)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			insertCdpCan(allowedUsr, "1", blockNumber)
+			insertCdpCan(revokedUsr, "1", blockNumber)
+			insertCdpCan(revokedUsr, "0", blockNumber+1)
+
+			var operators []string
+			getOperatorsErr := db.Select(&operators, `
+				SELECT * FROM api.managed_cdp_allowed_operators(
+					(SELECT (id, cdpi, usr, urn_identifier, ilk_identifier, created)::api.managed_cdp
+					 FROM api.managed_cdp
+					 WHERE cdpi = $1))
+			`, fakeCdpi)
+
+			Expect(getOperatorsErr).NotTo(HaveOccurred())
+			Expect(operators).To(ConsistOf(allowedUsr))
+		})
+
+		It("ignores permissions granted by a previous owner", func() {
+			cdpManagerRepository := cdp_manager.CdpManagerStorageRepository{}
+			cdpManagerRepository.SetDB(db)
+			metadata := utils.GetStorageValueMetadata(cdp_manager.CdpCan, map[utils.Key]string{
+				constants.Owner: "previousOwner", constants.Cdpi: strconv.Itoa(fakeCdpi), constants.Usr: "usr"}, utils.Uint256)
+			createErr := cdpManagerRepository.Create(blockNumber, fakeHeader.Hash, metadata, "1")
+			Expect(createErr).NotTo(HaveOccurred())
+
+			var operators []string
+			getOperatorsErr := db.Select(&operators, `
+				SELECT * FROM api.managed_cdp_allowed_operators(
+					(SELECT (id, cdpi, usr, urn_identifier, ilk_identifier, created)::api.managed_cdp
+					 FROM api.managed_cdp
+					 WHERE cdpi = $1))
+			`, fakeCdpi)
+
+			Expect(getOperatorsErr).NotTo(HaveOccurred())
+			Expect(operators).To(BeEmpty())
+		})
+	})
 })
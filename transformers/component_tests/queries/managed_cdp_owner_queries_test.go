@@ -0,0 +1,163 @@
+package queries
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/abi_event"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/cdp_manager"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("Managed CDP owner queries", func() {
+	var (
+		db         *postgres.DB
+		fakeHeader core.Header
+		headerID   int64
+		proxyRepo  abi_event.Repository
+		wallet     = "0xWallet"
+		proxy      = "0xProxy"
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepository := repositories.NewHeaderRepository(db)
+		fakeHeader = fakes.GetFakeHeader(rand.Int63n(1000000))
+		var headerErr error
+		headerID, headerErr = headerRepository.CreateOrUpdateHeader(fakeHeader)
+		Expect(headerErr).NotTo(HaveOccurred())
+		proxyRepo = abi_event.Repository{}
+		proxyRepo.SetDB(db)
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createManagedCdp := func(cdpi int, owner string) {
+		storageValues := test_helpers.GetCdpManagerStorageValues(1, test_helpers.FakeIlk.Hex, test_data.FakeUrn, cdpi)
+		storageValues[cdp_manager.Owns] = owner
+		cdpErr := test_helpers.CreateManagedCdp(db, fakeHeader, storageValues, test_helpers.GetCdpManagerMetadatas(strconv.Itoa(cdpi)))
+		Expect(cdpErr).NotTo(HaveOccurred())
+	}
+
+	createProxy := func(owner, proxy string) {
+		proxyCreated := test_data.ProxyCreatedModel()
+		proxyCreated.ColumnValues["owner"] = owner
+		proxyCreated.ColumnValues["proxy"] = proxy
+		proxyCreated.ColumnValues[constants.HeaderFK] = headerID
+		proxyCreated.ColumnValues[constants.LogFK] = test_data.CreateTestLog(headerID, db).ID
+		createErr := proxyRepo.Create([]shared.InsertionModel{proxyCreated})
+		Expect(createErr).NotTo(HaveOccurred())
+	}
+
+	Describe("managed_cdp_owner", func() {
+		It("returns the owner of the proxy holding the cdp", func() {
+			cdpi := rand.Int()
+			createManagedCdp(cdpi, proxy)
+			createProxy(wallet, proxy)
+
+			var owner string
+			err := db.Get(&owner, `
+				SELECT * FROM api.managed_cdp_owner(
+					(SELECT (id, cdpi, usr, urn_identifier, ilk_identifier, created)::api.managed_cdp
+					 FROM api.managed_cdp
+					 WHERE cdpi = $1))
+			`, cdpi)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(wallet))
+		})
+
+		It("matches the proxy regardless of address case", func() {
+			cdpi := rand.Int()
+			createManagedCdp(cdpi, strings.ToLower(proxy))
+			createProxy(wallet, proxy)
+
+			var owner string
+			err := db.Get(&owner, `
+				SELECT * FROM api.managed_cdp_owner(
+					(SELECT (id, cdpi, usr, urn_identifier, ilk_identifier, created)::api.managed_cdp
+					 FROM api.managed_cdp
+					 WHERE cdpi = $1))
+			`, cdpi)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(wallet))
+		})
+
+		It("returns the cdp usr if it is not a known proxy", func() {
+			cdpi := rand.Int()
+			createManagedCdp(cdpi, wallet)
+
+			var owner string
+			err := db.Get(&owner, `
+				SELECT * FROM api.managed_cdp_owner(
+					(SELECT (id, cdpi, usr, urn_identifier, ilk_identifier, created)::api.managed_cdp
+					 FROM api.managed_cdp
+					 WHERE cdpi = $1))
+			`, cdpi)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(wallet))
+		})
+	})
+
+	Describe("get_managed_cdps_by_owner", func() {
+		It("returns cdps held directly by the owner or through their proxy", func() {
+			directCdpi := 1
+			proxiedCdpi := 2
+			otherCdpi := 3
+			createManagedCdp(directCdpi, wallet)
+			createManagedCdp(proxiedCdpi, proxy)
+			createManagedCdp(otherCdpi, "0xOther")
+			createProxy(wallet, proxy)
+
+			var cdpis []string
+			err := db.Select(&cdpis, `SELECT cdpi FROM api.get_managed_cdps_by_owner($1)`, wallet)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cdpis).To(ConsistOf(strconv.Itoa(directCdpi), strconv.Itoa(proxiedCdpi)))
+		})
+
+		It("matches the owner and proxies regardless of address case", func() {
+			directCdpi := 1
+			proxiedCdpi := 2
+			createManagedCdp(directCdpi, strings.ToLower(wallet))
+			createManagedCdp(proxiedCdpi, strings.ToUpper(proxy))
+			createProxy(wallet, proxy)
+
+			var cdpis []string
+			err := db.Select(&cdpis, `SELECT cdpi FROM api.get_managed_cdps_by_owner($1)`, strings.ToUpper(wallet))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cdpis).To(ConsistOf(strconv.Itoa(directCdpi), strconv.Itoa(proxiedCdpi)))
+		})
+
+		It("applies max_results and result_offset", func() {
+			createManagedCdp(1, wallet)
+			createManagedCdp(2, proxy)
+			createProxy(wallet, proxy)
+
+			var cdpis []string
+			err := db.Select(&cdpis, `SELECT cdpi FROM api.get_managed_cdps_by_owner($1, $2, $3)`, wallet, 1, 1)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cdpis).To(ConsistOf("2"))
+		})
+	})
+})
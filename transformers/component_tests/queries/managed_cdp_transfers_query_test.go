@@ -0,0 +1,127 @@
+package queries
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/abi_event"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("Managed cdp transfers query", func() {
+	var (
+		db           *postgres.DB
+		headerRepo   repositories.HeaderRepository
+		newCdpRepo   abi_event.Repository
+		giveRepo     log_note.Repository
+		cdpi         string
+		blockOne     int64
+		timestampOne int
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepo = repositories.NewHeaderRepository(db)
+		newCdpRepo = abi_event.Repository{}
+		newCdpRepo.SetDB(db)
+		giveRepo = log_note.Repository{}
+		giveRepo.SetDB(db)
+		rand.Seed(GinkgoRandomSeed())
+		cdpi = strconv.Itoa(rand.Int())
+		blockOne = rand.Int63n(1000000)
+		timestampOne = int(rand.Int31())
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createHeader := func(blockNumber int64, timestamp int) int64 {
+		header := fakes.GetFakeHeader(blockNumber)
+		header.Timestamp = strconv.Itoa(timestamp)
+		headerID, headerErr := headerRepo.CreateOrUpdateHeader(header)
+		Expect(headerErr).NotTo(HaveOccurred())
+		return headerID
+	}
+
+	createNewCdp := func(headerID int64, cdp string) shared.InsertionModel {
+		newCdp := test_data.NewCdpModel()
+		newCdp.ColumnValues["cdp"] = cdp
+		newCdp.ColumnValues[constants.HeaderFK] = headerID
+		newCdp.ColumnValues[constants.LogFK] = test_data.CreateTestLog(headerID, db).ID
+		createErr := newCdpRepo.Create([]shared.InsertionModel{newCdp})
+		Expect(createErr).NotTo(HaveOccurred())
+		return newCdp
+	}
+
+	createGive := func(headerID int64, cdp, dst string) shared.InsertionModel {
+		give := test_data.CopyModel(test_data.CdpManagerGiveModel)
+		give.ColumnValues["cdpi"] = cdp
+		give.ColumnValues["dst"] = dst
+		give.ColumnValues[constants.HeaderFK] = headerID
+		give.ColumnValues[constants.LogFK] = test_data.CreateTestLog(headerID, db).ID
+		createErr := giveRepo.Create([]shared.InsertionModel{give})
+		Expect(createErr).NotTo(HaveOccurred())
+		return give
+	}
+
+	It("returns the opening owner and every subsequent give for the cdp, newest first", func() {
+		headerOneID := createHeader(blockOne, timestampOne)
+		newCdp := createNewCdp(headerOneID, cdpi)
+
+		blockTwo := blockOne + 1
+		timestampTwo := timestampOne + 1000
+		headerTwoID := createHeader(blockTwo, timestampTwo)
+		newOwner := "0x7a3E2B5c8d9F0a1b2c3D4E5f6a7B8c9d0e1F2A3b"
+		give := createGive(headerTwoID, cdpi, newOwner)
+
+		var transfers []test_helpers.ManagedCdpTransfer
+		err := db.Select(&transfers, `SELECT cdpi, owner, msg_sender, block_height, transferred FROM api.managed_cdp_transfers($1)`, cdpi)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transfers).To(Equal([]test_helpers.ManagedCdpTransfer{
+			{
+				Cdpi:        cdpi,
+				Owner:       newOwner,
+				MsgSender:   give.ColumnValues["msg_sender"].(string),
+				BlockHeight: blockTwo,
+				Transferred: test_helpers.GetExpectedTimestamp(timestampTwo),
+			},
+			{
+				Cdpi:        cdpi,
+				Owner:       newCdp.ColumnValues["own"].(string),
+				MsgSender:   newCdp.ColumnValues["usr"].(string),
+				BlockHeight: blockOne,
+				Transferred: test_helpers.GetExpectedTimestamp(timestampOne),
+			},
+		}))
+	})
+
+	It("ignores transfers of other cdps", func() {
+		headerID := createHeader(blockOne, timestampOne)
+		createNewCdp(headerID, cdpi)
+		otherCdpi := cdpi + "1"
+		createNewCdp(headerID, otherCdpi)
+		createGive(headerID, otherCdpi, "0x7a3E2B5c8d9F0a1b2c3D4E5f6a7B8c9d0e1F2A3b")
+
+		var transfers []test_helpers.ManagedCdpTransfer
+		err := db.Select(&transfers, `SELECT cdpi, owner, msg_sender, block_height, transferred FROM api.managed_cdp_transfers($1)`, cdpi)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(transfers)).To(Equal(1))
+		Expect(transfers[0].Cdpi).To(Equal(cdpi))
+	})
+})
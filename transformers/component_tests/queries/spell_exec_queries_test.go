@@ -0,0 +1,175 @@
+package queries
+
+import (
+	"database/sql"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/events/vat_file/ilk"
+	"github.com/vulcanize/mcd_transformers/transformers/events/vow_file"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("Spell exec queries", func() {
+	var (
+		db           *postgres.DB
+		headerID     int64
+		blockNumber  int64
+		logIndex     uint
+		pauseRepo    log_note.Repository
+		vatFileRepo  ilk.VatFileIlkRepository
+		vowFileRepo  vow_file.VowFileRepository
+		spellTxHash  = common.HexToHash("0x5e11")
+		otherTxHash  = common.HexToHash("0x07e7")
+		spellTxIndex = uint(1)
+		otherTxIndex = uint(2)
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepo := repositories.NewHeaderRepository(db)
+		pauseRepo = log_note.Repository{}
+		pauseRepo.SetDB(db)
+		vatFileRepo = ilk.VatFileIlkRepository{}
+		vatFileRepo.SetDB(db)
+		vowFileRepo = vow_file.VowFileRepository{}
+		vowFileRepo.SetDB(db)
+
+		blockNumber = rand.Int63n(1000000)
+		var headerErr error
+		headerID, headerErr = headerRepo.CreateOrUpdateHeader(fakes.GetFakeHeader(blockNumber))
+		Expect(headerErr).NotTo(HaveOccurred())
+		logIndex = 0
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createLogInTransaction := func(txHash common.Hash, txIndex uint) core.HeaderSyncLog {
+		logIndex++
+		log := types.Log{
+			BlockNumber: uint64(blockNumber),
+			TxHash:      txHash,
+			TxIndex:     txIndex,
+			Index:       logIndex,
+		}
+		return test_data.CreateLogs(headerID, []types.Log{log}, db)[0]
+	}
+
+	createModel := func(model shared.InsertionModel, log core.HeaderSyncLog) shared.InsertionModel {
+		copiedModel := test_data.CopyModel(model)
+		copiedModel.ColumnValues[constants.HeaderFK] = headerID
+		copiedModel.ColumnValues[constants.LogFK] = log.ID
+		return copiedModel
+	}
+
+	createSpellExec := func() shared.InsertionModel {
+		pauseExec := createModel(test_data.PauseExecModel, createLogInTransaction(spellTxHash, spellTxIndex))
+		pauseErr := pauseRepo.Create([]shared.InsertionModel{pauseExec})
+		Expect(pauseErr).NotTo(HaveOccurred())
+		return pauseExec
+	}
+
+	createVatFileIlk := func(txHash common.Hash, txIndex uint) shared.InsertionModel {
+		vatFile := createModel(test_data.VatFileIlkDustModel(), createLogInTransaction(txHash, txIndex))
+		vatFile.ForeignKeyValues[constants.IlkFK] = test_helpers.FakeIlk.Hex
+		vatFileErr := vatFileRepo.Create([]shared.InsertionModel{vatFile})
+		Expect(vatFileErr).NotTo(HaveOccurred())
+		return vatFile
+	}
+
+	Describe("all_spell_exec_events", func() {
+		It("returns executed spells", func() {
+			pauseExec := createSpellExec()
+
+			var actualExecs []test_helpers.SpellExecEvent
+			err := db.Select(&actualExecs, `SELECT usr, tag, fax, eta FROM api.all_spell_exec_events()`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualExecs).To(ConsistOf(test_helpers.SpellExecEvent{
+				Usr: pauseExec.ColumnValues["usr"].(string),
+				Tag: pauseExec.ColumnValues["tag"].(string),
+				Fax: pauseExec.ColumnValues["fax"].(string),
+				Eta: pauseExec.ColumnValues["eta"].(string),
+			}))
+		})
+	})
+
+	Describe("spell_exec_event_file_events", func() {
+		It("returns the file events emitted in the same transaction as the spell", func() {
+			createSpellExec()
+			vatFile := createVatFileIlk(spellTxHash, spellTxIndex)
+			vowFile := createModel(test_data.VowFileModel, createLogInTransaction(spellTxHash, spellTxIndex))
+			vowFileErr := vowFileRepo.Create([]shared.InsertionModel{vowFile})
+			Expect(vowFileErr).NotTo(HaveOccurred())
+			createVatFileIlk(otherTxHash, otherTxIndex)
+
+			var actualFiles []test_helpers.SpellFileEvent
+			err := db.Select(&actualFiles, `
+				SELECT source, ilk_identifier, what, data
+				FROM api.spell_exec_event_file_events(
+					(SELECT (usr, tag, fax, eta, block_height, log_id)::api.spell_exec_event
+					 FROM api.all_spell_exec_events()))`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualFiles).To(Equal([]test_helpers.SpellFileEvent{{
+				Source:        "vat_file_ilk",
+				IlkIdentifier: sql.NullString{String: test_helpers.FakeIlk.Identifier, Valid: true},
+				What:          vatFile.ColumnValues["what"].(string),
+				Data:          vatFile.ColumnValues["data"].(string),
+			}, {
+				Source: "vow_file",
+				What:   vowFile.ColumnValues["what"].(string),
+				Data:   vowFile.ColumnValues["data"].(string),
+			}}))
+		})
+	})
+
+	Describe("ilk_file_event_spell", func() {
+		It("returns the spell executed in the same transaction as the file event", func() {
+			pauseExec := createSpellExec()
+			createVatFileIlk(spellTxHash, spellTxIndex)
+
+			var spell test_helpers.SpellExecEvent
+			err := db.Get(&spell, `
+				SELECT usr, tag, fax, eta
+				FROM api.ilk_file_event_spell(
+					(SELECT (ilk_identifier, what, data, block_height, log_id)::api.ilk_file_event
+					 FROM api.all_ilk_file_events($1)))`, test_helpers.FakeIlk.Identifier)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spell.Tag).To(Equal(pauseExec.ColumnValues["tag"].(string)))
+		})
+
+		It("returns nothing for a file event made outside of a spell", func() {
+			createSpellExec()
+			createVatFileIlk(otherTxHash, otherTxIndex)
+
+			var tag sql.NullString
+			err := db.Get(&tag, `
+				SELECT tag
+				FROM api.ilk_file_event_spell(
+					(SELECT (ilk_identifier, what, data, block_height, log_id)::api.ilk_file_event
+					 FROM api.all_ilk_file_events($1)))`, test_helpers.FakeIlk.Identifier)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tag.Valid).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,166 @@
+package queries
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres/repositories"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/events/flip_kick"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/events/yank"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+var _ = Describe("System live status query", func() {
+	var (
+		db           *postgres.DB
+		cageRepo     log_note.Repository
+		flipKickRepo flip_kick.FlipKickRepository
+		yankRepo     yank.YankRepository
+		headerRepo   repositories.HeaderRepository
+		blockOne     int64
+		blockTwo     int64
+		allLive      test_helpers.SystemStatus
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		headerRepo = repositories.NewHeaderRepository(db)
+		cageRepo = log_note.Repository{}
+		cageRepo.SetDB(db)
+		flipKickRepo = flip_kick.FlipKickRepository{}
+		flipKickRepo.SetDB(db)
+		yankRepo = yank.YankRepository{}
+		yankRepo.SetDB(db)
+		rand.Seed(GinkgoRandomSeed())
+		blockOne = rand.Int63n(1000000)
+		blockTwo = blockOne + 1
+		allLive = test_helpers.SystemStatus{
+			BlockHeight: blockOne,
+			VatLive:     true,
+			CatLive:     true,
+			VowLive:     true,
+			FlapLive:    true,
+			FlopLive:    true,
+			SpotLive:    true,
+			PotLive:     true,
+		}
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	createHeader := func(blockNumber int64) int64 {
+		headerID, headerErr := headerRepo.CreateOrUpdateHeader(fakes.GetFakeHeader(blockNumber))
+		Expect(headerErr).NotTo(HaveOccurred())
+		return headerID
+	}
+
+	createCage := func(headerID int64, model shared.InsertionModel) {
+		cageModel := test_data.CopyModel(model)
+		cageModel.ColumnValues[constants.HeaderFK] = headerID
+		cageModel.ColumnValues[constants.LogFK] = test_data.CreateTestLog(headerID, db).ID
+		createErr := cageRepo.Create([]shared.InsertionModel{cageModel})
+		Expect(createErr).NotTo(HaveOccurred())
+	}
+
+	It("reports every module as live when nothing has been caged", func() {
+		createHeader(blockOne)
+
+		var status test_helpers.SystemStatus
+		err := db.Get(&status, `SELECT * FROM api.system_live_status($1)`, blockOne)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status).To(Equal(allLive))
+	})
+
+	It("reports a module as shut down from the block it was caged", func() {
+		createHeader(blockOne)
+		headerTwoID := createHeader(blockTwo)
+		createCage(headerTwoID, test_data.VatCageModel)
+		createCage(headerTwoID, test_data.FlapCageModel)
+
+		var statusBeforeCage, statusAfterCage test_helpers.SystemStatus
+		errBefore := db.Get(&statusBeforeCage, `SELECT * FROM api.system_live_status($1)`, blockOne)
+		errAfter := db.Get(&statusAfterCage, `SELECT * FROM api.system_live_status($1)`, blockTwo)
+
+		Expect(errBefore).NotTo(HaveOccurred())
+		Expect(statusBeforeCage).To(Equal(allLive))
+		Expect(errAfter).NotTo(HaveOccurred())
+		expectedStatus := allLive
+		expectedStatus.BlockHeight = blockTwo
+		expectedStatus.VatLive = false
+		expectedStatus.FlapLive = false
+		Expect(statusAfterCage).To(Equal(expectedStatus))
+	})
+
+	It("reports a module as shut down when its live storage value is zeroed", func() {
+		_, insertErr := db.Exec(`INSERT INTO maker.cat_live (block_number, block_hash, live) VALUES ($1, $2, $3), ($4, $5, $6)`,
+			blockOne, fakes.FakeHash.Hex(), 1, blockTwo, fakes.FakeHash.Hex(), 0)
+		Expect(insertErr).NotTo(HaveOccurred())
+
+		var statusBeforeCage, statusAfterCage test_helpers.SystemStatus
+		errBefore := db.Get(&statusBeforeCage, `SELECT * FROM api.system_live_status($1)`, blockOne)
+		errAfter := db.Get(&statusAfterCage, `SELECT * FROM api.system_live_status($1)`, blockTwo)
+
+		Expect(errBefore).NotTo(HaveOccurred())
+		Expect(statusBeforeCage).To(Equal(allLive))
+		Expect(errAfter).NotTo(HaveOccurred())
+		expectedStatus := allLive
+		expectedStatus.BlockHeight = blockTwo
+		expectedStatus.CatLive = false
+		Expect(statusAfterCage).To(Equal(expectedStatus))
+	})
+
+	It("counts flip auctions yanked at or before the block", func() {
+		flipAddress := test_data.EthFlipAddress()
+		headerOneID := createHeader(blockOne)
+		headerTwoID := createHeader(blockTwo)
+		bidID := rand.Int()
+
+		flipKickLog := test_data.CreateTestLog(headerOneID, db)
+		flipKickErr := test_helpers.CreateFlipKick(flipAddress, bidID, headerOneID, flipKickLog.ID,
+			test_data.FlipKickModel().ColumnValues["usr"].(string), flipKickRepo)
+		Expect(flipKickErr).NotTo(HaveOccurred())
+
+		flapYankLog := test_data.CreateTestLog(headerTwoID, db)
+		flapYankErr := test_helpers.CreateYank(test_helpers.YankCreationInput{
+			BidId:           rand.Int(),
+			ContractAddress: test_data.FlapAddress(),
+			YankRepo:        yankRepo,
+			YankHeaderId:    headerTwoID,
+			YankLogId:       flapYankLog.ID,
+		})
+		Expect(flapYankErr).NotTo(HaveOccurred())
+
+		flipYankLog := test_data.CreateTestLog(headerTwoID, db)
+		flipYankErr := test_helpers.CreateYank(test_helpers.YankCreationInput{
+			BidId:           bidID,
+			ContractAddress: flipAddress,
+			YankRepo:        yankRepo,
+			YankHeaderId:    headerTwoID,
+			YankLogId:       flipYankLog.ID,
+		})
+		Expect(flipYankErr).NotTo(HaveOccurred())
+
+		var statusBeforeYank, statusAfterYank test_helpers.SystemStatus
+		errBefore := db.Get(&statusBeforeYank, `SELECT * FROM api.system_live_status($1)`, blockOne)
+		errAfter := db.Get(&statusAfterYank, `SELECT * FROM api.system_live_status($1)`, blockTwo)
+
+		Expect(errBefore).NotTo(HaveOccurred())
+		Expect(statusBeforeYank.FlipBidsYanked).To(Equal(int64(0)))
+		Expect(errAfter).NotTo(HaveOccurred())
+		Expect(statusAfterYank.FlipBidsYanked).To(Equal(int64(1)))
+	})
+})
@@ -784,6 +784,20 @@ type IlkFileEvent struct {
 	Data          string
 }
 
+type SpellExecEvent struct {
+	Usr string
+	Tag string
+	Fax string
+	Eta string
+}
+
+type SpellFileEvent struct {
+	Source        string
+	IlkIdentifier sql.NullString `db:"ilk_identifier"`
+	What          string
+	Data          string
+}
+
 type FrobEvent struct {
 	IlkIdentifier string `db:"ilk_identifier"`
 	UrnIdentifier string `db:"urn_identifier"`
@@ -812,6 +826,65 @@ type PokeEvent struct {
 	Spot  string
 }
 
+type MedianPrice struct {
+	IlkIdentifier string `db:"ilk_identifier"`
+	Val           string
+	Age           string
+}
+
+type AuctionFileEvent struct {
+	ContractAddress string `db:"contract_address"`
+	What            string
+	Data            string
+	BlockHeight     int64 `db:"block_height"`
+}
+
+type SystemStatus struct {
+	BlockHeight    int64 `db:"block_height"`
+	VatLive        bool  `db:"vat_live"`
+	CatLive        bool  `db:"cat_live"`
+	VowLive        bool  `db:"vow_live"`
+	FlapLive       bool  `db:"flap_live"`
+	FlopLive       bool  `db:"flop_live"`
+	SpotLive       bool  `db:"spot_live"`
+	PotLive        bool  `db:"pot_live"`
+	FlipBidsYanked int64 `db:"flip_bids_yanked"`
+}
+
+type EsmState struct {
+	BlockHeight int64 `db:"block_height"`
+	Sum         string
+	Min         string
+	Remaining   string
+	Fired       bool
+}
+
+type ManagedCdpTransfer struct {
+	Cdpi        string
+	Owner       string
+	MsgSender   string `db:"msg_sender"`
+	BlockHeight int64  `db:"block_height"`
+	Transferred string
+}
+
+type UrnPermission struct {
+	UrnIdentifier string `db:"urn_identifier"`
+	Usr           string
+	BlockHeight   int `db:"block_height"`
+}
+
+type VowAuctionTrigger struct {
+	MsgSender   string         `db:"msg_sender"`
+	BidId       sql.NullString `db:"bid_id"`
+	BlockHeight int64          `db:"block_height"`
+}
+
+type VowDebtSettlement struct {
+	Act         string
+	Rad         string
+	BlockHeight int64 `db:"block_height"`
+}
+
 func GetExpectedTimestamp(epoch int) string {
 	return time.Unix(int64(epoch), 0).UTC().Format(time.RFC3339)
 }
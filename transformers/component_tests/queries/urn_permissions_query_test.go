@@ -0,0 +1,101 @@
+package queries
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/vat"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("urn permissions query", func() {
+	var (
+		db          *postgres.DB
+		vatRepo     vat.VatStorageRepository
+		blockNumber int
+		urn         = "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		proxy       = "0x9759A6Ac90977b93B58547b4A71c78317f391A28"
+		manager     = "0x1476483dD8C35F25e568113C5f70249D3976ba21"
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		vatRepo = vat.VatStorageRepository{}
+		vatRepo.SetDB(db)
+		blockNumber = rand.Intn(1000000)
+	})
+
+	AfterEach(func() {
+		closeErr := db.Close()
+		Expect(closeErr).NotTo(HaveOccurred())
+	})
+
+	It("returns addresses granted permission on the urn", func() {
+		createCan(vatRepo, blockNumber, urn, proxy, "1")
+		createCan(vatRepo, blockNumber+1, urn, manager, "1")
+
+		var permissions []test_helpers.UrnPermission
+		err := db.Select(&permissions, `SELECT urn_identifier, usr, block_height FROM api.urn_permissions($1)`, urn)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permissions).To(Equal([]test_helpers.UrnPermission{
+			{UrnIdentifier: urn, Usr: manager, BlockHeight: blockNumber + 1},
+			{UrnIdentifier: urn, Usr: proxy, BlockHeight: blockNumber},
+		}))
+	})
+
+	It("excludes permissions that have since been revoked", func() {
+		createCan(vatRepo, blockNumber, urn, proxy, "1")
+		createCan(vatRepo, blockNumber+1, urn, proxy, "0")
+
+		var permissions []test_helpers.UrnPermission
+		err := db.Select(&permissions, `SELECT urn_identifier, usr, block_height FROM api.urn_permissions($1)`, urn)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permissions).To(BeEmpty())
+	})
+
+	It("returns permissions as of the given block height", func() {
+		createCan(vatRepo, blockNumber, urn, proxy, "1")
+		createCan(vatRepo, blockNumber+1, urn, proxy, "0")
+
+		var permissions []test_helpers.UrnPermission
+		err := db.Select(&permissions, `SELECT urn_identifier, usr, block_height FROM api.urn_permissions($1, $2)`,
+			urn, blockNumber)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permissions).To(ConsistOf(test_helpers.UrnPermission{
+			UrnIdentifier: urn,
+			Usr:           proxy,
+			BlockHeight:   blockNumber,
+		}))
+	})
+
+	It("ignores permissions granted on other urns", func() {
+		createCan(vatRepo, blockNumber, urn, proxy, "1")
+		createCan(vatRepo, blockNumber, manager, proxy, "1")
+
+		var permissions []test_helpers.UrnPermission
+		err := db.Select(&permissions, `SELECT urn_identifier, usr, block_height FROM api.urn_permissions($1)`, urn)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permissions).To(ConsistOf(test_helpers.UrnPermission{
+			UrnIdentifier: urn,
+			Usr:           proxy,
+			BlockHeight:   blockNumber,
+		}))
+	})
+})
+
+func createCan(vatRepo vat.VatStorageRepository, blockNumber int, bit, usr, can string) {
+	metadata := utils.GetStorageValueMetadata(vat.Can, map[utils.Key]string{constants.Bit: bit, constants.Usr: usr}, utils.Uint256)
+	err := vatRepo.Create(blockNumber, fakes.FakeHash.Hex(), metadata, can)
+	Expect(err).NotTo(HaveOccurred())
+}
@@ -32,7 +32,7 @@ import (
 	"github.com/vulcanize/mcd_transformers/test_config"
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
 	"github.com/vulcanize/mcd_transformers/transformers/events/bite"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/cat"
@@ -116,7 +116,7 @@ var _ = Describe("Urn state computed columns", func() {
 			urnMetadata := test_helpers.GetUrnMetadata(test_helpers.FakeIlk.Hex, fakeGuy)
 			test_helpers.CreateUrn(urnSetupData, urnMetadata, vatRepository, headerRepository)
 
-			frobRepo := vat_frob.VatFrobRepository{}
+			frobRepo := log_note.Repository{}
 			frobRepo.SetDB(db)
 			frobEvent := test_data.VatFrobModelWithPositiveDart()
 			frobEvent.ForeignKeyValues[constants.UrnFK] = fakeGuy
@@ -153,7 +153,7 @@ var _ = Describe("Urn state computed columns", func() {
 				urnMetadata := test_helpers.GetUrnMetadata(test_helpers.FakeIlk.Hex, fakeGuy)
 				test_helpers.CreateUrn(urnSetupData, urnMetadata, vatRepository, headerRepository)
 
-				frobRepo := vat_frob.VatFrobRepository{}
+				frobRepo := log_note.Repository{}
 				frobRepo.SetDB(db)
 
 				frobEventOne = test_data.VatFrobModelWithPositiveDart()
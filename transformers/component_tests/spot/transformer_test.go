@@ -36,9 +36,9 @@ var _ = Describe("Executing the transformer", func() {
 		db                *postgres.DB
 		err               error
 		ilkID             int64
-		storageKeysLookup = storage.NewKeysLookup(spot.NewKeysLoader(&mcdStorage.MakerStorageRepository{}))
-		repository        = spot.SpotStorageRepository{}
 		contractAddress   = "a57d4123c8a80ac410e924df9d5e47765ffd1375"
+		storageKeysLookup = storage.NewKeysLookup(spot.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress))
+		repository        = spot.SpotStorageRepository{}
 		transformer       = storage.Transformer{
 			HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
 			StorageKeysLookup: storageKeysLookup,
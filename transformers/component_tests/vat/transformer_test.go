@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
@@ -38,20 +38,22 @@ import (
 
 var _ = Describe("Executing the transformer", func() {
 	var (
-		db                *postgres.DB
-		storageKeysLookup = storage.NewKeysLookup(vat.NewKeysLoader(&mcdStorage.MakerStorageRepository{}))
-		repository        = vat.VatStorageRepository{}
-		contractAddress   = "48f749bd988caafacd7b951abbecc1aa31488690"
-		transformer       = storage.Transformer{
-			HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
-			StorageKeysLookup: storageKeysLookup,
-			Repository:        &repository,
-		}
+		db              *postgres.DB
+		contractAddress = "48f749bd988caafacd7b951abbecc1aa31488690"
+		repository      = vat.VatStorageRepository{}
+		transformer     mcdStorage.Transformer
 	)
 
 	BeforeEach(func() {
 		db = test_config.NewTestDB(test_config.NewTestNode())
 		test_config.CleanTestDB(db)
+		keysLoader, layoutErr := vat.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress)
+		Expect(layoutErr).NotTo(HaveOccurred())
+		transformer = mcdStorage.Transformer{
+			HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
+			StorageKeysLookup: storage.NewKeysLookup(keysLoader),
+			Repository:        &repository,
+		}
 		transformer.NewTransformer(db)
 	})
 
@@ -286,7 +288,7 @@ var _ = Describe("Executing the transformer", func() {
 			headerID, err := headerRepository.CreateOrUpdateHeader(fakes.FakeHeader)
 			Expect(err).NotTo(HaveOccurred())
 			vatFrobLog := test_data.CreateTestLog(headerID, db)
-			vatFrobRepository := vat_frob.VatFrobRepository{}
+			vatFrobRepository := log_note.Repository{}
 			vatFrobRepository.SetDB(db)
 			vatFrob := test_data.VatFrobModelWithPositiveDart()
 			vatFrob.ForeignKeyValues[constants.IlkFK] = ilk
@@ -327,7 +329,7 @@ var _ = Describe("Executing the transformer", func() {
 			headerID, err := headerRepository.CreateOrUpdateHeader(fakes.FakeHeader)
 			Expect(err).NotTo(HaveOccurred())
 			vatFrobLog := test_data.CreateTestLog(headerID, db)
-			vatFrobRepository := vat_frob.VatFrobRepository{}
+			vatFrobRepository := log_note.Repository{}
 			vatFrobRepository.SetDB(db)
 			vatFrob := test_data.VatFrobModelWithPositiveDart()
 			vatFrob.ColumnValues["w"] = guy
@@ -32,9 +32,9 @@ import (
 var _ = Describe("Executing the transformer", func() {
 	var (
 		db                *postgres.DB
-		storageKeysLookup = storage.NewKeysLookup(vow.NewKeysLoader(&mcdStorage.MakerStorageRepository{}))
-		repository        = vow.VowStorageRepository{}
 		contractAddress   = "4afcab85f27dd2e1a5ec1008b5b294e44e487f90"
+		storageKeysLookup = storage.NewKeysLookup(vow.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress))
+		repository        = vow.VowStorageRepository{}
 		transformer       = storage.Transformer{
 			HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
 			StorageKeysLookup: storageKeysLookup,
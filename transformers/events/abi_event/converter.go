@@ -0,0 +1,110 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package abi_event
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/eth"
+)
+
+// Converter unpacks a named event from the configured ABI into insertion models, without an entity type
+type Converter struct {
+	Definition Definition
+	// ContractIlks maps each watched deployment to its contract's configured ilk, for definitions with ContractIlk set
+	ContractIlks map[common.Address]string
+}
+
+// NewConverter returns a Converter for definition, reading the ilk of each of contractNames from config
+// when the definition needs it
+func NewConverter(definition Definition, contractNames []string) Converter {
+	converter := Converter{Definition: definition}
+	if definition.ContractIlk {
+		converter.ContractIlks = constants.GetContractIlksByAddress(contractNames)
+	}
+	return converter
+}
+
+func (converter Converter) ToModels(contractAbi string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
+	definition := converter.Definition
+	parsedAbi, parseErr := eth.ParseAbi(contractAbi)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	event, ok := parsedAbi.Events[definition.EventName]
+	if !ok {
+		return nil, fmt.Errorf("event %s not found in abi", definition.EventName)
+	}
+
+	var models []shared.InsertionModel
+	for _, log := range logs {
+		contract := bind.NewBoundContract(log.Log.Address, parsedAbi, nil, nil, nil)
+		values := make(map[string]interface{})
+		unpackErr := contract.UnpackLogIntoMap(values, definition.EventName, log.Log)
+		if unpackErr != nil {
+			return nil, fmt.Errorf("couldn't unpack %s log: %v", definition.EventName, unpackErr)
+		}
+
+		model := shared.InsertionModel{
+			SchemaName:     "maker",
+			TableName:      definition.TableName,
+			OrderedColumns: []string{constants.HeaderFK, constants.LogFK},
+			ColumnValues: shared.ColumnValues{
+				constants.HeaderFK: log.HeaderID,
+				constants.LogFK:    log.ID,
+			},
+			ForeignKeyValues: shared.ForeignKeyValues{},
+		}
+		if definition.ContractAddress {
+			model.OrderedColumns = append(model.OrderedColumns, string(constants.AddressFK))
+			model.ForeignKeyValues[constants.AddressFK] = log.Log.Address.Hex()
+		}
+		if definition.ContractIlk {
+			ilk, ok := converter.ContractIlks[log.Log.Address]
+			if !ok {
+				return nil, fmt.Errorf("no ilk configured for %s contract %s", definition.EventName, log.Log.Address.Hex())
+			}
+			model.OrderedColumns = append(model.OrderedColumns, string(constants.IlkFK))
+			model.ForeignKeyValues[constants.IlkFK] = ilk
+		}
+		for _, argument := range event.Inputs {
+			field := definition.Fields[argument.Name]
+			value, normalizeErr := normalize(values[argument.Name], argument, field.Normalization)
+			if normalizeErr != nil {
+				return nil, normalizeErr
+			}
+			if field.Normalization == Ilk {
+				model.OrderedColumns = append(model.OrderedColumns, string(constants.IlkFK))
+				model.ForeignKeyValues[constants.IlkFK] = value
+				continue
+			}
+			column := field.Column
+			if column == "" {
+				column = argument.Name
+			}
+			model.OrderedColumns = append(model.OrderedColumns, column)
+			model.ColumnValues[column] = value
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
@@ -0,0 +1,152 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package abi_event_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/events/abi_event"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+var _ = Describe("ABI event converter", func() {
+	It("converts a log using the ABI argument names and types", func() {
+		converter := abi_event.Converter{Definition: abi_event.Definitions[constants.NewCdpLabel]}
+
+		models, err := converter.ToModels(constants.CdpManagerABI(), []core.HeaderSyncLog{test_data.NewCdpHeaderSyncLog})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.NewCdpModel()}))
+	})
+
+	It("converts the events registered in Definitions", func() {
+		convert := func(label, contractAbi string, log core.HeaderSyncLog) shared.InsertionModel {
+			converter := abi_event.Converter{Definition: abi_event.Definitions[label]}
+			models, err := converter.ToModels(contractAbi, []core.HeaderSyncLog{log})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(models)).To(Equal(1))
+			return models[0]
+		}
+
+		Expect(convert(constants.ProxyCreatedLabel, constants.ProxyFactoryABI(), test_data.ProxyCreatedHeaderSyncLog)).To(Equal(test_data.ProxyCreatedModel()))
+		Expect(convert(constants.DaiTransferLabel, constants.DaiABI(), test_data.DaiTransferHeaderSyncLog)).To(Equal(test_data.DaiTransferModel))
+		Expect(convert(constants.DaiApprovalLabel, constants.DaiABI(), test_data.DaiApprovalHeaderSyncLog)).To(Equal(test_data.DaiApprovalModel))
+		Expect(convert(constants.LogValueLabel, constants.OsmABI(), test_data.LogValueHeaderSyncLog)).To(Equal(test_data.LogValueModel))
+	})
+
+	Describe("definitions with a contract ilk", func() {
+		var converter abi_event.Converter
+
+		BeforeEach(func() {
+			converter = abi_event.NewConverter(abi_event.Definitions[constants.LogMedianPriceLabel], []string{"MEDIAN_ETH_A", "MEDIAN_BAT_A"})
+		})
+
+		It("maps each configured contract to its ilk", func() {
+			ethAddress := common.HexToAddress(test_data.MedianEthAddress())
+			Expect(converter.ContractIlks[ethAddress]).To(Equal(constants.GetContractIlk("MEDIAN_ETH_A")))
+			Expect(len(converter.ContractIlks)).To(Equal(2))
+		})
+
+		It("resolves the contract's address and ilk", func() {
+			models, err := converter.ToModels(constants.MedianABI(), []core.HeaderSyncLog{test_data.LogMedianPriceHeaderSyncLog})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(models).To(Equal([]shared.InsertionModel{test_data.LogMedianPriceModel}))
+		})
+
+		It("returns an error if the log is from a contract without a configured ilk", func() {
+			log := test_data.LogMedianPriceHeaderSyncLog
+			log.Log.Address = common.HexToAddress("0x0123456789abcdef000000000000000000000000")
+
+			_, err := converter.ToModels(constants.MedianABI(), []core.HeaderSyncLog{log})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("applies configured columns and normalizations", func() {
+		converter := abi_event.Converter{Definition: abi_event.Definition{
+			TableName: "spot_poke",
+			EventName: "Poke",
+			Fields: map[string]abi_event.Field{
+				"ilk": {Normalization: abi_event.Ilk},
+				"val": {Column: "value", Normalization: abi_event.Uint},
+			},
+		}}
+
+		models, err := converter.ToModels(constants.SpotABI(), []core.HeaderSyncLog{test_data.SpotPokeHeaderSyncLog})
+
+		Expect(err).NotTo(HaveOccurred())
+		expectedModel := test_data.SpotPokeModel()
+		expectedModel.ColumnValues["value"] = "89066421500000000"
+		Expect(models).To(Equal([]shared.InsertionModel{expectedModel}))
+	})
+
+	It("reads negative indexed ints as signed", func() {
+		testAbi := `[{"anonymous":false,"inputs":[{"indexed":true,"name":"dink","type":"int256"},` +
+			`{"indexed":false,"name":"dart","type":"int256"}],"name":"Test","type":"event"}]`
+		converter := abi_event.Converter{Definition: abi_event.Definition{TableName: "test_event", EventName: "Test"}}
+		log := core.HeaderSyncLog{
+			Log: types.Log{
+				Topics: []common.Hash{
+					{},
+					common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff9c"),
+				},
+				Data: common.HexToHash("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe").Bytes(),
+			},
+		}
+
+		models, err := converter.ToModels(testAbi, []core.HeaderSyncLog{log})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models[0].ColumnValues["dink"]).To(Equal("-100"))
+		Expect(models[0].ColumnValues["dart"]).To(Equal("-2"))
+	})
+
+	It("returns an error if the abi can't be parsed", func() {
+		converter := abi_event.Converter{Definition: abi_event.Definitions[constants.NewCdpLabel]}
+
+		_, err := converter.ToModels("error abi", []core.HeaderSyncLog{test_data.NewCdpHeaderSyncLog})
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns an error if the event isn't in the abi", func() {
+		converter := abi_event.Converter{Definition: abi_event.Definition{TableName: "new_cdp", EventName: "Missing"}}
+
+		_, err := converter.ToModels(constants.CdpManagerABI(), []core.HeaderSyncLog{test_data.NewCdpHeaderSyncLog})
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns an error if a normalization doesn't fit the unpacked value", func() {
+		converter := abi_event.Converter{Definition: abi_event.Definition{
+			TableName: "new_cdp",
+			EventName: "NewCdp",
+			Fields:    map[string]abi_event.Field{"cdp": {Normalization: abi_event.Address}},
+		}}
+
+		_, err := converter.ToModels(constants.CdpManagerABI(), []core.HeaderSyncLog{test_data.NewCdpHeaderSyncLog})
+
+		Expect(err).To(HaveOccurred())
+	})
+})
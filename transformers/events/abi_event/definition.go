@@ -0,0 +1,159 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package abi_event
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Normalization says how an unpacked event argument is formatted before it's persisted
+type Normalization int
+
+const (
+	FromABIType Normalization = iota // picked from the argument's type in the ABI
+	Address                          // checksummed address
+	Uint
+	Int
+	Bytes32 // hex encoded
+	Ilk     // hex encoded bytes32, resolved to an ilk_id foreign key on insert
+)
+
+// Field overrides the column and normalization used for an event argument
+type Field struct {
+	Column        string
+	Normalization Normalization
+}
+
+// Definition declares a non-anonymous event transformer: the event unpacked from the configured ABI and the table
+// it's written to. Arguments without an entry in Fields are stored in a column named after the argument.
+type Definition struct {
+	TableName string
+	EventName string
+	Fields    map[string]Field
+	// ContractAddress resolves the emitting contract to an address_id foreign key
+	ContractAddress bool
+	// ContractIlk resolves the ilk configured for the emitting contract (contract.<NAME>.ilk) to an ilk_id foreign key
+	ContractIlk bool
+}
+
+var twoToThe256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+func normalize(value interface{}, argument abi.Argument, normalization Normalization) (string, error) {
+	if normalization == FromABIType {
+		switch argument.Type.T {
+		case abi.AddressTy:
+			normalization = Address
+		case abi.UintTy:
+			normalization = Uint
+		case abi.IntTy:
+			normalization = Int
+		case abi.FixedBytesTy, abi.HashTy:
+			normalization = Bytes32
+		case abi.BoolTy:
+			return fmt.Sprintf("%t", value), nil
+		case abi.StringTy:
+			return fmt.Sprintf("%s", value), nil
+		default:
+			return "", fmt.Errorf("no default normalization for %s argument %s", argument.Type, argument.Name)
+		}
+	}
+
+	switch normalization {
+	case Address:
+		if address, ok := value.(common.Address); ok {
+			return address.Hex(), nil
+		}
+		valueBytes, err := toBytes(value)
+		if err != nil {
+			return "", err
+		}
+		return common.BytesToAddress(valueBytes).Hex(), nil
+	case Uint:
+		number, err := toBigInt(value)
+		if err != nil {
+			return "", err
+		}
+		return number.String(), nil
+	case Int:
+		number, err := toBigInt(value)
+		if err != nil {
+			return "", err
+		}
+		// indexed ints are read from their topic as unsigned
+		if number.Sign() > 0 && number.BitLen() == 256 {
+			number = new(big.Int).Sub(number, twoToThe256)
+		}
+		return number.String(), nil
+	case Bytes32, Ilk:
+		valueBytes, err := toBytes(value)
+		if err != nil {
+			return "", err
+		}
+		return hexutil.Encode(valueBytes), nil
+	default:
+		return "", fmt.Errorf("unrecognized normalization %d for argument %s", normalization, argument.Name)
+	}
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case [32]byte:
+		return v[:], nil
+	case common.Hash:
+		return v.Bytes(), nil
+	case common.Address:
+		return v.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("can't read %T as bytes", value)
+	}
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case int8:
+		return big.NewInt(int64(v)), nil
+	case int16:
+		return big.NewInt(int64(v)), nil
+	case int32:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	default:
+		valueBytes, err := toBytes(value)
+		if err != nil {
+			return nil, fmt.Errorf("can't read %T as a number", value)
+		}
+		return new(big.Int).SetBytes(valueBytes), nil
+	}
+}
@@ -0,0 +1,53 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package abi_event
+
+import (
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+)
+
+// Definitions declares every transformer whose models are built by the generic ABI event Converter, keyed by label
+var Definitions = map[string]Definition{
+	constants.DaiApprovalLabel: {
+		TableName: "dai_approval",
+		EventName: "Approval",
+	},
+	constants.DaiTransferLabel: {
+		TableName: "dai_transfer",
+		EventName: "Transfer",
+	},
+	constants.LogMedianPriceLabel: {
+		TableName:       "log_median_price",
+		EventName:       "LogMedianPrice",
+		ContractAddress: true,
+		ContractIlk:     true,
+	},
+	constants.LogValueLabel: {
+		TableName:       "log_value",
+		EventName:       "LogValue",
+		Fields:          map[string]Field{"val": {Normalization: Uint}},
+		ContractAddress: true,
+	},
+	constants.NewCdpLabel: {
+		TableName: "new_cdp",
+		EventName: "NewCdp",
+	},
+	constants.ProxyCreatedLabel: {
+		TableName: "proxies",
+		EventName: "Created",
+	},
+}
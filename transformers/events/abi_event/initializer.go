@@ -0,0 +1,38 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package abi_event
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+)
+
+// NewEventTransformerInitializer wires the generic ABI event Converter and Repository to the Definition registered for label
+func NewEventTransformerInitializer(label, signature string) transformer.EventTransformerInitializer {
+	definition, ok := Definitions[label]
+	if !ok {
+		panic(fmt.Sprintf("no abi event definition for %s", label))
+	}
+	return shared.EventTransformer{
+		Config:     shared.GetEventTransformerConfig(label, signature),
+		Converter:  NewConverter(definition, constants.GetTransformerContractNames(label)),
+		Repository: &Repository{},
+	}.NewEventTransformer
+}
@@ -14,30 +14,31 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_frob_test
+package dai_exit_test
 
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
+	"github.com/vulcanize/mcd_transformers/transformers/events/dai_exit"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
 	"github.com/vulcanize/vulcanizedb/pkg/core"
 )
 
-var _ = Describe("Frob converter", func() {
-	converter := vat_frob.VatFrobConverter{}
+var _ = Describe("DaiExit converter", func() {
+	converter := dai_exit.DaiExitConverter{}
 
 	It("returns err if log is missing topics", func() {
 		badLog := core.HeaderSyncLog{
 			Log: types.Log{
-				Data: []byte{1, 1, 1, 1, 1},
+				Topics: []common.Hash{{}},
+				Data:   []byte{1, 1, 1, 1, 1},
 			}}
 
-		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+		_, err := converter.ToModels(constants.DaiJoinABI(), []core.HeaderSyncLog{badLog})
 		Expect(err).To(HaveOccurred())
 	})
 
@@ -47,23 +48,14 @@ var _ = Describe("Frob converter", func() {
 				Topics: []common.Hash{{}, {}, {}, {}},
 			}}
 
-		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+		_, err := converter.ToModels(constants.DaiJoinABI(), []core.HeaderSyncLog{badLog})
 		Expect(err).To(HaveOccurred())
 	})
 
-	It("converts a log with positive dart to a model", func() {
-		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{test_data.VatFrobHeaderSyncLogWithPositiveDart})
+	It("converts a log to a model", func() {
+		models, err := converter.ToModels(constants.DaiJoinABI(), []core.HeaderSyncLog{test_data.DaiExitHeaderSyncLog})
 
 		Expect(err).NotTo(HaveOccurred())
-		Expect(len(models)).To(Equal(1))
-		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatFrobModelWithPositiveDart()}))
-	})
-
-	It("converts a log with negative dink to a model", func() {
-		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{test_data.VatFrobHeaderSyncLogWithNegativeDink})
-
-		Expect(err).NotTo(HaveOccurred())
-		Expect(len(models)).To(Equal(1))
-		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatFrobModelWithNegativeDink()}))
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.DaiExitModel}))
 	})
 })
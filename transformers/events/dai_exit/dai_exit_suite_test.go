@@ -14,7 +14,7 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_fork_test
+package dai_exit_test
 
 import (
 	"io/ioutil"
@@ -25,9 +25,9 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func TestVatFork(t *testing.T) {
+func TestDaiExit(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "VatFork Suite")
+	RunSpecs(t, "DaiExit Suite")
 }
 
 var _ = BeforeSuite(func() {
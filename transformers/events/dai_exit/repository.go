@@ -14,21 +14,21 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_fork
+package dai_exit
 
 import (
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
 
-type VatForkRepository struct {
+type DaiExitRepository struct {
 	db *postgres.DB
 }
 
-func (repository VatForkRepository) Create(models []shared.InsertionModel) error {
+func (repository DaiExitRepository) Create(models []shared.InsertionModel) error {
 	return shared.Create(models, repository.db)
 }
 
-func (repository *VatForkRepository) SetDB(db *postgres.DB) {
+func (repository *DaiExitRepository) SetDB(db *postgres.DB) {
 	repository.db = db
 }
@@ -14,7 +14,7 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_fold
+package dai_join
 
 import (
 	"github.com/ethereum/go-ethereum/common"
@@ -23,14 +23,14 @@ import (
 	"github.com/vulcanize/vulcanizedb/pkg/core"
 )
 
-type VatFoldConverter struct{}
+type DaiJoinConverter struct{}
 
 const (
-	logDataRequired   = false
+	logDataRequired   = true
 	numTopicsRequired = 4
 )
 
-func (VatFoldConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
+func (DaiJoinConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
 	var models []shared.InsertionModel
 	for _, log := range logs {
 		err := shared.VerifyLog(log.Log, numTopicsRequired, logDataRequired)
@@ -38,24 +38,25 @@ func (VatFoldConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.
 			return nil, err
 		}
 
-		ilk := log.Log.Topics[1].Hex()
-		urn := common.BytesToAddress(log.Log.Topics[2].Bytes()).String()
-		rate := shared.ConvertInt256HexToBigInt(log.Log.Topics[3].Hex())
+		msgSender := common.BytesToAddress(log.Log.Topics[1].Bytes()).String()
+		usr := common.BytesToAddress(log.Log.Topics[2].Bytes()).String()
+		wad := shared.ConvertUint256HexToBigInt(log.Log.Topics[3].Hex())
 
 		model := shared.InsertionModel{
 			SchemaName: "maker",
-			TableName:  "vat_fold",
+			TableName:  "dai_join",
 			OrderedColumns: []string{
-				constants.HeaderFK, string(constants.UrnFK), "rate", constants.LogFK,
+				constants.HeaderFK, string(constants.AddressFK), "msg_sender", "usr", "wad", constants.LogFK,
 			},
 			ColumnValues: shared.ColumnValues{
-				"rate":             rate.String(),
+				"msg_sender":       msgSender,
+				"usr":              usr,
+				"wad":              wad.String(),
 				constants.HeaderFK: log.HeaderID,
 				constants.LogFK:    log.ID,
 			},
 			ForeignKeyValues: shared.ForeignKeyValues{
-				constants.IlkFK: ilk,
-				constants.UrnFK: urn,
+				constants.AddressFK: log.Log.Address.Hex(),
 			},
 		}
 		models = append(models, model)
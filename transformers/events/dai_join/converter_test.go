@@ -14,41 +14,48 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_flux_test
+package dai_join_test
 
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/vulcanize/vulcanizedb/pkg/core"
-
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_flux"
+	"github.com/vulcanize/mcd_transformers/transformers/events/dai_join"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
 )
 
-var _ = Describe("VatFlux converter", func() {
-	var converter = vat_flux.VatFluxConverter{}
-	It("Converts logs to models", func() {
-		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{test_data.VatFluxHeaderSyncLog})
+var _ = Describe("DaiJoin converter", func() {
+	converter := dai_join.DaiJoinConverter{}
 
-		Expect(err).NotTo(HaveOccurred())
-		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatFluxModel}))
+	It("returns err if log is missing topics", func() {
+		badLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Topics: []common.Hash{{}},
+				Data:   []byte{1, 1, 1, 1, 1},
+			}}
+
+		_, err := converter.ToModels(constants.DaiJoinABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
 	})
 
-	It("Returns an error there are missing topics", func() {
+	It("returns err if log is missing data", func() {
 		badLog := core.HeaderSyncLog{
 			Log: types.Log{
-				Topics: []common.Hash{
-					common.HexToHash("0x"),
-					common.HexToHash("0x"),
-					common.HexToHash("0x"),
-				}},
-		}
-
-		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+				Topics: []common.Hash{{}, {}, {}, {}},
+			}}
+
+		_, err := converter.ToModels(constants.DaiJoinABI(), []core.HeaderSyncLog{badLog})
 		Expect(err).To(HaveOccurred())
 	})
+
+	It("converts a log to a model", func() {
+		models, err := converter.ToModels(constants.DaiJoinABI(), []core.HeaderSyncLog{test_data.DaiJoinHeaderSyncLog})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.DaiJoinModel}))
+	})
 })
@@ -14,22 +14,21 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_frob
+package dai_join
 
 import (
-	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
-
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
 
-type VatFrobRepository struct {
+type DaiJoinRepository struct {
 	db *postgres.DB
 }
 
-func (repository VatFrobRepository) Create(models []shared.InsertionModel) error {
+func (repository DaiJoinRepository) Create(models []shared.InsertionModel) error {
 	return shared.Create(models, repository.db)
 }
 
-func (repository *VatFrobRepository) SetDB(db *postgres.DB) {
+func (repository *DaiJoinRepository) SetDB(db *postgres.DB) {
 	repository.db = db
 }
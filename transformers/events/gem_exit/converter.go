@@ -14,24 +14,32 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_flux
+package gem_exit
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/vulcanizedb/pkg/core"
 )
 
-type VatFluxConverter struct{}
+// Each gem adapter handles a single ilk, configured alongside the contract address
+type GemExitConverter struct {
+	ContractIlks map[common.Address]string
+}
+
+func NewGemExitConverter(contractNames []string) GemExitConverter {
+	return GemExitConverter{ContractIlks: constants.GetContractIlksByAddress(contractNames)}
+}
 
 const (
 	logDataRequired   = true
 	numTopicsRequired = 4
 )
 
-func (VatFluxConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
+func (converter GemExitConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
 	var models []shared.InsertionModel
 	for _, log := range logs {
 		err := shared.VerifyLog(log.Log, numTopicsRequired, logDataRequired)
@@ -39,34 +47,34 @@ func (VatFluxConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.
 			return nil, err
 		}
 
-		ilk := log.Log.Topics[1].Hex()
-		src := common.BytesToAddress(log.Log.Topics[2].Bytes()).String()
-		dst := common.BytesToAddress(log.Log.Topics[3].Bytes()).String()
-		wadBytes, wadErr := shared.GetLogNoteArgumentAtIndex(3, log.Log.Data)
-		if wadErr != nil {
-			return nil, wadErr
+		ilk, ok := converter.ContractIlks[log.Log.Address]
+		if !ok {
+			return nil, fmt.Errorf("no ilk configured for gem adapter %s", log.Log.Address.Hex())
 		}
-		wad := shared.ConvertUint256HexToBigInt(hexutil.Encode(wadBytes))
+
+		msgSender := common.BytesToAddress(log.Log.Topics[1].Bytes()).String()
+		usr := common.BytesToAddress(log.Log.Topics[2].Bytes()).String()
+		wad := shared.ConvertUint256HexToBigInt(log.Log.Topics[3].Hex())
 
 		model := shared.InsertionModel{
 			SchemaName: "maker",
-			TableName:  "vat_flux",
+			TableName:  "gem_exit",
 			OrderedColumns: []string{
-				constants.HeaderFK, string(constants.IlkFK), "src", "dst", "wad", constants.LogFK,
+				constants.HeaderFK, string(constants.AddressFK), string(constants.IlkFK), "msg_sender", "usr", "wad", constants.LogFK,
 			},
 			ColumnValues: shared.ColumnValues{
-				"src":              src,
-				"dst":              dst,
+				"msg_sender":       msgSender,
+				"usr":              usr,
 				"wad":              wad.String(),
 				constants.HeaderFK: log.HeaderID,
 				constants.LogFK:    log.ID,
 			},
 			ForeignKeyValues: shared.ForeignKeyValues{
-				constants.IlkFK: ilk,
+				constants.AddressFK: log.Log.Address.Hex(),
+				constants.IlkFK:     ilk,
 			},
 		}
 		models = append(models, model)
 	}
-
 	return models, nil
 }
@@ -0,0 +1,82 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gem_exit_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/events/gem_exit"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+var _ = Describe("GemExit converter", func() {
+	var converter gem_exit.GemExitConverter
+
+	BeforeEach(func() {
+		converter = gem_exit.NewGemExitConverter([]string{"MCD_JOIN_ETH_A", "MCD_JOIN_BAT_A"})
+	})
+
+	It("maps each configured gem adapter to its ilk", func() {
+		ethAddress := common.HexToAddress(test_data.EthGemJoinAddress())
+
+		Expect(converter.ContractIlks[ethAddress]).To(Equal(constants.GetContractIlk("MCD_JOIN_ETH_A")))
+		Expect(len(converter.ContractIlks)).To(Equal(2))
+	})
+
+	It("returns err if log is missing topics", func() {
+		badLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Address: common.HexToAddress(test_data.EthGemJoinAddress()),
+				Topics:  []common.Hash{{}},
+				Data:    []byte{1, 1, 1, 1, 1},
+			}}
+
+		_, err := converter.ToModels(constants.GemJoinABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns err if log is missing data", func() {
+		badLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Address: common.HexToAddress(test_data.EthGemJoinAddress()),
+				Topics:  []common.Hash{{}, {}, {}, {}},
+			}}
+
+		_, err := converter.ToModels(constants.GemJoinABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns err if the log is from an unconfigured adapter", func() {
+		log := test_data.GemExitHeaderSyncLog
+		log.Log.Address = common.HexToAddress("0x0123456789abcdef000000000000000000000000")
+
+		_, err := converter.ToModels(constants.GemJoinABI(), []core.HeaderSyncLog{log})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("converts a log to a model", func() {
+		models, err := converter.ToModels(constants.GemJoinABI(), []core.HeaderSyncLog{test_data.GemExitHeaderSyncLog})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.GemExitModel}))
+	})
+})
@@ -1,5 +1,5 @@
 // VulcanizeDB
-// Copyright © 2018 Vulcanize
+// Copyright © 2019 Vulcanize
 
 // This program is free software: you can redistribute it and/or modify
 // it under the terms of the GNU Affero General Public License as published by
@@ -14,20 +14,20 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_frob_test
+package gem_exit_test
 
 import (
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
 )
 
-func TestVatFrob(t *testing.T) {
+func TestGemExit(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "VatFrob Suite")
+	RunSpecs(t, "GemExit Suite")
 }
 
 var _ = BeforeSuite(func() {
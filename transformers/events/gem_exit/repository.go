@@ -14,22 +14,21 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_fold
+package gem_exit
 
 import (
-	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
-
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
 
-type VatFoldRepository struct {
+type GemExitRepository struct {
 	db *postgres.DB
 }
 
-func (repository VatFoldRepository) Create(models []shared.InsertionModel) error {
+func (repository GemExitRepository) Create(models []shared.InsertionModel) error {
 	return shared.Create(models, repository.db)
 }
 
-func (repository *VatFoldRepository) SetDB(db *postgres.DB) {
+func (repository *GemExitRepository) SetDB(db *postgres.DB) {
 	repository.db = db
 }
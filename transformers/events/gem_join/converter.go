@@ -14,24 +14,32 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_fork
+package gem_join
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/vulcanizedb/pkg/core"
 )
 
-type VatForkConverter struct{}
+// Each gem adapter handles a single ilk, configured alongside the contract address
+type GemJoinConverter struct {
+	ContractIlks map[common.Address]string
+}
+
+func NewGemJoinConverter(contractNames []string) GemJoinConverter {
+	return GemJoinConverter{ContractIlks: constants.GetContractIlksByAddress(contractNames)}
+}
 
 const (
 	logDataRequired   = true
 	numTopicsRequired = 4
 )
 
-func (VatForkConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
+func (converter GemJoinConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
 	var models []shared.InsertionModel
 	for _, log := range logs {
 		err := shared.VerifyLog(log.Log, numTopicsRequired, logDataRequired)
@@ -39,42 +47,34 @@ func (VatForkConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.
 			return nil, err
 		}
 
-		ilk := log.Log.Topics[1].Hex()
-		src := common.BytesToAddress(log.Log.Topics[2].Bytes()).String()
-		dst := common.BytesToAddress(log.Log.Topics[3].Bytes()).String()
-
-		dinkBytes, dinkErr := shared.GetLogNoteArgumentAtIndex(3, log.Log.Data)
-		if dinkErr != nil {
-			return nil, dinkErr
+		ilk, ok := converter.ContractIlks[log.Log.Address]
+		if !ok {
+			return nil, fmt.Errorf("no ilk configured for gem adapter %s", log.Log.Address.Hex())
 		}
-		dink := shared.ConvertInt256HexToBigInt(hexutil.Encode(dinkBytes))
 
-		dartBytes, dartErr := shared.GetLogNoteArgumentAtIndex(4, log.Log.Data)
-		if dartErr != nil {
-			return nil, dartErr
-		}
-		dart := shared.ConvertInt256HexToBigInt(hexutil.Encode(dartBytes))
+		msgSender := common.BytesToAddress(log.Log.Topics[1].Bytes()).String()
+		usr := common.BytesToAddress(log.Log.Topics[2].Bytes()).String()
+		wad := shared.ConvertUint256HexToBigInt(log.Log.Topics[3].Hex())
 
 		model := shared.InsertionModel{
 			SchemaName: "maker",
-			TableName:  "vat_fork",
+			TableName:  "gem_join",
 			OrderedColumns: []string{
-				constants.HeaderFK, string(constants.IlkFK), "src", "dst", "dink", "dart", constants.LogFK,
+				constants.HeaderFK, string(constants.AddressFK), string(constants.IlkFK), "msg_sender", "usr", "wad", constants.LogFK,
 			},
 			ColumnValues: shared.ColumnValues{
-				"src":              src,
-				"dst":              dst,
-				"dink":             dink.String(),
-				"dart":             dart.String(),
+				"msg_sender":       msgSender,
+				"usr":              usr,
+				"wad":              wad.String(),
 				constants.HeaderFK: log.HeaderID,
 				constants.LogFK:    log.ID,
 			},
 			ForeignKeyValues: shared.ForeignKeyValues{
-				constants.IlkFK: ilk,
+				constants.AddressFK: log.Log.Address.Hex(),
+				constants.IlkFK:     ilk,
 			},
 		}
 		models = append(models, model)
 	}
-
 	return models, nil
 }
@@ -14,22 +14,21 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_flux
+package gem_join
 
 import (
-	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
-
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
 
-type VatFluxRepository struct {
+type GemJoinRepository struct {
 	db *postgres.DB
 }
 
-func (repository VatFluxRepository) Create(models []shared.InsertionModel) error {
+func (repository GemJoinRepository) Create(models []shared.InsertionModel) error {
 	return shared.Create(models, repository.db)
 }
 
-func (repository *VatFluxRepository) SetDB(db *postgres.DB) {
+func (repository *GemJoinRepository) SetDB(db *postgres.DB) {
 	repository.db = db
 }
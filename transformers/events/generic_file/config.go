@@ -0,0 +1,102 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package generic_file
+
+import (
+	"math"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+)
+
+type fileOverload struct {
+	paramTypes []string
+	// transformers that record every file call of this overload on the contracts they watch
+	dedicatedLabels []string
+}
+
+var fileOverloads = map[string]fileOverload{
+	constants.GenericFileAddressLabel: {
+		paramTypes:      []string{"bytes32", "address"},
+		dedicatedLabels: []string{constants.CatFileVowLabel, constants.JugFileVowLabel, constants.PotFileVowLabel},
+	},
+	constants.GenericFileIlkAddressLabel: {
+		paramTypes:      []string{"bytes32", "bytes32", "address"},
+		dedicatedLabels: []string{constants.CatFileFlipLabel, constants.SpotFilePipLabel},
+	},
+	constants.GenericFileIlkUintLabel: {
+		paramTypes: []string{"bytes32", "bytes32", "uint256"},
+		dedicatedLabels: []string{constants.CatFileChopLumpLabel, constants.JugFileIlkLabel, constants.SpotFileMatLabel,
+			constants.VatFileIlkLabel},
+	},
+	constants.GenericFileUintLabel: {
+		paramTypes: []string{"bytes32", "uint256"},
+		dedicatedLabels: []string{constants.JugFileBaseLabel, constants.PotFileDSRLabel, constants.SpotFileParLabel,
+			constants.VatFileDebtCeilingLabel, constants.VowFileLabel},
+	},
+}
+
+// GetEventTransformerConfig derives what a generic file transformer watches from config. Of the contracts configured
+// for the transformer, it watches each deployment whose ABI declares the transformer's file overload, unless a
+// configured transformer dedicated to that overload already watches the contract. When nothing is left to watch the
+// transformer has no addresses, and its starting block never marks headers unchecked.
+func GetEventTransformerConfig(transformerLabel, signature string) transformer.EventTransformerConfig {
+	overload := fileOverloads[transformerLabel]
+	dedicatedContracts := getDedicatedContractNames(overload.dedicatedLabels)
+
+	var addresses []string
+	startingBlock := int64(math.MaxInt64)
+	for _, contractName := range constants.GetTransformerContractNames(transformerLabel) {
+		if dedicatedContracts[contractName] {
+			continue
+		}
+		for _, deployment := range constants.GetContractDeployments(contractName) {
+			if !constants.HasOverloadedFunction(deployment.Abi, "file", overload.paramTypes) {
+				continue
+			}
+			addresses = append(addresses, deployment.Address)
+			if deployment.Deployed < startingBlock {
+				startingBlock = deployment.Deployed
+			}
+		}
+	}
+
+	return transformer.EventTransformerConfig{
+		TransformerName:     transformerLabel,
+		ContractAddresses:   addresses,
+		Topic:               signature,
+		StartingBlockNumber: startingBlock,
+		EndingBlockNumber:   -1,
+	}
+}
+
+func getDedicatedContractNames(dedicatedLabels []string) map[string]bool {
+	configured := make(map[string]bool)
+	for _, transformerName := range constants.GetTransformerNames() {
+		configured[transformerName] = true
+	}
+	contractNames := make(map[string]bool)
+	for _, label := range dedicatedLabels {
+		if !configured[label] {
+			continue
+		}
+		for _, contractName := range constants.GetTransformerContractNames(label) {
+			contractNames[contractName] = true
+		}
+	}
+	return contractNames
+}
@@ -0,0 +1,95 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package generic_file_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"github.com/vulcanize/mcd_transformers/transformers/events/generic_file"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+)
+
+var _ = Describe("Generic file transformer config", func() {
+	var (
+		contractsKey      = "exporter." + constants.GenericFileUintLabel + ".contracts"
+		transformerNames  = "exporter.transformerNames"
+		originalContracts []string
+		originalNames     []string
+		uintFileAbi       = `[{"constant":false,"inputs":[{"name":"what","type":"bytes32"},{"name":"data","type":"uint256"}],"name":"file","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+		addressFileAbi    = `[{"constant":false,"inputs":[{"name":"what","type":"bytes32"},{"name":"data","type":"address"}],"name":"file","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+		uintFileAddress   = "0x1111111111111111111111111111111111111111"
+		otherFileAddress  = "0x2222222222222222222222222222222222222222"
+	)
+
+	BeforeEach(func() {
+		originalContracts = constants.GetTransformerContractNames(constants.GenericFileUintLabel)
+		originalNames = constants.GetTransformerNames()
+		viper.Set("contract.TEST_UINT_FILE.address", uintFileAddress)
+		viper.Set("contract.TEST_UINT_FILE.abi", uintFileAbi)
+		viper.Set("contract.TEST_UINT_FILE.deployed", 100)
+		viper.Set("contract.TEST_ADDRESS_FILE.address", otherFileAddress)
+		viper.Set("contract.TEST_ADDRESS_FILE.abi", addressFileAbi)
+		viper.Set("contract.TEST_ADDRESS_FILE.deployed", 50)
+		viper.Set(contractsKey, []string{"TEST_UINT_FILE", "TEST_ADDRESS_FILE"})
+	})
+
+	AfterEach(func() {
+		viper.Set(contractsKey, originalContracts)
+		viper.Set(transformerNames, originalNames)
+	})
+
+	It("watches the configured deployments whose ABI declares the overload", func() {
+		viper.Set(transformerNames, []string{constants.GenericFileUintLabel})
+
+		config := generic_file.GetEventTransformerConfig(constants.GenericFileUintLabel, constants.GenericFileUintSignature())
+
+		Expect(config.TransformerName).To(Equal(constants.GenericFileUintLabel))
+		Expect(config.ContractAddresses).To(ConsistOf(uintFileAddress))
+		Expect(config.Topic).To(Equal(constants.GenericFileUintSignature()))
+		Expect(config.StartingBlockNumber).To(Equal(int64(100)))
+		Expect(config.EndingBlockNumber).To(Equal(int64(-1)))
+	})
+
+	It("skips contracts watched by a configured transformer dedicated to the overload", func() {
+		originalVowFileContracts := constants.GetTransformerContractNames(constants.VowFileLabel)
+		defer viper.Set("exporter."+constants.VowFileLabel+".contracts", originalVowFileContracts)
+		viper.Set("exporter."+constants.VowFileLabel+".contracts", []string{"TEST_UINT_FILE"})
+		viper.Set(transformerNames, []string{constants.GenericFileUintLabel, constants.VowFileLabel})
+
+		config := generic_file.GetEventTransformerConfig(constants.GenericFileUintLabel, constants.GenericFileUintSignature())
+
+		Expect(config.ContractAddresses).To(BeEmpty())
+		Expect(config.StartingBlockNumber).To(Equal(int64(math.MaxInt64)))
+	})
+
+	It("leaves nothing to watch while every configured overload has a dedicated transformer", func() {
+		viper.Set(contractsKey, originalContracts)
+
+		for label, signature := range map[string]string{
+			constants.GenericFileAddressLabel:    constants.GenericFileAddressSignature(),
+			constants.GenericFileIlkAddressLabel: constants.GenericFileIlkAddressSignature(),
+			constants.GenericFileIlkUintLabel:    constants.GenericFileIlkUintSignature(),
+			constants.GenericFileUintLabel:       constants.GenericFileUintSignature(),
+		} {
+			config := generic_file.GetEventTransformerConfig(label, signature)
+			Expect(config.ContractAddresses).To(BeEmpty(), label)
+		}
+	})
+})
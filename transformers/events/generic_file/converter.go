@@ -0,0 +1,118 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package generic_file
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	vdbConstants "github.com/vulcanize/vulcanizedb/libraries/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+const (
+	logDataRequired   = true
+	numTopicsRequired = 4
+)
+
+// log note data begins with an offset word and a length word, followed by the 4 byte function selector
+var firstArgumentOffset = 2*vdbConstants.DataItemLength + 4
+
+// GenericFileConverter handles file overloads that have no dedicated transformer on a given contract.
+// Arguments are read from the logged calldata, since Vat notes and LibNote notes index different
+// values in the topics. The data argument is stored as raw hex so that any parameter type fits.
+type GenericFileConverter struct{}
+
+func (GenericFileConverter) ToModels(_ string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
+	var models []shared.InsertionModel
+	for _, log := range logs {
+		err := shared.VerifyLog(log.Log, numTopicsRequired, logDataRequired)
+		if err != nil {
+			return nil, err
+		}
+
+		hasIlk, shapeErr := isIlkFile(log.Log.Topics[0])
+		if shapeErr != nil {
+			return nil, shapeErr
+		}
+
+		numArguments := 2
+		if hasIlk {
+			numArguments = 3
+		}
+		arguments, argumentsErr := getArguments(log.Log.Data, numArguments)
+		if argumentsErr != nil {
+			return nil, argumentsErr
+		}
+
+		model := shared.InsertionModel{
+			SchemaName: "maker",
+			TableName:  "generic_file",
+			OrderedColumns: []string{
+				constants.HeaderFK, string(constants.AddressFK), string(constants.IlkFK), "signature", "what", "data", constants.LogFK,
+			},
+			ColumnValues: shared.ColumnValues{
+				"signature":        hexutil.Encode(log.Log.Topics[0].Bytes()[:4]),
+				"what":             shared.DecodeHexToText(hexutil.Encode(arguments[numArguments-2])),
+				"data":             hexutil.Encode(arguments[numArguments-1]),
+				constants.HeaderFK: log.HeaderID,
+				constants.LogFK:    log.ID,
+			},
+			ForeignKeyValues: shared.ForeignKeyValues{
+				constants.AddressFK: log.Log.Address.String(),
+			},
+		}
+		// the insertion query is memoized per table, so ilk_id is always a column and left null without an ilk
+		if hasIlk {
+			model.ForeignKeyValues[constants.IlkFK] = hexutil.Encode(arguments[0])
+		} else {
+			model.ColumnValues[string(constants.IlkFK)] = nil
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+func isIlkFile(topicZero common.Hash) (bool, error) {
+	switch topicZero {
+	case common.HexToHash(constants.GenericFileIlkUintSignature()),
+		common.HexToHash(constants.GenericFileIlkAddressSignature()):
+		return true, nil
+	case common.HexToHash(constants.GenericFileUintSignature()),
+		common.HexToHash(constants.GenericFileAddressSignature()):
+		return false, nil
+	default:
+		return false, fmt.Errorf("unrecognized generic file signature: %s", topicZero.Hex())
+	}
+}
+
+func getArguments(logData []byte, numArguments int) ([][]byte, error) {
+	argumentsEnd := firstArgumentOffset + numArguments*vdbConstants.DataItemLength
+	if len(logData) < argumentsEnd {
+		return nil, errors.New("log data too short to contain file arguments")
+	}
+	var arguments [][]byte
+	for i := 0; i < numArguments; i++ {
+		argumentStart := firstArgumentOffset + i*vdbConstants.DataItemLength
+		arguments = append(arguments, logData[argumentStart:argumentStart+vdbConstants.DataItemLength])
+	}
+	return arguments, nil
+}
@@ -14,26 +14,22 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_grab_test
+package generic_file_test
 
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/vulcanize/vulcanizedb/pkg/core"
-
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_grab"
+	"github.com/vulcanize/mcd_transformers/transformers/events/generic_file"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
 )
 
-var _ = Describe("Vat grab converter", func() {
-	var converter vat_grab.VatGrabConverter
-
-	BeforeEach(func() {
-		converter = vat_grab.VatGrabConverter{}
-	})
+var _ = Describe("Generic file converter", func() {
+	converter := generic_file.GenericFileConverter{}
 
 	It("returns err if log is missing topics", func() {
 		badLog := core.HeaderSyncLog{
@@ -55,19 +51,34 @@ var _ = Describe("Vat grab converter", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
-	It("converts a log with positive dink to a model", func() {
-		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{test_data.VatGrabHeaderSyncLogWithPositiveDink})
+	It("returns err if log signature is not a file overload", func() {
+		badLog := test_data.GenericFileAddressHeaderSyncLog
+		badLog.Log.Topics = []common.Hash{common.HexToHash(constants.VatHopeSignature()), {}, {}, {}}
+
+		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unrecognized generic file signature"))
+	})
+
+	It("returns err if log data is too short to contain the arguments", func() {
+		badLog := test_data.GenericFileIlkAddressHeaderSyncLog
+		badLog.Log.Data = badLog.Log.Data[:100]
+
+		_, err := converter.ToModels(constants.JugABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("converts a Vat file log without an ilk to a model", func() {
+		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{test_data.GenericFileAddressHeaderSyncLog})
 
 		Expect(err).NotTo(HaveOccurred())
-		Expect(len(models)).To(Equal(1))
-		Expect(models[0]).To(Equal(test_data.VatGrabModelWithPositiveDink))
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.GenericFileAddressModel()}))
 	})
 
-	It("converts a log with negative dink to a model", func() {
-		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{test_data.VatGrabHeaderSyncLogWithNegativeDink})
+	It("converts a LibNote file log with an ilk to a model", func() {
+		models, err := converter.ToModels(constants.JugABI(), []core.HeaderSyncLog{test_data.GenericFileIlkAddressHeaderSyncLog})
 
 		Expect(err).NotTo(HaveOccurred())
-		Expect(len(models)).To(Equal(1))
-		Expect(models[0]).To(Equal(test_data.VatGrabModelWithNegativeDink))
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.GenericFileIlkAddressModel()}))
 	})
 })
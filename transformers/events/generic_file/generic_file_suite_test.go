@@ -0,0 +1,39 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package generic_file_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+)
+
+func TestGenericFile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GenericFile Suite")
+}
+
+// Transformer configs are derived from the configured contracts and exporters
+var configSet = test_data.SetTestConfig()
+
+var _ = BeforeSuite(func() {
+	log.SetOutput(ioutil.Discard)
+})
@@ -14,27 +14,17 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package new_cdp
+package initializer
 
 import (
+	"github.com/vulcanize/mcd_transformers/transformers/events/generic_file"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
-	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 )
 
-const InsertNewCdpQuery = `INSERT INTO maker.new_cdp
-	(header_id, usr, own, cdp, log_id)
-	VALUES($1, $2, $3, $4::NUMERIC, $5)
-	ON CONFLICT (header_id, log_id)
-	DO UPDATE SET usr = $2, own = $3, cdp = $4;`
-
-type NewCdpRepository struct {
-	db *postgres.DB
-}
-
-func (repository *NewCdpRepository) SetDB(db *postgres.DB) {
-	repository.db = db
-}
-
-func (repository NewCdpRepository) Create(models []shared.InsertionModel) error {
-	return shared.Create(models, repository.db)
-}
+var EventTransformerInitializer transformer.EventTransformerInitializer = shared.EventTransformer{
+	Config:     generic_file.GetEventTransformerConfig(constants.GenericFileUintLabel, constants.GenericFileUintSignature()),
+	Converter:  &generic_file.GenericFileConverter{},
+	Repository: &generic_file.GenericFileRepository{},
+}.NewEventTransformer
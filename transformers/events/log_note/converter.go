@@ -0,0 +1,104 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log_note
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/eth"
+)
+
+// Converter builds insertion models for any log note from its Definition
+type Converter struct {
+	Definition Definition
+}
+
+func (converter Converter) ToModels(contractAbi string, logs []core.HeaderSyncLog) ([]shared.InsertionModel, error) {
+	definition := converter.Definition
+	var parsedAbi *abi.ABI
+	if definition.readsMethodArguments() {
+		abiValue, abiErr := eth.ParseAbi(contractAbi)
+		if abiErr != nil {
+			return nil, abiErr
+		}
+		parsedAbi = &abiValue
+	}
+
+	var models []shared.InsertionModel
+	notesSeen := make(map[repeatedNoteKey]int)
+	for _, log := range logs {
+		logDefinition := definition
+		if definition.RepeatedNote != nil {
+			key := repeatedNoteKey{headerID: log.HeaderID, txIndex: log.Log.TxIndex, data: string(log.Log.Data)}
+			if notesSeen[key]%2 == 1 {
+				logDefinition = *definition.RepeatedNote
+			}
+			notesSeen[key]++
+		}
+		model, modelErr := logDefinition.toModel(log, parsedAbi)
+		if modelErr != nil {
+			return nil, modelErr
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+// repeatedNoteKey identifies notes logged by the same call; both notes of a repeated call share a header, so they're
+// always converted together
+type repeatedNoteKey struct {
+	headerID int64
+	txIndex  uint
+	data     string
+}
+
+func (definition Definition) toModel(log core.HeaderSyncLog, parsedAbi *abi.ABI) (shared.InsertionModel, error) {
+	err := shared.VerifyLog(log.Log, definition.NumTopicsRequired, definition.LogDataRequired)
+	if err != nil {
+		return shared.InsertionModel{}, err
+	}
+
+	model := shared.InsertionModel{
+		SchemaName:     "maker",
+		TableName:      definition.TableName,
+		OrderedColumns: []string{constants.HeaderFK},
+		ColumnValues: shared.ColumnValues{
+			constants.HeaderFK: log.HeaderID,
+			constants.LogFK:    log.ID,
+		},
+		ForeignKeyValues: shared.ForeignKeyValues{},
+	}
+	for _, field := range definition.Fields {
+		value, valueErr := field.value(log.Log, parsedAbi)
+		if valueErr != nil {
+			return shared.InsertionModel{}, valueErr
+		}
+		if field.ForeignKey != "" {
+			model.ForeignKeyValues[field.ForeignKey] = value
+			if !field.KeyOnly {
+				model.OrderedColumns = append(model.OrderedColumns, string(field.ForeignKey))
+			}
+			continue
+		}
+		model.OrderedColumns = append(model.OrderedColumns, field.Column)
+		model.ColumnValues[field.Column] = value
+	}
+	model.OrderedColumns = append(model.OrderedColumns, constants.LogFK)
+	return model, nil
+}
@@ -0,0 +1,116 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log_note_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+var _ = Describe("Log note converter", func() {
+	definition := log_note.Definition{
+		TableName:         "test_note",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []log_note.Field{
+			{ForeignKey: constants.IlkFK, KeyOnly: true, Source: log_note.Topic(1), Type: log_note.Bytes32},
+			{ForeignKey: constants.UrnFK, Source: log_note.Topic(2), Type: log_note.Address},
+			{Column: "what", Source: log_note.Topic(3), Type: log_note.Text},
+			{Column: "data", Source: log_note.DataArgument(3), Type: log_note.Uint256},
+		},
+	}
+	converter := log_note.Converter{Definition: definition}
+
+	It("returns err if log is missing topics", func() {
+		badLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Data: []byte{1, 1, 1, 1, 1},
+			}}
+
+		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns err if log is missing data", func() {
+		badLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Topics: []common.Hash{{}, {}, {}, {}},
+			}}
+
+		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns err if a field reads a topic the log doesn't have", func() {
+		badConverter := log_note.Converter{Definition: log_note.Definition{
+			TableName:         "test_note",
+			NumTopicsRequired: 2,
+			Fields:            []log_note.Field{{Column: "usr", Source: log_note.Topic(3), Type: log_note.Address}},
+		}}
+		shortLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Topics: []common.Hash{{}, {}},
+			}}
+
+		_, err := badConverter.ToModels(constants.VatABI(), []core.HeaderSyncLog{shortLog})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("converts fields in declared order, leaving key only fields out of the columns", func() {
+		log := core.HeaderSyncLog{
+			ID:       1,
+			HeaderID: 2,
+			Log: types.Log{
+				Topics: []common.Hash{
+					{},
+					common.HexToHash("0x4554482d41000000000000000000000000000000000000000000000000000000"),
+					common.HexToHash("0x000000000000000000000000c8e093e5f3f9b5aa6a6a3c6b0b0f2a3e8c5d7e3c"),
+					common.HexToHash("0x6c696e6500000000000000000000000000000000000000000000000000000000"),
+				},
+				Data: test_data.VatFluxHeaderSyncLog.Log.Data,
+			},
+		}
+
+		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{log})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models).To(Equal([]shared.InsertionModel{{
+			SchemaName: "maker",
+			TableName:  "test_note",
+			OrderedColumns: []string{
+				constants.HeaderFK, string(constants.UrnFK), "what", "data", constants.LogFK,
+			},
+			ColumnValues: shared.ColumnValues{
+				"what":             "line",
+				"data":             test_data.VatFluxModel.ColumnValues["wad"],
+				constants.HeaderFK: log.HeaderID,
+				constants.LogFK:    log.ID,
+			},
+			ForeignKeyValues: shared.ForeignKeyValues{
+				constants.IlkFK: "0x4554482d41000000000000000000000000000000000000000000000000000000",
+				constants.UrnFK: "0xc8e093e5f3f9b5aa6a6A3c6B0b0F2A3E8C5d7E3C",
+			},
+		}}))
+	})
+})
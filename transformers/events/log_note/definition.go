@@ -0,0 +1,253 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log_note
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	vdbConstants "github.com/vulcanize/vulcanizedb/libraries/shared/constants"
+)
+
+// ValueType says how a log note value is decoded before it's persisted
+type ValueType int
+
+const (
+	Bytes32 ValueType = iota // hex encoded, e.g. an ilk
+	Address                  // checksummed address
+	Uint256
+	Int256
+	Text         // bytes32 decoded to a string, e.g. the what of a file
+	Bytes        // hex encoded dynamic bytes, only from a MethodArgument
+	AddressArray // Postgres array literal of checksummed addresses, only from a MethodArgument
+	Slate        // DSChief slate hash of an address array, only from a MethodArgument
+)
+
+type sourceKind int
+
+const (
+	topicSource sourceKind = iota
+	dataArgumentSource
+	calldataWordSource
+	methodArgumentSource
+	contractAddressSource
+	derivedSource
+)
+
+// Source says where a log note value is read from
+type Source struct {
+	kind   sourceKind
+	index  int
+	derive func(types.Log) (string, error)
+}
+
+func Topic(index int) Source {
+	return Source{kind: topicSource, index: index}
+}
+
+// DataArgument reads the calldata argument at the position used by shared.GetLogNoteArgumentAtIndex
+func DataArgument(index int) Source {
+	return Source{kind: dataArgumentSource, index: index}
+}
+
+// CalldataWord reads the 32 byte calldata argument at index, counted from the function selector, for notes whose
+// data length depends on the emitting contract
+func CalldataWord(index int) Source {
+	return Source{kind: calldataWordSource, index: index}
+}
+
+// MethodArgument ABI decodes the argument at index from a ds-note's logged calldata, for values that don't fit in
+// a word such as bytes or arrays
+func MethodArgument(index int) Source {
+	return Source{kind: methodArgumentSource, index: index}
+}
+
+// ContractAddress reads the address of the contract that emitted the log
+func ContractAddress() Source {
+	return Source{kind: contractAddressSource}
+}
+
+// Derived computes a value from the whole log; the field's Type is ignored
+func Derived(derive func(types.Log) (string, error)) Source {
+	return Source{kind: derivedSource, derive: derive}
+}
+
+// Field maps one log note value to a column, or to a foreign key that is resolved to an id on insert
+type Field struct {
+	Column     string
+	ForeignKey constants.ForeignKeyField
+	// KeyOnly fields are only used to resolve another foreign key, e.g. the ilk of an urn
+	KeyOnly bool
+	Source  Source
+	Type    ValueType
+}
+
+// Definition declares a log note transformer: the table it writes to and how log values map to its columns
+type Definition struct {
+	TableName         string
+	NumTopicsRequired int
+	LogDataRequired   bool
+	Fields            []Field
+	// RepeatedNote converts every second note with the same transaction and calldata, for methods that are logged
+	// twice because they call two noted methods, each of which logs the outer selector and calldata
+	RepeatedNote *Definition
+}
+
+// log note data is an offset word and a length word followed by the calldata, which starts with a 4 byte selector
+var firstCalldataArgumentOffset = 2*vdbConstants.DataItemLength + 4
+
+var ErrUnexpectedMethodArgument = errors.New("log note method argument doesn't match its field type")
+
+func (definition Definition) readsMethodArguments() bool {
+	for _, field := range definition.Fields {
+		if field.Source.kind == methodArgumentSource {
+			return true
+		}
+	}
+	return definition.RepeatedNote != nil && definition.RepeatedNote.readsMethodArguments()
+}
+
+func (field Field) value(log types.Log, contractAbi *abi.ABI) (string, error) {
+	switch field.Source.kind {
+	case methodArgumentSource:
+		argument, err := getMethodArgument(field.Source.index, log, contractAbi)
+		if err != nil {
+			return "", err
+		}
+		return formatMethodArgument(argument, field.Type)
+	case derivedSource:
+		return field.Source.derive(log)
+	}
+
+	word, err := field.word(log)
+	if err != nil {
+		return "", err
+	}
+	switch field.Type {
+	case Bytes32:
+		return hexutil.Encode(word), nil
+	case Address:
+		return common.BytesToAddress(word).String(), nil
+	case Uint256:
+		return shared.ConvertUint256HexToBigInt(hexutil.Encode(word)).String(), nil
+	case Int256:
+		return shared.ConvertInt256HexToBigInt(hexutil.Encode(word)).String(), nil
+	case Text:
+		return shared.DecodeHexToText(hexutil.Encode(word)), nil
+	default:
+		return "", fmt.Errorf("log note value type %d can't be read from a single word", field.Type)
+	}
+}
+
+func (field Field) word(log types.Log) ([]byte, error) {
+	index := field.Source.index
+	switch field.Source.kind {
+	case topicSource:
+		if index >= len(log.Topics) {
+			return nil, fmt.Errorf("log note field reads topic %d of a log with %d topics", index, len(log.Topics))
+		}
+		return log.Topics[index].Bytes(), nil
+	case dataArgumentSource:
+		return shared.GetLogNoteArgumentAtIndex(index, log.Data)
+	case calldataWordSource:
+		start := firstCalldataArgumentOffset + index*vdbConstants.DataItemLength
+		end := start + vdbConstants.DataItemLength
+		if len(log.Data) < end {
+			return nil, fmt.Errorf("log note data too short to contain calldata argument %d", index)
+		}
+		return log.Data[start:end], nil
+	case contractAddressSource:
+		return log.Address.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unrecognized log note source: %d", field.Source.kind)
+	}
+}
+
+func getMethodArgument(index int, log types.Log, contractAbi *abi.ABI) (interface{}, error) {
+	calldata, calldataErr := shared.GetDSNoteCalldata(log.Data)
+	if calldataErr != nil {
+		return nil, calldataErr
+	}
+	method, methodErr := contractAbi.MethodById(calldata[:4])
+	if methodErr != nil {
+		return nil, methodErr
+	}
+	arguments, unpackErr := method.Inputs.UnpackValues(calldata[4:])
+	if unpackErr != nil {
+		return nil, fmt.Errorf("couldn't unpack %s calldata: %v", method.Name, unpackErr)
+	}
+	if index >= len(arguments) {
+		return nil, fmt.Errorf("%s has no argument %d", method.Name, index)
+	}
+	return arguments[index], nil
+}
+
+func formatMethodArgument(argument interface{}, valueType ValueType) (string, error) {
+	switch value := argument.(type) {
+	case *big.Int:
+		if valueType == Uint256 || valueType == Int256 {
+			return value.String(), nil
+		}
+	case []byte:
+		if valueType == Bytes {
+			return hexutil.Encode(value), nil
+		}
+	case [32]byte:
+		if valueType == Bytes32 {
+			return hexutil.Encode(value[:]), nil
+		}
+	case common.Address:
+		if valueType == Address {
+			return value.String(), nil
+		}
+	case []common.Address:
+		switch valueType {
+		case AddressArray:
+			return formatAddressArray(value), nil
+		case Slate:
+			return getSlate(value), nil
+		}
+	}
+	return "", ErrUnexpectedMethodArgument
+}
+
+// Formatted as a Postgres array literal for insertion into a TEXT[] column
+func formatAddressArray(addresses []common.Address) string {
+	var addressStrings []string
+	for _, address := range addresses {
+		addressStrings = append(addressStrings, address.String())
+	}
+	return "{" + strings.Join(addressStrings, ",") + "}"
+}
+
+// DSChief identifies a slate by the hash of its ordered, abi encoded candidate addresses
+func getSlate(yays []common.Address) string {
+	var encodedYays []byte
+	for _, yay := range yays {
+		encodedYays = append(encodedYays, common.LeftPadBytes(yay.Bytes(), 32)...)
+	}
+	return crypto.Keccak256Hash(encodedYays).Hex()
+}
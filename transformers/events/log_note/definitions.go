@@ -0,0 +1,475 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log_note
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+)
+
+const (
+	Rely = "rely"
+	Deny = "deny"
+)
+
+// contract config names for the modules that can be caged, keyed to the module name stored with each event
+var cageableContracts = map[string]string{
+	"MCD_VAT":  "vat",
+	"MCD_CAT":  "cat",
+	"MCD_VOW":  "vow",
+	"MCD_FLAP": "flap",
+	"MCD_FLOP": "flop",
+	"MCD_SPOT": "spot",
+	"MCD_POT":  "pot",
+}
+
+// Definitions declares every transformer whose models are built by the generic log note Converter, keyed by label
+var Definitions = map[string]Definition{
+	constants.AuctionFileLabel: {
+		TableName:         "auction_file",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{ForeignKey: constants.AddressFK, Source: ContractAddress(), Type: Address},
+			{Column: "what", Source: Topic(2), Type: Text},
+			{Column: "data", Source: Topic(3), Type: Uint256},
+		},
+	},
+	// cage() from the core modules and cage(uint256) from the Flapper; the module is resolved from the emitting
+	// contract so shutdown status can be queried by module
+	constants.CageLabel:     cageDefinition(),
+	constants.FlapCageLabel: cageDefinition(),
+	constants.CdpManagerCdpAllowLabel: {
+		TableName:         "cdp_manager_cdp_allow",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "cdpi", Source: Topic(2), Type: Uint256},
+			{Column: "usr", Source: Topic(3), Type: Address},
+			{Column: "ok", Source: DataArgument(2), Type: Uint256},
+		},
+	},
+	constants.CdpManagerEnterLabel: {
+		TableName:         "cdp_manager_enter",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "src", Source: Topic(2), Type: Address},
+			{Column: "cdpi", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.CdpManagerFluxLabel: cdpTransferDefinition("cdp_manager_flux", "wad"),
+	constants.CdpManagerFrobLabel: {
+		TableName:         "cdp_manager_frob",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "cdpi", Source: Topic(2), Type: Uint256},
+			{Column: "dink", Source: Topic(3), Type: Int256},
+			{Column: "dart", Source: DataArgument(2), Type: Int256},
+		},
+	},
+	constants.CdpManagerGiveLabel: cdpTransferDefinition("cdp_manager_give", ""),
+	constants.CdpManagerMoveLabel: cdpTransferDefinition("cdp_manager_move", "rad"),
+	constants.CdpManagerQuitLabel: cdpTransferDefinition("cdp_manager_quit", ""),
+	constants.CdpManagerShiftLabel: {
+		TableName:         "cdp_manager_shift",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "cdp_src", Source: Topic(2), Type: Uint256},
+			{Column: "cdp_dst", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.CdpManagerUrnAllowLabel: {
+		TableName:         "cdp_manager_urn_allow",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "usr", Source: Topic(2), Type: Address},
+			{Column: "ok", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.ChiefEtchLabel: slateDefinition("chief_etch"),
+	constants.ChiefFreeLabel: wadDefinition("chief_free", false),
+	constants.ChiefLiftLabel: {
+		TableName:         "chief_lift",
+		NumTopicsRequired: 3,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "whom", Source: Topic(2), Type: Address},
+		},
+	},
+	constants.ChiefLockLabel: wadDefinition("chief_lock", false),
+	constants.ChiefVoteLabel: {
+		TableName:         "chief_vote",
+		NumTopicsRequired: 3,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "slate", Source: Topic(2), Type: Bytes32},
+		},
+	},
+	// DSChief's vote(address[]) has no note of its own, but it calls etch and vote(bytes32), which both are. DSNote
+	// logs msg.sig and msg.data, so a single vote(address[]) call emits two identical LogNotes carrying its selector
+	// and calldata. The first is recorded once in chief_vote_yays, and the second as the nested vote in chief_vote.
+	constants.ChiefVoteYaysLabel: voteYaysDefinition(),
+	constants.DenyLabel:          authDefinition(),
+	constants.EndCageLabel: {
+		TableName:         "end_cage",
+		NumTopicsRequired: 2,
+		LogDataRequired:   true,
+	},
+	constants.EndCageIlkLabel: ilkDefinition("end_cage_ilk"),
+	constants.EndCashLabel: {
+		TableName:         "end_cash",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, Source: Topic(2), Type: Bytes32},
+			msgSenderField,
+			{Column: "wad", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.EndFlowLabel: ilkDefinition("end_flow"),
+	constants.EndFreeLabel: {
+		TableName:         "end_free",
+		NumTopicsRequired: 3,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, KeyOnly: true, Source: Topic(2), Type: Bytes32},
+			{ForeignKey: constants.UrnFK, Source: Topic(1), Type: Address},
+		},
+	},
+	constants.EndPackLabel: wadDefinition("end_pack", true),
+	constants.EndSkimLabel: {
+		TableName:         "end_skim",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, KeyOnly: true, Source: Topic(2), Type: Bytes32},
+			{ForeignKey: constants.UrnFK, Source: Topic(3), Type: Address},
+		},
+	},
+	constants.EndThawLabel: {
+		TableName:         "end_thaw",
+		NumTopicsRequired: 2,
+		LogDataRequired:   true,
+	},
+	constants.EsmFireLabel:   msgSenderDefinition("esm_fire"),
+	constants.EsmJoinLabel:   wadDefinition("esm_join", false),
+	constants.PauseDropLabel: pausePlanDefinition("pause_drop"),
+	constants.PauseExecLabel: pausePlanDefinition("pause_exec"),
+	constants.PausePlotLabel: pausePlanDefinition("pause_plot"),
+	constants.PotDripLabel: {
+		TableName:         "pot_drip",
+		NumTopicsRequired: 2,
+		LogDataRequired:   true,
+	},
+	constants.PotExitLabel: wadDefinition("pot_exit", true),
+	constants.PotFileDSRLabel: {
+		TableName:         "pot_file_dsr",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{Column: "what", Source: Topic(2), Type: Text},
+			{Column: "data", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.PotFileVowLabel: {
+		TableName:         "pot_file_vow",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{Column: "what", Source: Topic(2), Type: Text},
+			{Column: "data", Source: Topic(3), Type: Address},
+		},
+	},
+	constants.PotJoinLabel: wadDefinition("pot_join", true),
+	constants.RelyLabel:    authDefinition(),
+	constants.SpotFileParLabel: {
+		TableName:         "spot_file_par",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{Column: "what", Source: Topic(2), Type: Text},
+			{Column: "data", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.VatFluxLabel: {
+		TableName:         "vat_flux",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, Source: Topic(1), Type: Bytes32},
+			{Column: "src", Source: Topic(2), Type: Address},
+			{Column: "dst", Source: Topic(3), Type: Address},
+			{Column: "wad", Source: DataArgument(3), Type: Uint256},
+		},
+	},
+	constants.VatFoldLabel: {
+		TableName:         "vat_fold",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, KeyOnly: true, Source: Topic(1), Type: Bytes32},
+			{ForeignKey: constants.UrnFK, Source: Topic(2), Type: Address},
+			{Column: "rate", Source: Topic(3), Type: Int256},
+		},
+	},
+	constants.VatForkLabel: {
+		TableName:         "vat_fork",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, Source: Topic(1), Type: Bytes32},
+			{Column: "src", Source: Topic(2), Type: Address},
+			{Column: "dst", Source: Topic(3), Type: Address},
+			{Column: "dink", Source: DataArgument(3), Type: Int256},
+			{Column: "dart", Source: DataArgument(4), Type: Int256},
+		},
+	},
+	constants.VatFrobLabel: urnModificationDefinition("vat_frob"),
+	constants.VatGrabLabel: urnModificationDefinition("vat_grab"),
+	constants.VatHealLabel: {
+		TableName:         "vat_heal",
+		NumTopicsRequired: 2,
+		Fields: []Field{
+			{Column: "rad", Source: Topic(1), Type: Uint256},
+		},
+	},
+	constants.VatHopeLabel: permissionDefinition("vat_hope"),
+	constants.VatInitLabel: {
+		TableName:         "vat_init",
+		NumTopicsRequired: 2,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, Source: Topic(1), Type: Bytes32},
+		},
+	},
+	constants.VatMoveLabel: {
+		TableName:         "vat_move",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{Column: "src", Source: Topic(1), Type: Address},
+			{Column: "dst", Source: Topic(2), Type: Address},
+			{Column: "rad", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.VatNopeLabel: permissionDefinition("vat_nope"),
+	constants.VatSlipLabel: {
+		TableName:         "vat_slip",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, Source: Topic(1), Type: Bytes32},
+			{Column: "usr", Source: Topic(2), Type: Address},
+			{Column: "wad", Source: Topic(3), Type: Int256},
+		},
+	},
+	constants.VatSuckLabel: {
+		TableName:         "vat_suck",
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{Column: "u", Source: Topic(1), Type: Address},
+			{Column: "v", Source: Topic(2), Type: Address},
+			{Column: "rad", Source: Topic(3), Type: Uint256},
+		},
+	},
+	constants.VowFlapLabel: msgSenderDefinition("vow_flap"),
+	constants.VowFlopLabel: msgSenderDefinition("vow_flop"),
+	constants.VowHealLabel: radDefinition("vow_heal"),
+	constants.VowKissLabel: radDefinition("vow_kiss"),
+}
+
+// LibNote and DSNote both index msg.sender as the first topic
+var msgSenderField = Field{Column: "msg_sender", Source: Topic(1), Type: Address}
+
+// frob and grab share a signature shape: (ilk, urn, v, w, dink, dart)
+func urnModificationDefinition(tableName string) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, KeyOnly: true, Source: Topic(1), Type: Bytes32},
+			{ForeignKey: constants.UrnFK, Source: Topic(2), Type: Address},
+			{Column: "v", Source: Topic(3), Type: Address},
+			{Column: "w", Source: DataArgument(3), Type: Address},
+			{Column: "dink", Source: DataArgument(4), Type: Int256},
+			{Column: "dart", Source: DataArgument(5), Type: Int256},
+		},
+	}
+}
+
+// hope and nope both take a single usr
+func permissionDefinition(tableName string) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			{Column: "usr", Source: Topic(1), Type: Address},
+		},
+	}
+}
+
+// rely and deny share a table; the target is read from the calldata because Vat notes and LibNote notes index
+// different values in the topics but share the same data layout
+func authDefinition() Definition {
+	return Definition{
+		TableName:         "auth",
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.AddressFK, Source: ContractAddress(), Type: Address},
+			{Column: "usr", Source: CalldataWord(0), Type: Address},
+			{Column: "action", Source: Derived(getAuthAction)},
+		},
+	}
+}
+
+func getAuthAction(log types.Log) (string, error) {
+	switch log.Topics[0] {
+	case common.HexToHash(constants.RelySignature()):
+		return Rely, nil
+	case common.HexToHash(constants.DenySignature()):
+		return Deny, nil
+	default:
+		return "", fmt.Errorf("unrecognized auth signature: %s", log.Topics[0].Hex())
+	}
+}
+
+func cageDefinition() Definition {
+	return Definition{
+		TableName:         "cage",
+		NumTopicsRequired: 2,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.AddressFK, Source: ContractAddress(), Type: Address},
+			{Column: "module", Source: Derived(getCagedModule)},
+		},
+	}
+}
+
+func getCagedModule(log types.Log) (string, error) {
+	contractName, _ := constants.GetContractNameByAddress(log.Address.Hex())
+	module, ok := cageableContracts[contractName]
+	if !ok {
+		return "", fmt.Errorf("cage log from unrecognized contract: %s", log.Address.Hex())
+	}
+	return module, nil
+}
+
+// flux and move take (cdp, dst, amount); give and quit take only (cdp, dst)
+func cdpTransferDefinition(tableName, amountColumn string) Definition {
+	definition := Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 4,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "cdpi", Source: Topic(2), Type: Uint256},
+			{Column: "dst", Source: Topic(3), Type: Address},
+		},
+	}
+	if amountColumn != "" {
+		definition.LogDataRequired = true
+		definition.Fields = append(definition.Fields, Field{Column: amountColumn, Source: DataArgument(2), Type: Uint256})
+	}
+	return definition
+}
+
+func ilkDefinition(tableName string) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 3,
+		LogDataRequired:   true,
+		Fields: []Field{
+			{ForeignKey: constants.IlkFK, Source: Topic(2), Type: Bytes32},
+		},
+	}
+}
+
+func msgSenderDefinition(tableName string) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 2,
+		Fields:            []Field{msgSenderField},
+	}
+}
+
+// plot, drop and exec all take (usr, tag, fax, eta); fax and eta don't fit in the topics
+func pausePlanDefinition(tableName string) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 4,
+		LogDataRequired:   true,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "usr", Source: Topic(2), Type: Address},
+			{Column: "tag", Source: Topic(3), Type: Bytes32},
+			{Column: "fax", Source: MethodArgument(2), Type: Bytes},
+			{Column: "eta", Source: MethodArgument(3), Type: Uint256},
+		},
+	}
+}
+
+func radDefinition(tableName string) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 3,
+		Fields: []Field{
+			{Column: "rad", Source: Topic(2), Type: Uint256},
+		},
+	}
+}
+
+// etch and vote(address[]) both take the slate's candidates as their only argument
+func slateDefinition(tableName string) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 2,
+		LogDataRequired:   true,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "slate", Source: MethodArgument(0), Type: Slate},
+			{Column: "yays", Source: MethodArgument(0), Type: AddressArray},
+		},
+	}
+}
+
+func voteYaysDefinition() Definition {
+	definition := slateDefinition("chief_vote_yays")
+	definition.RepeatedNote = &Definition{
+		TableName:         "chief_vote",
+		NumTopicsRequired: definition.NumTopicsRequired,
+		LogDataRequired:   definition.LogDataRequired,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "slate", Source: MethodArgument(0), Type: Slate},
+		},
+	}
+	return definition
+}
+
+func wadDefinition(tableName string, logDataRequired bool) Definition {
+	return Definition{
+		TableName:         tableName,
+		NumTopicsRequired: 3,
+		LogDataRequired:   logDataRequired,
+		Fields: []Field{
+			msgSenderField,
+			{Column: "wad", Source: Topic(2), Type: Uint256},
+		},
+	}
+}
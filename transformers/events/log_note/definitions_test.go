@@ -0,0 +1,436 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log_note_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+var _ = Describe("Log note definitions", func() {
+	convert := func(label string, log core.HeaderSyncLog) []shared.InsertionModel {
+		converter := log_note.Converter{Definition: log_note.Definitions[label]}
+		models, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{log})
+		Expect(err).NotTo(HaveOccurred())
+		return models
+	}
+
+	convertWithAbi := func(label, contractAbi string, log core.HeaderSyncLog) []shared.InsertionModel {
+		converter := log_note.Converter{Definition: log_note.Definitions[label]}
+		models, err := converter.ToModels(contractAbi, []core.HeaderSyncLog{log})
+		Expect(err).NotTo(HaveOccurred())
+		return models
+	}
+
+	It("converts an auction file log to a model", func() {
+		models := convert(constants.AuctionFileLabel, test_data.AuctionFileHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.AuctionFileModel}))
+	})
+
+	It("converts a vat cage log to a model", func() {
+		models := convert(constants.CageLabel, test_data.VatCageHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatCageModel}))
+	})
+
+	It("converts a flap cage log to a model", func() {
+		models := convert(constants.FlapCageLabel, test_data.FlapCageHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.FlapCageModel}))
+	})
+
+	It("converts a cdp manager cdp allow log to a model", func() {
+		models := convert(constants.CdpManagerCdpAllowLabel, test_data.CdpManagerCdpAllowHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerCdpAllowModel}))
+	})
+
+	It("converts a cdp manager enter log to a model", func() {
+		models := convert(constants.CdpManagerEnterLabel, test_data.CdpManagerEnterHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerEnterModel}))
+	})
+
+	It("converts a cdp manager flux log to a model", func() {
+		models := convert(constants.CdpManagerFluxLabel, test_data.CdpManagerFluxHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerFluxModel}))
+	})
+
+	It("converts a cdp manager frob log to a model", func() {
+		models := convert(constants.CdpManagerFrobLabel, test_data.CdpManagerFrobHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerFrobModel}))
+	})
+
+	It("converts a cdp manager give log to a model", func() {
+		models := convert(constants.CdpManagerGiveLabel, test_data.CdpManagerGiveHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerGiveModel}))
+	})
+
+	It("converts a cdp manager move log to a model", func() {
+		models := convert(constants.CdpManagerMoveLabel, test_data.CdpManagerMoveHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerMoveModel}))
+	})
+
+	It("converts a cdp manager quit log to a model", func() {
+		models := convert(constants.CdpManagerQuitLabel, test_data.CdpManagerQuitHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerQuitModel}))
+	})
+
+	It("converts a cdp manager shift log to a model", func() {
+		models := convert(constants.CdpManagerShiftLabel, test_data.CdpManagerShiftHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerShiftModel}))
+	})
+
+	It("converts a cdp manager urn allow log to a model", func() {
+		models := convert(constants.CdpManagerUrnAllowLabel, test_data.CdpManagerUrnAllowHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.CdpManagerUrnAllowModel}))
+	})
+
+	It("converts a chief free log to a model", func() {
+		models := convert(constants.ChiefFreeLabel, test_data.ChiefFreeHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefFreeModel}))
+	})
+
+	It("converts a chief lift log to a model", func() {
+		models := convert(constants.ChiefLiftLabel, test_data.ChiefLiftHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefLiftModel}))
+	})
+
+	It("converts a chief lock log to a model", func() {
+		models := convert(constants.ChiefLockLabel, test_data.ChiefLockHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefLockModel}))
+	})
+
+	It("converts a chief vote log to a model", func() {
+		models := convert(constants.ChiefVoteLabel, test_data.ChiefVoteHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefVoteModel}))
+	})
+
+	It("converts a LibNote deny log to a model", func() {
+		models := convert(constants.DenyLabel, test_data.DenyHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.DenyModel}))
+	})
+
+	It("converts an end cage log to a model", func() {
+		models := convert(constants.EndCageLabel, test_data.EndCageHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndCageModel}))
+	})
+
+	It("converts an end cage ilk log to a model", func() {
+		models := convert(constants.EndCageIlkLabel, test_data.EndCageIlkHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndCageIlkModel}))
+	})
+
+	It("converts an end cash log to a model", func() {
+		models := convert(constants.EndCashLabel, test_data.EndCashHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndCashModel}))
+	})
+
+	It("converts an end flow log to a model", func() {
+		models := convert(constants.EndFlowLabel, test_data.EndFlowHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndFlowModel}))
+	})
+
+	It("converts an end free log to a model", func() {
+		models := convert(constants.EndFreeLabel, test_data.EndFreeHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndFreeModel}))
+	})
+
+	It("converts an end pack log to a model", func() {
+		models := convert(constants.EndPackLabel, test_data.EndPackHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndPackModel}))
+	})
+
+	It("converts an end skim log to a model", func() {
+		models := convert(constants.EndSkimLabel, test_data.EndSkimHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndSkimModel}))
+	})
+
+	It("converts an end thaw log to a model", func() {
+		models := convert(constants.EndThawLabel, test_data.EndThawHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EndThawModel}))
+	})
+
+	It("converts an esm fire log to a model", func() {
+		models := convert(constants.EsmFireLabel, test_data.EsmFireHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EsmFireModel}))
+	})
+
+	It("converts an esm join log to a model", func() {
+		models := convert(constants.EsmJoinLabel, test_data.EsmJoinHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.EsmJoinModel}))
+	})
+
+	It("converts a pot drip log to a model", func() {
+		models := convert(constants.PotDripLabel, test_data.PotDripHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PotDripModel}))
+	})
+
+	It("converts a pot exit log to a model", func() {
+		models := convert(constants.PotExitLabel, test_data.PotExitHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PotExitModel}))
+	})
+
+	It("converts a pot file dsr log to a model", func() {
+		models := convert(constants.PotFileDSRLabel, test_data.PotFileDSRHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PotFileDSRModel}))
+	})
+
+	It("converts a pot file vow log to a model", func() {
+		models := convert(constants.PotFileVowLabel, test_data.PotFileVowHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PotFileVowModel}))
+	})
+
+	It("converts a pot join log to a model", func() {
+		models := convert(constants.PotJoinLabel, test_data.PotJoinHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PotJoinModel}))
+	})
+
+	It("converts a vat rely log to a model", func() {
+		models := convert(constants.RelyLabel, test_data.RelyHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.RelyModel}))
+	})
+
+	It("converts a chief etch log to a model", func() {
+		models := convertWithAbi(constants.ChiefEtchLabel, constants.ChiefABI(), test_data.ChiefEtchHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefEtchModel}))
+	})
+
+	It("converts a chief vote yays log to a model", func() {
+		models := convertWithAbi(constants.ChiefVoteYaysLabel, constants.ChiefABI(), test_data.ChiefVoteYaysHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefVoteYaysModel}))
+	})
+
+	It("converts a pause drop log to a model", func() {
+		models := convertWithAbi(constants.PauseDropLabel, constants.PauseABI(), test_data.PauseDropHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PauseDropModel}))
+	})
+
+	It("converts a pause exec log to a model", func() {
+		models := convertWithAbi(constants.PauseExecLabel, constants.PauseABI(), test_data.PauseExecHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PauseExecModel}))
+	})
+
+	It("converts a pause plot log to a model", func() {
+		models := convertWithAbi(constants.PausePlotLabel, constants.PauseABI(), test_data.PausePlotHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.PausePlotModel}))
+	})
+
+	It("records the second LogNote emitted by a vote(address[]) call as a chief vote", func() {
+		// the nested etch and vote(bytes32) calls each log the outer selector and calldata
+		secondLog := test_data.ChiefVoteYaysHeaderSyncLog
+		secondLog.ID = secondLog.ID + 1
+		secondLog.Log.Index = secondLog.Log.Index + 2
+		voteModel := test_data.CopyModel(test_data.ChiefVoteModel)
+		voteModel.ColumnValues[constants.HeaderFK] = secondLog.HeaderID
+		voteModel.ColumnValues[constants.LogFK] = secondLog.ID
+		converter := log_note.Converter{Definition: log_note.Definitions[constants.ChiefVoteYaysLabel]}
+
+		models, err := converter.ToModels(constants.ChiefABI(), []core.HeaderSyncLog{test_data.ChiefVoteYaysHeaderSyncLog, secondLog})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefVoteYaysModel, voteModel}))
+	})
+
+	It("records separate vote(address[]) calls in a transaction once each", func() {
+		otherCallLog := test_data.ChiefVoteYaysHeaderSyncLog
+		otherCallLog.ID = otherCallLog.ID + 1
+		otherCallLog.Log.TxIndex = otherCallLog.Log.TxIndex + 1
+		otherCallModel := test_data.CopyModel(test_data.ChiefVoteYaysModel)
+		otherCallModel.ColumnValues[constants.LogFK] = otherCallLog.ID
+		converter := log_note.Converter{Definition: log_note.Definitions[constants.ChiefVoteYaysLabel]}
+
+		models, err := converter.ToModels(constants.ChiefABI(), []core.HeaderSyncLog{test_data.ChiefVoteYaysHeaderSyncLog, otherCallLog})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.ChiefVoteYaysModel, otherCallModel}))
+	})
+
+	It("returns err if a cage log is not from a cageable contract", func() {
+		badLog := test_data.VatCageHeaderSyncLog
+		badLog.Log.Address = common.HexToAddress(test_data.EndAddress())
+		converter := log_note.Converter{Definition: log_note.Definitions[constants.CageLabel]}
+
+		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unrecognized contract"))
+	})
+
+	It("returns err if an auth log signature is not rely or deny", func() {
+		badLog := test_data.RelyHeaderSyncLog
+		badLog.Log.Topics = []common.Hash{common.HexToHash(constants.VatHopeSignature()), {}, {}, {}}
+		converter := log_note.Converter{Definition: log_note.Definitions[constants.RelyLabel]}
+
+		_, err := converter.ToModels(constants.VatABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unrecognized auth signature"))
+	})
+
+	It("returns err if chief log data does not contain the yays", func() {
+		badLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Topics: test_data.ChiefEtchHeaderSyncLog.Log.Topics,
+				Data:   test_data.ChiefEtchHeaderSyncLog.Log.Data[:96],
+			}}
+		converter := log_note.Converter{Definition: log_note.Definitions[constants.ChiefEtchLabel]}
+
+		_, err := converter.ToModels(constants.ChiefABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(MatchError(shared.ErrDSNoteDataMalformed))
+	})
+
+	It("returns err if pause log data does not contain the calldata", func() {
+		badLog := core.HeaderSyncLog{
+			Log: types.Log{
+				Topics: test_data.PauseDropHeaderSyncLog.Log.Topics,
+				Data:   test_data.PauseDropHeaderSyncLog.Log.Data[:96],
+			}}
+		converter := log_note.Converter{Definition: log_note.Definitions[constants.PauseDropLabel]}
+
+		_, err := converter.ToModels(constants.PauseABI(), []core.HeaderSyncLog{badLog})
+		Expect(err).To(MatchError(shared.ErrDSNoteDataMalformed))
+	})
+
+	It("returns err if a definition reading method arguments gets an invalid abi", func() {
+		converter := log_note.Converter{Definition: log_note.Definitions[constants.PauseDropLabel]}
+
+		_, err := converter.ToModels("error abi", []core.HeaderSyncLog{test_data.PauseDropHeaderSyncLog})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("converts a vat flux log to a model", func() {
+		models := convert(constants.VatFluxLabel, test_data.VatFluxHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatFluxModel}))
+	})
+
+	It("converts vat fold logs with positive and negative rates to models", func() {
+		Expect(convert(constants.VatFoldLabel, test_data.VatFoldHeaderSyncLogWithPositiveRate)).
+			To(Equal([]shared.InsertionModel{test_data.VatFoldModelWithPositiveRate}))
+		Expect(convert(constants.VatFoldLabel, test_data.VatFoldHeaderSyncLogWithNegativeRate)).
+			To(Equal([]shared.InsertionModel{test_data.VatFoldModelWithNegativeRate}))
+	})
+
+	It("converts vat fork logs with positive and negative dink and dart to models", func() {
+		Expect(convert(constants.VatForkLabel, test_data.VatForkHeaderSyncLogWithPositiveDinkDart)).
+			To(Equal([]shared.InsertionModel{test_data.VatForkModelWithPositiveDinkDart}))
+		Expect(convert(constants.VatForkLabel, test_data.VatForkHeaderSyncLogWithNegativeDinkDart)).
+			To(Equal([]shared.InsertionModel{test_data.VatForkModelWithNegativeDinkDart}))
+	})
+
+	It("converts vat frob logs with positive dart and negative dink to models", func() {
+		Expect(convert(constants.VatFrobLabel, test_data.VatFrobHeaderSyncLogWithPositiveDart)).
+			To(Equal([]shared.InsertionModel{test_data.VatFrobModelWithPositiveDart()}))
+		Expect(convert(constants.VatFrobLabel, test_data.VatFrobHeaderSyncLogWithNegativeDink)).
+			To(Equal([]shared.InsertionModel{test_data.VatFrobModelWithNegativeDink()}))
+	})
+
+	It("converts vat grab logs with positive and negative dink to models", func() {
+		Expect(convert(constants.VatGrabLabel, test_data.VatGrabHeaderSyncLogWithPositiveDink)).
+			To(Equal([]shared.InsertionModel{test_data.VatGrabModelWithPositiveDink}))
+		Expect(convert(constants.VatGrabLabel, test_data.VatGrabHeaderSyncLogWithNegativeDink)).
+			To(Equal([]shared.InsertionModel{test_data.VatGrabModelWithNegativeDink}))
+	})
+
+	It("converts a vat heal log to a model", func() {
+		models := convert(constants.VatHealLabel, test_data.VatHealHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatHealModel}))
+	})
+
+	It("converts a vat hope log to a model", func() {
+		models := convert(constants.VatHopeLabel, test_data.VatHopeHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatHopeModel}))
+	})
+
+	It("converts a vat init log to a model", func() {
+		models := convert(constants.VatInitLabel, test_data.VatInitHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatInitModel}))
+	})
+
+	It("converts a vat move log to a model", func() {
+		models := convert(constants.VatMoveLabel, test_data.VatMoveHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatMoveModel}))
+	})
+
+	It("converts a vat nope log to a model", func() {
+		models := convert(constants.VatNopeLabel, test_data.VatNopeHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatNopeModel}))
+	})
+
+	It("converts vat slip logs with positive and negative wad to models", func() {
+		Expect(convert(constants.VatSlipLabel, test_data.VatSlipHeaderSyncLogWithPositiveWad)).
+			To(Equal([]shared.InsertionModel{test_data.VatSlipModelWithPositiveWad}))
+		Expect(convert(constants.VatSlipLabel, test_data.VatSlipHeaderSyncLogWithNegativeWad)).
+			To(Equal([]shared.InsertionModel{test_data.VatSlipModelWithNegativeWad}))
+	})
+
+	It("converts a vat suck log to a model", func() {
+		models := convert(constants.VatSuckLabel, test_data.VatSuckHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VatSuckModel}))
+	})
+	It("converts a spot file par log to a model", func() {
+		models := convert(constants.SpotFileParLabel, test_data.SpotFileParHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.SpotFileParModel()}))
+	})
+
+	It("converts a vow flap log to a model", func() {
+		models := convert(constants.VowFlapLabel, test_data.VowFlapHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VowFlapModel}))
+	})
+
+	It("converts a vow flop log to a model", func() {
+		models := convert(constants.VowFlopLabel, test_data.VowFlopHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VowFlopModel}))
+	})
+
+	It("converts a vow heal log to a model", func() {
+		models := convert(constants.VowHealLabel, test_data.VowHealHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VowHealModel}))
+	})
+
+	It("converts a vow kiss log to a model", func() {
+		models := convert(constants.VowKissLabel, test_data.VowKissHeaderSyncLog)
+		Expect(models).To(Equal([]shared.InsertionModel{test_data.VowKissModel}))
+	})
+
+	Describe("exporter config", func() {
+		// initializers are package level vars, so a label without a definition would only fail when the plugin loads
+		It("has a definition for every configured transformer with a log note initializer", func() {
+			Expect(viper.ReadInConfig()).To(Succeed())
+			configured := make(map[string]bool)
+			for _, label := range viper.GetStringSlice("exporter.transformerNames") {
+				configured[label] = true
+				path := viper.GetString("exporter." + label + ".path")
+				source, readErr := ioutil.ReadFile(filepath.Join("..", "..", "..", path, "initializer.go"))
+				if readErr != nil || !strings.Contains(string(source), "log_note.NewEventTransformerInitializer") {
+					continue
+				}
+				Expect(log_note.Definitions).To(HaveKey(label))
+			}
+
+			for label := range log_note.Definitions {
+				Expect(configured).To(HaveKey(label))
+			}
+		})
+	})
+})
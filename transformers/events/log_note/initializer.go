@@ -0,0 +1,36 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log_note
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+)
+
+// NewEventTransformerInitializer wires the generic Converter and Repository to the Definition registered for label
+func NewEventTransformerInitializer(label, signature string) transformer.EventTransformerInitializer {
+	definition, ok := Definitions[label]
+	if !ok {
+		log.Fatalf("No log note definition registered for transformer \"%v\"", label)
+	}
+	return shared.EventTransformer{
+		Config:     shared.GetEventTransformerConfig(label, signature),
+		Converter:  Converter{Definition: definition},
+		Repository: &Repository{},
+	}.NewEventTransformer
+}
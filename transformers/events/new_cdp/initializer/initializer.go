@@ -1,5 +1,5 @@
 // VulcanizeDB
-// Copyright © 2018 Vulcanize
+// Copyright © 2019 Vulcanize
 
 // This program is free software: you can redistribute it and/or modify
 // it under the terms of the GNU Affero General Public License as published by
@@ -19,13 +19,9 @@ package initializer
 import (
 	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 
-	"github.com/vulcanize/mcd_transformers/transformers/events/new_cdp"
-	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/events/abi_event"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 )
 
-var EventTransformerInitializer transformer.EventTransformerInitializer = shared.EventTransformer{
-	Config:     shared.GetEventTransformerConfig(constants.NewCdpLabel, constants.NewCdpSignature()),
-	Converter:  new_cdp.NewCdpConverter{},
-	Repository: &new_cdp.NewCdpRepository{},
-}.NewEventTransformer
+var EventTransformerInitializer transformer.EventTransformerInitializer = abi_event.NewEventTransformerInitializer(
+	constants.NewCdpLabel, constants.NewCdpSignature())
@@ -14,22 +14,14 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_grab
+package initializer
 
 import (
-	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 
-	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/events/abi_event"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 )
 
-type VatGrabRepository struct {
-	db *postgres.DB
-}
-
-func (repository VatGrabRepository) Create(models []shared.InsertionModel) error {
-	return shared.Create(models, repository.db)
-}
-
-func (repository *VatGrabRepository) SetDB(db *postgres.DB) {
-	repository.db = db
-}
+var EventTransformerInitializer transformer.EventTransformerInitializer = abi_event.NewEventTransformerInitializer(
+	constants.ProxyCreatedLabel, constants.ProxyCreatedSignature())
@@ -17,14 +17,10 @@
 package initializer
 
 import (
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_fork"
-	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 )
 
-var EventTransformerInitializer transformer.EventTransformerInitializer = shared.EventTransformer{
-	Config:     shared.GetEventTransformerConfig(constants.VatForkLabel, constants.VatForkSignature()),
-	Converter:  &vat_fork.VatForkConverter{},
-	Repository: &vat_fork.VatForkRepository{},
-}.NewEventTransformer
+var EventTransformerInitializer transformer.EventTransformerInitializer = log_note.NewEventTransformerInitializer(
+	constants.VatForkLabel, constants.VatForkSignature())
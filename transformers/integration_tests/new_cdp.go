@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/new_cdp"
+	"github.com/vulcanize/mcd_transformers/transformers/events/abi_event"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	mcdConstants "github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -63,8 +63,8 @@ var _ = Describe("NewCdp Transformer", func() {
 
 		tr := shared.EventTransformer{
 			Config:     newCdpConfig,
-			Converter:  &new_cdp.NewCdpConverter{},
-			Repository: &new_cdp.NewCdpRepository{},
+			Converter:  abi_event.Converter{Definition: abi_event.Definitions[mcdConstants.NewCdpLabel]},
+			Repository: &abi_event.Repository{},
 		}.NewEventTransformer(db)
 
 		logFetcher := fetcher.NewLogFetcher(blockChain)
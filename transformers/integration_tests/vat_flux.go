@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_flux"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -65,8 +65,8 @@ var _ = XDescribe("VatFlux EventTransformer", func() {
 
 		initializer := shared.EventTransformer{
 			Config:     vatFluxConfig,
-			Converter:  &vat_flux.VatFluxConverter{},
-			Repository: &vat_flux.VatFluxRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatFluxLabel]},
+			Repository: &log_note.Repository{},
 		}
 		transformer := initializer.NewEventTransformer(db)
 
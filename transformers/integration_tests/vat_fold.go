@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_fold"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -73,8 +73,8 @@ var _ = Describe("VatFold Transformer", func() {
 
 		transformer := shared.EventTransformer{
 			Config:     vatFoldConfig,
-			Converter:  &vat_fold.VatFoldConverter{},
-			Repository: &vat_fold.VatFoldRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatFoldLabel]},
+			Repository: &log_note.Repository{},
 		}.NewEventTransformer(db)
 
 		err = transformer.Execute(headerSyncLogs)
@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_fork"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -64,8 +64,8 @@ var _ = XDescribe("Vat fork transformer", func() {
 
 		initializer := shared.EventTransformer{
 			Config:     vatForkConfig,
-			Converter:  &vat_fork.VatForkConverter{},
-			Repository: &vat_fork.VatForkRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatForkLabel]},
+			Repository: &log_note.Repository{},
 		}
 		tr := initializer.NewEventTransformer(db)
 
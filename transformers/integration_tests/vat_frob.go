@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_frob"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -59,8 +59,8 @@ var _ = Describe("Vat frob Transformer", func() {
 
 		initializer = shared.EventTransformer{
 			Config:     vatFrobConfig,
-			Converter:  &vat_frob.VatFrobConverter{},
-			Repository: &vat_frob.VatFrobRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatFrobLabel]},
+			Repository: &log_note.Repository{},
 		}
 	})
 
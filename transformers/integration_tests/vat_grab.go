@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_grab"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -67,8 +67,8 @@ var _ = XDescribe("Vat Grab Transformer", func() {
 
 		tr := shared.EventTransformer{
 			Config:     vatGrabConfig,
-			Converter:  &vat_grab.VatGrabConverter{},
-			Repository: &vat_grab.VatGrabRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatGrabLabel]},
+			Repository: &log_note.Repository{},
 		}.NewEventTransformer(db)
 
 		err = tr.Execute(headerSyncLogs)
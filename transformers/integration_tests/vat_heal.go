@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_heal"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -64,8 +64,8 @@ var _ = XDescribe("VatHeal Transformer", func() {
 
 		tr := shared.EventTransformer{
 			Config:     vatHealConfig,
-			Converter:  &vat_heal.VatHealConverter{},
-			Repository: &vat_heal.VatHealRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatHealLabel]},
+			Repository: &log_note.Repository{},
 		}.NewEventTransformer(db)
 
 		err = tr.Execute(headerSyncLogs)
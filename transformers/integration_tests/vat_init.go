@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_init"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -65,8 +65,8 @@ var _ = Describe("VatInit EventTransformer", func() {
 
 		transformer := shared.EventTransformer{
 			Config:     vatInitConfig,
-			Converter:  &vat_init.VatInitConverter{},
-			Repository: &vat_init.VatInitRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatInitLabel]},
+			Repository: &log_note.Repository{},
 		}.NewEventTransformer(db)
 
 		err = transformer.Execute(headerSyncLogs)
@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_move"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -64,8 +64,8 @@ var _ = Describe("VatMove EventTransformer", func() {
 
 		tr := shared.EventTransformer{
 			Config:     vatMoveConfig,
-			Converter:  &vat_move.VatMoveConverter{},
-			Repository: &vat_move.VatMoveRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatMoveLabel]},
+			Repository: &log_note.Repository{},
 		}.NewEventTransformer(db)
 
 		err = tr.Execute(headerSyncLogs)
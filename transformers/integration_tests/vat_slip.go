@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_slip"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -73,8 +73,8 @@ var _ = Describe("Vat slip transformer", func() {
 
 		tr := shared.EventTransformer{
 			Config:     vatSlipConfig,
-			Converter:  &vat_slip.VatSlipConverter{},
-			Repository: &vat_slip.VatSlipRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatSlipLabel]},
+			Repository: &log_note.Repository{},
 		}.NewEventTransformer(db)
 
 		err = tr.Execute(headerSyncLogs)
@@ -21,7 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/test_config"
-	"github.com/vulcanize/mcd_transformers/transformers/events/vat_suck"
+	"github.com/vulcanize/mcd_transformers/transformers/events/log_note"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/mcd_transformers/transformers/test_data"
@@ -64,8 +64,8 @@ var _ = Describe("VatSuck Transformer", func() {
 
 		tr := shared.EventTransformer{
 			Config:     vatSuckConfig,
-			Converter:  &vat_suck.VatSuckConverter{},
-			Repository: &vat_suck.VatSuckRepository{},
+			Converter:  log_note.Converter{Definition: log_note.Definitions[constants.VatSuckLabel]},
+			Repository: &log_note.Repository{},
 		}.NewEventTransformer(db)
 
 		err = tr.Execute(headerSyncLogs)
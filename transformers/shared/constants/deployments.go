@@ -0,0 +1,151 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package constants
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// NotRetired marks a deployment that is still live
+const NotRetired int64 = -1
+
+// ContractDeployment is one deployment of a logical contract, live from its deployed block through its retired block
+type ContractDeployment struct {
+	Address  string
+	Abi      string
+	Deployed int64
+	Retired  int64
+}
+
+func (deployment ContractDeployment) IsLiveAt(blockNumber int64) bool {
+	if blockNumber < deployment.Deployed {
+		return false
+	}
+	return deployment.Retired == NotRetired || blockNumber <= deployment.Retired
+}
+
+// Returns the current deployment of a contract followed by any previous deployments:
+//
+//	[contract.MCD_CAT]
+//		address  = "0x..."
+//		abi      = '[...]'
+//		deployed = 14764534
+//		[[contract.MCD_CAT.previous]]   <----
+//			address  = "0x..."
+//			abi      = '[...]'
+//			deployed = 14374534
+//			retired  = 14764533
+func GetContractDeployments(contractName string) []ContractDeployment {
+	initConfig()
+	deployments := []ContractDeployment{{
+		Address:  GetContractAddress(contractName),
+		Abi:      getContractABI(contractName),
+		Deployed: getDeploymentBlock(contractName),
+		Retired:  getRetiredBlock(contractName),
+	}}
+
+	var previous []ContractDeployment
+	configKey := "contract." + contractName + ".previous"
+	if err := viper.UnmarshalKey(configKey, &previous); err != nil {
+		log.Fatalf("Invalid previous deployments configured for contract \"%v\": %v", contractName, err)
+	}
+	for _, deployment := range previous {
+		if deployment.Address == "" || deployment.Abi == "" || deployment.Retired == 0 {
+			log.Fatalf("Previous deployments of contract \"%v\" need an address, abi and retired block", contractName)
+		}
+	}
+	return append(deployments, previous...)
+}
+
+type indexedDeployment struct {
+	contractName string
+	deployment   ContractDeployment
+}
+
+// every configured deployment keyed by lower cased address, built on first use since config doesn't change at runtime
+var deploymentIndex map[string]indexedDeployment
+
+func getDeploymentIndex() map[string]indexedDeployment {
+	initConfig()
+	if deploymentIndex != nil {
+		return deploymentIndex
+	}
+	deploymentIndex = make(map[string]indexedDeployment)
+	for _, contractName := range getContractNames() {
+		for _, deployment := range GetContractDeployments(contractName) {
+			// viper lower cases config keys, but contract names are configured and referenced in upper case
+			deploymentIndex[strings.ToLower(deployment.Address)] = indexedDeployment{
+				contractName: strings.ToUpper(contractName),
+				deployment:   deployment,
+			}
+		}
+	}
+	return deploymentIndex
+}
+
+// Get the deployments of any configured contract that has one of the given addresses, keyed by lower cased address
+func GetContractDeploymentsByAddress(addresses []string) map[string]ContractDeployment {
+	index := getDeploymentIndex()
+	deployments := make(map[string]ContractDeployment)
+	for _, address := range addresses {
+		address = strings.ToLower(address)
+		if indexed, ok := index[address]; ok {
+			deployments[address] = indexed.deployment
+		}
+	}
+	return deployments
+}
+
+// Get the name of the configured contract with a current or previous deployment at the given address
+func GetContractNameByAddress(address string) (string, bool) {
+	indexed, ok := getDeploymentIndex()[strings.ToLower(address)]
+	return indexed.contractName, ok
+}
+
+// Get the configured ilk of each contract, keyed by the address of every deployment of the contract
+func GetContractIlksByAddress(contractNames []string) map[common.Address]string {
+	contractIlks := make(map[common.Address]string)
+	for _, contractName := range contractNames {
+		ilk := GetContractIlk(contractName)
+		for _, deployment := range GetContractDeployments(contractName) {
+			contractIlks[common.HexToAddress(deployment.Address)] = ilk
+		}
+	}
+	return contractIlks
+}
+
+func getContractNames() []string {
+	var contractNames []string
+	for _, key := range viper.AllKeys() {
+		if strings.HasPrefix(key, "contract.") && strings.HasSuffix(key, ".address") && strings.Count(key, ".") == 2 {
+			contractNames = append(contractNames, strings.TrimSuffix(strings.TrimPrefix(key, "contract."), ".address"))
+		}
+	}
+	return contractNames
+}
+
+func getRetiredBlock(contractName string) int64 {
+	configKey := "contract." + contractName + ".retired"
+	if !viper.IsSet(configKey) {
+		return NotRetired
+	}
+	return viper.GetInt64(configKey)
+}
@@ -0,0 +1,115 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package constants_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+
+	. "github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+)
+
+var _ = Describe("Contract deployments", func() {
+	var (
+		currentAddress  = "0x1C3e3d5E4f4F9E3B7C2dA2B5C8E1f0a2b3C4d5E6"
+		previousAddress = "0xA1b2C3d4E5f60718293a4B5c6D7e8F9011223344"
+	)
+
+	BeforeEach(func() {
+		viper.Set("contract.TEST_REDEPLOYED.address", currentAddress)
+		viper.Set("contract.TEST_REDEPLOYED.abi", "[current]")
+		viper.Set("contract.TEST_REDEPLOYED.deployed", 200)
+		viper.Set("contract.TEST_REDEPLOYED.previous", []map[string]interface{}{{
+			"address":  previousAddress,
+			"abi":      "[previous]",
+			"deployed": 100,
+			"retired":  199,
+		}})
+	})
+
+	It("returns a single live deployment for a contract that hasn't been redeployed", func() {
+		deployments := GetContractDeployments("MCD_CAT")
+
+		Expect(deployments).To(Equal([]ContractDeployment{{
+			Address:  GetContractAddress("MCD_CAT"),
+			Abi:      CatABI(),
+			Deployed: GetMinDeploymentBlock([]string{"MCD_CAT"}),
+			Retired:  NotRetired,
+		}}))
+	})
+
+	It("returns the current deployment followed by previous deployments", func() {
+		deployments := GetContractDeployments("TEST_REDEPLOYED")
+
+		Expect(deployments).To(Equal([]ContractDeployment{
+			{Address: currentAddress, Abi: "[current]", Deployed: 200, Retired: NotRetired},
+			{Address: previousAddress, Abi: "[previous]", Deployed: 100, Retired: 199},
+		}))
+	})
+
+	It("includes previous deployments in contract addresses and the minimum deployment block", func() {
+		Expect(GetContractAddresses([]string{"TEST_REDEPLOYED"})).To(ConsistOf(currentAddress, previousAddress))
+		Expect(GetMinDeploymentBlock([]string{"TEST_REDEPLOYED"})).To(Equal(int64(100)))
+	})
+
+	It("looks up deployments by lower cased address", func() {
+		deployments := GetContractDeploymentsByAddress([]string{previousAddress})
+
+		Expect(len(deployments)).To(Equal(1))
+		Expect(deployments["0xa1b2c3d4e5f60718293a4b5c6d7e8f9011223344"].Retired).To(Equal(int64(199)))
+	})
+
+	It("looks up the contract name of current and previous deployments", func() {
+		currentName, currentFound := GetContractNameByAddress(currentAddress)
+		previousName, previousFound := GetContractNameByAddress(previousAddress)
+		_, unknownFound := GetContractNameByAddress("0x0000000000000000000000000000000000000001")
+
+		Expect(currentFound).To(BeTrue())
+		Expect(previousFound).To(BeTrue())
+		Expect(unknownFound).To(BeFalse())
+		Expect(currentName).To(Equal("TEST_REDEPLOYED"))
+		Expect(previousName).To(Equal("TEST_REDEPLOYED"))
+	})
+
+	It("maps every deployment of a contract to its ilk", func() {
+		viper.Set("contract.TEST_REDEPLOYED.ilk", "ETH-A")
+
+		contractIlks := GetContractIlksByAddress([]string{"TEST_REDEPLOYED"})
+
+		Expect(contractIlks).To(Equal(map[common.Address]string{
+			common.HexToAddress(currentAddress):  GetContractIlk("TEST_REDEPLOYED"),
+			common.HexToAddress(previousAddress): GetContractIlk("TEST_REDEPLOYED"),
+		}))
+	})
+
+	It("is live only from its deployed block through its retired block", func() {
+		deployment := ContractDeployment{Deployed: 100, Retired: 199}
+
+		Expect(deployment.IsLiveAt(99)).To(BeFalse())
+		Expect(deployment.IsLiveAt(100)).To(BeTrue())
+		Expect(deployment.IsLiveAt(199)).To(BeTrue())
+		Expect(deployment.IsLiveAt(200)).To(BeFalse())
+	})
+
+	It("never retires a deployment without a retired block", func() {
+		deployment := ContractDeployment{Deployed: 100, Retired: NotRetired}
+
+		Expect(deployment.IsLiveAt(1000000000)).To(BeTrue())
+	})
+})
@@ -77,6 +77,12 @@ func getOverloadedFunctionSignature(rawAbi, name string, paramTypes []string) st
 	return result
 }
 
+// Check whether an ABI declares a method with the given name and parameter types
+func HasOverloadedFunction(rawAbi, name string, paramTypes []string) bool {
+	_, err := findSignatureInAbi(rawAbi, name, paramTypes)
+	return err == nil
+}
+
 func findSignatureInAbi(rawAbi, name string, paramTypes []string) (string, error) {
 	contractMethods := make([]ContractMethod, 0)
 	err := json.Unmarshal([]byte(rawAbi), &contractMethods)
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -67,6 +69,12 @@ func GetTransformerContractNames(transformerLabel string) []string {
 	return contracts
 }
 
+// Get the names of the transformers configured under exporter.transformerNames
+func GetTransformerNames() []string {
+	initConfig()
+	return viper.GetStringSlice("exporter.transformerNames")
+}
+
 // Get the ABI for multiple contracts from config
 // Makes sure the ABI matches for all, since a single transformer may run against many contracts.
 func GetContractsABI(contractNames []string) string {
@@ -92,7 +100,7 @@ func getContractABI(contractName string) string {
 	return abi
 }
 
-// Get the minimum deployment block for multiple contracts from config
+// Get the minimum deployment block for multiple contracts from config, including previous deployments
 func GetMinDeploymentBlock(contractNames []string) int64 {
 	if len(contractNames) < 1 {
 		log.Fatalf("No contracts supplied")
@@ -100,9 +108,10 @@ func GetMinDeploymentBlock(contractNames []string) int64 {
 	initConfig()
 	minBlock := int64(math.MaxInt64)
 	for _, c := range contractNames {
-		deployed := getDeploymentBlock(c)
-		if deployed < minBlock {
-			minBlock = deployed
+		for _, deployment := range GetContractDeployments(c) {
+			if deployment.Deployed < minBlock {
+				minBlock = deployment.Deployed
+			}
 		}
 	}
 	return minBlock
@@ -118,14 +127,16 @@ func getDeploymentBlock(contractName string) int64 {
 	return value
 }
 
-// Get the addresses for multiple contracts from config
+// Get the addresses for multiple contracts from config, including previous deployments
 func GetContractAddresses(contractNames []string) (addresses []string) {
 	if len(contractNames) < 1 {
 		log.Fatalf("No contracts supplied")
 	}
 	initConfig()
 	for _, contractName := range contractNames {
-		addresses = append(addresses, GetContractAddress(contractName))
+		for _, deployment := range GetContractDeployments(contractName) {
+			addresses = append(addresses, deployment.Address)
+		}
 	}
 	return
 }
@@ -133,3 +144,15 @@ func GetContractAddresses(contractNames []string) (addresses []string) {
 func GetContractAddress(contract string) string {
 	return getEnvironmentString("contract." + contract + ".address")
 }
+
+/* Returns the hex encoded ilk for a contract that serves a single ilk:
+[contract.MEDIAN_ETH_A]
+	address  = "0x..."
+	abi      = '[...]'
+	deployed = 14374540
+	ilk      = "ETH-A"   <----
+*/
+func GetContractIlk(contract string) string {
+	ilk := getEnvironmentString("contract." + contract + ".ilk")
+	return hexutil.Encode(common.RightPadBytes([]byte(ilk), 32))
+}
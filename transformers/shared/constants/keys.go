@@ -27,8 +27,13 @@ const (
 	Guy       utils.Key = "guy"
 	Flip      utils.Key = "flip"
 	Timestamp utils.Key = "timestamp"
+	Bit       utils.Key = "bit"
 	Cdpi      utils.Key = "cdpi"
 	Owner     utils.Key = "owner"
+	Spender   utils.Key = "spender"
+	Usr       utils.Key = "usr"
+	MsgSender utils.Key = "msg_sender"
+	Candidate utils.Key = "candidate"
 )
 
 // TODO remove after transition to ColumnName
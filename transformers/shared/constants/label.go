@@ -17,40 +17,98 @@
 package constants
 
 const (
-	BiteLabel               = "bite"
-	CatFileChopLumpLabel    = "cat_file_chop_lump"
-	CatFileFlipLabel        = "cat_file_flip"
-	CatFileVowLabel         = "cat_file_vow"
-	DealLabel               = "deal"
-	DentLabel               = "dent"
-	FlapKickLabel           = "flap_kick"
-	FlipKickLabel           = "flip_kick"
-	FlopKickLabel           = "flop_kick"
-	JugDripLabel            = "jug_drip"
-	JugFileBaseLabel        = "jug_file_base"
-	JugFileIlkLabel         = "jug_file_ilk"
-	JugFileVowLabel         = "jug_file_vow"
-	JugInitLabel            = "jug_init"
-	NewCdpLabel             = "new_cdp"
-	SpotFileMatLabel        = "spot_file_mat"
-	SpotFilePipLabel        = "spot_file_pip"
-	SpotPokeLabel           = "spot_poke"
-	TendLabel               = "tend"
-	TickLabel               = "tick"
-	VatFileDebtCeilingLabel = "vat_file_debt_ceiling"
-	VatFileIlkLabel         = "vat_file_ilk"
-	VatFluxLabel            = "vat_flux"
-	VatFoldLabel            = "vat_fold"
-	VatForkLabel            = "vat_fork"
-	VatFrobLabel            = "vat_frob"
-	VatGrabLabel            = "vat_grab"
-	VatHealLabel            = "vat_heal"
-	VatInitLabel            = "vat_init"
-	VatMoveLabel            = "vat_move"
-	VatSlipLabel            = "vat_slip"
-	VatSuckLabel            = "vat_suck"
-	VowFessLabel            = "vow_fess"
-	VowFileLabel            = "vow_file"
-	VowFlogLabel            = "vow_flog"
-	YankLabel               = "yank"
+	AuctionFileLabel           = "auction_file"
+	BiteLabel                  = "bite"
+	CageLabel                  = "cage"
+	CatFileChopLumpLabel       = "cat_file_chop_lump"
+	CatFileFlipLabel           = "cat_file_flip"
+	CatFileVowLabel            = "cat_file_vow"
+	CdpManagerCdpAllowLabel    = "cdp_manager_cdp_allow"
+	CdpManagerEnterLabel       = "cdp_manager_enter"
+	CdpManagerFluxLabel        = "cdp_manager_flux"
+	CdpManagerFrobLabel        = "cdp_manager_frob"
+	CdpManagerGiveLabel        = "cdp_manager_give"
+	CdpManagerMoveLabel        = "cdp_manager_move"
+	CdpManagerQuitLabel        = "cdp_manager_quit"
+	CdpManagerShiftLabel       = "cdp_manager_shift"
+	CdpManagerUrnAllowLabel    = "cdp_manager_urn_allow"
+	ChiefEtchLabel             = "chief_etch"
+	ChiefFreeLabel             = "chief_free"
+	ChiefLiftLabel             = "chief_lift"
+	ChiefLockLabel             = "chief_lock"
+	ChiefVoteLabel             = "chief_vote"
+	ChiefVoteYaysLabel         = "chief_vote_yays"
+	DaiApprovalLabel           = "dai_approval"
+	DaiExitLabel               = "dai_exit"
+	DaiJoinLabel               = "dai_join"
+	DaiTransferLabel           = "dai_transfer"
+	DealLabel                  = "deal"
+	DentLabel                  = "dent"
+	DenyLabel                  = "deny"
+	EndCageLabel               = "end_cage"
+	EndCageIlkLabel            = "end_cage_ilk"
+	EndCashLabel               = "end_cash"
+	EndFlowLabel               = "end_flow"
+	EndFreeLabel               = "end_free"
+	EndPackLabel               = "end_pack"
+	EndSkimLabel               = "end_skim"
+	EndThawLabel               = "end_thaw"
+	FlapCageLabel              = "flap_cage"
+	EsmFireLabel               = "esm_fire"
+	EsmJoinLabel               = "esm_join"
+	FlapKickLabel              = "flap_kick"
+	FlipKickLabel              = "flip_kick"
+	FlopKickLabel              = "flop_kick"
+	GenericFileAddressLabel    = "generic_file_address"
+	GenericFileIlkAddressLabel = "generic_file_ilk_address"
+	GenericFileIlkUintLabel    = "generic_file_ilk_uint"
+	GenericFileUintLabel       = "generic_file_uint"
+	GemExitLabel               = "gem_exit"
+	GemJoinLabel               = "gem_join"
+	JugDripLabel               = "jug_drip"
+	JugFileBaseLabel           = "jug_file_base"
+	JugFileIlkLabel            = "jug_file_ilk"
+	JugFileVowLabel            = "jug_file_vow"
+	JugInitLabel               = "jug_init"
+	LogMedianPriceLabel        = "log_median_price"
+	LogValueLabel              = "log_value"
+	NewCdpLabel                = "new_cdp"
+	PauseDropLabel             = "pause_drop"
+	PauseExecLabel             = "pause_exec"
+	PausePlotLabel             = "pause_plot"
+	PotDripLabel               = "pot_drip"
+	PotExitLabel               = "pot_exit"
+	PotFileDSRLabel            = "pot_file_dsr"
+	PotFileVowLabel            = "pot_file_vow"
+	PotJoinLabel               = "pot_join"
+	ProxyCreatedLabel          = "proxy_created"
+	RelyLabel                  = "rely"
+	SpotFileMatLabel           = "spot_file_mat"
+	SpotFileParLabel           = "spot_file_par"
+	SpotFilePipLabel           = "spot_file_pip"
+	SpotPokeLabel              = "spot_poke"
+	TendLabel                  = "tend"
+	TickLabel                  = "tick"
+	VatFileDebtCeilingLabel    = "vat_file_debt_ceiling"
+	VatFileIlkLabel            = "vat_file_ilk"
+	VatFluxLabel               = "vat_flux"
+	VatFoldLabel               = "vat_fold"
+	VatForkLabel               = "vat_fork"
+	VatFrobLabel               = "vat_frob"
+	VatGrabLabel               = "vat_grab"
+	VatHealLabel               = "vat_heal"
+	VatHopeLabel               = "vat_hope"
+	VatInitLabel               = "vat_init"
+	VatMoveLabel               = "vat_move"
+	VatNopeLabel               = "vat_nope"
+	VatSlipLabel               = "vat_slip"
+	VatSuckLabel               = "vat_suck"
+	VowFessLabel               = "vow_fess"
+	VowFileLabel               = "vow_file"
+	VowFlapLabel               = "vow_flap"
+	VowFlogLabel               = "vow_flog"
+	VowFlopLabel               = "vow_flop"
+	VowHealLabel               = "vow_heal"
+	VowKissLabel               = "vow_kiss"
+	YankLabel                  = "yank"
 )
@@ -19,6 +19,11 @@ package constants
 // TODO Figure out signatures automatically from config somehow :(
 func CatABI() string        { return getContractABI("MCD_CAT") }
 func CdpManagerABI() string { return getContractABI("CDP_MANAGER") }
+func ChiefABI() string      { return getContractABI("MCD_ADM") }
+func DaiABI() string        { return getContractABI("MCD_DAI") }
+func DaiJoinABI() string    { return getContractABI("MCD_JOIN_DAI") }
+func EndABI() string        { return getContractABI("MCD_END") }
+func EsmABI() string        { return getContractABI("MCD_ESM") }
 func FlapABI() string       { return getContractABI("MCD_FLAP") }
 func FlipABI() string {
 	return GetContractsABI([]string{
@@ -27,12 +32,28 @@ func FlipABI() string {
 	})
 }
 func FlopABI() string { return getContractABI("MCD_FLOP") }
-func JugABI() string  { return getContractABI("MCD_JUG") }
-func SpotABI() string { return getContractABI("MCD_SPOT") }
-func VatABI() string  { return getContractABI("MCD_VAT") }
-func VowABI() string  { return getContractABI("MCD_VOW") }
+func GemJoinABI() string {
+	return GetContractsABI([]string{"MCD_JOIN_ETH_A", "MCD_JOIN_BAT_A"})
+}
+func JugABI() string { return getContractABI("MCD_JUG") }
+func MedianABI() string {
+	return GetContractsABI([]string{"MEDIAN_ETH_A", "MEDIAN_BAT_A"})
+}
+func OsmABI() string          { return getContractABI("PIP_ETH") }
+func PauseABI() string        { return getContractABI("MCD_PAUSE") }
+func PotABI() string          { return getContractABI("MCD_POT") }
+func ProxyFactoryABI() string { return getContractABI("PROXY_FACTORY") }
+func SpotABI() string         { return getContractABI("MCD_SPOT") }
+func VatABI() string          { return getContractABI("MCD_VAT") }
+func VowABI() string          { return getContractABI("MCD_VOW") }
 
+func auctionFileMethod() string {
+	return getSolidityFunctionSignature(FlipABI(), "file")
+}
 func biteMethod() string { return getSolidityFunctionSignature(CatABI(), "Bite") }
+func cageMethod() string {
+	return getOverloadedFunctionSignature(VatABI(), "cage", []string{})
+}
 func catFileChopLumpMethod() string {
 	return getOverloadedFunctionSignature(CatABI(), "file", []string{"bytes32", "bytes32", "uint256"})
 }
@@ -42,12 +63,87 @@ func catFileFlipMethod() string {
 func catFileVowMethod() string {
 	return getOverloadedFunctionSignature(CatABI(), "file", []string{"bytes32", "address"})
 }
-func dealMethod() string     { return getSolidityFunctionSignature(FlipABI(), "deal") }
-func dentMethod() string     { return getSolidityFunctionSignature(FlipABI(), "dent") }
+func cdpManagerCdpAllowMethod() string {
+	return getSolidityFunctionSignature(CdpManagerABI(), "cdpAllow")
+}
+func cdpManagerEnterMethod() string {
+	return getSolidityFunctionSignature(CdpManagerABI(), "enter")
+}
+func cdpManagerFluxMethod() string {
+	return getOverloadedFunctionSignature(CdpManagerABI(), "flux", []string{"uint256", "address", "uint256"})
+}
+func cdpManagerFrobMethod() string {
+	return getOverloadedFunctionSignature(CdpManagerABI(), "frob", []string{"uint256", "int256", "int256"})
+}
+func cdpManagerGiveMethod() string {
+	return getSolidityFunctionSignature(CdpManagerABI(), "give")
+}
+func cdpManagerMoveMethod() string {
+	return getSolidityFunctionSignature(CdpManagerABI(), "move")
+}
+func cdpManagerQuitMethod() string {
+	return getSolidityFunctionSignature(CdpManagerABI(), "quit")
+}
+func cdpManagerShiftMethod() string {
+	return getSolidityFunctionSignature(CdpManagerABI(), "shift")
+}
+func cdpManagerUrnAllowMethod() string {
+	return getSolidityFunctionSignature(CdpManagerABI(), "urnAllow")
+}
+func chiefEtchMethod() string { return getSolidityFunctionSignature(ChiefABI(), "etch") }
+func chiefFreeMethod() string { return getSolidityFunctionSignature(ChiefABI(), "free") }
+func chiefLiftMethod() string { return getSolidityFunctionSignature(ChiefABI(), "lift") }
+func chiefLockMethod() string { return getSolidityFunctionSignature(ChiefABI(), "lock") }
+func chiefVoteMethod() string {
+	return getOverloadedFunctionSignature(ChiefABI(), "vote", []string{"bytes32"})
+}
+func chiefVoteYaysMethod() string {
+	return getOverloadedFunctionSignature(ChiefABI(), "vote", []string{"address[]"})
+}
+func daiApprovalMethod() string {
+	return getSolidityFunctionSignature(DaiABI(), "Approval")
+}
+func daiExitMethod() string {
+	return getSolidityFunctionSignature(DaiJoinABI(), "exit")
+}
+func daiJoinMethod() string {
+	return getSolidityFunctionSignature(DaiJoinABI(), "join")
+}
+func daiTransferMethod() string {
+	return getSolidityFunctionSignature(DaiABI(), "Transfer")
+}
+func dealMethod() string { return getSolidityFunctionSignature(FlipABI(), "deal") }
+func dentMethod() string { return getSolidityFunctionSignature(FlipABI(), "dent") }
+func denyMethod() string { return getSolidityFunctionSignature(VatABI(), "deny") }
+func endCageMethod() string {
+	return getOverloadedFunctionSignature(EndABI(), "cage", []string{})
+}
+func endCageIlkMethod() string {
+	return getOverloadedFunctionSignature(EndABI(), "cage", []string{"bytes32"})
+}
+func endCashMethod() string { return getSolidityFunctionSignature(EndABI(), "cash") }
+func endFlowMethod() string { return getSolidityFunctionSignature(EndABI(), "flow") }
+func endFreeMethod() string { return getSolidityFunctionSignature(EndABI(), "free") }
+func endPackMethod() string { return getSolidityFunctionSignature(EndABI(), "pack") }
+func endSkimMethod() string { return getSolidityFunctionSignature(EndABI(), "skim") }
+func endThawMethod() string { return getSolidityFunctionSignature(EndABI(), "thaw") }
+func esmFireMethod() string { return getSolidityFunctionSignature(EsmABI(), "fire") }
+func esmJoinMethod() string { return getSolidityFunctionSignature(EsmABI(), "join") }
+func flapCageMethod() string {
+	return getOverloadedFunctionSignature(FlapABI(), "cage", []string{"uint256"})
+}
 func flapKickMethod() string { return getSolidityFunctionSignature(FlapABI(), "Kick") }
 func flipKickMethod() string { return getSolidityFunctionSignature(FlipABI(), "Kick") }
 func flopKickMethod() string { return getSolidityFunctionSignature(FlopABI(), "Kick") }
-func jugDripMethod() string  { return getSolidityFunctionSignature(JugABI(), "drip") }
+
+// Generic file methods are matched on argument types alone, so they don't depend on any one contract's ABI
+func genericFileAddressMethod() string    { return "file(bytes32,address)" }
+func genericFileIlkAddressMethod() string { return "file(bytes32,bytes32,address)" }
+func genericFileIlkUintMethod() string    { return "file(bytes32,bytes32,uint256)" }
+func genericFileUintMethod() string       { return "file(bytes32,uint256)" }
+func gemExitMethod() string               { return getSolidityFunctionSignature(GemJoinABI(), "exit") }
+func gemJoinMethod() string               { return getSolidityFunctionSignature(GemJoinABI(), "join") }
+func jugDripMethod() string               { return getSolidityFunctionSignature(JugABI(), "drip") }
 func jugFileBaseMethod() string {
 	return getOverloadedFunctionSignature(JugABI(), "file", []string{"bytes32", "uint256"})
 }
@@ -58,10 +154,35 @@ func jugFileVowMethod() string {
 	return getOverloadedFunctionSignature(JugABI(), "file", []string{"bytes32", "address"})
 }
 func jugInitMethod() string { return getSolidityFunctionSignature(JugABI(), "init") }
-func newCdpMethod() string  { return getSolidityFunctionSignature(CdpManagerABI(), "NewCdp") }
+func logMedianPriceMethod() string {
+	return getSolidityFunctionSignature(MedianABI(), "LogMedianPrice")
+}
+func logValueMethod() string {
+	return getSolidityFunctionSignature(OsmABI(), "LogValue")
+}
+func newCdpMethod() string    { return getSolidityFunctionSignature(CdpManagerABI(), "NewCdp") }
+func pauseDropMethod() string { return getSolidityFunctionSignature(PauseABI(), "drop") }
+func pauseExecMethod() string { return getSolidityFunctionSignature(PauseABI(), "exec") }
+func pausePlotMethod() string { return getSolidityFunctionSignature(PauseABI(), "plot") }
+func potDripMethod() string   { return getSolidityFunctionSignature(PotABI(), "drip") }
+func potExitMethod() string   { return getSolidityFunctionSignature(PotABI(), "exit") }
+func potFileDSRMethod() string {
+	return getOverloadedFunctionSignature(PotABI(), "file", []string{"bytes32", "uint256"})
+}
+func potFileVowMethod() string {
+	return getOverloadedFunctionSignature(PotABI(), "file", []string{"bytes32", "address"})
+}
+func potJoinMethod() string { return getSolidityFunctionSignature(PotABI(), "join") }
+func proxyCreatedMethod() string {
+	return getSolidityFunctionSignature(ProxyFactoryABI(), "Created")
+}
+func relyMethod() string { return getSolidityFunctionSignature(VatABI(), "rely") }
 func spotFileMatMethod() string {
 	return getOverloadedFunctionSignature(SpotABI(), "file", []string{"bytes32", "bytes32", "uint256"})
 }
+func spotFileParMethod() string {
+	return getOverloadedFunctionSignature(SpotABI(), "file", []string{"bytes32", "uint256"})
+}
 func spotFilePipMethod() string {
 	return getOverloadedFunctionSignature(SpotABI(), "file", []string{"bytes32", "bytes32", "address"})
 }
@@ -80,11 +201,17 @@ func vatForkMethod() string { return getSolidityFunctionSignature(VatABI(), "for
 func vatFrobMethod() string { return getSolidityFunctionSignature(VatABI(), "frob") }
 func vatGrabMethod() string { return getSolidityFunctionSignature(VatABI(), "grab") }
 func vatHealMethod() string { return getSolidityFunctionSignature(VatABI(), "heal") }
+func vatHopeMethod() string { return getSolidityFunctionSignature(VatABI(), "hope") }
 func vatInitMethod() string { return getSolidityFunctionSignature(VatABI(), "init") }
 func vatMoveMethod() string { return getSolidityFunctionSignature(VatABI(), "move") }
+func vatNopeMethod() string { return getSolidityFunctionSignature(VatABI(), "nope") }
 func vatSlipMethod() string { return getSolidityFunctionSignature(VatABI(), "slip") }
 func vatSuckMethod() string { return getSolidityFunctionSignature(VatABI(), "suck") }
 func vowFessMethod() string { return getSolidityFunctionSignature(VowABI(), "fess") }
 func vowFileMethod() string { return getSolidityFunctionSignature(VowABI(), "file") }
+func vowFlapMethod() string { return getSolidityFunctionSignature(VowABI(), "flap") }
 func vowFlogMethod() string { return getSolidityFunctionSignature(VowABI(), "flog") }
+func vowFlopMethod() string { return getSolidityFunctionSignature(VowABI(), "flop") }
+func vowHealMethod() string { return getSolidityFunctionSignature(VowABI(), "heal") }
+func vowKissMethod() string { return getSolidityFunctionSignature(VowABI(), "kiss") }
 func yankMethod() string    { return getSolidityFunctionSignature(FlipABI(), "yank") }
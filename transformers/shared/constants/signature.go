@@ -16,22 +16,76 @@
 
 package constants
 
+func AuctionFileSignature() string        { return getLogNoteTopicZero(auctionFileMethod()) }
 func BiteSignature() string               { return getEventTopicZero(biteMethod()) }
+func CageSignature() string               { return getLogNoteTopicZero(cageMethod()) }
 func CatFileChopLumpSignature() string    { return getLogNoteTopicZero(catFileChopLumpMethod()) }
 func CatFileFlipSignature() string        { return getLogNoteTopicZero(catFileFlipMethod()) }
 func CatFileVowSignature() string         { return getLogNoteTopicZero(catFileVowMethod()) }
+func CdpManagerCdpAllowSignature() string { return getLogNoteTopicZero(cdpManagerCdpAllowMethod()) }
+func CdpManagerEnterSignature() string    { return getLogNoteTopicZero(cdpManagerEnterMethod()) }
+func CdpManagerFluxSignature() string     { return getLogNoteTopicZero(cdpManagerFluxMethod()) }
+func CdpManagerFrobSignature() string     { return getLogNoteTopicZero(cdpManagerFrobMethod()) }
+func CdpManagerGiveSignature() string     { return getLogNoteTopicZero(cdpManagerGiveMethod()) }
+func CdpManagerMoveSignature() string     { return getLogNoteTopicZero(cdpManagerMoveMethod()) }
+func CdpManagerQuitSignature() string     { return getLogNoteTopicZero(cdpManagerQuitMethod()) }
+func CdpManagerShiftSignature() string    { return getLogNoteTopicZero(cdpManagerShiftMethod()) }
+func CdpManagerUrnAllowSignature() string { return getLogNoteTopicZero(cdpManagerUrnAllowMethod()) }
+func ChiefEtchSignature() string          { return getLogNoteTopicZero(chiefEtchMethod()) }
+func ChiefFreeSignature() string          { return getLogNoteTopicZero(chiefFreeMethod()) }
+func ChiefLiftSignature() string          { return getLogNoteTopicZero(chiefLiftMethod()) }
+func ChiefLockSignature() string          { return getLogNoteTopicZero(chiefLockMethod()) }
+func ChiefVoteSignature() string          { return getLogNoteTopicZero(chiefVoteMethod()) }
+func ChiefVoteYaysSignature() string      { return getLogNoteTopicZero(chiefVoteYaysMethod()) }
+func DaiApprovalSignature() string        { return getEventTopicZero(daiApprovalMethod()) }
+func DaiExitSignature() string            { return getLogNoteTopicZero(daiExitMethod()) }
+func DaiJoinSignature() string            { return getLogNoteTopicZero(daiJoinMethod()) }
+func DaiTransferSignature() string        { return getEventTopicZero(daiTransferMethod()) }
 func DealSignature() string               { return getLogNoteTopicZero(dealMethod()) }
 func DentSignature() string               { return getLogNoteTopicZero(dentMethod()) }
+func DenySignature() string               { return getLogNoteTopicZero(denyMethod()) }
+func EndCageSignature() string            { return getLogNoteTopicZero(endCageMethod()) }
+func EndCageIlkSignature() string         { return getLogNoteTopicZero(endCageIlkMethod()) }
+func EndCashSignature() string            { return getLogNoteTopicZero(endCashMethod()) }
+func EndFlowSignature() string            { return getLogNoteTopicZero(endFlowMethod()) }
+func EndFreeSignature() string            { return getLogNoteTopicZero(endFreeMethod()) }
+func EndPackSignature() string            { return getLogNoteTopicZero(endPackMethod()) }
+func EndSkimSignature() string            { return getLogNoteTopicZero(endSkimMethod()) }
+func EndThawSignature() string            { return getLogNoteTopicZero(endThawMethod()) }
+func EsmFireSignature() string            { return getLogNoteTopicZero(esmFireMethod()) }
+func EsmJoinSignature() string            { return getLogNoteTopicZero(esmJoinMethod()) }
+func FlapCageSignature() string           { return getLogNoteTopicZero(flapCageMethod()) }
 func FlapKickSignature() string           { return getEventTopicZero(flapKickMethod()) }
 func FlipKickSignature() string           { return getEventTopicZero(flipKickMethod()) }
 func FlopKickSignature() string           { return getEventTopicZero(flopKickMethod()) }
+func GenericFileAddressSignature() string { return getLogNoteTopicZero(genericFileAddressMethod()) }
+func GenericFileIlkAddressSignature() string {
+	return getLogNoteTopicZero(genericFileIlkAddressMethod())
+}
+func GenericFileIlkUintSignature() string { return getLogNoteTopicZero(genericFileIlkUintMethod()) }
+func GenericFileUintSignature() string    { return getLogNoteTopicZero(genericFileUintMethod()) }
+func GemExitSignature() string            { return getLogNoteTopicZero(gemExitMethod()) }
+func GemJoinSignature() string            { return getLogNoteTopicZero(gemJoinMethod()) }
 func JugDripSignature() string            { return getLogNoteTopicZero(jugDripMethod()) }
 func JugFileBaseSignature() string        { return getLogNoteTopicZero(jugFileBaseMethod()) }
 func JugFileIlkSignature() string         { return getLogNoteTopicZero(jugFileIlkMethod()) }
 func JugFileVowSignature() string         { return getLogNoteTopicZero(jugFileVowMethod()) }
 func JugInitSignature() string            { return getLogNoteTopicZero(jugInitMethod()) }
+func LogMedianPriceSignature() string     { return getEventTopicZero(logMedianPriceMethod()) }
+func LogValueSignature() string           { return getEventTopicZero(logValueMethod()) }
 func NewCdpSignature() string             { return getEventTopicZero(newCdpMethod()) }
+func PauseDropSignature() string          { return getLogNoteTopicZero(pauseDropMethod()) }
+func PauseExecSignature() string          { return getLogNoteTopicZero(pauseExecMethod()) }
+func PausePlotSignature() string          { return getLogNoteTopicZero(pausePlotMethod()) }
+func PotDripSignature() string            { return getLogNoteTopicZero(potDripMethod()) }
+func PotExitSignature() string            { return getLogNoteTopicZero(potExitMethod()) }
+func PotFileDSRSignature() string         { return getLogNoteTopicZero(potFileDSRMethod()) }
+func PotFileVowSignature() string         { return getLogNoteTopicZero(potFileVowMethod()) }
+func PotJoinSignature() string            { return getLogNoteTopicZero(potJoinMethod()) }
+func ProxyCreatedSignature() string       { return getEventTopicZero(proxyCreatedMethod()) }
+func RelySignature() string               { return getLogNoteTopicZero(relyMethod()) }
 func SpotFileMatSignature() string        { return getLogNoteTopicZero(spotFileMatMethod()) }
+func SpotFileParSignature() string        { return getLogNoteTopicZero(spotFileParMethod()) }
 func SpotFilePipSignature() string        { return getLogNoteTopicZero(spotFilePipMethod()) }
 func SpotPokeSignature() string           { return getEventTopicZero(spotPokeMethod()) }
 func TendSignature() string               { return getLogNoteTopicZero(tendMethod()) }
@@ -44,11 +98,17 @@ func VatForkSignature() string            { return getLogNoteTopicZero(vatForkMe
 func VatFrobSignature() string            { return getLogNoteTopicZero(vatFrobMethod()) }
 func VatGrabSignature() string            { return getLogNoteTopicZero(vatGrabMethod()) }
 func VatHealSignature() string            { return getLogNoteTopicZero(vatHealMethod()) }
+func VatHopeSignature() string            { return getLogNoteTopicZero(vatHopeMethod()) }
 func VatInitSignature() string            { return getLogNoteTopicZero(vatInitMethod()) }
 func VatMoveSignature() string            { return getLogNoteTopicZero(vatMoveMethod()) }
+func VatNopeSignature() string            { return getLogNoteTopicZero(vatNopeMethod()) }
 func VatSlipSignature() string            { return getLogNoteTopicZero(vatSlipMethod()) }
 func VatSuckSignature() string            { return getLogNoteTopicZero(vatSuckMethod()) }
 func VowFessSignature() string            { return getLogNoteTopicZero(vowFessMethod()) }
 func VowFileSignature() string            { return getLogNoteTopicZero(vowFileMethod()) }
+func VowFlapSignature() string            { return getLogNoteTopicZero(vowFlapMethod()) }
 func VowFlogSignature() string            { return getLogNoteTopicZero(vowFlogMethod()) }
+func VowFlopSignature() string            { return getLogNoteTopicZero(vowFlopMethod()) }
+func VowHealSignature() string            { return getLogNoteTopicZero(vowHealMethod()) }
+func VowKissSignature() string            { return getLogNoteTopicZero(vowKissMethod()) }
 func YankSignature() string               { return getLogNoteTopicZero(yankMethod()) }
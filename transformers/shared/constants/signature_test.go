@@ -22,10 +22,18 @@ import (
 )
 
 var _ = Describe("Signature constants", func() {
+	It("generates auction file signature", func() {
+		Expect(AuctionFileSignature()).To(Equal("0x29ae811400000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates bite signature", func() {
 		Expect(BiteSignature()).To(Equal("0xa716da86bc1fb6d43d1493373f34d7a418b619681cd7b90f7ea667ba1489be28"))
 	})
 
+	It("generates cage signature", func() {
+		Expect(CageSignature()).To(Equal("0x6924500900000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates cat file chop lump signature", func() {
 		Expect(CatFileChopLumpSignature()).To(Equal("0x1a0b287e00000000000000000000000000000000000000000000000000000000"))
 	})
@@ -38,6 +46,82 @@ var _ = Describe("Signature constants", func() {
 		Expect(CatFileVowSignature()).To(Equal("0xd4e8be8300000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates cdp manager cdp allow signature", func() {
+		Expect(CdpManagerCdpAllowSignature()).To(Equal("0x0b63fb6200000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager enter signature", func() {
+		Expect(CdpManagerEnterSignature()).To(Equal("0x7e348b7d00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager flux signature", func() {
+		Expect(CdpManagerFluxSignature()).To(Equal("0x9bb8f83800000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager frob signature", func() {
+		Expect(CdpManagerFrobSignature()).To(Equal("0x45e6bdcd00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager give signature", func() {
+		Expect(CdpManagerGiveSignature()).To(Equal("0xfcafcc6800000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager move signature", func() {
+		Expect(CdpManagerMoveSignature()).To(Equal("0xf9f30db600000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager quit signature", func() {
+		Expect(CdpManagerQuitSignature()).To(Equal("0x1b0dbf7200000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager shift signature", func() {
+		Expect(CdpManagerShiftSignature()).To(Equal("0xe50322a200000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates cdp manager urn allow signature", func() {
+		Expect(CdpManagerUrnAllowSignature()).To(Equal("0xb68f400400000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates chief etch signature", func() {
+		Expect(ChiefEtchSignature()).To(Equal("0x5123e1fa00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates chief free signature", func() {
+		Expect(ChiefFreeSignature()).To(Equal("0xd8ccd0f300000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates chief lift signature", func() {
+		Expect(ChiefLiftSignature()).To(Equal("0x3c278bd500000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates chief lock signature", func() {
+		Expect(ChiefLockSignature()).To(Equal("0xdd46706400000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates chief vote slate signature", func() {
+		Expect(ChiefVoteSignature()).To(Equal("0xa69beaba00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates chief vote yays signature", func() {
+		Expect(ChiefVoteYaysSignature()).To(Equal("0xed08132900000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates dai approval signature", func() {
+		Expect(DaiApprovalSignature()).To(Equal("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"))
+	})
+
+	It("generates dai exit signature", func() {
+		Expect(DaiExitSignature()).To(Equal("0xef693bed00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates dai join signature", func() {
+		Expect(DaiJoinSignature()).To(Equal("0x3b4da69f00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates dai transfer signature", func() {
+		Expect(DaiTransferSignature()).To(Equal("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"))
+	})
+
 	It("generates deal signature", func() {
 		Expect(DealSignature()).To(Equal("0xc959c42b00000000000000000000000000000000000000000000000000000000"))
 	})
@@ -46,6 +130,54 @@ var _ = Describe("Signature constants", func() {
 		Expect(DentSignature()).To(Equal("0x5ff3a38200000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates deny signature", func() {
+		Expect(DenySignature()).To(Equal("0x9c52a7f100000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end cage signature", func() {
+		Expect(EndCageSignature()).To(Equal("0x6924500900000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end cage ilk signature", func() {
+		Expect(EndCageIlkSignature()).To(Equal("0xe2702fdc00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end cash signature", func() {
+		Expect(EndCashSignature()).To(Equal("0xfe8507c600000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end flow signature", func() {
+		Expect(EndFlowSignature()).To(Equal("0x4a10eaa600000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end free signature", func() {
+		Expect(EndFreeSignature()).To(Equal("0xc83062c600000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end pack signature", func() {
+		Expect(EndPackSignature()).To(Equal("0x6ea4255500000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end skim signature", func() {
+		Expect(EndSkimSignature()).To(Equal("0x89ea45d300000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates end thaw signature", func() {
+		Expect(EndThawSignature()).To(Equal("0x5920375c00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates esm fire signature", func() {
+		Expect(EsmFireSignature()).To(Equal("0x457094cc00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates esm join signature", func() {
+		Expect(EsmJoinSignature()).To(Equal("0x049878f300000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates flap cage signature", func() {
+		Expect(FlapCageSignature()).To(Equal("0xa2f91af200000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates flap kick signature", func() {
 		Expect(FlapKickSignature()).To(Equal("0xe6dde59cbc017becba89714a037778d234a84ce7f0a137487142a007e580d609"))
 	})
@@ -62,6 +194,30 @@ var _ = Describe("Signature constants", func() {
 		Expect(FlopKickSignature()).To(Equal("0x7e8881001566f9f89aedb9c5dc3d856a2b81e5235a8196413ed484be91cc0df6"))
 	})
 
+	It("generates generic file address signature", func() {
+		Expect(GenericFileAddressSignature()).To(Equal("0xd4e8be8300000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates generic file ilk address signature", func() {
+		Expect(GenericFileIlkAddressSignature()).To(Equal("0xebecb39d00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates generic file ilk uint signature", func() {
+		Expect(GenericFileIlkUintSignature()).To(Equal("0x1a0b287e00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates generic file uint signature", func() {
+		Expect(GenericFileUintSignature()).To(Equal("0x29ae811400000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates gem exit signature", func() {
+		Expect(GemExitSignature()).To(Equal("0xef693bed00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates gem join signature", func() {
+		Expect(GemJoinSignature()).To(Equal("0x3b4da69f00000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates jug drip signature", func() {
 		Expect(JugDripSignature()).To(Equal("0x44e2a5a800000000000000000000000000000000000000000000000000000000"))
 	})
@@ -82,14 +238,66 @@ var _ = Describe("Signature constants", func() {
 		Expect(JugInitSignature()).To(Equal("0x3b66319500000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates log median price signature", func() {
+		Expect(LogMedianPriceSignature()).To(Equal("0xb78ebc573f1f889ca9e1e0fb62c843c836f3d3a2e1f43ef62940e9b894f4ea4c"))
+	})
+
+	It("generates log value signature", func() {
+		Expect(LogValueSignature()).To(Equal("0x296ba4ca62c6c21c95e828080cb8aec7481b71390585605300a8a76f9e95b527"))
+	})
+
 	It("generates cdp manager new cdp signature", func() {
 		Expect(NewCdpSignature()).To(Equal("0xd6be0bc178658a382ff4f91c8c68b542aa6b71685b8fe427966b87745c3ea7a2"))
 	})
 
+	It("generates pause drop signature", func() {
+		Expect(PauseDropSignature()).To(Equal("0x162c7de300000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates pause exec signature", func() {
+		Expect(PauseExecSignature()).To(Equal("0x168ccd6700000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates pause plot signature", func() {
+		Expect(PausePlotSignature()).To(Equal("0x46d2fbbb00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates pot drip signature", func() {
+		Expect(PotDripSignature()).To(Equal("0x9f678cca00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates pot exit signature", func() {
+		Expect(PotExitSignature()).To(Equal("0x7f8661a100000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates pot file dsr signature", func() {
+		Expect(PotFileDSRSignature()).To(Equal("0x29ae811400000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates pot file vow signature", func() {
+		Expect(PotFileVowSignature()).To(Equal("0xd4e8be8300000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates pot join signature", func() {
+		Expect(PotJoinSignature()).To(Equal("0x049878f300000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates proxy factory created signature", func() {
+		Expect(ProxyCreatedSignature()).To(Equal("0x259b30ca39885c6d801a0b5dbc988640f3c25e2f37531fe138c5c5af8955d41b"))
+	})
+
+	It("generates rely signature", func() {
+		Expect(RelySignature()).To(Equal("0x65fae35e00000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates spot file mat signature", func() {
 		Expect(SpotFileMatSignature()).To(Equal("0x1a0b287e00000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates spot file par signature", func() {
+		Expect(SpotFileParSignature()).To(Equal("0x29ae811400000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates spot file pip signature", func() {
 		Expect(SpotFilePipSignature()).To(Equal("0xebecb39d00000000000000000000000000000000000000000000000000000000"))
 	})
@@ -134,6 +342,10 @@ var _ = Describe("Signature constants", func() {
 		Expect(VatHealSignature()).To(Equal("0xf37ac61c00000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates vat hope signature", func() {
+		Expect(VatHopeSignature()).To(Equal("0xa3b22fc400000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates vat init signature", func() {
 		Expect(VatInitSignature()).To(Equal("0x3b66319500000000000000000000000000000000000000000000000000000000"))
 	})
@@ -142,6 +354,10 @@ var _ = Describe("Signature constants", func() {
 		Expect(VatMoveSignature()).To(Equal("0xbb35783b00000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates vat nope signature", func() {
+		Expect(VatNopeSignature()).To(Equal("0xdc4d20fa00000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates vat slip signature", func() {
 		Expect(VatSlipSignature()).To(Equal("0x7cdd3fde00000000000000000000000000000000000000000000000000000000"))
 	})
@@ -158,10 +374,26 @@ var _ = Describe("Signature constants", func() {
 		Expect(VowFileSignature()).To(Equal("0x29ae811400000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates vow flap signature", func() {
+		Expect(VowFlapSignature()).To(Equal("0x0e01198b00000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates vow flog signature", func() {
 		Expect(VowFlogSignature()).To(Equal("0xd7ee674b00000000000000000000000000000000000000000000000000000000"))
 	})
 
+	It("generates vow flop signature", func() {
+		Expect(VowFlopSignature()).To(Equal("0xbbbb0d7b00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates vow heal signature", func() {
+		Expect(VowHealSignature()).To(Equal("0xf37ac61c00000000000000000000000000000000000000000000000000000000"))
+	})
+
+	It("generates vow kiss signature", func() {
+		Expect(VowKissSignature()).To(Equal("0x2506855a00000000000000000000000000000000000000000000000000000000"))
+	})
+
 	It("generates yank signature", func() {
 		Expect(YankSignature()).To(Equal("0x26e027f100000000000000000000000000000000000000000000000000000000"))
 	})
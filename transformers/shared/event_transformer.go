@@ -17,8 +17,11 @@
 package shared
 
 import (
+	"strings"
+
 	log "github.com/sirupsen/logrus"
 
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 	"github.com/vulcanize/vulcanizedb/pkg/core"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
@@ -28,10 +31,13 @@ type EventTransformer struct {
 	Config     transformer.EventTransformerConfig
 	Converter  Converter
 	Repository SharedRepository
+	// deployments of the watched contracts, keyed by lower cased address
+	deployments map[string]constants.ContractDeployment
 }
 
 func (tr EventTransformer) NewEventTransformer(db *postgres.DB) transformer.EventTransformer {
 	tr.Repository.SetDB(db)
+	tr.deployments = constants.GetContractDeploymentsByAddress(tr.Config.ContractAddresses)
 	return tr
 }
 
@@ -43,13 +49,21 @@ func (tr EventTransformer) Execute(logs []core.HeaderSyncLog) error {
 		return nil
 	}
 
-	models, err := tr.Converter.ToModels(tr.Config.ContractAbi, logs)
-	if err != nil {
-		log.Printf("Error converting logs in %v: %v", transformerName, err)
-		return err
+	var models []InsertionModel
+	for _, chunk := range tr.chunkLogsByDeployment(logs) {
+		chunkModels, err := tr.Converter.ToModels(chunk.abi, chunk.logs)
+		if err != nil {
+			log.Printf("Error converting logs in %v: %v", transformerName, err)
+			return err
+		}
+		models = append(models, chunkModels...)
+	}
+
+	if len(models) < 1 {
+		return nil
 	}
 
-	err = tr.Repository.Create(models)
+	err := tr.Repository.Create(models)
 	if err != nil {
 		log.Printf("Error persisting %v record: %v", transformerName, err)
 		return err
@@ -64,3 +78,33 @@ func (tr EventTransformer) GetName() string {
 func (tr EventTransformer) GetConfig() transformer.EventTransformerConfig {
 	return tr.Config
 }
+
+type logChunk struct {
+	abi  string
+	logs []core.HeaderSyncLog
+}
+
+// Drops logs emitted outside their contract deployment's block range, and groups the rest by the deployment's ABI
+// so that a redeployed contract's logs are converted with the ABI it was deployed with
+func (tr EventTransformer) chunkLogsByDeployment(logs []core.HeaderSyncLog) []logChunk {
+	var chunks []logChunk
+	chunkIndexes := make(map[string]int)
+	for _, log := range logs {
+		abi := tr.Config.ContractAbi
+		deployment, ok := tr.deployments[strings.ToLower(log.Log.Address.Hex())]
+		if ok {
+			if !deployment.IsLiveAt(int64(log.Log.BlockNumber)) {
+				continue
+			}
+			abi = deployment.Abi
+		}
+		index, seen := chunkIndexes[abi]
+		if !seen {
+			index = len(chunks)
+			chunkIndexes[abi] = index
+			chunks = append(chunks, logChunk{abi: abi})
+		}
+		chunks[index].logs = append(chunks[index].logs, log)
+	}
+	return chunks
+}
@@ -31,6 +31,8 @@ var ErrInvalidIndex = func(index int) error {
 	return errors.New(fmt.Sprintf("unsupported log data index: %d", index))
 }
 
+var ErrDSNoteDataMalformed = errors.New("ds-note log data does not contain the expected calldata")
+
 func BigIntToInt64(value *big.Int) int64 {
 	if value == nil {
 		return int64(0)
@@ -94,6 +96,37 @@ func getDataWithIndexOffset(offset int, logData []byte) []byte {
 	return logData[dataBegin:dataEnd]
 }
 
+// ds-note LogNote data holds msg.value followed by the full calldata as dynamic bytes, so arguments
+// that don't fit in the topics (like arrays) have to be decoded from the calldata.
+// Calldata includes the four byte function selector, so arguments begin at calldata[4:]
+func GetDSNoteCalldata(logData []byte) ([]byte, error) {
+	// the first word is msg.value, the second the offset of the calldata bytes
+	calldataOffset, offsetErr := getDataWordAsInt(logData, constants.DataItemLength)
+	if offsetErr != nil {
+		return nil, offsetErr
+	}
+	calldataLength, lengthErr := getDataWordAsInt(logData, calldataOffset)
+	if lengthErr != nil {
+		return nil, lengthErr
+	}
+	calldataStart := calldataOffset + constants.DataItemLength
+	if calldataLength < 4 || calldataStart+calldataLength > len(logData) {
+		return nil, ErrDSNoteDataMalformed
+	}
+	return logData[calldataStart : calldataStart+calldataLength], nil
+}
+
+func getDataWordAsInt(data []byte, start int) (int, error) {
+	if start < 0 || start+constants.DataItemLength > len(data) {
+		return 0, ErrDSNoteDataMalformed
+	}
+	word := big.NewInt(0).SetBytes(data[start : start+constants.DataItemLength])
+	if !word.IsInt64() || word.Int64() > int64(len(data)) {
+		return 0, ErrDSNoteDataMalformed
+	}
+	return int(word.Int64()), nil
+}
+
 func MinInt64(ints []int64) (min int64) {
 	if len(ints) == 0 {
 		return 0
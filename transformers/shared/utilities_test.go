@@ -94,6 +94,22 @@ var _ = Describe("Shared utilities", func() {
 		})
 	})
 
+	Describe("getting ds-note calldata", func() {
+		It("extracts the calldata including the function selector", func() {
+			calldata, err := shared.GetDSNoteCalldata(test_data.ChiefEtchHeaderSyncLog.Log.Data)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(calldata)).To(Equal(132))
+			Expect(hexutil.Encode(calldata[:4])).To(Equal("0x5123e1fa"))
+		})
+
+		It("returns error if the data is too short to hold the calldata", func() {
+			_, err := shared.GetDSNoteCalldata(test_data.ChiefEtchHeaderSyncLog.Log.Data[:96])
+
+			Expect(err).To(MatchError(shared.ErrDSNoteDataMalformed))
+		})
+	})
+
 	Describe("converting int256 hex to big int", func() {
 		It("correctly converts positive number", func() {
 			result := shared.ConvertInt256HexToBigInt("0x00000000000000000000000000000000000000000000000007a1fe1602770000")
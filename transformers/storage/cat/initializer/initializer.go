@@ -17,7 +17,6 @@
 package initializer
 
 import (
-	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/cat"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
@@ -25,8 +24,12 @@ import (
 	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 )
 
-var StorageTransformerInitializer transformer.StorageTransformerInitializer = storage.Transformer{
-	HashedAddress:     utils.HexToKeccak256Hash(constants.GetContractAddress("MCD_CAT")),
-	StorageKeysLookup: storage.NewKeysLookup(cat.NewKeysLoader(&mcdStorage.MakerStorageRepository{})),
-	Repository:        &cat.CatStorageRepository{},
-}.NewTransformer
+var StorageTransformerInitializer = mcdStorage.GenerateDeploymentInitializer("MCD_CAT", GenerateStorageTransformerInitializer)
+
+func GenerateStorageTransformerInitializer(contractAddress string) transformer.StorageTransformerInitializer {
+	return storage.Transformer{
+		HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
+		StorageKeysLookup: storage.NewKeysLookup(cat.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress)),
+		Repository:        &cat.CatStorageRepository{ContractAddress: contractAddress},
+	}.NewTransformer
+}
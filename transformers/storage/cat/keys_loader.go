@@ -51,10 +51,14 @@ var (
 
 type keysLoader struct {
 	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
 }
 
-func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository) storage.KeysLoader {
-	return &keysLoader{storageRepository: storageRepository}
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) storage.KeysLoader {
+	return &keysLoader{
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}
 }
 
 func (loader *keysLoader) SetDB(db *postgres.DB) {
@@ -63,7 +67,11 @@ func (loader *keysLoader) SetDB(db *postgres.DB) {
 
 func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
 	mappings := loadStaticMappings()
-	return loader.addIlkKeys(mappings)
+	mappings, ilkErr := loader.addIlkKeys(mappings)
+	if ilkErr != nil {
+		return nil, ilkErr
+	}
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
 }
 
 func (loader *keysLoader) addIlkKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/cat"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
@@ -37,7 +38,7 @@ var _ = Describe("Cat storage keys loader", func() {
 
 	BeforeEach(func() {
 		storageRepository = &test_helpers.MockMakerStorageRepository{}
-		storageKeysLoader = cat.NewKeysLoader(storageRepository)
+		storageKeysLoader = cat.NewKeysLoader(storageRepository, test_helpers.FakeAddress)
 	})
 
 	It("returns value metadata for static keys", func() {
@@ -107,4 +108,28 @@ var _ = Describe("Cat storage keys loader", func() {
 			})
 		})
 	})
+
+	Describe("wards", func() {
+		It("returns value metadata for wards", func() {
+			wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+			storageRepository.WardsKeys = []string{wardsUser}
+			paddedWardsUser := "0x000000000000000000000000" + wardsUser[2:]
+			wardsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedWardsUser + mcdStorage.WardsMappingIndex)))
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetWardsKeysCalledWith).To(Equal(test_helpers.FakeAddress))
+			Expect(mappings[wardsKey]).To(Equal(mcdStorage.GetWardsMetadata(wardsUser)))
+		})
+
+		It("returns error if wards keys lookup fails", func() {
+			storageRepository.GetWardsKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+	})
 })
@@ -7,6 +7,7 @@ import (
 
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 )
 
 const (
@@ -20,7 +21,8 @@ const (
 )
 
 type CatStorageRepository struct {
-	db *postgres.DB
+	ContractAddress string
+	db              *postgres.DB
 }
 
 func (repository *CatStorageRepository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
@@ -37,8 +39,10 @@ func (repository *CatStorageRepository) Create(blockNumber int, blockHash string
 		return repository.insertIlkChop(blockNumber, blockHash, metadata, value.(string))
 	case IlkLump:
 		return repository.insertIlkLump(blockNumber, blockHash, metadata, value.(string))
+	case mcdStorage.Wards:
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
 	default:
-		panic(fmt.Sprintf("unrecognized cat contract storage name: %s", metadata.Name))
+		return fmt.Errorf("unrecognized cat contract storage name: %s", metadata.Name)
 	}
 }
 
@@ -14,8 +14,10 @@ import (
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/cat"
 	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
 )
 
 var _ = Describe("Cat storage repository", func() {
@@ -32,7 +34,7 @@ var _ = Describe("Cat storage repository", func() {
 	BeforeEach(func() {
 		db = test_config.NewTestDB(test_config.NewTestNode())
 		test_config.CleanTestDB(db)
-		repo = cat.CatStorageRepository{}
+		repo = cat.CatStorageRepository{ContractAddress: FakeAddress}
 		repo.SetDB(db)
 	})
 
@@ -569,4 +571,20 @@ var _ = Describe("Cat storage repository", func() {
 			})
 		})
 	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   mcdStorage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repo,
+			Metadata:         mcdStorage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
 })
@@ -1,5 +1,5 @@
 // VulcanizeDB
-// Copyright © 2019 Vulcanize
+// Copyright © 2018 Vulcanize
 
 // This program is free software: you can redistribute it and/or modify
 // it under the terms of the GNU Affero General Public License as published by
@@ -17,7 +17,6 @@
 package initializer
 
 import (
-	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/cdp_manager"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
@@ -25,8 +24,12 @@ import (
 	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 )
 
-var StorageTransformerInitializer transformer.StorageTransformerInitializer = storage.Transformer{
-	HashedAddress:     utils.HexToKeccak256Hash(constants.GetContractAddress("CDP_MANAGER")),
-	StorageKeysLookup: storage.NewKeysLookup(cdp_manager.NewKeysLoader(&mcdStorage.MakerStorageRepository{})),
-	Repository:        &cdp_manager.CdpManagerStorageRepository{},
-}.NewTransformer
+var StorageTransformerInitializer = mcdStorage.GenerateDeploymentInitializer("CDP_MANAGER", GenerateStorageTransformerInitializer)
+
+func GenerateStorageTransformerInitializer(contractAddress string) transformer.StorageTransformerInitializer {
+	return storage.Transformer{
+		HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
+		StorageKeysLookup: storage.NewKeysLookup(cdp_manager.NewKeysLoader(&mcdStorage.MakerStorageRepository{})),
+		Repository:        &cdp_manager.CdpManagerStorageRepository{},
+	}.NewTransformer
+}
@@ -38,6 +38,8 @@ const (
 	First    = "first"
 	Last     = "last"
 	Count    = "count"
+	CdpCan   = "cdp_can"
+	UrnCan   = "urn_can"
 )
 
 var (
@@ -55,13 +57,15 @@ var (
 		Type: utils.Uint256,
 	}
 
-	UrnsMappingIndex  = utils.IndexTwo
-	ListMappingIndex  = utils.IndexThree
-	OwnsMappingIndex  = utils.IndexFour
-	IlksMappingIndex  = utils.IndexFive
-	FirstMappingIndex = utils.IndexSix
-	LastMappingIndex  = utils.IndexSeven
-	CountMappingIndex = utils.IndexEight
+	UrnsMappingIndex   = utils.IndexTwo
+	ListMappingIndex   = utils.IndexThree
+	OwnsMappingIndex   = utils.IndexFour
+	IlksMappingIndex   = utils.IndexFive
+	FirstMappingIndex  = utils.IndexSix
+	LastMappingIndex   = utils.IndexSeven
+	CountMappingIndex  = utils.IndexEight
+	CdpCanMappingIndex = utils.IndexNine
+	UrnCanMappingIndex = utils.IndexTen
 )
 
 type keysLoader struct {
@@ -82,7 +86,15 @@ func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMeta
 	if cdpiErr != nil {
 		return nil, cdpiErr
 	}
-	return loader.loadOwnsKeyMappings(mappings)
+	mappings, ownsErr := loader.loadOwnsKeyMappings(mappings)
+	if ownsErr != nil {
+		return nil, ownsErr
+	}
+	mappings, cdpCanErr := loader.loadCdpCanKeyMappings(mappings)
+	if cdpCanErr != nil {
+		return nil, cdpCanErr
+	}
+	return loader.loadUrnCanKeyMappings(mappings)
 }
 
 func (loader *keysLoader) loadCdpiKeyMappings(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
@@ -121,6 +133,48 @@ func (loader *keysLoader) loadOwnsKeyMappings(mappings map[common.Hash]utils.Sto
 	return mappings, nil
 }
 
+func (loader *keysLoader) loadCdpCanKeyMappings(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	cdpCanKeys, cdpCanErr := loader.storageRepository.GetCdpCanKeys()
+	if cdpCanErr != nil {
+		return nil, cdpCanErr
+	}
+	for _, cdpCan := range cdpCanKeys {
+		paddedOwner, ownerPadErr := utilities.PadAddress(cdpCan.Owner)
+		if ownerPadErr != nil {
+			return nil, ownerPadErr
+		}
+		hexCdpi, hexErr := shared.ConvertIntStringToHex(cdpCan.Cdpi)
+		if hexErr != nil {
+			return nil, hexErr
+		}
+		paddedUsr, usrPadErr := utilities.PadAddress(cdpCan.Usr)
+		if usrPadErr != nil {
+			return nil, usrPadErr
+		}
+		mappings[getCdpCanKey(paddedOwner, hexCdpi, paddedUsr)] = getCdpCanMetadata(cdpCan.Owner, cdpCan.Cdpi, cdpCan.Usr)
+	}
+	return mappings, nil
+}
+
+func (loader *keysLoader) loadUrnCanKeyMappings(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	urnCanKeys, urnCanErr := loader.storageRepository.GetUrnCanKeys()
+	if urnCanErr != nil {
+		return nil, urnCanErr
+	}
+	for _, urnCan := range urnCanKeys {
+		paddedOwner, ownerPadErr := utilities.PadAddress(urnCan.Owner)
+		if ownerPadErr != nil {
+			return nil, ownerPadErr
+		}
+		paddedUsr, usrPadErr := utilities.PadAddress(urnCan.Usr)
+		if usrPadErr != nil {
+			return nil, usrPadErr
+		}
+		mappings[getUrnCanKey(paddedOwner, paddedUsr)] = getUrnCanMetadata(urnCan.Owner, urnCan.Usr)
+	}
+	return mappings, nil
+}
+
 func loadStaticMappings() map[common.Hash]utils.StorageValueMetadata {
 	mappings := make(map[common.Hash]utils.StorageValueMetadata)
 	mappings[VatKey] = VatMetadata
@@ -199,3 +253,22 @@ func getCountMetadata(ownerAddress string) utils.StorageValueMetadata {
 	keys := map[utils.Key]string{constants.Owner: ownerAddress}
 	return utils.GetStorageValueMetadata(Count, keys, utils.Uint256)
 }
+
+func getCdpCanKey(ownerAddress, hexCdpi, usrAddress string) common.Hash {
+	ownerCdpKey := utils.GetStorageKeyForNestedMapping(CdpCanMappingIndex, ownerAddress, hexCdpi)
+	return utils.GetStorageKeyForMapping(ownerCdpKey.Hex()[2:], usrAddress)
+}
+
+func getCdpCanMetadata(ownerAddress, cdpi, usrAddress string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Owner: ownerAddress, constants.Cdpi: cdpi, constants.Usr: usrAddress}
+	return utils.GetStorageValueMetadata(CdpCan, keys, utils.Uint256)
+}
+
+func getUrnCanKey(ownerAddress, usrAddress string) common.Hash {
+	return utils.GetStorageKeyForNestedMapping(UrnCanMappingIndex, ownerAddress, usrAddress)
+}
+
+func getUrnCanMetadata(ownerAddress, usrAddress string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Owner: ownerAddress, constants.Usr: usrAddress}
+	return utils.GetStorageValueMetadata(UrnCan, keys, utils.Uint256)
+}
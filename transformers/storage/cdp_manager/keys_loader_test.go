@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/cdp_manager"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
@@ -192,5 +193,76 @@ var _ = Describe("CDP Manager storage keys loader", func() {
 				})
 			})
 		})
+
+		Describe("cdpCan", func() {
+			Describe("when getting cdpCan keys fails", func() {
+				It("returns error", func() {
+					storageRepository.GetCdpCanKeysError = fakes.FakeError
+
+					_, err := storageKeysLoader.LoadMappings()
+
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(MatchError(fakes.FakeError))
+				})
+			})
+
+			Describe("when getting cdpCan keys succeeds", func() {
+				It("returns value metadata for owner, cdp and usr", func() {
+					owner := test_helpers.FakeAddress
+					paddedOwner, _ := utilities.PadAddress(owner)
+					cdpi := strconv.FormatInt(rand.Int63(), 10)
+					cdpiHex, _ := shared.ConvertIntStringToHex(cdpi)
+					usr := fakes.FakeAddress.Hex()
+					paddedUsr, _ := utilities.PadAddress(usr)
+					storageRepository.CdpCanKeys = []mcdStorage.CdpCan{{Owner: owner, Cdpi: cdpi, Usr: usr}}
+					ownerMappingKey := crypto.Keccak256(common.FromHex(paddedOwner + cdp_manager.CdpCanMappingIndex))
+					cdpMappingKey := crypto.Keccak256(common.FromHex(cdpiHex), ownerMappingKey)
+					cdpCanKey := crypto.Keccak256Hash(common.FromHex(paddedUsr), cdpMappingKey)
+
+					mappings, err := storageKeysLoader.LoadMappings()
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(mappings[cdpCanKey]).To(Equal(utils.StorageValueMetadata{
+						Name: cdp_manager.CdpCan,
+						Keys: map[utils.Key]string{constants.Owner: owner, constants.Cdpi: cdpi, constants.Usr: usr},
+						Type: utils.Uint256,
+					}))
+				})
+			})
+		})
+
+		Describe("urnCan", func() {
+			Describe("when getting urnCan keys fails", func() {
+				It("returns error", func() {
+					storageRepository.GetUrnCanKeysError = fakes.FakeError
+
+					_, err := storageKeysLoader.LoadMappings()
+
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(MatchError(fakes.FakeError))
+				})
+			})
+
+			Describe("when getting urnCan keys succeeds", func() {
+				It("returns value metadata for owner and usr", func() {
+					owner := test_helpers.FakeAddress
+					paddedOwner, _ := utilities.PadAddress(owner)
+					usr := fakes.FakeAddress.Hex()
+					paddedUsr, _ := utilities.PadAddress(usr)
+					storageRepository.UrnCanKeys = []mcdStorage.UrnCan{{Owner: owner, Usr: usr}}
+					ownerMappingKey := crypto.Keccak256(common.FromHex(paddedOwner + cdp_manager.UrnCanMappingIndex))
+					urnCanKey := crypto.Keccak256Hash(common.FromHex(paddedUsr), ownerMappingKey)
+
+					mappings, err := storageKeysLoader.LoadMappings()
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(mappings[urnCanKey]).To(Equal(utils.StorageValueMetadata{
+						Name: cdp_manager.UrnCan,
+						Keys: map[utils.Key]string{constants.Owner: owner, constants.Usr: usr},
+						Type: utils.Uint256,
+					}))
+				})
+			})
+		})
 	})
 })
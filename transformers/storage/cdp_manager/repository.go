@@ -17,6 +17,8 @@
 package cdp_manager
 
 import (
+	"fmt"
+
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
@@ -34,6 +36,8 @@ const (
 	insertFirstQuery    = `INSERT INTO maker.cdp_manager_first (block_number, block_hash, owner, first) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
 	insertLastQuery     = `INSERT INTO maker.cdp_manager_last (block_number, block_hash, owner, last) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
 	insertCountQuery    = `INSERT INTO maker.cdp_manager_count (block_number, block_hash, owner, count) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertCdpCanQuery   = `INSERT INTO maker.cdp_manager_cdp_can (block_number, block_hash, owner, cdpi, usr, can) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING`
+	insertUrnCanQuery   = `INSERT INTO maker.cdp_manager_urn_can (block_number, block_hash, owner, usr, can) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
 )
 
 type CdpManagerStorageRepository struct {
@@ -66,8 +70,12 @@ func (repository CdpManagerStorageRepository) Create(blockNumber int, blockHash
 		return repository.insertLast(blockNumber, blockHash, metadata, value.(string))
 	case Count:
 		return repository.insertCount(blockNumber, blockHash, metadata, value.(string))
+	case CdpCan:
+		return repository.insertCdpCan(blockNumber, blockHash, metadata, value.(string))
+	case UrnCan:
+		return repository.insertUrnCan(blockNumber, blockHash, metadata, value.(string))
 	default:
-		panic("unrecognized storage metadata name")
+		return fmt.Errorf("unrecognized cdp manager contract storage name: %s", metadata.Name)
 	}
 }
 
@@ -165,6 +173,38 @@ func (repository CdpManagerStorageRepository) insertCount(blockNumber int, block
 	return writeErr
 }
 
+func (repository CdpManagerStorageRepository) insertCdpCan(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, can string) error {
+	owner, ownerErr := getOwner(metadata.Keys)
+	if ownerErr != nil {
+		return ownerErr
+	}
+	cdpi, cdpiErr := getCdpi(metadata.Keys)
+	if cdpiErr != nil {
+		return cdpiErr
+	}
+	usr, usrErr := getUsr(metadata.Keys)
+	if usrErr != nil {
+		return usrErr
+	}
+
+	_, writeErr := repository.db.Exec(insertCdpCanQuery, blockNumber, blockHash, owner, cdpi, usr, can)
+	return writeErr
+}
+
+func (repository CdpManagerStorageRepository) insertUrnCan(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, can string) error {
+	owner, ownerErr := getOwner(metadata.Keys)
+	if ownerErr != nil {
+		return ownerErr
+	}
+	usr, usrErr := getUsr(metadata.Keys)
+	if usrErr != nil {
+		return usrErr
+	}
+
+	_, writeErr := repository.db.Exec(insertUrnCanQuery, blockNumber, blockHash, owner, usr, can)
+	return writeErr
+}
+
 func getCdpi(keys map[utils.Key]string) (string, error) {
 	cdpi, ok := keys[constants.Cdpi]
 	if !ok {
@@ -180,3 +220,11 @@ func getOwner(keys map[utils.Key]string) (string, error) {
 	}
 	return owner, nil
 }
+
+func getUsr(keys map[utils.Key]string) (string, error) {
+	usr, ok := keys[constants.Usr]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: constants.Usr}
+	}
+	return usr, nil
+}
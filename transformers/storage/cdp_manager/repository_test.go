@@ -54,13 +54,12 @@ var _ = Describe("CDP Manager storage repository", func() {
 		fakeHash = fakes.FakeHash.Hex()
 	})
 
-	It("panics if the metadata name is not recognized", func() {
+	It("returns an error if the metadata name is not recognized", func() {
 		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
-		repoCreate := func() {
-			repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
-		}
 
-		Expect(repoCreate).Should(Panic())
+		err := repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized cdp manager contract storage name: unrecognized"))
 	})
 
 	Describe("vat", func() {
@@ -355,4 +354,119 @@ var _ = Describe("CDP Manager storage repository", func() {
 			shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
 		})
 	})
+
+	Describe("cdpCan", func() {
+		var (
+			fakeOwner      = FakeAddress
+			fakeCdpi       = strconv.Itoa(rand.Int())
+			fakeUsr        = "fake_usr"
+			fakeCan        = "1"
+			cdpCanMetadata = utils.GetStorageValueMetadata(cdp_manager.CdpCan, map[utils.Key]string{
+				constants.Owner: fakeOwner, constants.Cdpi: fakeCdpi, constants.Usr: fakeUsr}, utils.Uint256)
+		)
+
+		It("writes a row", func() {
+			err := repository.Create(fakeBlockNumber, fakeHash, cdpCanMetadata, fakeCan)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			var result DoubleMappingRes
+			err = db.Get(&result, `SELECT block_number, block_hash, owner AS key_one, usr AS key_two, can AS value
+				FROM maker.cdp_manager_cdp_can WHERE cdpi = $1`, fakeCdpi)
+			Expect(err).NotTo(HaveOccurred())
+			AssertDoubleMapping(result, fakeBlockNumber, fakeHash, fakeOwner, fakeUsr, fakeCan)
+		})
+
+		It("does not duplicate row", func() {
+			insertOneErr := repository.Create(fakeBlockNumber, fakeHash, cdpCanMetadata, fakeCan)
+			Expect(insertOneErr).NotTo(HaveOccurred())
+
+			insertTwoErr := repository.Create(fakeBlockNumber, fakeHash, cdpCanMetadata, fakeCan)
+
+			Expect(insertTwoErr).NotTo(HaveOccurred())
+			var count int
+			getCountErr := db.Get(&count, `SELECT count(*) FROM maker.cdp_manager_cdp_can`)
+			Expect(getCountErr).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("returns error if metadata missing owner", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(cdp_manager.CdpCan,
+				map[utils.Key]string{constants.Cdpi: fakeCdpi, constants.Usr: fakeUsr}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeCan)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Owner}))
+		})
+
+		It("returns error if metadata missing cdpi", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(cdp_manager.CdpCan,
+				map[utils.Key]string{constants.Owner: fakeOwner, constants.Usr: fakeUsr}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeCan)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Cdpi}))
+		})
+
+		It("returns error if metadata missing usr", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(cdp_manager.CdpCan,
+				map[utils.Key]string{constants.Owner: fakeOwner, constants.Cdpi: fakeCdpi}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeCan)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Usr}))
+		})
+	})
+
+	Describe("urnCan", func() {
+		var (
+			fakeOwner      = FakeAddress
+			fakeUsr        = "fake_usr"
+			fakeCan        = "1"
+			urnCanMetadata = utils.GetStorageValueMetadata(cdp_manager.UrnCan,
+				map[utils.Key]string{constants.Owner: fakeOwner, constants.Usr: fakeUsr}, utils.Uint256)
+		)
+
+		It("writes a row", func() {
+			err := repository.Create(fakeBlockNumber, fakeHash, urnCanMetadata, fakeCan)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			var result DoubleMappingRes
+			err = db.Get(&result, `SELECT block_number, block_hash, owner AS key_one, usr AS key_two, can AS value FROM maker.cdp_manager_urn_can`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertDoubleMapping(result, fakeBlockNumber, fakeHash, fakeOwner, fakeUsr, fakeCan)
+		})
+
+		It("does not duplicate row", func() {
+			insertOneErr := repository.Create(fakeBlockNumber, fakeHash, urnCanMetadata, fakeCan)
+			Expect(insertOneErr).NotTo(HaveOccurred())
+
+			insertTwoErr := repository.Create(fakeBlockNumber, fakeHash, urnCanMetadata, fakeCan)
+
+			Expect(insertTwoErr).NotTo(HaveOccurred())
+			var count int
+			getCountErr := db.Get(&count, `SELECT count(*) FROM maker.cdp_manager_urn_can`)
+			Expect(getCountErr).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("returns error if metadata missing owner", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(cdp_manager.UrnCan,
+				map[utils.Key]string{constants.Usr: fakeUsr}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeCan)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Owner}))
+		})
+
+		It("returns error if metadata missing usr", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(cdp_manager.UrnCan,
+				map[utils.Key]string{constants.Owner: fakeOwner}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeCan)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Usr}))
+		})
+	})
 })
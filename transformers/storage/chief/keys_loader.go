@@ -0,0 +1,128 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package chief
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	Votes     = "votes"
+	Approvals = "approvals"
+	Deposits  = "deposits"
+	Hat       = "hat"
+)
+
+var (
+	VotesIndex     = utils.IndexSeven
+	ApprovalsIndex = utils.IndexEight
+	DepositsIndex  = utils.IndexNine
+
+	HatKey      = common.HexToHash(mcdStorage.IndexTwelve)
+	HatMetadata = utils.GetStorageValueMetadata(Hat, nil, utils.Address)
+)
+
+type keysLoader struct {
+	storageRepository mcdStorage.IMakerStorageRepository
+}
+
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository) storage.KeysLoader {
+	return &keysLoader{storageRepository: storageRepository}
+}
+
+func (loader *keysLoader) SetDB(db *postgres.DB) {
+	loader.storageRepository.SetDB(db)
+}
+
+func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
+	mappings := loadStaticMappings()
+	mappings, voterErr := loader.loadVoterKeys(mappings)
+	if voterErr != nil {
+		return nil, voterErr
+	}
+	return loader.loadCandidateKeys(mappings)
+}
+
+func (loader *keysLoader) loadVoterKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	voters, err := loader.storageRepository.GetChiefVoters()
+	if err != nil {
+		return nil, err
+	}
+	for _, voter := range voters {
+		paddedVoter, padErr := utilities.PadAddress(voter)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getVotesKey(paddedVoter)] = getVotesMetadata(voter)
+		mappings[getDepositsKey(paddedVoter)] = getDepositsMetadata(voter)
+	}
+	return mappings, nil
+}
+
+func (loader *keysLoader) loadCandidateKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	candidates, err := loader.storageRepository.GetChiefCandidates()
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		paddedCandidate, padErr := utilities.PadAddress(candidate)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getApprovalsKey(paddedCandidate)] = getApprovalsMetadata(candidate)
+	}
+	return mappings, nil
+}
+
+func loadStaticMappings() map[common.Hash]utils.StorageValueMetadata {
+	mappings := make(map[common.Hash]utils.StorageValueMetadata)
+	mappings[HatKey] = HatMetadata
+	return mappings
+}
+
+func getVotesKey(paddedVoter string) common.Hash {
+	return utils.GetStorageKeyForMapping(VotesIndex, paddedVoter)
+}
+
+func getVotesMetadata(voter string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.MsgSender: voter}
+	return utils.GetStorageValueMetadata(Votes, keys, utils.Bytes32)
+}
+
+func getDepositsKey(paddedVoter string) common.Hash {
+	return utils.GetStorageKeyForMapping(DepositsIndex, paddedVoter)
+}
+
+func getDepositsMetadata(voter string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.MsgSender: voter}
+	return utils.GetStorageValueMetadata(Deposits, keys, utils.Uint256)
+}
+
+func getApprovalsKey(paddedCandidate string) common.Hash {
+	return utils.GetStorageKeyForMapping(ApprovalsIndex, paddedCandidate)
+}
+
+func getApprovalsMetadata(candidate string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Candidate: candidate}
+	return utils.GetStorageValueMetadata(Approvals, keys, utils.Uint256)
+}
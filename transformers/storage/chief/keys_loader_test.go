@@ -0,0 +1,148 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package chief_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/chief"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("Chief storage keys loader", func() {
+	var (
+		storageRepository *test_helpers.MockMakerStorageRepository
+		storageKeysLoader storage.KeysLoader
+	)
+
+	BeforeEach(func() {
+		storageRepository = &test_helpers.MockMakerStorageRepository{}
+		storageKeysLoader = chief.NewKeysLoader(storageRepository)
+	})
+
+	It("returns value metadata for static keys", func() {
+		mappings, err := storageKeysLoader.LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings[chief.HatKey]).To(Equal(chief.HatMetadata))
+	})
+
+	Describe("voter mappings", func() {
+		Describe("when getting voters fails", func() {
+			It("returns error", func() {
+				storageRepository.GetChiefVotersError = fakes.FakeError
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(MatchError(fakes.FakeError))
+			})
+		})
+
+		Describe("when getting voters succeeds", func() {
+			var (
+				voter          = test_helpers.FakeAddress
+				paddedVoter, _ = utilities.PadAddress(voter)
+			)
+
+			BeforeEach(func() {
+				storageRepository.ChiefVoters = []string{voter}
+			})
+
+			It("returns value metadata for votes", func() {
+				votesKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedVoter + chief.VotesIndex)))
+
+				mappings, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storageRepository.GetChiefVotersCalled).To(BeTrue())
+				Expect(mappings[votesKey]).To(Equal(utils.StorageValueMetadata{
+					Name: chief.Votes,
+					Keys: map[utils.Key]string{constants.MsgSender: voter},
+					Type: utils.Bytes32,
+				}))
+			})
+
+			It("returns value metadata for deposits", func() {
+				depositsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedVoter + chief.DepositsIndex)))
+
+				mappings, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mappings[depositsKey]).To(Equal(utils.StorageValueMetadata{
+					Name: chief.Deposits,
+					Keys: map[utils.Key]string{constants.MsgSender: voter},
+					Type: utils.Uint256,
+				}))
+			})
+
+			It("returns error if voter address is invalid", func() {
+				storageRepository.ChiefVoters = []string{"0xinvalid"}
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("approvals", func() {
+		Describe("when getting candidates fails", func() {
+			It("returns error", func() {
+				storageRepository.GetChiefCandidatesError = fakes.FakeError
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(MatchError(fakes.FakeError))
+			})
+		})
+
+		Describe("when getting candidates succeeds", func() {
+			It("returns value metadata for approvals", func() {
+				candidate := test_helpers.FakeAddress
+				storageRepository.ChiefCandidates = []string{candidate}
+				paddedCandidate, padErr := utilities.PadAddress(candidate)
+				Expect(padErr).NotTo(HaveOccurred())
+				approvalsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedCandidate + chief.ApprovalsIndex)))
+
+				mappings, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storageRepository.GetChiefCandidatesCalled).To(BeTrue())
+				Expect(mappings[approvalsKey]).To(Equal(utils.StorageValueMetadata{
+					Name: chief.Approvals,
+					Keys: map[utils.Key]string{constants.Candidate: candidate},
+					Type: utils.Uint256,
+				}))
+			})
+
+			It("returns error if candidate address is invalid", func() {
+				storageRepository.ChiefCandidates = []string{"0xinvalid"}
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
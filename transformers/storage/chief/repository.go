@@ -0,0 +1,103 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package chief
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	insertVotesQuery     = `INSERT INTO maker.chief_votes (block_number, block_hash, msg_sender, slate) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertApprovalsQuery = `INSERT INTO maker.chief_approvals (block_number, block_hash, candidate, approvals) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertDepositsQuery  = `INSERT INTO maker.chief_deposits (block_number, block_hash, msg_sender, deposits) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertHatQuery       = `INSERT INTO maker.chief_hat (block_number, block_hash, hat) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+)
+
+type ChiefStorageRepository struct {
+	db *postgres.DB
+}
+
+func (repository *ChiefStorageRepository) SetDB(db *postgres.DB) {
+	repository.db = db
+}
+
+func (repository ChiefStorageRepository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	switch metadata.Name {
+	case Votes:
+		return repository.insertVotes(blockNumber, blockHash, metadata, value.(string))
+	case Approvals:
+		return repository.insertApprovals(blockNumber, blockHash, metadata, value.(string))
+	case Deposits:
+		return repository.insertDeposits(blockNumber, blockHash, metadata, value.(string))
+	case Hat:
+		return repository.insertHat(blockNumber, blockHash, value.(string))
+	default:
+		return fmt.Errorf("unrecognized chief contract storage name: %s", metadata.Name)
+	}
+}
+
+func (repository ChiefStorageRepository) insertVotes(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, slate string) error {
+	msgSender, keyErr := getMsgSender(metadata.Keys)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertVotesQuery, blockNumber, blockHash, msgSender, slate)
+	return writeErr
+}
+
+func (repository ChiefStorageRepository) insertApprovals(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, approvals string) error {
+	candidate, keyErr := getCandidate(metadata.Keys)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertApprovalsQuery, blockNumber, blockHash, candidate, approvals)
+	return writeErr
+}
+
+func (repository ChiefStorageRepository) insertDeposits(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, deposits string) error {
+	msgSender, keyErr := getMsgSender(metadata.Keys)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertDepositsQuery, blockNumber, blockHash, msgSender, deposits)
+	return writeErr
+}
+
+func (repository ChiefStorageRepository) insertHat(blockNumber int, blockHash string, hat string) error {
+	_, err := repository.db.Exec(insertHatQuery, blockNumber, blockHash, hat)
+	return err
+}
+
+func getMsgSender(keys map[utils.Key]string) (string, error) {
+	msgSender, ok := keys[constants.MsgSender]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: constants.MsgSender}
+	}
+	return msgSender, nil
+}
+
+func getCandidate(keys map[utils.Key]string) (string, error) {
+	candidate, ok := keys[constants.Candidate]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: constants.Candidate}
+	}
+	return candidate, nil
+}
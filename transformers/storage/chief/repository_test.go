@@ -0,0 +1,144 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package chief_test
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/chief"
+	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("Chief storage repository", func() {
+	var (
+		db              *postgres.DB
+		repository      chief.ChiefStorageRepository
+		fakeBlockNumber int
+		fakeHash        string
+		fakeUint256     = strconv.Itoa(rand.Int())
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		repository = chief.ChiefStorageRepository{}
+		repository.SetDB(db)
+		fakeBlockNumber = rand.Int()
+		fakeHash = fakes.FakeHash.Hex()
+	})
+
+	It("returns an error if the metadata name is not recognized", func() {
+		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
+
+		err := repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized chief contract storage name: unrecognized"))
+	})
+
+	Describe("votes", func() {
+		It("returns an error if metadata is missing the voter", func() {
+			badMetadata := utils.GetStorageValueMetadata(chief.Votes, map[utils.Key]string{}, utils.Bytes32)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakes.FakeHash.Hex())
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.MsgSender}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "msg_sender",
+			ValueFieldName:   "slate",
+			Key:              FakeAddress,
+			Value:            fakes.FakeHash.Hex(),
+			IsAMapping:       true,
+			StorageTableName: "maker.chief_votes",
+			Repository:       &repository,
+			Metadata: utils.GetStorageValueMetadata(chief.Votes,
+				map[utils.Key]string{constants.MsgSender: FakeAddress}, utils.Bytes32),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("approvals", func() {
+		It("returns an error if metadata is missing the candidate", func() {
+			badMetadata := utils.GetStorageValueMetadata(chief.Approvals, map[utils.Key]string{}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakeUint256)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Candidate}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "candidate",
+			ValueFieldName:   "approvals",
+			Key:              FakeAddress,
+			Value:            fakeUint256,
+			IsAMapping:       true,
+			StorageTableName: "maker.chief_approvals",
+			Repository:       &repository,
+			Metadata: utils.GetStorageValueMetadata(chief.Approvals,
+				map[utils.Key]string{constants.Candidate: FakeAddress}, utils.Uint256),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("deposits", func() {
+		It("returns an error if metadata is missing the voter", func() {
+			badMetadata := utils.GetStorageValueMetadata(chief.Deposits, map[utils.Key]string{}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakeUint256)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.MsgSender}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "msg_sender",
+			ValueFieldName:   "deposits",
+			Key:              FakeAddress,
+			Value:            fakeUint256,
+			IsAMapping:       true,
+			StorageTableName: "maker.chief_deposits",
+			Repository:       &repository,
+			Metadata: utils.GetStorageValueMetadata(chief.Deposits,
+				map[utils.Key]string{constants.MsgSender: FakeAddress}, utils.Uint256),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("hat", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   chief.Hat,
+			Value:            FakeAddress,
+			StorageTableName: "maker.chief_hat",
+			Repository:       &repository,
+			Metadata:         chief.HatMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+})
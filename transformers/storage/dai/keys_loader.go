@@ -0,0 +1,158 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dai
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	TotalSupply = "totalSupply"
+	BalanceOf   = "balanceOf"
+	Allowance   = "allowance"
+	Nonces      = "nonces"
+)
+
+var (
+	TotalSupplyKey      = common.HexToHash(utils.IndexOne)
+	TotalSupplyMetadata = utils.GetStorageValueMetadata(TotalSupply, nil, utils.Uint256)
+
+	BalanceOfMappingIndex = utils.IndexTwo
+	AllowanceMappingIndex = utils.IndexThree
+	NoncesMappingIndex    = utils.IndexFour
+)
+
+type keysLoader struct {
+	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
+}
+
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) storage.KeysLoader {
+	return &keysLoader{
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}
+}
+
+func (loader *keysLoader) SetDB(db *postgres.DB) {
+	loader.storageRepository.SetDB(db)
+}
+
+func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
+	mappings := loadStaticMappings()
+	mappings, balanceErr := loader.addBalanceOfKeys(mappings)
+	if balanceErr != nil {
+		return nil, balanceErr
+	}
+	mappings, allowanceErr := loader.addAllowanceKeys(mappings)
+	if allowanceErr != nil {
+		return nil, allowanceErr
+	}
+	mappings, noncesErr := loader.addNoncesKeys(mappings)
+	if noncesErr != nil {
+		return nil, noncesErr
+	}
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
+}
+
+func (loader *keysLoader) addBalanceOfKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	guys, err := loader.storageRepository.GetDaiBalanceOfKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, guy := range guys {
+		paddedGuy, padErr := utilities.PadAddress(guy)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getBalanceOfKey(paddedGuy)] = getBalanceOfMetadata(guy)
+	}
+	return mappings, nil
+}
+
+func (loader *keysLoader) addAllowanceKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	allowances, err := loader.storageRepository.GetDaiAllowanceKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, allowance := range allowances {
+		paddedOwner, ownerPadErr := utilities.PadAddress(allowance.Owner)
+		if ownerPadErr != nil {
+			return nil, ownerPadErr
+		}
+		paddedSpender, spenderPadErr := utilities.PadAddress(allowance.Spender)
+		if spenderPadErr != nil {
+			return nil, spenderPadErr
+		}
+		mappings[getAllowanceKey(paddedOwner, paddedSpender)] = getAllowanceMetadata(allowance.Owner, allowance.Spender)
+	}
+	return mappings, nil
+}
+
+func (loader *keysLoader) addNoncesKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	guys, err := loader.storageRepository.GetDaiNoncesKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, guy := range guys {
+		paddedGuy, padErr := utilities.PadAddress(guy)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getNoncesKey(paddedGuy)] = getNoncesMetadata(guy)
+	}
+	return mappings, nil
+}
+
+func loadStaticMappings() map[common.Hash]utils.StorageValueMetadata {
+	mappings := make(map[common.Hash]utils.StorageValueMetadata)
+	mappings[TotalSupplyKey] = TotalSupplyMetadata
+	return mappings
+}
+
+func getBalanceOfKey(paddedGuy string) common.Hash {
+	return utils.GetStorageKeyForMapping(BalanceOfMappingIndex, paddedGuy)
+}
+
+func getBalanceOfMetadata(guy string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Guy: guy}
+	return utils.GetStorageValueMetadata(BalanceOf, keys, utils.Uint256)
+}
+
+func getAllowanceKey(paddedOwner, paddedSpender string) common.Hash {
+	return utils.GetStorageKeyForNestedMapping(AllowanceMappingIndex, paddedOwner, paddedSpender)
+}
+
+func getAllowanceMetadata(owner, spender string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Owner: owner, constants.Spender: spender}
+	return utils.GetStorageValueMetadata(Allowance, keys, utils.Uint256)
+}
+
+func getNoncesKey(paddedGuy string) common.Hash {
+	return utils.GetStorageKeyForMapping(NoncesMappingIndex, paddedGuy)
+}
+
+func getNoncesMetadata(guy string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Guy: guy}
+	return utils.GetStorageValueMetadata(Nonces, keys, utils.Uint256)
+}
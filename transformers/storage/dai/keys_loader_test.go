@@ -0,0 +1,184 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dai_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/dai"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	storageFactory "github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("Dai storage keys loader", func() {
+	var (
+		storageRepository *test_helpers.MockMakerStorageRepository
+		storageKeysLoader storageFactory.KeysLoader
+	)
+
+	BeforeEach(func() {
+		storageRepository = &test_helpers.MockMakerStorageRepository{}
+		storageKeysLoader = dai.NewKeysLoader(storageRepository, test_helpers.FakeAddress)
+	})
+
+	It("returns value metadata for static keys", func() {
+		mappings, err := storageKeysLoader.LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings[dai.TotalSupplyKey]).To(Equal(dai.TotalSupplyMetadata))
+	})
+
+	Describe("balanceOf", func() {
+		It("returns error if getting balanceOf keys fails", func() {
+			storageRepository.GetDaiBalanceOfKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+
+		It("returns value metadata for balanceOf", func() {
+			guy := test_helpers.FakeAddress
+			storageRepository.DaiBalanceOfKeys = []string{guy}
+			paddedGuy, padErr := utilities.PadAddress(guy)
+			Expect(padErr).NotTo(HaveOccurred())
+			balanceOfKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedGuy + dai.BalanceOfMappingIndex)))
+			expectedMetadata := utils.StorageValueMetadata{
+				Name: dai.BalanceOf,
+				Keys: map[utils.Key]string{constants.Guy: guy},
+				Type: utils.Uint256,
+			}
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetDaiBalanceOfKeysCalled).To(BeTrue())
+			Expect(mappings[balanceOfKey]).To(Equal(expectedMetadata))
+		})
+
+		It("returns error if address is invalid", func() {
+			storageRepository.DaiBalanceOfKeys = []string{"0xinvalid"}
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("allowance", func() {
+		It("returns error if getting allowance keys fails", func() {
+			storageRepository.GetDaiAllowanceKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+
+		It("returns value metadata for allowance", func() {
+			owner := test_helpers.FakeAddress
+			spender := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+			storageRepository.DaiAllowanceKeys = []storage.Allowance{{Owner: owner, Spender: spender}}
+			paddedOwner, ownerPadErr := utilities.PadAddress(owner)
+			Expect(ownerPadErr).NotTo(HaveOccurred())
+			encodedPrimaryMapIndex := crypto.Keccak256(common.FromHex(paddedOwner + dai.AllowanceMappingIndex))
+			paddedSpender := common.FromHex("0x000000000000000000000000" + spender[2:])
+			allowanceKey := common.BytesToHash(crypto.Keccak256(paddedSpender, encodedPrimaryMapIndex))
+			expectedMetadata := utils.StorageValueMetadata{
+				Name: dai.Allowance,
+				Keys: map[utils.Key]string{constants.Owner: owner, constants.Spender: spender},
+				Type: utils.Uint256,
+			}
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetDaiAllowanceKeysCalled).To(BeTrue())
+			Expect(mappings[allowanceKey]).To(Equal(expectedMetadata))
+		})
+
+		It("returns error if spender address is invalid", func() {
+			storageRepository.DaiAllowanceKeys = []storage.Allowance{{Owner: test_helpers.FakeAddress, Spender: "0xinvalid"}}
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("nonces", func() {
+		It("returns error if getting nonces keys fails", func() {
+			storageRepository.GetDaiNoncesKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+
+		It("returns value metadata for nonces", func() {
+			guy := test_helpers.FakeAddress
+			storageRepository.DaiNoncesKeys = []string{guy}
+			paddedGuy, padErr := utilities.PadAddress(guy)
+			Expect(padErr).NotTo(HaveOccurred())
+			noncesKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedGuy + dai.NoncesMappingIndex)))
+			expectedMetadata := utils.StorageValueMetadata{
+				Name: dai.Nonces,
+				Keys: map[utils.Key]string{constants.Guy: guy},
+				Type: utils.Uint256,
+			}
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetDaiNoncesKeysCalled).To(BeTrue())
+			Expect(mappings[noncesKey]).To(Equal(expectedMetadata))
+		})
+	})
+
+	Describe("wards", func() {
+		It("returns value metadata for wards", func() {
+			wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+			storageRepository.WardsKeys = []string{wardsUser}
+			paddedWardsUser := "0x000000000000000000000000" + wardsUser[2:]
+			wardsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedWardsUser + storage.WardsMappingIndex)))
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetWardsKeysCalledWith).To(Equal(test_helpers.FakeAddress))
+			Expect(mappings[wardsKey]).To(Equal(storage.GetWardsMetadata(wardsUser)))
+		})
+
+		It("returns error if wards keys lookup fails", func() {
+			storageRepository.GetWardsKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+	})
+})
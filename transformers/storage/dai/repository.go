@@ -0,0 +1,103 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dai
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	insertTotalSupplyQuery = `INSERT INTO maker.dai_total_supply (block_number, block_hash, total_supply) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertBalanceOfQuery   = `INSERT INTO maker.dai_balance_of (block_number, block_hash, guy, balance) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertAllowanceQuery   = `INSERT INTO maker.dai_allowance (block_number, block_hash, owner, spender, allowance) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+	insertNoncesQuery      = `INSERT INTO maker.dai_nonces (block_number, block_hash, guy, nonce) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+)
+
+type DaiStorageRepository struct {
+	ContractAddress string
+	db              *postgres.DB
+}
+
+func (repository *DaiStorageRepository) SetDB(db *postgres.DB) {
+	repository.db = db
+}
+
+func (repository DaiStorageRepository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	switch metadata.Name {
+	case TotalSupply:
+		return repository.insertTotalSupply(blockNumber, blockHash, value.(string))
+	case BalanceOf:
+		return repository.insertBalanceOf(blockNumber, blockHash, metadata, value.(string))
+	case Allowance:
+		return repository.insertAllowance(blockNumber, blockHash, metadata, value.(string))
+	case Nonces:
+		return repository.insertNonces(blockNumber, blockHash, metadata, value.(string))
+	case mcdStorage.Wards:
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
+	default:
+		return fmt.Errorf("unrecognized dai contract storage name: %s", metadata.Name)
+	}
+}
+
+func (repository DaiStorageRepository) insertTotalSupply(blockNumber int, blockHash string, totalSupply string) error {
+	_, err := repository.db.Exec(insertTotalSupplyQuery, blockNumber, blockHash, totalSupply)
+	return err
+}
+
+func (repository DaiStorageRepository) insertBalanceOf(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, balance string) error {
+	guy, keyErr := getKey(metadata.Keys, constants.Guy)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertBalanceOfQuery, blockNumber, blockHash, guy, balance)
+	return writeErr
+}
+
+func (repository DaiStorageRepository) insertAllowance(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, allowance string) error {
+	owner, ownerErr := getKey(metadata.Keys, constants.Owner)
+	if ownerErr != nil {
+		return ownerErr
+	}
+	spender, spenderErr := getKey(metadata.Keys, constants.Spender)
+	if spenderErr != nil {
+		return spenderErr
+	}
+	_, writeErr := repository.db.Exec(insertAllowanceQuery, blockNumber, blockHash, owner, spender, allowance)
+	return writeErr
+}
+
+func (repository DaiStorageRepository) insertNonces(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, nonce string) error {
+	guy, keyErr := getKey(metadata.Keys, constants.Guy)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertNoncesQuery, blockNumber, blockHash, guy, nonce)
+	return writeErr
+}
+
+func getKey(keys map[utils.Key]string, key utils.Key) (string, error) {
+	value, ok := keys[key]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: key}
+	}
+	return value, nil
+}
@@ -0,0 +1,192 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dai_test
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/dai"
+	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("Dai storage repository", func() {
+	var (
+		db              *postgres.DB
+		repository      dai.DaiStorageRepository
+		fakeBlockNumber int
+		fakeHash        string
+		fakeUint256     = strconv.Itoa(rand.Int())
+		fakeSpender     = "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		repository = dai.DaiStorageRepository{ContractAddress: FakeAddress}
+		repository.SetDB(db)
+		fakeBlockNumber = rand.Int()
+		fakeHash = fakes.FakeHash.Hex()
+	})
+
+	It("returns an error if the metadata name is not recognized", func() {
+		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
+
+		err := repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized dai contract storage name: unrecognized"))
+	})
+
+	Describe("totalSupply", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   "total_supply",
+			Value:            fakeUint256,
+			StorageTableName: "maker.dai_total_supply",
+			Repository:       &repository,
+			Metadata:         dai.TotalSupplyMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("balanceOf", func() {
+		It("returns an error if metadata is missing the guy", func() {
+			badMetadata := utils.StorageValueMetadata{
+				Name: dai.BalanceOf,
+				Keys: map[utils.Key]string{},
+				Type: utils.Uint256,
+			}
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Guy}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "guy",
+			ValueFieldName:   "balance",
+			Key:              FakeAddress,
+			Value:            fakeUint256,
+			IsAMapping:       true,
+			StorageTableName: "maker.dai_balance_of",
+			Repository:       &repository,
+			Metadata: utils.StorageValueMetadata{
+				Name: dai.BalanceOf,
+				Keys: map[utils.Key]string{constants.Guy: FakeAddress},
+				Type: utils.Uint256,
+			},
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("allowance", func() {
+		var allowanceMetadata = utils.GetStorageValueMetadata(dai.Allowance,
+			map[utils.Key]string{constants.Owner: FakeAddress, constants.Spender: fakeSpender}, utils.Uint256)
+
+		It("writes a row", func() {
+			err := repository.Create(fakeBlockNumber, fakeHash, allowanceMetadata, fakeUint256)
+
+			Expect(err).NotTo(HaveOccurred())
+			var result DoubleMappingRes
+			err = db.Get(&result, `SELECT block_number, block_hash, owner AS key_one, spender AS key_two, allowance AS value FROM maker.dai_allowance`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertDoubleMapping(result, fakeBlockNumber, fakeHash, FakeAddress, fakeSpender, fakeUint256)
+		})
+
+		It("does not duplicate row", func() {
+			insertOneErr := repository.Create(fakeBlockNumber, fakeHash, allowanceMetadata, fakeUint256)
+			Expect(insertOneErr).NotTo(HaveOccurred())
+
+			insertTwoErr := repository.Create(fakeBlockNumber, fakeHash, allowanceMetadata, fakeUint256)
+
+			Expect(insertTwoErr).NotTo(HaveOccurred())
+			var count int
+			getCountErr := db.Get(&count, `SELECT count(*) FROM maker.dai_allowance`)
+			Expect(getCountErr).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("returns an error if metadata missing owner", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(dai.Allowance,
+				map[utils.Key]string{constants.Spender: fakeSpender}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Owner}))
+		})
+
+		It("returns an error if metadata missing spender", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(dai.Allowance,
+				map[utils.Key]string{constants.Owner: FakeAddress}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Spender}))
+		})
+	})
+
+	Describe("nonces", func() {
+		It("returns an error if metadata is missing the guy", func() {
+			badMetadata := utils.StorageValueMetadata{
+				Name: dai.Nonces,
+				Keys: map[utils.Key]string{},
+				Type: utils.Uint256,
+			}
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Guy}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "guy",
+			ValueFieldName:   "nonce",
+			Key:              FakeAddress,
+			Value:            fakeUint256,
+			IsAMapping:       true,
+			StorageTableName: "maker.dai_nonces",
+			Repository:       &repository,
+			Metadata: utils.StorageValueMetadata{
+				Name: dai.Nonces,
+				Keys: map[utils.Key]string{constants.Guy: FakeAddress},
+				Type: utils.Uint256,
+			},
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   mcdStorage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repository,
+			Metadata:         mcdStorage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+})
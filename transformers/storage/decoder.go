@@ -0,0 +1,113 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+)
+
+// Packed item types that vulcanizedb's decoder doesn't support. They start well past utils.PackedSlot so they
+// can't collide with the upstream types.
+const (
+	Uint16 utils.ValueType = iota + 100
+	Uint64
+)
+
+const (
+	bitsPerByte  = 8
+	addressBytes = 20
+)
+
+type ErrUnsupportedValueType struct {
+	Type utils.ValueType
+}
+
+func (e ErrUnsupportedValueType) Error() string {
+	return fmt.Sprintf("can't decode storage value of unsupported type: %d", e.Type)
+}
+
+type ErrPackedSlotOverflow struct {
+	Position int
+}
+
+func (e ErrPackedSlotOverflow) Error() string {
+	return fmt.Sprintf("packed item at position %d doesn't fit in the storage slot", e.Position)
+}
+
+// Decode behaves like utils.Decode, except packed slots may also hold Uint16 and Uint64 items and unknown types
+// return an error rather than panicking.
+func Decode(diff utils.StorageDiff, metadata utils.StorageValueMetadata) (interface{}, error) {
+	switch metadata.Type {
+	case utils.Uint256, utils.Uint48, utils.Uint128, utils.Address, utils.Bytes32:
+		return utils.Decode(diff, metadata)
+	case utils.PackedSlot:
+		return decodePackedSlot(diff.StorageValue.Bytes(), metadata.PackedTypes)
+	default:
+		return nil, ErrUnsupportedValueType{Type: metadata.Type}
+	}
+}
+
+// Packed items are laid out from the low-order end of the slot, in position order
+func decodePackedSlot(raw []byte, packedTypes map[int]utils.ValueType) (map[int]string, error) {
+	remaining := raw
+	decoded := make(map[int]string, len(packedTypes))
+	for position := 0; position < len(packedTypes); position++ {
+		itemType, ok := packedTypes[position]
+		if !ok {
+			return nil, utils.ErrMetadataMalformed{MissingData: utils.Key(fmt.Sprintf("packed type %d", position))}
+		}
+		length, lengthErr := getNumberOfBytes(itemType)
+		if lengthErr != nil {
+			return nil, lengthErr
+		}
+		if length > len(remaining) {
+			return nil, ErrPackedSlotOverflow{Position: position}
+		}
+		start := len(remaining) - length
+		decoded[position] = decodeItem(remaining[start:], itemType)
+		remaining = remaining[:start]
+	}
+	return decoded, nil
+}
+
+func decodeItem(raw []byte, itemType utils.ValueType) string {
+	if itemType == utils.Address {
+		return common.BytesToAddress(raw).Hex()
+	}
+	return new(big.Int).SetBytes(raw).String()
+}
+
+func getNumberOfBytes(itemType utils.ValueType) (int, error) {
+	switch itemType {
+	case Uint16:
+		return 16 / bitsPerByte, nil
+	case utils.Uint48:
+		return 48 / bitsPerByte, nil
+	case Uint64:
+		return 64 / bitsPerByte, nil
+	case utils.Uint128:
+		return 128 / bitsPerByte, nil
+	case utils.Address:
+		return addressBytes, nil
+	default:
+		return 0, ErrUnsupportedValueType{Type: itemType}
+	}
+}
@@ -0,0 +1,82 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+)
+
+var _ = Describe("Storage decoder", func() {
+	It("decodes unpacked values like the vulcanizedb decoder", func() {
+		diff := utils.StorageDiff{StorageValue: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000539")}
+		metadata := utils.GetStorageValueMetadata("fake", nil, utils.Uint256)
+
+		result, err := storage.Decode(diff, metadata)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("1337"))
+	})
+
+	It("decodes a packed slot holding uint16 and uint64 items", func() {
+		// src = 0x0123456789abcdef000000000000000000000000, hop = 3600, zzz = 1570000000
+		diff := utils.StorageDiff{StorageValue: common.HexToHash("0x0000000000005d944c800e100123456789abcdef000000000000000000000000")}
+		packedNames := map[int]string{0: "src", 1: "hop", 2: "zzz"}
+		packedTypes := map[int]utils.ValueType{0: utils.Address, 1: storage.Uint16, 2: storage.Uint64}
+		metadata := utils.GetStorageValueMetadataForPackedSlot(storage.Packed, nil, utils.PackedSlot, packedNames, packedTypes)
+
+		result, err := storage.Decode(diff, metadata)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(map[int]string{
+			0: common.HexToAddress("0x0123456789abcdef000000000000000000000000").Hex(),
+			1: "3600",
+			2: "1570000000",
+		}))
+	})
+
+	It("returns an error if a packed item type is not supported", func() {
+		packedNames := map[int]string{0: "fake"}
+		packedTypes := map[int]utils.ValueType{0: utils.Bytes32}
+		metadata := utils.GetStorageValueMetadataForPackedSlot(storage.Packed, nil, utils.PackedSlot, packedNames, packedTypes)
+
+		_, err := storage.Decode(utils.StorageDiff{}, metadata)
+
+		Expect(err).To(MatchError(storage.ErrUnsupportedValueType{Type: utils.Bytes32}))
+	})
+
+	It("returns an error if the packed items don't fit in the slot", func() {
+		packedNames := map[int]string{0: "first", 1: "second", 2: "third"}
+		packedTypes := map[int]utils.ValueType{0: utils.Uint128, 1: utils.Uint128, 2: storage.Uint16}
+		metadata := utils.GetStorageValueMetadataForPackedSlot(storage.Packed, nil, utils.PackedSlot, packedNames, packedTypes)
+
+		_, err := storage.Decode(utils.StorageDiff{}, metadata)
+
+		Expect(err).To(MatchError(storage.ErrPackedSlotOverflow{Position: 2}))
+	})
+
+	It("returns an error rather than panicking for an unknown value type", func() {
+		metadata := utils.GetStorageValueMetadata("fake", nil, storage.Uint16)
+
+		_, err := storage.Decode(utils.StorageDiff{}, metadata)
+
+		Expect(err).To(MatchError(storage.ErrUnsupportedValueType{Type: storage.Uint16}))
+	})
+})
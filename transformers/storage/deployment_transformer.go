@@ -0,0 +1,69 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+// DeploymentTransformer limits a storage transformer to the diffs from the block range its deployment was live in
+type DeploymentTransformer struct {
+	Deployment  constants.ContractDeployment
+	Transformer transformer.StorageTransformer
+}
+
+func (t DeploymentTransformer) KeccakContractAddress() common.Hash {
+	return t.Transformer.KeccakContractAddress()
+}
+
+func (t DeploymentTransformer) Execute(diff utils.StorageDiff) error {
+	if !t.Deployment.IsLiveAt(int64(diff.BlockHeight)) {
+		return nil
+	}
+	return t.Transformer.Execute(diff)
+}
+
+// GenerateDeploymentInitializer builds the storage transformer for the current deployment of a contract. The deployment
+// is looked up when the transformer is initialized, and generate builds the keys lookup and repository for its address.
+func GenerateDeploymentInitializer(contractName string, generate func(contractAddress string) transformer.StorageTransformerInitializer) transformer.StorageTransformerInitializer {
+	return func(db *postgres.DB) transformer.StorageTransformer {
+		return generateForDeployment(constants.GetContractDeployments(contractName)[0], generate)(db)
+	}
+}
+
+// GeneratePreviousDeploymentInitializers builds a storage transformer for each deployment of a contract configured under
+// previous, since transformers are registered per address
+func GeneratePreviousDeploymentInitializers(contractName string, generate func(contractAddress string) transformer.StorageTransformerInitializer) []transformer.StorageTransformerInitializer {
+	var initializers []transformer.StorageTransformerInitializer
+	for _, deployment := range constants.GetContractDeployments(contractName)[1:] {
+		initializers = append(initializers, generateForDeployment(deployment, generate))
+	}
+	return initializers
+}
+
+func generateForDeployment(deployment constants.ContractDeployment, generate func(contractAddress string) transformer.StorageTransformerInitializer) transformer.StorageTransformerInitializer {
+	return func(db *postgres.DB) transformer.StorageTransformer {
+		return DeploymentTransformer{
+			Deployment:  deployment,
+			Transformer: generate(deployment.Address)(db),
+		}
+	}
+}
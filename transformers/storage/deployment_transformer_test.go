@@ -0,0 +1,131 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage_test
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/mocks"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+var _ = Describe("Deployment transformer", func() {
+	var (
+		mockTransformer       *mocks.MockStorageTransformer
+		deploymentTransformer storage.DeploymentTransformer
+	)
+
+	BeforeEach(func() {
+		mockTransformer = &mocks.MockStorageTransformer{KeccakOfAddress: common.HexToHash("0x12345")}
+		deploymentTransformer = storage.DeploymentTransformer{
+			Deployment:  constants.ContractDeployment{Deployed: 100, Retired: 199},
+			Transformer: mockTransformer,
+		}
+	})
+
+	It("returns the wrapped transformer's hashed address", func() {
+		Expect(deploymentTransformer.KeccakContractAddress()).To(Equal(common.HexToHash("0x12345")))
+	})
+
+	It("executes diffs from while the deployment was live", func() {
+		diff := utils.StorageDiff{BlockHeight: 150}
+		mockTransformer.ExecuteErr = errors.New("execute error")
+
+		err := deploymentTransformer.Execute(diff)
+
+		Expect(err).To(MatchError("execute error"))
+		Expect(mockTransformer.PassedDiff).To(Equal(diff))
+	})
+
+	It("skips diffs from outside the deployment's block range", func() {
+		mockTransformer.ExecuteErr = errors.New("execute error")
+
+		Expect(deploymentTransformer.Execute(utils.StorageDiff{BlockHeight: 99})).To(Succeed())
+		Expect(deploymentTransformer.Execute(utils.StorageDiff{BlockHeight: 200})).To(Succeed())
+		Expect(mockTransformer.PassedDiff).To(Equal(utils.StorageDiff{}))
+	})
+
+	Describe("generating initializers from config", func() {
+		var (
+			currentAddress   = "0x1C3e3d5E4f4F9E3B7C2dA2B5C8E1f0a2b3C4d5E6"
+			previousAddress  = "0xA1b2C3d4E5f60718293a4B5c6D7e8F9011223344"
+			generatedAddress string
+			generate         func(contractAddress string) transformer.StorageTransformerInitializer
+		)
+
+		BeforeEach(func() {
+			viper.Set("contract.TEST_STORAGE_REDEPLOYED.address", currentAddress)
+			viper.Set("contract.TEST_STORAGE_REDEPLOYED.abi", "[current]")
+			viper.Set("contract.TEST_STORAGE_REDEPLOYED.deployed", 200)
+			viper.Set("contract.TEST_STORAGE_REDEPLOYED.previous", []map[string]interface{}{{
+				"address":  previousAddress,
+				"abi":      "[previous]",
+				"deployed": 100,
+				"retired":  199,
+			}})
+			generatedAddress = ""
+			generate = func(contractAddress string) transformer.StorageTransformerInitializer {
+				generatedAddress = contractAddress
+				return mockTransformer.FakeTransformerInitializer
+			}
+		})
+
+		It("generates the transformer for the current deployment", func() {
+			generated := storage.GenerateDeploymentInitializer("TEST_STORAGE_REDEPLOYED", generate)(&postgres.DB{})
+
+			Expect(generatedAddress).To(Equal(currentAddress))
+			Expect(generated).To(Equal(storage.DeploymentTransformer{
+				Deployment: constants.ContractDeployment{
+					Address:  currentAddress,
+					Abi:      "[current]",
+					Deployed: 200,
+					Retired:  constants.NotRetired,
+				},
+				Transformer: mockTransformer,
+			}))
+		})
+
+		It("generates a transformer for each previous deployment", func() {
+			initializers := storage.GeneratePreviousDeploymentInitializers("TEST_STORAGE_REDEPLOYED", generate)
+
+			Expect(len(initializers)).To(Equal(1))
+			generated := initializers[0](&postgres.DB{})
+			Expect(generatedAddress).To(Equal(previousAddress))
+			Expect(generated).To(Equal(storage.DeploymentTransformer{
+				Deployment: constants.ContractDeployment{
+					Address:  previousAddress,
+					Abi:      "[previous]",
+					Deployed: 100,
+					Retired:  199,
+				},
+				Transformer: mockTransformer,
+			}))
+		})
+
+		It("generates no previous transformers for a contract that hasn't been redeployed", func() {
+			Expect(storage.GeneratePreviousDeploymentInitializers("MCD_CAT", generate)).To(BeEmpty())
+		})
+	})
+})
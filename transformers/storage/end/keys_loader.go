@@ -0,0 +1,174 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package end
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	Live   = "live"
+	When   = "when"
+	Wait   = "wait"
+	Debt   = "debt"
+	IlkTag = "tag"
+	IlkGap = "gap"
+	IlkArt = "Art"
+	IlkFix = "fix"
+	Bag    = "bag"
+	Out    = "out"
+)
+
+var (
+	LiveKey      = common.HexToHash(utils.IndexFive)
+	LiveMetadata = utils.GetStorageValueMetadata(Live, nil, utils.Uint256)
+
+	WhenKey      = common.HexToHash(utils.IndexSix)
+	WhenMetadata = utils.GetStorageValueMetadata(When, nil, utils.Uint256)
+
+	WaitKey      = common.HexToHash(utils.IndexSeven)
+	WaitMetadata = utils.GetStorageValueMetadata(Wait, nil, utils.Uint256)
+
+	DebtKey      = common.HexToHash(utils.IndexEight)
+	DebtMetadata = utils.GetStorageValueMetadata(Debt, nil, utils.Uint256)
+
+	TagMappingIndex = utils.IndexNine
+	GapMappingIndex = utils.IndexTen
+	ArtMappingIndex = utils.IndexEleven
+	FixMappingIndex = mcdStorage.IndexTwelve
+	BagMappingIndex = mcdStorage.IndexThirteen
+	OutMappingIndex = mcdStorage.IndexFourteen
+)
+
+type keysLoader struct {
+	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
+}
+
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) storage.KeysLoader {
+	return &keysLoader{
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}
+}
+
+func (loader *keysLoader) SetDB(db *postgres.DB) {
+	loader.storageRepository.SetDB(db)
+}
+
+func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
+	mappings := loadStaticMappings()
+	mappings, ilkErr := loader.addIlkKeys(mappings)
+	if ilkErr != nil {
+		return nil, ilkErr
+	}
+	mappings, bagErr := loader.addBagKeys(mappings)
+	if bagErr != nil {
+		return nil, bagErr
+	}
+	mappings, outErr := loader.addOutKeys(mappings)
+	if outErr != nil {
+		return nil, outErr
+	}
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
+}
+
+func loadStaticMappings() map[common.Hash]utils.StorageValueMetadata {
+	mappings := make(map[common.Hash]utils.StorageValueMetadata)
+	mappings[LiveKey] = LiveMetadata
+	mappings[WhenKey] = WhenMetadata
+	mappings[WaitKey] = WaitMetadata
+	mappings[DebtKey] = DebtMetadata
+	return mappings
+}
+
+func (loader *keysLoader) addIlkKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	ilks, err := loader.storageRepository.GetIlks()
+	if err != nil {
+		return nil, err
+	}
+	for _, ilk := range ilks {
+		mappings[getIlkKey(TagMappingIndex, ilk)] = getIlkMetadata(IlkTag, ilk)
+		mappings[getIlkKey(GapMappingIndex, ilk)] = getIlkMetadata(IlkGap, ilk)
+		mappings[getIlkKey(ArtMappingIndex, ilk)] = getIlkMetadata(IlkArt, ilk)
+		mappings[getIlkKey(FixMappingIndex, ilk)] = getIlkMetadata(IlkFix, ilk)
+	}
+	return mappings, nil
+}
+
+func (loader *keysLoader) addBagKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	bagKeys, err := loader.storageRepository.GetEndBagKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range bagKeys {
+		paddedUser, padErr := utilities.PadAddress(user)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getBagKey(paddedUser)] = getBagMetadata(user)
+	}
+	return mappings, nil
+}
+
+func (loader *keysLoader) addOutKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	outKeys, err := loader.storageRepository.GetEndOutKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, out := range outKeys {
+		paddedUser, padErr := utilities.PadAddress(out.Identifier)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getOutKey(out.Ilk, paddedUser)] = getOutMetadata(out.Ilk, out.Identifier)
+	}
+	return mappings, nil
+}
+
+func getIlkKey(index, ilk string) common.Hash {
+	return utils.GetStorageKeyForMapping(index, ilk)
+}
+
+func getIlkMetadata(name, ilk string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Ilk: ilk}
+	return utils.GetStorageValueMetadata(name, keys, utils.Uint256)
+}
+
+func getBagKey(paddedUser string) common.Hash {
+	return utils.GetStorageKeyForMapping(BagMappingIndex, paddedUser)
+}
+
+func getBagMetadata(user string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.MsgSender: user}
+	return utils.GetStorageValueMetadata(Bag, keys, utils.Uint256)
+}
+
+func getOutKey(ilk, paddedUser string) common.Hash {
+	return utils.GetStorageKeyForNestedMapping(OutMappingIndex, ilk, paddedUser)
+}
+
+func getOutMetadata(ilk, user string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Ilk: ilk, constants.MsgSender: user}
+	return utils.GetStorageValueMetadata(Out, keys, utils.Uint256)
+}
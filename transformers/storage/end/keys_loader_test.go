@@ -0,0 +1,221 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package end_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/end"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	storageFactory "github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("End storage keys loader", func() {
+	var (
+		storageRepository *test_helpers.MockMakerStorageRepository
+		storageKeysLoader storageFactory.KeysLoader
+	)
+
+	BeforeEach(func() {
+		storageRepository = &test_helpers.MockMakerStorageRepository{}
+		storageKeysLoader = end.NewKeysLoader(storageRepository, test_helpers.FakeAddress)
+	})
+
+	It("returns value metadata for static keys", func() {
+		mappings, err := storageKeysLoader.LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings[end.LiveKey]).To(Equal(end.LiveMetadata))
+		Expect(mappings[end.WhenKey]).To(Equal(end.WhenMetadata))
+		Expect(mappings[end.WaitKey]).To(Equal(end.WaitMetadata))
+		Expect(mappings[end.DebtKey]).To(Equal(end.DebtMetadata))
+	})
+
+	Describe("ilk mappings", func() {
+		It("returns error if getting ilks fails", func() {
+			storageRepository.GetIlksError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+
+		Describe("when getting ilks succeeds", func() {
+			var (
+				ilk      = test_helpers.FakeIlk
+				mappings map[common.Hash]utils.StorageValueMetadata
+			)
+
+			BeforeEach(func() {
+				storageRepository.Ilks = []string{ilk}
+				var err error
+				mappings, err = storageKeysLoader.LoadMappings()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storageRepository.GetIlksCalled).To(BeTrue())
+			})
+
+			It("returns value metadata for tag", func() {
+				tagKey := common.BytesToHash(crypto.Keccak256(common.FromHex(ilk + end.TagMappingIndex)))
+				expectedMetadata := utils.StorageValueMetadata{
+					Name: end.IlkTag,
+					Keys: map[utils.Key]string{constants.Ilk: ilk},
+					Type: utils.Uint256,
+				}
+				Expect(mappings[tagKey]).To(Equal(expectedMetadata))
+			})
+
+			It("returns value metadata for gap", func() {
+				gapKey := common.BytesToHash(crypto.Keccak256(common.FromHex(ilk + end.GapMappingIndex)))
+				expectedMetadata := utils.StorageValueMetadata{
+					Name: end.IlkGap,
+					Keys: map[utils.Key]string{constants.Ilk: ilk},
+					Type: utils.Uint256,
+				}
+				Expect(mappings[gapKey]).To(Equal(expectedMetadata))
+			})
+
+			It("returns value metadata for Art", func() {
+				artKey := common.BytesToHash(crypto.Keccak256(common.FromHex(ilk + end.ArtMappingIndex)))
+				expectedMetadata := utils.StorageValueMetadata{
+					Name: end.IlkArt,
+					Keys: map[utils.Key]string{constants.Ilk: ilk},
+					Type: utils.Uint256,
+				}
+				Expect(mappings[artKey]).To(Equal(expectedMetadata))
+			})
+
+			It("returns value metadata for fix", func() {
+				fixKey := common.BytesToHash(crypto.Keccak256(common.FromHex(ilk + end.FixMappingIndex)))
+				expectedMetadata := utils.StorageValueMetadata{
+					Name: end.IlkFix,
+					Keys: map[utils.Key]string{constants.Ilk: ilk},
+					Type: utils.Uint256,
+				}
+				Expect(mappings[fixKey]).To(Equal(expectedMetadata))
+			})
+		})
+	})
+
+	Describe("bag", func() {
+		It("returns error if getting bag keys fails", func() {
+			storageRepository.GetEndBagKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+
+		It("returns value metadata for bag", func() {
+			user := test_helpers.FakeAddress
+			storageRepository.EndBagKeys = []string{user}
+			paddedUser, padErr := utilities.PadAddress(user)
+			Expect(padErr).NotTo(HaveOccurred())
+			bagKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedUser + end.BagMappingIndex)))
+			expectedMetadata := utils.StorageValueMetadata{
+				Name: end.Bag,
+				Keys: map[utils.Key]string{constants.MsgSender: user},
+				Type: utils.Uint256,
+			}
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetEndBagKeysCalled).To(BeTrue())
+			Expect(mappings[bagKey]).To(Equal(expectedMetadata))
+		})
+
+		It("returns error if bag address is invalid", func() {
+			storageRepository.EndBagKeys = []string{"0xinvalid"}
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("out", func() {
+		It("returns error if getting out keys fails", func() {
+			storageRepository.GetEndOutKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+
+		It("returns value metadata for out", func() {
+			ilk := test_helpers.FakeIlk
+			user := test_helpers.FakeAddress
+			storageRepository.EndOutKeys = []storage.Urn{{Ilk: ilk, Identifier: user}}
+			encodedPrimaryMapIndex := crypto.Keccak256(common.FromHex(ilk + end.OutMappingIndex))
+			paddedUser := common.FromHex("0x000000000000000000000000" + user[2:])
+			outKey := common.BytesToHash(crypto.Keccak256(paddedUser, encodedPrimaryMapIndex))
+			expectedMetadata := utils.StorageValueMetadata{
+				Name: end.Out,
+				Keys: map[utils.Key]string{constants.Ilk: ilk, constants.MsgSender: user},
+				Type: utils.Uint256,
+			}
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetEndOutKeysCalled).To(BeTrue())
+			Expect(mappings[outKey]).To(Equal(expectedMetadata))
+		})
+
+		It("returns error if out address is invalid", func() {
+			storageRepository.EndOutKeys = []storage.Urn{{Ilk: test_helpers.FakeIlk, Identifier: "0xinvalid"}}
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("wards", func() {
+		It("returns value metadata for wards", func() {
+			wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+			storageRepository.WardsKeys = []string{wardsUser}
+			paddedWardsUser := "0x000000000000000000000000" + wardsUser[2:]
+			wardsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedWardsUser + storage.WardsMappingIndex)))
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetWardsKeysCalledWith).To(Equal(test_helpers.FakeAddress))
+			Expect(mappings[wardsKey]).To(Equal(storage.GetWardsMetadata(wardsUser)))
+		})
+
+		It("returns error if wards keys lookup fails", func() {
+			storageRepository.GetWardsKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package end
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	insertLiveQuery   = `INSERT INTO maker.end_live (block_number, block_hash, live) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertWhenQuery   = `INSERT INTO maker.end_when (block_number, block_hash, "when") VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertWaitQuery   = `INSERT INTO maker.end_wait (block_number, block_hash, wait) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertDebtQuery   = `INSERT INTO maker.end_debt (block_number, block_hash, debt) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertIlkTagQuery = `INSERT INTO maker.end_ilk_tag (block_number, block_hash, ilk_id, tag) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertIlkGapQuery = `INSERT INTO maker.end_ilk_gap (block_number, block_hash, ilk_id, gap) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertIlkArtQuery = `INSERT INTO maker.end_ilk_art (block_number, block_hash, ilk_id, art) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertIlkFixQuery = `INSERT INTO maker.end_ilk_fix (block_number, block_hash, ilk_id, fix) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertBagQuery    = `INSERT INTO maker.end_bag (block_number, block_hash, msg_sender, bag) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertOutQuery    = `INSERT INTO maker.end_out (block_number, block_hash, ilk_id, msg_sender, out) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+)
+
+type EndStorageRepository struct {
+	ContractAddress string
+	db              *postgres.DB
+}
+
+func (repository *EndStorageRepository) SetDB(db *postgres.DB) {
+	repository.db = db
+}
+
+func (repository EndStorageRepository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	switch metadata.Name {
+	case Live:
+		return repository.insertVariable(blockNumber, blockHash, insertLiveQuery, value.(string))
+	case When:
+		return repository.insertVariable(blockNumber, blockHash, insertWhenQuery, value.(string))
+	case Wait:
+		return repository.insertVariable(blockNumber, blockHash, insertWaitQuery, value.(string))
+	case Debt:
+		return repository.insertVariable(blockNumber, blockHash, insertDebtQuery, value.(string))
+	case IlkTag:
+		return repository.insertIlkField(blockNumber, blockHash, metadata, IlkTag, insertIlkTagQuery, value.(string))
+	case IlkGap:
+		return repository.insertIlkField(blockNumber, blockHash, metadata, IlkGap, insertIlkGapQuery, value.(string))
+	case IlkArt:
+		return repository.insertIlkField(blockNumber, blockHash, metadata, IlkArt, insertIlkArtQuery, value.(string))
+	case IlkFix:
+		return repository.insertIlkField(blockNumber, blockHash, metadata, IlkFix, insertIlkFixQuery, value.(string))
+	case Bag:
+		return repository.insertBag(blockNumber, blockHash, metadata, value.(string))
+	case Out:
+		return repository.insertOut(blockNumber, blockHash, metadata, value.(string))
+	case mcdStorage.Wards:
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
+	default:
+		return fmt.Errorf("unrecognized end contract storage name: %s", metadata.Name)
+	}
+}
+
+func (repository EndStorageRepository) insertVariable(blockNumber int, blockHash, query, value string) error {
+	_, err := repository.db.Exec(query, blockNumber, blockHash, value)
+	return err
+}
+
+func (repository EndStorageRepository) insertIlkField(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, variableName, query, value string) error {
+	ilk, keyErr := getIlk(metadata.Keys)
+	if keyErr != nil {
+		return keyErr
+	}
+	tx, txErr := repository.db.Beginx()
+	if txErr != nil {
+		return txErr
+	}
+	ilkID, ilkErr := shared.GetOrCreateIlkInTransaction(ilk, tx)
+	if ilkErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError("ilk", ilkErr.Error())
+		}
+		return ilkErr
+	}
+	_, writeErr := tx.Exec(query, blockNumber, blockHash, ilkID, value)
+	if writeErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError(variableName, writeErr.Error())
+		}
+		return writeErr
+	}
+	return tx.Commit()
+}
+
+func (repository EndStorageRepository) insertBag(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, bag string) error {
+	msgSender, keyErr := getMsgSender(metadata.Keys)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertBagQuery, blockNumber, blockHash, msgSender, bag)
+	return writeErr
+}
+
+func (repository EndStorageRepository) insertOut(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, out string) error {
+	ilk, ilkKeyErr := getIlk(metadata.Keys)
+	if ilkKeyErr != nil {
+		return ilkKeyErr
+	}
+	msgSender, senderKeyErr := getMsgSender(metadata.Keys)
+	if senderKeyErr != nil {
+		return senderKeyErr
+	}
+	tx, txErr := repository.db.Beginx()
+	if txErr != nil {
+		return txErr
+	}
+	ilkID, ilkErr := shared.GetOrCreateIlkInTransaction(ilk, tx)
+	if ilkErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError("ilk", ilkErr.Error())
+		}
+		return ilkErr
+	}
+	_, writeErr := tx.Exec(insertOutQuery, blockNumber, blockHash, ilkID, msgSender, out)
+	if writeErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError(Out, writeErr.Error())
+		}
+		return writeErr
+	}
+	return tx.Commit()
+}
+
+func getIlk(keys map[utils.Key]string) (string, error) {
+	ilk, ok := keys[constants.Ilk]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: constants.Ilk}
+	}
+	return ilk, nil
+}
+
+func getMsgSender(keys map[utils.Key]string) (string, error) {
+	msgSender, ok := keys[constants.MsgSender]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: constants.MsgSender}
+	}
+	return msgSender, nil
+}
@@ -0,0 +1,247 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package end_test
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/end"
+	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("End storage repository", func() {
+	var (
+		db              *postgres.DB
+		repository      end.EndStorageRepository
+		fakeBlockNumber int
+		fakeHash        string
+		fakeUint256     = strconv.Itoa(rand.Int())
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		repository = end.EndStorageRepository{ContractAddress: FakeAddress}
+		repository.SetDB(db)
+		fakeBlockNumber = rand.Int()
+		fakeHash = fakes.FakeHash.Hex()
+	})
+
+	It("returns an error if the metadata name is not recognized", func() {
+		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
+
+		err := repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized end contract storage name: unrecognized"))
+	})
+
+	Describe("live", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   end.Live,
+			Value:            fakeUint256,
+			StorageTableName: "maker.end_live",
+			Repository:       &repository,
+			Metadata:         end.LiveMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("when", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   `"when"`,
+			Value:            fakeUint256,
+			StorageTableName: "maker.end_when",
+			Repository:       &repository,
+			Metadata:         end.WhenMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("wait", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   end.Wait,
+			Value:            fakeUint256,
+			StorageTableName: "maker.end_wait",
+			Repository:       &repository,
+			Metadata:         end.WaitMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("debt", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   end.Debt,
+			Value:            fakeUint256,
+			StorageTableName: "maker.end_debt",
+			Repository:       &repository,
+			Metadata:         end.DebtMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("ilk mappings", func() {
+		ilkFields := []struct {
+			name, table, column string
+		}{
+			{end.IlkTag, "maker.end_ilk_tag", "tag"},
+			{end.IlkGap, "maker.end_ilk_gap", "gap"},
+			{end.IlkArt, "maker.end_ilk_art", "art"},
+			{end.IlkFix, "maker.end_ilk_fix", "fix"},
+		}
+
+		for _, field := range ilkFields {
+			field := field
+
+			Describe(field.name, func() {
+				It("writes a row", func() {
+					metadata := utils.GetStorageValueMetadata(field.name, map[utils.Key]string{constants.Ilk: FakeIlk}, utils.Uint256)
+
+					err := repository.Create(fakeBlockNumber, fakeHash, metadata, fakeUint256)
+
+					Expect(err).NotTo(HaveOccurred())
+					var result MappingRes
+					err = db.Get(&result, `SELECT block_number, block_hash, ilk_id AS key, `+field.column+` AS value FROM `+field.table)
+					Expect(err).NotTo(HaveOccurred())
+					ilkID, ilkErr := shared.GetOrCreateIlk(FakeIlk, db)
+					Expect(ilkErr).NotTo(HaveOccurred())
+					AssertMapping(result, fakeBlockNumber, fakeHash, strconv.FormatInt(ilkID, 10), fakeUint256)
+				})
+
+				It("does not duplicate row", func() {
+					metadata := utils.GetStorageValueMetadata(field.name, map[utils.Key]string{constants.Ilk: FakeIlk}, utils.Uint256)
+					insertOneErr := repository.Create(fakeBlockNumber, fakeHash, metadata, fakeUint256)
+					Expect(insertOneErr).NotTo(HaveOccurred())
+
+					insertTwoErr := repository.Create(fakeBlockNumber, fakeHash, metadata, fakeUint256)
+
+					Expect(insertTwoErr).NotTo(HaveOccurred())
+					var count int
+					getCountErr := db.Get(&count, `SELECT count(*) FROM `+field.table)
+					Expect(getCountErr).NotTo(HaveOccurred())
+					Expect(count).To(Equal(1))
+				})
+
+				It("returns an error if metadata missing ilk", func() {
+					malformedMetadata := utils.GetStorageValueMetadata(field.name, nil, utils.Uint256)
+
+					err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeUint256)
+					Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Ilk}))
+				})
+			})
+		}
+	})
+
+	Describe("bag", func() {
+		It("returns an error if metadata is missing the user", func() {
+			badMetadata := utils.GetStorageValueMetadata(end.Bag, map[utils.Key]string{}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.MsgSender}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "msg_sender",
+			ValueFieldName:   end.Bag,
+			Key:              FakeAddress,
+			Value:            fakeUint256,
+			IsAMapping:       true,
+			StorageTableName: "maker.end_bag",
+			Repository:       &repository,
+			Metadata: utils.GetStorageValueMetadata(end.Bag,
+				map[utils.Key]string{constants.MsgSender: FakeAddress}, utils.Uint256),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("out", func() {
+		var outMetadata = utils.GetStorageValueMetadata(end.Out,
+			map[utils.Key]string{constants.Ilk: FakeIlk, constants.MsgSender: FakeAddress}, utils.Uint256)
+
+		It("writes a row", func() {
+			err := repository.Create(fakeBlockNumber, fakeHash, outMetadata, fakeUint256)
+
+			Expect(err).NotTo(HaveOccurred())
+			var result DoubleMappingRes
+			err = db.Get(&result, `SELECT block_number, block_hash, ilk_id AS key_one, msg_sender AS key_two, out AS value FROM maker.end_out`)
+			Expect(err).NotTo(HaveOccurred())
+			ilkID, ilkErr := shared.GetOrCreateIlk(FakeIlk, db)
+			Expect(ilkErr).NotTo(HaveOccurred())
+			AssertDoubleMapping(result, fakeBlockNumber, fakeHash, strconv.FormatInt(ilkID, 10), FakeAddress, fakeUint256)
+		})
+
+		It("does not duplicate row", func() {
+			insertOneErr := repository.Create(fakeBlockNumber, fakeHash, outMetadata, fakeUint256)
+			Expect(insertOneErr).NotTo(HaveOccurred())
+
+			insertTwoErr := repository.Create(fakeBlockNumber, fakeHash, outMetadata, fakeUint256)
+
+			Expect(insertTwoErr).NotTo(HaveOccurred())
+			var count int
+			getCountErr := db.Get(&count, `SELECT count(*) FROM maker.end_out`)
+			Expect(getCountErr).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("returns an error if metadata missing ilk", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(end.Out,
+				map[utils.Key]string{constants.MsgSender: FakeAddress}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Ilk}))
+		})
+
+		It("returns an error if metadata missing user", func() {
+			malformedMetadata := utils.GetStorageValueMetadata(end.Out,
+				map[utils.Key]string{constants.Ilk: FakeIlk}, utils.Uint256)
+
+			err := repository.Create(fakeBlockNumber, fakeHash, malformedMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.MsgSender}))
+		})
+	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   mcdStorage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repository,
+			Metadata:         mcdStorage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+})
@@ -0,0 +1,95 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package esm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	Min     = "min"
+	Fired   = "fired"
+	UserSum = "sum"
+	Sum     = "Sum"
+)
+
+var (
+	MinKey      = common.HexToHash(utils.IndexThree)
+	MinMetadata = utils.GetStorageValueMetadata(Min, nil, utils.Uint256)
+
+	FiredKey      = common.HexToHash(utils.IndexFour)
+	FiredMetadata = utils.GetStorageValueMetadata(Fired, nil, utils.Uint256)
+
+	UserSumIndex = utils.IndexFive
+
+	SumKey      = common.HexToHash(utils.IndexSix)
+	SumMetadata = utils.GetStorageValueMetadata(Sum, nil, utils.Uint256)
+)
+
+type keysLoader struct {
+	storageRepository mcdStorage.IMakerStorageRepository
+}
+
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository) storage.KeysLoader {
+	return &keysLoader{storageRepository: storageRepository}
+}
+
+func (loader *keysLoader) SetDB(db *postgres.DB) {
+	loader.storageRepository.SetDB(db)
+}
+
+func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
+	mappings := loadStaticMappings()
+	return loader.loadUserSumKeys(mappings)
+}
+
+func (loader *keysLoader) loadUserSumKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	users, err := loader.storageRepository.GetEsmSumUsers()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		paddedUser, padErr := utilities.PadAddress(user)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getUserSumKey(paddedUser)] = getUserSumMetadata(user)
+	}
+	return mappings, nil
+}
+
+func loadStaticMappings() map[common.Hash]utils.StorageValueMetadata {
+	mappings := make(map[common.Hash]utils.StorageValueMetadata)
+	mappings[MinKey] = MinMetadata
+	mappings[FiredKey] = FiredMetadata
+	mappings[SumKey] = SumMetadata
+	return mappings
+}
+
+func getUserSumKey(paddedUser string) common.Hash {
+	return utils.GetStorageKeyForMapping(UserSumIndex, paddedUser)
+}
+
+func getUserSumMetadata(user string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.MsgSender: user}
+	return utils.GetStorageValueMetadata(UserSum, keys, utils.Uint256)
+}
@@ -0,0 +1,93 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package esm_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/esm"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("ESM storage keys loader", func() {
+	var (
+		storageRepository *test_helpers.MockMakerStorageRepository
+		storageKeysLoader storage.KeysLoader
+	)
+
+	BeforeEach(func() {
+		storageRepository = &test_helpers.MockMakerStorageRepository{}
+		storageKeysLoader = esm.NewKeysLoader(storageRepository)
+	})
+
+	It("returns value metadata for static keys", func() {
+		mappings, err := storageKeysLoader.LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings[esm.MinKey]).To(Equal(esm.MinMetadata))
+		Expect(mappings[esm.FiredKey]).To(Equal(esm.FiredMetadata))
+		Expect(mappings[esm.SumKey]).To(Equal(esm.SumMetadata))
+	})
+
+	Describe("sum", func() {
+		Describe("when getting users fails", func() {
+			It("returns error", func() {
+				storageRepository.GetEsmSumUsersError = fakes.FakeError
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(MatchError(fakes.FakeError))
+			})
+		})
+
+		Describe("when getting users succeeds", func() {
+			It("returns value metadata for user sum", func() {
+				user := test_helpers.FakeAddress
+				storageRepository.EsmSumUsers = []string{user}
+				paddedUser, padErr := utilities.PadAddress(user)
+				Expect(padErr).NotTo(HaveOccurred())
+				sumKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedUser + esm.UserSumIndex)))
+				expectedMetadata := utils.StorageValueMetadata{
+					Name: esm.UserSum,
+					Keys: map[utils.Key]string{constants.MsgSender: user},
+					Type: utils.Uint256,
+				}
+
+				mappings, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storageRepository.GetEsmSumUsersCalled).To(BeTrue())
+				Expect(mappings[sumKey]).To(Equal(expectedMetadata))
+			})
+
+			It("returns error if user address is invalid", func() {
+				storageRepository.EsmSumUsers = []string{"0xinvalid"}
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
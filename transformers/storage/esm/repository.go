@@ -0,0 +1,86 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package esm
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	insertMinQuery     = `INSERT INTO maker.esm_min (block_number, block_hash, min) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertFiredQuery   = `INSERT INTO maker.esm_fired (block_number, block_hash, fired) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertUserSumQuery = `INSERT INTO maker.esm_user_sum (block_number, block_hash, msg_sender, sum) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertSumQuery     = `INSERT INTO maker.esm_sum (block_number, block_hash, sum) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+)
+
+type EsmStorageRepository struct {
+	db *postgres.DB
+}
+
+func (repository *EsmStorageRepository) SetDB(db *postgres.DB) {
+	repository.db = db
+}
+
+func (repository EsmStorageRepository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	switch metadata.Name {
+	case Min:
+		return repository.insertMin(blockNumber, blockHash, value.(string))
+	case Fired:
+		return repository.insertFired(blockNumber, blockHash, value.(string))
+	case UserSum:
+		return repository.insertUserSum(blockNumber, blockHash, metadata, value.(string))
+	case Sum:
+		return repository.insertSum(blockNumber, blockHash, value.(string))
+	default:
+		return fmt.Errorf("unrecognized esm contract storage name: %s", metadata.Name)
+	}
+}
+
+func (repository EsmStorageRepository) insertMin(blockNumber int, blockHash string, min string) error {
+	_, err := repository.db.Exec(insertMinQuery, blockNumber, blockHash, min)
+	return err
+}
+
+func (repository EsmStorageRepository) insertFired(blockNumber int, blockHash string, fired string) error {
+	_, err := repository.db.Exec(insertFiredQuery, blockNumber, blockHash, fired)
+	return err
+}
+
+func (repository EsmStorageRepository) insertUserSum(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, sum string) error {
+	msgSender, keyErr := getMsgSender(metadata.Keys)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertUserSumQuery, blockNumber, blockHash, msgSender, sum)
+	return writeErr
+}
+
+func (repository EsmStorageRepository) insertSum(blockNumber int, blockHash string, sum string) error {
+	_, err := repository.db.Exec(insertSumQuery, blockNumber, blockHash, sum)
+	return err
+}
+
+func getMsgSender(keys map[utils.Key]string) (string, error) {
+	msgSender, ok := keys[constants.MsgSender]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: constants.MsgSender}
+	}
+	return msgSender, nil
+}
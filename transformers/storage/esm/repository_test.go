@@ -0,0 +1,124 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package esm_test
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/esm"
+	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("ESM storage repository", func() {
+	var (
+		db              *postgres.DB
+		repository      esm.EsmStorageRepository
+		fakeBlockNumber int
+		fakeHash        string
+		fakeUint256     = strconv.Itoa(rand.Int())
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		repository = esm.EsmStorageRepository{}
+		repository.SetDB(db)
+		fakeBlockNumber = rand.Int()
+		fakeHash = fakes.FakeHash.Hex()
+	})
+
+	It("returns an error if the metadata name is not recognized", func() {
+		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
+
+		err := repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized esm contract storage name: unrecognized"))
+	})
+
+	Describe("min", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   esm.Min,
+			Value:            fakeUint256,
+			StorageTableName: "maker.esm_min",
+			Repository:       &repository,
+			Metadata:         esm.MinMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("fired", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   esm.Fired,
+			Value:            "1",
+			StorageTableName: "maker.esm_fired",
+			Repository:       &repository,
+			Metadata:         esm.FiredMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("user sum", func() {
+		It("returns an error if metadata is missing the user", func() {
+			badMetadata := utils.StorageValueMetadata{
+				Name: esm.UserSum,
+				Keys: map[utils.Key]string{},
+				Type: utils.Uint256,
+			}
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.MsgSender}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "msg_sender",
+			ValueFieldName:   "sum",
+			Key:              FakeAddress,
+			Value:            fakeUint256,
+			IsAMapping:       true,
+			StorageTableName: "maker.esm_user_sum",
+			Repository:       &repository,
+			Metadata: utils.StorageValueMetadata{
+				Name: esm.UserSum,
+				Keys: map[utils.Key]string{constants.MsgSender: FakeAddress},
+				Type: utils.Uint256,
+			},
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("Sum", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   "sum",
+			Value:            fakeUint256,
+			StorageTableName: "maker.esm_sum",
+			Repository:       &repository,
+			Metadata:         esm.SumMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+})
@@ -1,5 +1,5 @@
 // VulcanizeDB
-// Copyright © 2019 Vulcanize
+// Copyright © 2018 Vulcanize
 
 // This program is free software: you can redistribute it and/or modify
 // it under the terms of the GNU Affero General Public License as published by
@@ -17,7 +17,6 @@
 package initializer
 
 import (
-	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/flap"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
@@ -25,10 +24,12 @@ import (
 	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
 )
 
-var StorageTransformerInitializer transformer.StorageTransformerInitializer = storage.Transformer{
-	HashedAddress: utils.HexToKeccak256Hash(constants.GetContractAddress("MCD_FLAP")),
-	StorageKeysLookup: storage.NewKeysLookup(flap.NewKeysLoader(
-		&mcdStorage.MakerStorageRepository{},
-		constants.GetContractAddress("MCD_FLAP"))),
-	Repository: &flap.FlapStorageRepository{ContractAddress: constants.GetContractAddress("MCD_FLAP")},
-}.NewTransformer
+var StorageTransformerInitializer = mcdStorage.GenerateDeploymentInitializer("MCD_FLAP", GenerateStorageTransformerInitializer)
+
+func GenerateStorageTransformerInitializer(contractAddress string) transformer.StorageTransformerInitializer {
+	return storage.Transformer{
+		HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
+		StorageKeysLookup: storage.NewKeysLookup(flap.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress)),
+		Repository:        &flap.FlapStorageRepository{ContractAddress: contractAddress},
+	}.NewTransformer
+}
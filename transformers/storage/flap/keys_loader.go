@@ -68,7 +68,11 @@ func (loader *keysLoader) SetDB(db *postgres.DB) {
 
 func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
 	mappings := loadStaticKeys()
-	return loader.loadBidKeys(mappings)
+	mappings, bidErr := loader.loadBidKeys(mappings)
+	if bidErr != nil {
+		return nil, bidErr
+	}
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
 }
 
 func (loader *keysLoader) loadBidKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
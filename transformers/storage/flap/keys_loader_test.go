@@ -146,4 +146,28 @@ var _ = Describe("Flap storage keys loader", func() {
 			})
 		})
 	})
+
+	Describe("wards", func() {
+		It("returns value metadata for wards", func() {
+			wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+			storageRepository.WardsKeys = []string{wardsUser}
+			paddedWardsUser := "0x000000000000000000000000" + wardsUser[2:]
+			wardsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedWardsUser + mcdStorage.WardsMappingIndex)))
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetWardsKeysCalledWith).To(Equal(test_data.FlapAddress()))
+			Expect(mappings[wardsKey]).To(Equal(mcdStorage.GetWardsMetadata(wardsUser)))
+		})
+
+		It("returns error if wards keys lookup fails", func() {
+			storageRepository.GetWardsKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+	})
 })
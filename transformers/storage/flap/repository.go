@@ -47,8 +47,10 @@ func (repository *FlapStorageRepository) Create(blockNumber int, blockHash strin
 		return repository.insertBidLot(blockNumber, blockHash, metadata, value.(string))
 	case storage.Packed:
 		return repository.insertPackedValueRecord(blockNumber, blockHash, metadata, value.(map[int]string))
+	case storage.Wards:
+		return storage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
 	default:
-		panic(fmt.Sprintf("unrecognized flap contract storage name: %s", metadata.Name))
+		return fmt.Errorf("unrecognized flap contract storage name: %s", metadata.Name)
 	}
 }
 
@@ -139,7 +141,7 @@ func (repository *FlapStorageRepository) insertPackedValueRecord(blockNumber int
 		case storage.BidEnd:
 			insertErr = repository.insertBidEnd(blockNumber, blockHash, metadata, value)
 		default:
-			panic(fmt.Sprintf("unrecognized flap contract storage name in packed values: %s", metadata.Name))
+			return fmt.Errorf("unrecognized flap contract storage name in packed values: %s", metadata.PackedNames[order])
 		}
 		if insertErr != nil {
 			return insertErr
@@ -39,13 +39,12 @@ var _ = Describe("Flap storage repository", func() {
 		fakeHash = fakes.FakeHash.Hex()
 	})
 
-	It("panics if the metadata name is not recognized", func() {
+	It("returns an error if the metadata name is not recognized", func() {
 		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
-		flapCreate := func() {
-			repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
-		}
 
-		Expect(flapCreate).Should(Panic())
+		err := repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized flap contract storage name: unrecognized"))
 	})
 
 	It("rolls back the record and address insertions if there's a failure", func() {
@@ -171,7 +170,7 @@ var _ = Describe("Flap storage repository", func() {
 			AssertVariable(tauResult, fakeBlockNumber, fakeHash, fakeTau)
 		})
 
-		It("panics if the packed name is not recognized", func() {
+		It("returns an error if the packed name is not recognized", func() {
 			packedNames := make(map[int]string)
 			packedNames[0] = "notRecognized"
 
@@ -180,10 +179,9 @@ var _ = Describe("Flap storage repository", func() {
 				PackedNames: packedNames,
 			}
 
-			createFunc := func() {
-				repository.Create(fakeBlockNumber, fakeHash, badMetadata, values)
-			}
-			Expect(createFunc).To(Panic())
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, values)
+
+			Expect(err).To(MatchError("unrecognized flap contract storage name in packed values: notRecognized"))
 		})
 
 		It("returns an error if inserting fails", func() {
@@ -384,4 +382,20 @@ var _ = Describe("Flap storage repository", func() {
 			})
 		})
 	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   storage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repository,
+			Metadata:         storage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
 })
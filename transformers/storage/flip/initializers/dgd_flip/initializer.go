@@ -17,8 +17,8 @@
 package dgd_flip
 
 import (
-	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/flip/initializers"
 )
 
-var StorageTransformerInitializer = initializers.GenerateStorageTransformerInitializer(constants.GetContractAddress("MCD_FLIP_DGD_A"))
+var StorageTransformerInitializer = mcdStorage.GenerateDeploymentInitializer("MCD_FLIP_DGD_A", initializers.GenerateStorageTransformerInitializer)
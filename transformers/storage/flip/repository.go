@@ -55,8 +55,10 @@ func (repository *FlipStorageRepository) Create(blockNumber int, blockHash strin
 		return repository.insertBidTab(blockNumber, blockHash, metadata, value.(string))
 	case storage.Packed:
 		return repository.insertPackedValueRecord(blockNumber, blockHash, metadata, value.(map[int]string))
+	case storage.Wards:
+		return storage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
 	default:
-		panic(fmt.Sprintf("unrecognized flip contract storage name: %s", metadata.Name))
+		return fmt.Errorf("unrecognized flip contract storage name: %s", metadata.Name)
 	}
 }
 
@@ -180,7 +182,7 @@ func (repository *FlipStorageRepository) insertPackedValueRecord(blockNumber int
 		case storage.BidEnd:
 			insertErr = repository.insertBidEnd(blockNumber, blockHash, metadata, value)
 		default:
-			panic(fmt.Sprintf("unrecognized flip contract storage name in packed values: %s", metadata.Name))
+			return fmt.Errorf("unrecognized flip contract storage name in packed values: %s", metadata.PackedNames[order])
 		}
 		if insertErr != nil {
 			return insertErr
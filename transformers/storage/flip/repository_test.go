@@ -34,13 +34,12 @@ var _ = Describe("Flip storage repository", func() {
 		repo.SetDB(db)
 	})
 
-	It("panics if the metadata name is not recognized", func() {
+	It("returns an error if the metadata name is not recognized", func() {
 		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
-		flipCreate := func() {
-			_ = repo.Create(fakeBlockNumber, fakeBlockHash, unrecognizedMetadata, "")
-		}
 
-		Expect(flipCreate).Should(Panic())
+		err := repo.Create(fakeBlockNumber, fakeBlockHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized flip contract storage name: unrecognized"))
 	})
 
 	It("rolls back the record and address insertions if there's a failure", func() {
@@ -144,7 +143,7 @@ var _ = Describe("Flip storage repository", func() {
 				AssertVariable(tauResult, fakeBlockNumber, fakeBlockHash, fakeTau)
 			})
 
-			It("panics if the packed name is not recognized", func() {
+			It("returns an error if the packed name is not recognized", func() {
 				packedNames := make(map[int]string)
 				packedNames[0] = "notRecognized"
 
@@ -153,10 +152,9 @@ var _ = Describe("Flip storage repository", func() {
 					PackedNames: packedNames,
 				}
 
-				createFunc := func() {
-					_ = repo.Create(fakeBlockNumber, fakeBlockHash, badMetadata, values)
-				}
-				Expect(createFunc).To(Panic())
+				err := repo.Create(fakeBlockNumber, fakeBlockHash, badMetadata, values)
+
+				Expect(err).To(MatchError("unrecognized flip contract storage name in packed values: notRecognized"))
 			})
 
 			It("returns an error if inserting fails", func() {
@@ -355,4 +353,20 @@ var _ = Describe("Flip storage repository", func() {
 			shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
 		})
 	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   storage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repo,
+			Metadata:         storage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
 })
@@ -52,8 +52,10 @@ func (repository *FlopStorageRepository) Create(blockNumber int, blockHash strin
 		return repository.insertBidBid(blockNumber, blockHash, metadata, value.(string))
 	case storage.BidLot:
 		return repository.insertBidLot(blockNumber, blockHash, metadata, value.(string))
+	case storage.Wards:
+		return storage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
 	default:
-		panic(fmt.Sprintf("unrecognized flop contract storage name: %s", metadata.Name))
+		return fmt.Errorf("unrecognized flop contract storage name: %s", metadata.Name)
 	}
 }
 
@@ -148,7 +150,7 @@ func (repository *FlopStorageRepository) insertPackedValueRecord(blockNumber int
 		case storage.BidEnd:
 			insertErr = repository.insertBidEnd(blockNumber, blockHash, metadata, value)
 		default:
-			panic(fmt.Sprintf("unrecognized flop contract storage name in packed values: %s", metadata.Name))
+			return fmt.Errorf("unrecognized flop contract storage name in packed values: %s", metadata.PackedNames[order])
 		}
 		if insertErr != nil {
 			return insertErr
@@ -35,13 +35,12 @@ var _ = Describe("Flop storage repository", func() {
 		repo.SetDB(db)
 	})
 
-	It("panics if the metadata name is not recognized", func() {
+	It("returns an error if the metadata name is not recognized", func() {
 		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
-		flopCreate := func() {
-			repo.Create(fakeBlockNumber, fakeBlockHash, unrecognizedMetadata, "")
-		}
 
-		Expect(flopCreate).Should(Panic())
+		err := repo.Create(fakeBlockNumber, fakeBlockHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized flop contract storage name: unrecognized"))
 	})
 
 	Describe("Vat", func() {
@@ -148,7 +147,7 @@ var _ = Describe("Flop storage repository", func() {
 			AssertVariable(tauResult, fakeBlockNumber, fakeBlockHash, fakeTau)
 		})
 
-		It("panics if the packed name is not recognized", func() {
+		It("returns an error if the packed name is not recognized", func() {
 			packedNames := make(map[int]string)
 			packedNames[0] = "notRecognized"
 
@@ -157,10 +156,9 @@ var _ = Describe("Flop storage repository", func() {
 				PackedNames: packedNames,
 			}
 
-			createFunc := func() {
-				_ = repo.Create(fakeBlockNumber, fakeBlockHash, badMetadata, values)
-			}
-			Expect(createFunc).To(Panic())
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, badMetadata, values)
+
+			Expect(err).To(MatchError("unrecognized flop contract storage name in packed values: notRecognized"))
 		})
 
 		It("returns an error if inserting fails", func() {
@@ -349,4 +347,20 @@ var _ = Describe("Flop storage repository", func() {
 			})
 		})
 	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   storage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repo,
+			Metadata:         storage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
 })
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
@@ -33,7 +34,8 @@ const (
 )
 
 type JugStorageRepository struct {
-	db *postgres.DB
+	ContractAddress string
+	db              *postgres.DB
 }
 
 func (repository *JugStorageRepository) SetDB(db *postgres.DB) {
@@ -52,9 +54,11 @@ func (repository JugStorageRepository) Create(blockNumber int, blockHash string,
 		return repository.insertJugVow(blockNumber, blockHash, value.(string))
 	case Base:
 		return repository.insertJugBase(blockNumber, blockHash, value.(string))
+	case mcdStorage.Wards:
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
 
 	default:
-		panic(fmt.Sprintf("unrecognized jug contract storage name: %s", metadata.Name))
+		return fmt.Errorf("unrecognized jug contract storage name: %s", metadata.Name)
 	}
 }
 
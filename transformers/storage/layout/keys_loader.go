@@ -0,0 +1,149 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package layout
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+type keysLoader struct {
+	storageLayout     Layout
+	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
+}
+
+// NewKeysLoader returns a keys loader that computes storage keys for every variable in the layout, or an error if
+// the layout is misdescribed. The layout is only validated here, not on every reload of the mappings.
+func NewKeysLoader(storageLayout Layout, storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) (storage.KeysLoader, error) {
+	validateErr := storageLayout.Validate()
+	if validateErr != nil {
+		return nil, validateErr
+	}
+	return &keysLoader{
+		storageLayout:     storageLayout,
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}, nil
+}
+
+func (loader *keysLoader) SetDB(db *postgres.DB) {
+	loader.storageRepository.SetDB(db)
+}
+
+func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
+	mappings := make(map[common.Hash]utils.StorageValueMetadata)
+	for _, variable := range loader.storageLayout.Variables {
+		rows := [][]string{nil}
+		if len(variable.Keys) > 0 {
+			loadedRows, loadErr := variable.LoadKeys(loader.storageRepository, loader.contractAddress)
+			if loadErr != nil {
+				return nil, loadErr
+			}
+			rows = loadedRows
+		}
+		for _, row := range rows {
+			key, metadata, mappingErr := getMapping(variable, row)
+			if mappingErr != nil {
+				return nil, mappingErr
+			}
+			mappings[key] = metadata
+		}
+	}
+	if loader.storageLayout.Wards {
+		return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
+	}
+	return mappings, nil
+}
+
+// Mapping returns the storage key and value metadata of the named variable, for the given mapping key values
+func (storageLayout Layout) Mapping(name string, keyValues ...string) (common.Hash, utils.StorageValueMetadata, error) {
+	variable, variableErr := storageLayout.variable(name)
+	if variableErr != nil {
+		return common.Hash{}, utils.StorageValueMetadata{}, variableErr
+	}
+	return getMapping(variable, keyValues)
+}
+
+func getMapping(variable Variable, keyValues []string) (common.Hash, utils.StorageValueMetadata, error) {
+	if len(keyValues) != len(variable.Keys) {
+		return common.Hash{}, utils.StorageValueMetadata{}, fmt.Errorf("got %d keys for %s, expected %d", len(keyValues), variable.Name, len(variable.Keys))
+	}
+	if len(keyValues) == 0 {
+		return getKey(variable, nil), getMetadata(variable, nil), nil
+	}
+	encodedKeys := make([]string, len(keyValues))
+	metadataKeys := make(map[utils.Key]string)
+	for i, key := range variable.Keys {
+		encodedKey, encodeErr := encodeKey(key.Kind, keyValues[i])
+		if encodeErr != nil {
+			return common.Hash{}, utils.StorageValueMetadata{}, encodeErr
+		}
+		encodedKeys[i] = encodedKey
+		metadataKeys[key.Name] = keyValues[i]
+	}
+	return getKey(variable, encodedKeys), getMetadata(variable, metadataKeys), nil
+}
+
+func encodeKey(kind KeyKind, value string) (string, error) {
+	switch kind {
+	case Bytes32Key:
+		return value, nil
+	case AddressKey:
+		return utilities.PadAddress(value)
+	case UintKey:
+		return shared.ConvertIntStringToHex(value)
+	default:
+		return "", fmt.Errorf("unrecognized key kind: %d", kind)
+	}
+}
+
+func getKey(variable Variable, encodedKeys []string) common.Hash {
+	var key common.Hash
+	switch len(encodedKeys) {
+	case 0:
+		key = common.HexToHash(variable.Slot)
+	case 1:
+		key = utils.GetStorageKeyForMapping(variable.Slot, encodedKeys[0])
+	default:
+		key = utils.GetStorageKeyForNestedMapping(variable.Slot, encodedKeys[0], encodedKeys[1])
+	}
+	if variable.Offset == 0 {
+		return key
+	}
+	return utils.GetIncrementedStorageKey(key, variable.Offset)
+}
+
+func getMetadata(variable Variable, keys map[utils.Key]string) utils.StorageValueMetadata {
+	if variable.Type != utils.PackedSlot {
+		return utils.GetStorageValueMetadata(variable.Name, keys, variable.Type)
+	}
+	packedNames := make(map[int]string)
+	packedTypes := make(map[int]utils.ValueType)
+	for position, item := range variable.Packed {
+		packedNames[position] = item.Name
+		packedTypes[position] = item.Type
+	}
+	return utils.GetStorageValueMetadataForPackedSlot(variable.Name, keys, variable.Type, packedNames, packedTypes)
+}
@@ -0,0 +1,145 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package layout_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/layout"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("Layout storage keys loader", func() {
+	var (
+		storageRepository *test_helpers.MockMakerStorageRepository
+		fakeIlk           = "0x4554482d41000000000000000000000000000000000000000000000000000000"
+		fakeGuy           = "0x0000000000000000000000000000000000000123"
+		paddedGuy         = "0x0000000000000000000000000000000000000000000000000000000000000123"
+		loadKeysError     error
+		ilkKey            = layout.Key{Name: constants.Ilk, Kind: layout.Bytes32Key, ForeignKey: constants.IlkFK}
+		guyKey            = layout.Key{Name: constants.Guy, Kind: layout.AddressKey, Column: "guy"}
+		bidIdKey          = layout.Key{Name: constants.BidId, Kind: layout.UintKey, Column: "bid_id"}
+		loadIlkGuyKeys    = func(mcdStorage.IMakerStorageRepository, string) ([][]string, error) {
+			return [][]string{{fakeIlk, fakeGuy}}, loadKeysError
+		}
+		loadBidIdKeys = func(mcdStorage.IMakerStorageRepository, string) ([][]string, error) {
+			return [][]string{{"10"}}, nil
+		}
+		testLayout    layout.Layout
+		newKeysLoader = func() storage.KeysLoader {
+			loader, err := layout.NewKeysLoader(testLayout, storageRepository, test_helpers.FakeAddress)
+			Expect(err).NotTo(HaveOccurred())
+			return loader
+		}
+	)
+
+	BeforeEach(func() {
+		storageRepository = &test_helpers.MockMakerStorageRepository{}
+		loadKeysError = nil
+		testLayout = layout.Layout{
+			Contract: "test",
+			Wards:    true,
+			Variables: []layout.Variable{
+				{Name: "live", Slot: utils.IndexOne, Type: utils.Uint256, Table: "test_live", ValueColumn: "live"},
+				{Name: "ink", Slot: utils.IndexTwo, Type: utils.Uint256, Keys: []layout.Key{ilkKey, guyKey},
+					LoadKeys: loadIlkGuyKeys, Table: "test_ink", ValueColumn: "ink"},
+				{Name: "art", Slot: utils.IndexTwo, Offset: 1, Type: utils.Uint256, Keys: []layout.Key{ilkKey, guyKey},
+					LoadKeys: loadIlkGuyKeys, Table: "test_art", ValueColumn: "art"},
+				{Name: "bid", Slot: utils.IndexThree, Type: utils.PackedSlot, Keys: []layout.Key{bidIdKey}, LoadKeys: loadBidIdKeys,
+					Packed: []layout.PackedItem{
+						{Name: "guy", Type: utils.Address, Table: "test_bid_guy", Column: "guy"},
+						{Name: "tic", Type: utils.Uint48, Table: "test_bid_tic", Column: "tic"},
+					}},
+			},
+		}
+	})
+
+	It("returns metadata for static variables", func() {
+		mappings, err := newKeysLoader().LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings[common.HexToHash(utils.IndexOne)]).To(Equal(utils.StorageValueMetadata{Name: "live", Type: utils.Uint256}))
+	})
+
+	It("returns metadata for mapping variables at their offset", func() {
+		mappings, err := newKeysLoader().LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		inkKey := utils.GetStorageKeyForNestedMapping(utils.IndexTwo, fakeIlk, paddedGuy)
+		keys := map[utils.Key]string{constants.Ilk: fakeIlk, constants.Guy: fakeGuy}
+		Expect(mappings[inkKey]).To(Equal(utils.GetStorageValueMetadata("ink", keys, utils.Uint256)))
+		Expect(mappings[utils.GetIncrementedStorageKey(inkKey, 1)]).To(Equal(utils.GetStorageValueMetadata("art", keys, utils.Uint256)))
+	})
+
+	It("returns packed slot metadata keyed by hex encoded uint keys", func() {
+		mappings, err := newKeysLoader().LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		bidKey := utils.GetStorageKeyForMapping(utils.IndexThree, "0x000000000000000000000000000000000000000000000000000000000000000a")
+		Expect(mappings[bidKey]).To(Equal(utils.GetStorageValueMetadataForPackedSlot("bid",
+			map[utils.Key]string{constants.BidId: "10"}, utils.PackedSlot,
+			map[int]string{0: "guy", 1: "tic"}, map[int]utils.ValueType{0: utils.Address, 1: utils.Uint48})))
+	})
+
+	It("adds wards keys for auth'd contracts", func() {
+		storageRepository.WardsKeys = []string{fakeGuy}
+
+		mappings, err := newKeysLoader().LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(storageRepository.GetWardsKeysCalledWith).To(Equal(test_helpers.FakeAddress))
+		Expect(mappings[mcdStorage.GetWardsKey(paddedGuy)]).To(Equal(mcdStorage.GetWardsMetadata(fakeGuy)))
+	})
+
+	It("returns error if loading keys fails", func() {
+		loadKeysError = fakes.FakeError
+
+		_, err := newKeysLoader().LoadMappings()
+
+		Expect(err).To(MatchError(fakes.FakeError))
+	})
+
+	It("returns error if the layout declares a variable twice", func() {
+		testLayout.Variables = append(testLayout.Variables, testLayout.Variables[0])
+
+		_, err := layout.NewKeysLoader(testLayout, storageRepository, test_helpers.FakeAddress)
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns error if a mapping variable can't load its keys", func() {
+		testLayout.Variables[1].LoadKeys = nil
+
+		_, err := layout.NewKeysLoader(testLayout, storageRepository, test_helpers.FakeAddress)
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns error if an urn is resolved without an ilk key", func() {
+		testLayout.Variables[1].Keys = []layout.Key{{Name: constants.Guy, Kind: layout.AddressKey, ForeignKey: constants.UrnFK}}
+
+		_, err := layout.NewKeysLoader(testLayout, storageRepository, test_helpers.FakeAddress)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
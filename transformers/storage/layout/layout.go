@@ -0,0 +1,157 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package layout
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+)
+
+// KeyKind says how a mapping key is encoded before it's hashed into a storage key
+type KeyKind int
+
+const (
+	Bytes32Key KeyKind = iota // hashed as is, e.g. an ilk
+	AddressKey                // left padded to 32 bytes
+	UintKey                   // a base 10 integer, e.g. a bid id, converted to hex
+)
+
+// Key describes one key of a mapping, outermost first. The key value is written to Column, or resolved to the id
+// behind ForeignKey; keys with neither are only used to find the storage slot or to resolve another key.
+type Key struct {
+	Name       utils.Key
+	Kind       KeyKind
+	Column     string
+	ForeignKey constants.ForeignKeyField
+}
+
+// PackedItem describes one value packed into a slot, and the table it's written to
+type PackedItem struct {
+	Name   string
+	Type   utils.ValueType
+	Table  string
+	Column string
+}
+
+// LoadKeys returns one row of mapping key values, in the variable's Keys order, per entry that should be watched
+type LoadKeys func(repository mcdStorage.IMakerStorageRepository, contractAddress string) ([][]string, error)
+
+// Variable describes a storage variable: where it lives, how it's decoded, and the table it's written to
+type Variable struct {
+	Name string
+	// Slot is the index of the variable, or of the mapping that holds it
+	Slot string
+	// Offset is the variable's position within a struct stored at the slot, e.g. ilk rate is one slot after Art
+	Offset   int64
+	Type     utils.ValueType
+	Keys     []Key
+	LoadKeys LoadKeys
+	// Packed lists the values in a PackedSlot, by position
+	Packed      []PackedItem
+	Table       string
+	ValueColumn string
+}
+
+// Layout describes a contract's storage
+type Layout struct {
+	Contract  string
+	Variables []Variable
+	// Wards is set for auth'd contracts, which declare wards as their first storage variable
+	Wards bool
+}
+
+type ErrUnrecognizedStorageName struct {
+	Contract string
+	Name     string
+}
+
+func (e ErrUnrecognizedStorageName) Error() string {
+	return fmt.Sprintf("unrecognized %s contract storage name: %s", e.Contract, e.Name)
+}
+
+type ErrUnexpectedValueType struct {
+	Name  string
+	Value interface{}
+}
+
+func (e ErrUnexpectedValueType) Error() string {
+	return fmt.Sprintf("storage variable %s decoded to unexpected type %T, check its type in the storage layout", e.Name, e.Value)
+}
+
+func (storageLayout Layout) variable(name string) (Variable, error) {
+	for _, variable := range storageLayout.Variables {
+		if variable.Name == name {
+			return variable, nil
+		}
+	}
+	return Variable{}, ErrUnrecognizedStorageName{Contract: storageLayout.Contract, Name: name}
+}
+
+// Validate checks that every variable can be loaded and persisted, so a misdescribed layout fails at startup
+func (storageLayout Layout) Validate() error {
+	names := make(map[string]bool)
+	for _, variable := range storageLayout.Variables {
+		if names[variable.Name] {
+			return fmt.Errorf("%s storage layout declares %s more than once", storageLayout.Contract, variable.Name)
+		}
+		names[variable.Name] = true
+		if len(variable.Keys) > 2 {
+			return fmt.Errorf("%s storage variable %s has more than two mapping keys", storageLayout.Contract, variable.Name)
+		}
+		keyErr := storageLayout.validateKeys(variable)
+		if keyErr != nil {
+			return keyErr
+		}
+		if len(variable.Keys) > 0 && variable.LoadKeys == nil {
+			return fmt.Errorf("%s storage variable %s is a mapping without LoadKeys", storageLayout.Contract, variable.Name)
+		}
+		if variable.Type == utils.PackedSlot {
+			if len(variable.Packed) == 0 {
+				return fmt.Errorf("%s storage variable %s is a packed slot without packed items", storageLayout.Contract, variable.Name)
+			}
+			continue
+		}
+		if variable.Table == "" || variable.ValueColumn == "" {
+			return fmt.Errorf("%s storage variable %s has no table or value column", storageLayout.Contract, variable.Name)
+		}
+	}
+	return nil
+}
+
+func (storageLayout Layout) validateKeys(variable Variable) error {
+	hasIlk := false
+	for _, key := range variable.Keys {
+		if key.Name == constants.Ilk {
+			hasIlk = true
+		}
+	}
+	for _, key := range variable.Keys {
+		switch key.ForeignKey {
+		case "", constants.IlkFK, constants.AddressFK:
+		case constants.UrnFK:
+			if !hasIlk {
+				return fmt.Errorf("%s storage variable %s resolves an urn without an ilk key", storageLayout.Contract, variable.Name)
+			}
+		default:
+			return fmt.Errorf("%s storage variable %s has unsupported foreign key %s", storageLayout.Contract, variable.Name, key.ForeignKey)
+		}
+	}
+	return nil
+}
@@ -1,16 +1,17 @@
-package new_cdp_test
+package layout_test
 
 import (
-	. "github.com/onsi/ginkgo"
-	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 	"io/ioutil"
 	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
 )
 
-func TestNewCdp(t *testing.T) {
+func TestLayout(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "NewCdp Suite")
+	RunSpecs(t, "Layout Suite")
 }
 
 var _ = BeforeSuite(func() {
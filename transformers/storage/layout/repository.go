@@ -0,0 +1,169 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package layout
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+// Repository persists storage diffs for any contract described by a Layout
+type Repository struct {
+	Layout          Layout
+	ContractAddress string
+	db              *postgres.DB
+}
+
+func (repository *Repository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	return Create(repository.Layout, repository.ContractAddress, repository.db, blockNumber, blockHash, metadata, value)
+}
+
+func (repository *Repository) SetDB(db *postgres.DB) {
+	repository.db = db
+}
+
+// Create writes a decoded storage value to the table its variable is described with
+func Create(storageLayout Layout, contractAddress string, db *postgres.DB, blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	if storageLayout.Wards && metadata.Name == mcdStorage.Wards {
+		wards, ok := value.(string)
+		if !ok {
+			return ErrUnexpectedValueType{Name: metadata.Name, Value: value}
+		}
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, contractAddress, wards, db)
+	}
+	variable, variableErr := storageLayout.variable(metadata.Name)
+	if variableErr != nil {
+		return variableErr
+	}
+	for _, key := range variable.Keys {
+		if _, ok := metadata.Keys[key.Name]; !ok {
+			return utils.ErrMetadataMalformed{MissingData: key.Name}
+		}
+	}
+
+	if variable.Type != utils.PackedSlot {
+		stringValue, ok := value.(string)
+		if !ok {
+			return ErrUnexpectedValueType{Name: variable.Name, Value: value}
+		}
+		return insert(db, variable, variable.Name, variable.insertQuery(variable.Table, variable.ValueColumn), metadata.Keys, blockNumber, blockHash, stringValue)
+	}
+	packedValues, ok := value.(map[int]string)
+	if !ok {
+		return ErrUnexpectedValueType{Name: variable.Name, Value: value}
+	}
+	for position, item := range variable.Packed {
+		packedValue, ok := packedValues[position]
+		if !ok {
+			return fmt.Errorf("missing packed value %s of %s", item.Name, variable.Name)
+		}
+		insertErr := insert(db, variable, item.Name, variable.insertQuery(item.Table, item.Column), metadata.Keys, blockNumber, blockHash, packedValue)
+		if insertErr != nil {
+			return insertErr
+		}
+	}
+	return nil
+}
+
+// InsertQuery returns the query that writes the named variable, taking the block number, block hash, the value of
+// every key with a column (foreign keys as ids), and the value. Packed slots are written one item at a time, so
+// they have no single query.
+func (storageLayout Layout) InsertQuery(name string) (string, error) {
+	variable, variableErr := storageLayout.variable(name)
+	if variableErr != nil {
+		return "", variableErr
+	}
+	if variable.Type == utils.PackedSlot {
+		return "", fmt.Errorf("%s storage variable %s is a packed slot", storageLayout.Contract, name)
+	}
+	return variable.insertQuery(variable.Table, variable.ValueColumn), nil
+}
+
+func (variable Variable) insertQuery(table, valueColumn string) string {
+	columns := []string{"block_number", "block_hash"}
+	for _, key := range variable.Keys {
+		if key.ForeignKey != "" {
+			columns = append(columns, string(key.ForeignKey))
+		} else if key.Column != "" {
+			columns = append(columns, key.Column)
+		}
+	}
+	columns = append(columns, valueColumn)
+
+	placeholders := make([]string, len(columns))
+	for i := range columns {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	return fmt.Sprintf(`INSERT INTO maker.%s (%s) VALUES (%s) ON CONFLICT DO NOTHING`,
+		table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+}
+
+func insert(db *postgres.DB, variable Variable, name, query string, keys map[utils.Key]string, blockNumber int, blockHash, value string) error {
+	args := []interface{}{blockNumber, blockHash}
+
+	tx, txErr := db.Beginx()
+	if txErr != nil {
+		return txErr
+	}
+	for _, key := range variable.Keys {
+		keyValue := keys[key.Name]
+		if key.ForeignKey != "" {
+			id, idErr := getForeignKeyID(key.ForeignKey, keyValue, keys, tx)
+			if idErr != nil {
+				return rollback(tx, string(key.ForeignKey), idErr)
+			}
+			args = append(args, id)
+		} else if key.Column != "" {
+			args = append(args, keyValue)
+		}
+	}
+	args = append(args, value)
+
+	_, writeErr := tx.Exec(query, args...)
+	if writeErr != nil {
+		return rollback(tx, name, writeErr)
+	}
+	return tx.Commit()
+}
+
+func getForeignKeyID(foreignKey constants.ForeignKeyField, value string, keys map[utils.Key]string, tx *sqlx.Tx) (int64, error) {
+	switch foreignKey {
+	case constants.IlkFK:
+		return shared.GetOrCreateIlkInTransaction(value, tx)
+	case constants.UrnFK:
+		return shared.GetOrCreateUrnInTransaction(value, keys[constants.Ilk], tx)
+	case constants.AddressFK:
+		return shared.GetOrCreateAddressInTransaction(value, tx)
+	default:
+		return 0, fmt.Errorf("unsupported storage foreign key: %s", foreignKey)
+	}
+}
+
+func rollback(tx *sqlx.Tx, field string, err error) error {
+	rollbackErr := tx.Rollback()
+	if rollbackErr != nil {
+		return shared.FormatRollbackError(field, err.Error())
+	}
+	return err
+}
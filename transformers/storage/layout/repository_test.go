@@ -0,0 +1,64 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package layout_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/layout"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+)
+
+var _ = Describe("Layout storage repository", func() {
+	repository := layout.Repository{
+		Layout: layout.Layout{
+			Contract: "test",
+			Variables: []layout.Variable{
+				{Name: "dai", Slot: utils.IndexOne, Type: utils.Uint256, Table: "test_dai", ValueColumn: "dai",
+					Keys: []layout.Key{{Name: constants.Guy, Kind: layout.AddressKey, Column: "guy"}}},
+			},
+		},
+	}
+
+	It("returns an error if the metadata name is not recognized", func() {
+		err := repository.Create(1, "hash", utils.StorageValueMetadata{Name: "unrecognized"}, "")
+
+		Expect(err).To(MatchError(layout.ErrUnrecognizedStorageName{Contract: "test", Name: "unrecognized"}))
+	})
+
+	It("returns an error if the metadata is missing a key", func() {
+		err := repository.Create(1, "hash", utils.StorageValueMetadata{Name: "dai"}, "")
+
+		Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Guy}))
+	})
+
+	It("returns an error if the value doesn't match the variable's declared type", func() {
+		metadata := utils.StorageValueMetadata{Name: "dai", Keys: map[utils.Key]string{constants.Guy: "0x123"}}
+
+		err := repository.Create(1, "hash", metadata, map[int]string{0: "1"})
+
+		Expect(err).To(MatchError(layout.ErrUnexpectedValueType{Name: "dai", Value: map[int]string{0: "1"}}))
+	})
+
+	It("builds the insert query from the variable's keys and value column", func() {
+		query, err := repository.Layout.InsertQuery("dai")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(query).To(Equal(`INSERT INTO maker.test_dai (block_number, block_hash, guy, dai) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`))
+	})
+})
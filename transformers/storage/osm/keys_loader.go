@@ -0,0 +1,81 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package osm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	Stopped = "stopped"
+	Src     = "src"
+	Hop     = "hop"
+	Zzz     = "zzz"
+	CurVal  = "cur_val"
+	CurHas  = "cur_has"
+	NxtVal  = "nxt_val"
+	NxtHas  = "nxt_has"
+)
+
+var (
+	StoppedKey      = common.HexToHash(utils.IndexOne)
+	StoppedMetadata = utils.GetStorageValueMetadata(Stopped, nil, utils.Uint256)
+
+	SrcHopAndZzzKey      = common.HexToHash(utils.IndexTwo)
+	srcHopAndZzzTypes    = map[int]utils.ValueType{0: utils.Address, 1: mcdStorage.Uint16, 2: mcdStorage.Uint64}
+	srcHopAndZzzNames    = map[int]string{0: Src, 1: Hop, 2: Zzz}
+	SrcHopAndZzzMetadata = utils.GetStorageValueMetadataForPackedSlot(mcdStorage.Packed, nil, utils.PackedSlot, srcHopAndZzzNames, srcHopAndZzzTypes)
+
+	CurKey      = common.HexToHash(utils.IndexThree)
+	curTypes    = map[int]utils.ValueType{0: utils.Uint128, 1: utils.Uint128}
+	curNames    = map[int]string{0: CurVal, 1: CurHas}
+	CurMetadata = utils.GetStorageValueMetadataForPackedSlot(mcdStorage.Packed, nil, utils.PackedSlot, curNames, curTypes)
+
+	NxtKey      = common.HexToHash(utils.IndexFour)
+	nxtTypes    = map[int]utils.ValueType{0: utils.Uint128, 1: utils.Uint128}
+	nxtNames    = map[int]string{0: NxtVal, 1: NxtHas}
+	NxtMetadata = utils.GetStorageValueMetadataForPackedSlot(mcdStorage.Packed, nil, utils.PackedSlot, nxtNames, nxtTypes)
+)
+
+type keysLoader struct {
+	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
+}
+
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) storage.KeysLoader {
+	return &keysLoader{
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}
+}
+
+func (loader *keysLoader) SetDB(db *postgres.DB) {
+	loader.storageRepository.SetDB(db)
+}
+
+func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
+	mappings := make(map[common.Hash]utils.StorageValueMetadata)
+	mappings[StoppedKey] = StoppedMetadata
+	mappings[SrcHopAndZzzKey] = SrcHopAndZzzMetadata
+	mappings[CurKey] = CurMetadata
+	mappings[NxtKey] = NxtMetadata
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
+}
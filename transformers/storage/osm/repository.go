@@ -0,0 +1,111 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package osm
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	insertOsmStoppedQuery = `INSERT INTO maker.osm_stopped (block_number, block_hash, address_id, stopped) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertOsmHopQuery     = `INSERT INTO maker.osm_hop (block_number, block_hash, address_id, hop) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertOsmZzzQuery     = `INSERT INTO maker.osm_zzz (block_number, block_hash, address_id, zzz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertOsmCurValQuery  = `INSERT INTO maker.osm_cur_val (block_number, block_hash, address_id, val) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertOsmCurHasQuery  = `INSERT INTO maker.osm_cur_has (block_number, block_hash, address_id, has) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertOsmNxtValQuery  = `INSERT INTO maker.osm_nxt_val (block_number, block_hash, address_id, val) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertOsmNxtHasQuery  = `INSERT INTO maker.osm_nxt_has (block_number, block_hash, address_id, has) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+)
+
+type OsmStorageRepository struct {
+	ContractAddress string
+	db              *postgres.DB
+}
+
+func (repository *OsmStorageRepository) SetDB(db *postgres.DB) {
+	repository.db = db
+}
+
+func (repository *OsmStorageRepository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	switch metadata.Name {
+	case Stopped:
+		return repository.insertRecordWithAddress(blockNumber, blockHash, insertOsmStoppedQuery, value.(string))
+	case storage.Packed:
+		return repository.insertPackedValueRecord(blockNumber, blockHash, metadata, value.(map[int]string))
+	case storage.Wards:
+		return storage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
+	default:
+		return fmt.Errorf("unrecognized osm contract storage name: %s", metadata.Name)
+	}
+}
+
+func (repository *OsmStorageRepository) insertPackedValueRecord(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, packedValues map[int]string) error {
+	for order, value := range packedValues {
+		var insertErr error
+		switch metadata.PackedNames[order] {
+		case Src:
+			// src isn't persisted; only the values read from it are
+			continue
+		case Hop:
+			insertErr = repository.insertRecordWithAddress(blockNumber, blockHash, insertOsmHopQuery, value)
+		case Zzz:
+			insertErr = repository.insertRecordWithAddress(blockNumber, blockHash, insertOsmZzzQuery, value)
+		case CurVal:
+			insertErr = repository.insertRecordWithAddress(blockNumber, blockHash, insertOsmCurValQuery, value)
+		case CurHas:
+			insertErr = repository.insertRecordWithAddress(blockNumber, blockHash, insertOsmCurHasQuery, value)
+		case NxtVal:
+			insertErr = repository.insertRecordWithAddress(blockNumber, blockHash, insertOsmNxtValQuery, value)
+		case NxtHas:
+			insertErr = repository.insertRecordWithAddress(blockNumber, blockHash, insertOsmNxtHasQuery, value)
+		default:
+			return fmt.Errorf("unrecognized osm contract storage name in packed values: %s", metadata.PackedNames[order])
+		}
+		if insertErr != nil {
+			return insertErr
+		}
+	}
+	return nil
+}
+
+func (repository *OsmStorageRepository) insertRecordWithAddress(blockNumber int, blockHash, query, value string) error {
+	tx, txErr := repository.db.Beginx()
+	if txErr != nil {
+		return txErr
+	}
+	addressId, addressErr := shared.GetOrCreateAddressInTransaction(repository.ContractAddress, tx)
+	if addressErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError("osm address", addressErr.Error())
+		}
+		return addressErr
+	}
+	_, insertErr := tx.Exec(query, blockNumber, blockHash, addressId, value)
+	if insertErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError("osm field with address", insertErr.Error())
+		}
+		return insertErr
+	}
+	return tx.Commit()
+}
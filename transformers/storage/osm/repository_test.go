@@ -0,0 +1,155 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package osm_test
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/osm"
+	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("OSM storage repository", func() {
+	var (
+		db              *postgres.DB
+		repo            osm.OsmStorageRepository
+		fakeBlockHash   = fakes.FakeHash.Hex()
+		fakeBlockNumber int
+	)
+
+	BeforeEach(func() {
+		fakeBlockNumber = rand.Int()
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		repo = osm.OsmStorageRepository{ContractAddress: test_data.OsmEthAddress()}
+		repo.SetDB(db)
+	})
+
+	It("returns an error if the metadata name is not recognized", func() {
+		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
+
+		err := repo.Create(fakeBlockNumber, fakeBlockHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized osm contract storage name: unrecognized"))
+	})
+
+	Describe("stopped", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   osm.Stopped,
+			Value:            strconv.Itoa(rand.Intn(2)),
+			StorageTableName: "maker.osm_stopped",
+			Repository:       &repo,
+			Metadata:         osm.StoppedMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("src, hop and zzz packed storage", func() {
+		var values = map[int]string{0: test_data.OsmEthAddress(), 1: "3600", 2: "1570000000"}
+
+		It("persists hop and zzz records", func() {
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, osm.SrcHopAndZzzMetadata, values)
+			Expect(err).NotTo(HaveOccurred())
+
+			var hopResult VariableRes
+			err = db.Get(&hopResult, `SELECT block_number, block_hash, hop AS value FROM maker.osm_hop`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertVariable(hopResult, fakeBlockNumber, fakeBlockHash, "3600")
+
+			var zzzResult VariableRes
+			err = db.Get(&zzzResult, `SELECT block_number, block_hash, zzz AS value FROM maker.osm_zzz`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertVariable(zzzResult, fakeBlockNumber, fakeBlockHash, "1570000000")
+		})
+	})
+
+	Describe("cur and nxt packed storage", func() {
+		var (
+			fakeVal = strconv.Itoa(rand.Int())
+			fakeHas = strconv.Itoa(rand.Intn(2))
+			values  = map[int]string{0: fakeVal, 1: fakeHas}
+		)
+
+		It("persists cur val and has records", func() {
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, osm.CurMetadata, values)
+			Expect(err).NotTo(HaveOccurred())
+
+			var valResult VariableRes
+			err = db.Get(&valResult, `SELECT block_number, block_hash, val AS value FROM maker.osm_cur_val`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertVariable(valResult, fakeBlockNumber, fakeBlockHash, fakeVal)
+
+			var hasResult VariableRes
+			err = db.Get(&hasResult, `SELECT block_number, block_hash, has AS value FROM maker.osm_cur_has`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertVariable(hasResult, fakeBlockNumber, fakeBlockHash, fakeHas)
+		})
+
+		It("persists nxt val and has records", func() {
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, osm.NxtMetadata, values)
+			Expect(err).NotTo(HaveOccurred())
+
+			var valResult VariableRes
+			err = db.Get(&valResult, `SELECT block_number, block_hash, val AS value FROM maker.osm_nxt_val`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertVariable(valResult, fakeBlockNumber, fakeBlockHash, fakeVal)
+
+			var hasResult VariableRes
+			err = db.Get(&hasResult, `SELECT block_number, block_hash, has AS value FROM maker.osm_nxt_has`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertVariable(hasResult, fakeBlockNumber, fakeBlockHash, fakeHas)
+		})
+
+		It("returns an error if the packed name is not recognized", func() {
+			badMetadata := utils.StorageValueMetadata{
+				Name:        storage.Packed,
+				PackedNames: map[int]string{0: "notRecognized"},
+			}
+
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, badMetadata, map[int]string{0: fakeVal})
+
+			Expect(err).To(MatchError("unrecognized osm contract storage name in packed values: notRecognized"))
+		})
+	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   storage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repo,
+			Metadata:         storage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+})
@@ -0,0 +1,119 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pot
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	UserPie = "pie"
+	Pie     = "Pie"
+	Dsr     = "dsr"
+	Chi     = "chi"
+	Vow     = "vow"
+	Rho     = "rho"
+	Live    = "live"
+)
+
+var (
+	UserPieIndex = utils.IndexOne
+
+	PieKey      = common.HexToHash(utils.IndexTwo)
+	PieMetadata = utils.GetStorageValueMetadata(Pie, nil, utils.Uint256)
+
+	DsrKey      = common.HexToHash(utils.IndexThree)
+	DsrMetadata = utils.GetStorageValueMetadata(Dsr, nil, utils.Uint256)
+
+	ChiKey      = common.HexToHash(utils.IndexFour)
+	ChiMetadata = utils.GetStorageValueMetadata(Chi, nil, utils.Uint256)
+
+	VowKey      = common.HexToHash(utils.IndexSix)
+	VowMetadata = utils.GetStorageValueMetadata(Vow, nil, utils.Address)
+
+	RhoKey      = common.HexToHash(utils.IndexSeven)
+	RhoMetadata = utils.GetStorageValueMetadata(Rho, nil, utils.Uint256)
+
+	LiveKey      = common.HexToHash(utils.IndexEight)
+	LiveMetadata = utils.GetStorageValueMetadata(Live, nil, utils.Uint256)
+)
+
+type keysLoader struct {
+	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
+}
+
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) storage.KeysLoader {
+	return &keysLoader{
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}
+}
+
+func (loader *keysLoader) SetDB(db *postgres.DB) {
+	loader.storageRepository.SetDB(db)
+}
+
+func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
+	mappings := loadStaticMappings()
+	mappings, pieErr := loader.loadPieKeys(mappings)
+	if pieErr != nil {
+		return nil, pieErr
+	}
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
+}
+
+func (loader *keysLoader) loadPieKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
+	users, err := loader.storageRepository.GetPotPieUsers()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		paddedUser, padErr := utilities.PadAddress(user)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[getUserPieKey(paddedUser)] = getUserPieMetadata(user)
+	}
+	return mappings, nil
+}
+
+func loadStaticMappings() map[common.Hash]utils.StorageValueMetadata {
+	mappings := make(map[common.Hash]utils.StorageValueMetadata)
+	mappings[PieKey] = PieMetadata
+	mappings[DsrKey] = DsrMetadata
+	mappings[ChiKey] = ChiMetadata
+	mappings[VowKey] = VowMetadata
+	mappings[RhoKey] = RhoMetadata
+	mappings[LiveKey] = LiveMetadata
+	return mappings
+}
+
+func getUserPieKey(paddedUser string) common.Hash {
+	return utils.GetStorageKeyForMapping(UserPieIndex, paddedUser)
+}
+
+func getUserPieMetadata(user string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.MsgSender: user}
+	return utils.GetStorageValueMetadata(UserPie, keys, utils.Uint256)
+}
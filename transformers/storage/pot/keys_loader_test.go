@@ -0,0 +1,122 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pot_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/pot"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("Pot storage keys loader", func() {
+	var (
+		storageRepository *test_helpers.MockMakerStorageRepository
+		storageKeysLoader storage.KeysLoader
+	)
+
+	BeforeEach(func() {
+		storageRepository = &test_helpers.MockMakerStorageRepository{}
+		storageKeysLoader = pot.NewKeysLoader(storageRepository, test_helpers.FakeAddress)
+	})
+
+	It("returns value metadata for static keys", func() {
+		mappings, err := storageKeysLoader.LoadMappings()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings[pot.PieKey]).To(Equal(pot.PieMetadata))
+		Expect(mappings[pot.DsrKey]).To(Equal(pot.DsrMetadata))
+		Expect(mappings[pot.ChiKey]).To(Equal(pot.ChiMetadata))
+		Expect(mappings[pot.VowKey]).To(Equal(pot.VowMetadata))
+		Expect(mappings[pot.RhoKey]).To(Equal(pot.RhoMetadata))
+		Expect(mappings[pot.LiveKey]).To(Equal(pot.LiveMetadata))
+	})
+
+	Describe("pie", func() {
+		Describe("when getting users fails", func() {
+			It("returns error", func() {
+				storageRepository.GetPotPieUsersError = fakes.FakeError
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(MatchError(fakes.FakeError))
+			})
+		})
+
+		Describe("when getting users succeeds", func() {
+			It("returns value metadata for user pie", func() {
+				user := test_helpers.FakeAddress
+				storageRepository.PotPieUsers = []string{user}
+				paddedUser, padErr := utilities.PadAddress(user)
+				Expect(padErr).NotTo(HaveOccurred())
+				pieKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedUser + pot.UserPieIndex)))
+				expectedMetadata := utils.StorageValueMetadata{
+					Name: pot.UserPie,
+					Keys: map[utils.Key]string{constants.MsgSender: user},
+					Type: utils.Uint256,
+				}
+
+				mappings, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storageRepository.GetPotPieUsersCalled).To(BeTrue())
+				Expect(mappings[pieKey]).To(Equal(expectedMetadata))
+			})
+
+			It("returns error if user address is invalid", func() {
+				storageRepository.PotPieUsers = []string{"0xinvalid"}
+
+				_, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("wards", func() {
+		It("returns value metadata for wards", func() {
+			wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+			storageRepository.WardsKeys = []string{wardsUser}
+			paddedWardsUser := "0x000000000000000000000000" + wardsUser[2:]
+			wardsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedWardsUser + mcdStorage.WardsMappingIndex)))
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetWardsKeysCalledWith).To(Equal(test_helpers.FakeAddress))
+			Expect(mappings[wardsKey]).To(Equal(mcdStorage.GetWardsMetadata(wardsUser)))
+		})
+
+		It("returns error if wards keys lookup fails", func() {
+			storageRepository.GetWardsKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+	})
+})
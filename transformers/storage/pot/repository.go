@@ -0,0 +1,115 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pot
+
+import (
+	"fmt"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+const (
+	insertUserPieQuery = `INSERT INTO maker.pot_user_pie (block_number, block_hash, msg_sender, pie) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	insertPieQuery     = `INSERT INTO maker.pot_pie (block_number, block_hash, pie) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertDsrQuery     = `INSERT INTO maker.pot_dsr (block_number, block_hash, dsr) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertChiQuery     = `INSERT INTO maker.pot_chi (block_number, block_hash, chi) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertVowQuery     = `INSERT INTO maker.pot_vow (block_number, block_hash, vow) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertRhoQuery     = `INSERT INTO maker.pot_rho (block_number, block_hash, rho) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	insertLiveQuery    = `INSERT INTO maker.pot_live (block_number, block_hash, live) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+)
+
+type PotStorageRepository struct {
+	ContractAddress string
+	db              *postgres.DB
+}
+
+func (repository *PotStorageRepository) SetDB(db *postgres.DB) {
+	repository.db = db
+}
+
+func (repository PotStorageRepository) Create(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, value interface{}) error {
+	switch metadata.Name {
+	case UserPie:
+		return repository.insertUserPie(blockNumber, blockHash, metadata, value.(string))
+	case Pie:
+		return repository.insertPie(blockNumber, blockHash, value.(string))
+	case Dsr:
+		return repository.insertDsr(blockNumber, blockHash, value.(string))
+	case Chi:
+		return repository.insertChi(blockNumber, blockHash, value.(string))
+	case Vow:
+		return repository.insertVow(blockNumber, blockHash, value.(string))
+	case Rho:
+		return repository.insertRho(blockNumber, blockHash, value.(string))
+	case Live:
+		return repository.insertLive(blockNumber, blockHash, value.(string))
+	case mcdStorage.Wards:
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
+	default:
+		return fmt.Errorf("unrecognized pot contract storage name: %s", metadata.Name)
+	}
+}
+
+func (repository PotStorageRepository) insertUserPie(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, pie string) error {
+	msgSender, keyErr := getMsgSender(metadata.Keys)
+	if keyErr != nil {
+		return keyErr
+	}
+	_, writeErr := repository.db.Exec(insertUserPieQuery, blockNumber, blockHash, msgSender, pie)
+	return writeErr
+}
+
+func (repository PotStorageRepository) insertPie(blockNumber int, blockHash string, pie string) error {
+	_, err := repository.db.Exec(insertPieQuery, blockNumber, blockHash, pie)
+	return err
+}
+
+func (repository PotStorageRepository) insertDsr(blockNumber int, blockHash string, dsr string) error {
+	_, err := repository.db.Exec(insertDsrQuery, blockNumber, blockHash, dsr)
+	return err
+}
+
+func (repository PotStorageRepository) insertChi(blockNumber int, blockHash string, chi string) error {
+	_, err := repository.db.Exec(insertChiQuery, blockNumber, blockHash, chi)
+	return err
+}
+
+func (repository PotStorageRepository) insertVow(blockNumber int, blockHash string, vow string) error {
+	_, err := repository.db.Exec(insertVowQuery, blockNumber, blockHash, vow)
+	return err
+}
+
+func (repository PotStorageRepository) insertRho(blockNumber int, blockHash string, rho string) error {
+	_, err := repository.db.Exec(insertRhoQuery, blockNumber, blockHash, rho)
+	return err
+}
+
+func (repository PotStorageRepository) insertLive(blockNumber int, blockHash string, live string) error {
+	_, err := repository.db.Exec(insertLiveQuery, blockNumber, blockHash, live)
+	return err
+}
+
+func getMsgSender(keys map[utils.Key]string) (string, error) {
+	msgSender, ok := keys[constants.MsgSender]
+	if !ok {
+		return "", utils.ErrMetadataMalformed{MissingData: constants.MsgSender}
+	}
+	return msgSender, nil
+}
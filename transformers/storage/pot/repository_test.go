@@ -0,0 +1,178 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pot_test
+
+import (
+	"math/rand"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/test_config"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/pot"
+	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var _ = Describe("Pot storage repository", func() {
+	var (
+		db              *postgres.DB
+		repository      pot.PotStorageRepository
+		fakeBlockNumber int
+		fakeHash        string
+		fakeUint256     = strconv.Itoa(rand.Int())
+	)
+
+	BeforeEach(func() {
+		db = test_config.NewTestDB(test_config.NewTestNode())
+		test_config.CleanTestDB(db)
+		repository = pot.PotStorageRepository{ContractAddress: FakeAddress}
+		repository.SetDB(db)
+		fakeBlockNumber = rand.Int()
+		fakeHash = fakes.FakeHash.Hex()
+	})
+
+	It("returns an error if the metadata name is not recognized", func() {
+		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
+
+		err := repository.Create(fakeBlockNumber, fakeHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError("unrecognized pot contract storage name: unrecognized"))
+	})
+
+	Describe("user pie", func() {
+		It("returns an error if metadata is missing the user", func() {
+			badMetadata := utils.StorageValueMetadata{
+				Name: pot.UserPie,
+				Keys: map[utils.Key]string{},
+				Type: utils.Uint256,
+			}
+			err := repository.Create(fakeBlockNumber, fakeHash, badMetadata, fakeUint256)
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.MsgSender}))
+		})
+
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "msg_sender",
+			ValueFieldName:   "pie",
+			Key:              FakeAddress,
+			Value:            fakeUint256,
+			IsAMapping:       true,
+			StorageTableName: "maker.pot_user_pie",
+			Repository:       &repository,
+			Metadata: utils.StorageValueMetadata{
+				Name: pot.UserPie,
+				Keys: map[utils.Key]string{constants.MsgSender: FakeAddress},
+				Type: utils.Uint256,
+			},
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("Pie", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   "pie",
+			Value:            fakeUint256,
+			StorageTableName: "maker.pot_pie",
+			Repository:       &repository,
+			Metadata:         pot.PieMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("dsr", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   pot.Dsr,
+			Value:            fakeUint256,
+			StorageTableName: "maker.pot_dsr",
+			Repository:       &repository,
+			Metadata:         pot.DsrMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("chi", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   pot.Chi,
+			Value:            fakeUint256,
+			StorageTableName: "maker.pot_chi",
+			Repository:       &repository,
+			Metadata:         pot.ChiMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("vow", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   pot.Vow,
+			Value:            FakeAddress,
+			StorageTableName: "maker.pot_vow",
+			Repository:       &repository,
+			Metadata:         pot.VowMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("rho", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   pot.Rho,
+			Value:            fakeUint256,
+			StorageTableName: "maker.pot_rho",
+			Repository:       &repository,
+			Metadata:         pot.RhoMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("live", func() {
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			ValueFieldName:   pot.Live,
+			Value:            "1",
+			StorageTableName: "maker.pot_live",
+			Repository:       &repository,
+			Metadata:         pot.LiveMetadata,
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   mcdStorage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repository,
+			Metadata:         mcdStorage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
+})
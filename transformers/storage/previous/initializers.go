@@ -0,0 +1,86 @@
+// VulcanizeDB
+// Copyright © 2018 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package previous
+
+import (
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	cat "github.com/vulcanize/mcd_transformers/transformers/storage/cat/initializer"
+	cdp_manager "github.com/vulcanize/mcd_transformers/transformers/storage/cdp_manager/initializer"
+	chief "github.com/vulcanize/mcd_transformers/transformers/storage/chief/initializer"
+	dai "github.com/vulcanize/mcd_transformers/transformers/storage/dai/initializer"
+	end "github.com/vulcanize/mcd_transformers/transformers/storage/end/initializer"
+	esm "github.com/vulcanize/mcd_transformers/transformers/storage/esm/initializer"
+	flap "github.com/vulcanize/mcd_transformers/transformers/storage/flap/initializer"
+	flip "github.com/vulcanize/mcd_transformers/transformers/storage/flip/initializers"
+	flop "github.com/vulcanize/mcd_transformers/transformers/storage/flop/initializer"
+	jug "github.com/vulcanize/mcd_transformers/transformers/storage/jug/initializer"
+	osm "github.com/vulcanize/mcd_transformers/transformers/storage/osm/initializers"
+	pot "github.com/vulcanize/mcd_transformers/transformers/storage/pot/initializer"
+	spot "github.com/vulcanize/mcd_transformers/transformers/storage/spot/initializer"
+	vat "github.com/vulcanize/mcd_transformers/transformers/storage/vat/initializer"
+	vow "github.com/vulcanize/mcd_transformers/transformers/storage/vow/initializer"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+)
+
+// StorageContract is a contract with a storage transformer, and how to build the transformer for one of its deployments
+type StorageContract struct {
+	ContractName string
+	Generate     func(contractAddress string) transformer.StorageTransformerInitializer
+}
+
+// Contracts maps the exporter name of every storage transformer to its contract
+var Contracts = map[string]StorageContract{
+	"bat_flip":     {"MCD_FLIP_BAT_A", flip.GenerateStorageTransformerInitializer},
+	"cat":          {"MCD_CAT", cat.GenerateStorageTransformerInitializer},
+	"cdp_manager":  {"CDP_MANAGER", cdp_manager.GenerateStorageTransformerInitializer},
+	"chief":        {"MCD_ADM", chief.GenerateStorageTransformerInitializer},
+	"dai":          {"MCD_DAI", dai.GenerateStorageTransformerInitializer},
+	"dgd_flip":     {"MCD_FLIP_DGD_A", flip.GenerateStorageTransformerInitializer},
+	"end":          {"MCD_END", end.GenerateStorageTransformerInitializer},
+	"esm":          {"MCD_ESM", esm.GenerateStorageTransformerInitializer},
+	"eth_flip_a":   {"MCD_FLIP_ETH_A", flip.GenerateStorageTransformerInitializer},
+	"eth_flip_b":   {"MCD_FLIP_ETH_B", flip.GenerateStorageTransformerInitializer},
+	"eth_flip_c":   {"MCD_FLIP_ETH_C", flip.GenerateStorageTransformerInitializer},
+	"eth_osm":      {"PIP_ETH", osm.GenerateStorageTransformerInitializer},
+	"flap_storage": {"MCD_FLAP", flap.GenerateStorageTransformerInitializer},
+	"flop_storage": {"MCD_FLOP", flop.GenerateStorageTransformerInitializer},
+	"gnt_flip":     {"MCD_FLIP_GNT_A", flip.GenerateStorageTransformerInitializer},
+	"jug":          {"MCD_JUG", jug.GenerateStorageTransformerInitializer},
+	"omg_flip":     {"MCD_FLIP_OMG_A", flip.GenerateStorageTransformerInitializer},
+	"pot":          {"MCD_POT", pot.GenerateStorageTransformerInitializer},
+	"rep_flip":     {"MCD_FLIP_REP_A", flip.GenerateStorageTransformerInitializer},
+	"spot":         {"MCD_SPOT", spot.GenerateStorageTransformerInitializer},
+	"vat":          {"MCD_VAT", vat.GenerateStorageTransformerInitializer},
+	"vow":          {"MCD_VOW", vow.GenerateStorageTransformerInitializer},
+	"zrx_flip":     {"MCD_FLIP_ZRX_A", flip.GenerateStorageTransformerInitializer},
+}
+
+// StorageTransformerInitializers returns an initializer for each previous deployment of the configured storage
+// transformers' contracts. The plugin exporter only takes one initializer per package, so it appends these to the
+// configured ones.
+func StorageTransformerInitializers() []transformer.StorageTransformerInitializer {
+	var initializers []transformer.StorageTransformerInitializer
+	for _, transformerName := range constants.GetTransformerNames() {
+		contract, ok := Contracts[transformerName]
+		if !ok {
+			continue
+		}
+		initializers = append(initializers, mcdStorage.GeneratePreviousDeploymentInitializers(contract.ContractName, contract.Generate)...)
+	}
+	return initializers
+}
@@ -14,31 +14,31 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package new_cdp_test
+package previous_test
 
 import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/vulcanize/vulcanizedb/pkg/core"
-
-	"github.com/vulcanize/mcd_transformers/transformers/events/new_cdp"
+	"github.com/spf13/viper"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
-	"github.com/vulcanize/mcd_transformers/transformers/test_data"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/previous"
 )
 
-var _ = Describe("NewCdp Converter", func() {
-	var converter = new_cdp.NewCdpConverter{}
-
-	It("converts a log to a Model", func() {
-		models, err := converter.ToModels(constants.CdpManagerABI(), []core.HeaderSyncLog{test_data.NewCdpHeaderSyncLog})
-
-		Expect(err).NotTo(HaveOccurred())
-		Expect(len(models)).To(Equal(1))
-		Expect(models[0]).To(Equal(test_data.NewCdpModel()))
+var _ = Describe("Previous deployment storage initializers", func() {
+	It("registers every configured storage transformer", func() {
+		transformerNames := constants.GetTransformerNames()
+		Expect(transformerNames).NotTo(BeEmpty())
+		for _, transformerName := range transformerNames {
+			if viper.GetString("exporter."+transformerName+".type") != "eth_storage" {
+				continue
+			}
+			Expect(previous.Contracts).To(HaveKey(transformerName))
+		}
 	})
 
-	It("returns an error if converting log to entity fails", func() {
-		_, err := converter.ToModels("error abi", []core.HeaderSyncLog{test_data.NewCdpHeaderSyncLog})
-		Expect(err).To(HaveOccurred())
+	It("registers contracts that are configured", func() {
+		for _, contract := range previous.Contracts {
+			Expect(constants.GetContractDeployments(contract.ContractName)).NotTo(BeEmpty())
+		}
 	})
 })
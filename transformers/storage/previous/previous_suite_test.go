@@ -14,22 +14,20 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package vat_slip_test
+package previous_test
 
 import (
-	"io/ioutil"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	log "github.com/sirupsen/logrus"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
 )
 
-func TestVatSlip(t *testing.T) {
+func TestPrevious(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "VatSlip Suite")
+	RunSpecs(t, "Previous Suite")
 }
 
-var _ = BeforeSuite(func() {
-	log.SetOutput(ioutil.Discard)
-})
+// The registry is checked against the exporter config
+var configSet = test_data.SetTestConfig()
@@ -29,6 +29,27 @@ type Urn struct {
 	Identifier string
 }
 
+type Allowance struct {
+	Owner   string
+	Spender string
+}
+
+type Can struct {
+	Bit string
+	Usr string
+}
+
+type CdpCan struct {
+	Owner string
+	Cdpi  string
+	Usr   string
+}
+
+type UrnCan struct {
+	Owner string
+	Usr   string
+}
+
 var ErrNoFlips = errors.New("no flips exist in db")
 
 type IMakerStorageRepository interface {
@@ -41,8 +62,21 @@ type IMakerStorageRepository interface {
 	GetUrns() ([]Urn, error)
 	GetCdpis() ([]string, error)
 	GetOwners() ([]string, error)
+	GetCdpCanKeys() ([]CdpCan, error)
+	GetChiefVoters() ([]string, error)
+	GetChiefCandidates() ([]string, error)
+	GetUrnCanKeys() ([]UrnCan, error)
 	GetFlipBidIds(contractAddress string) ([]string, error)
 	GetFlopBidIds(contractAddress string) ([]string, error)
+	GetPotPieUsers() ([]string, error)
+	GetEsmSumUsers() ([]string, error)
+	GetEndBagKeys() ([]string, error)
+	GetEndOutKeys() ([]Urn, error)
+	GetDaiBalanceOfKeys() ([]string, error)
+	GetDaiAllowanceKeys() ([]Allowance, error)
+	GetDaiNoncesKeys() ([]string, error)
+	GetVatCanKeys() ([]Can, error)
+	GetWardsKeys(contractAddress string) ([]string, error)
 	SetDB(db *postgres.DB)
 }
 
@@ -190,6 +224,48 @@ func (repository *MakerStorageRepository) GetOwners() ([]string, error) {
 	return owners, err
 }
 
+// cdpAllow writes to the permissions of the CDP's current owner, so grants are paired with every known owner of the CDP
+func (repository *MakerStorageRepository) GetCdpCanKeys() ([]CdpCan, error) {
+	var cdpCanKeys []CdpCan
+	err := repository.db.Select(&cdpCanKeys, `
+		SELECT DISTINCT owns.owner, allow.cdpi, allow.usr
+		FROM maker.cdp_manager_cdp_allow allow
+			INNER JOIN maker.cdp_manager_owns owns ON owns.cdpi = allow.cdpi`)
+	return cdpCanKeys, err
+}
+
+func (repository *MakerStorageRepository) GetUrnCanKeys() ([]UrnCan, error) {
+	var urnCanKeys []UrnCan
+	err := repository.db.Select(&urnCanKeys, `
+		SELECT DISTINCT msg_sender AS owner, usr
+		FROM maker.cdp_manager_urn_allow`)
+	return urnCanKeys, err
+}
+
+func (repository *MakerStorageRepository) GetChiefVoters() ([]string, error) {
+	var voters []string
+	err := repository.db.Select(&voters, `
+		SELECT DISTINCT msg_sender FROM maker.chief_lock
+		UNION
+		SELECT DISTINCT msg_sender FROM maker.chief_free
+		UNION
+		SELECT DISTINCT msg_sender FROM maker.chief_vote
+		UNION
+		SELECT DISTINCT msg_sender FROM maker.chief_vote_yays`)
+	return voters, err
+}
+
+func (repository *MakerStorageRepository) GetChiefCandidates() ([]string, error) {
+	var candidates []string
+	err := repository.db.Select(&candidates, `
+		SELECT DISTINCT whom FROM maker.chief_lift
+		UNION
+		SELECT DISTINCT UNNEST(yays) FROM maker.chief_etch
+		UNION
+		SELECT DISTINCT UNNEST(yays) FROM maker.chief_vote_yays`)
+	return candidates, err
+}
+
 func (repository *MakerStorageRepository) GetFlipBidIds(contractAddress string) ([]string, error) {
 	var bidIds []string
 	addressId, addressErr := repository.GetOrCreateAddress(contractAddress)
@@ -244,6 +320,108 @@ func (repository *MakerStorageRepository) GetFlopBidIds(contractAddress string)
 	return bidIds, err
 }
 
+func (repository *MakerStorageRepository) GetPotPieUsers() ([]string, error) {
+	var userAddresses []string
+	err := repository.db.Select(&userAddresses, `
+		SELECT DISTINCT msg_sender FROM maker.pot_join
+		UNION
+		SELECT DISTINCT msg_sender FROM maker.pot_exit`)
+	return userAddresses, err
+}
+
+func (repository *MakerStorageRepository) GetEsmSumUsers() ([]string, error) {
+	var userAddresses []string
+	err := repository.db.Select(&userAddresses, `SELECT DISTINCT msg_sender FROM maker.esm_join`)
+	return userAddresses, err
+}
+
+func (repository *MakerStorageRepository) GetEndBagKeys() ([]string, error) {
+	var bagKeys []string
+	err := repository.db.Select(&bagKeys, `
+		SELECT DISTINCT msg_sender FROM maker.end_pack
+		UNION
+		SELECT DISTINCT msg_sender FROM maker.end_cash`)
+	return bagKeys, err
+}
+
+func (repository *MakerStorageRepository) GetEndOutKeys() ([]Urn, error) {
+	var outKeys []Urn
+	err := repository.db.Select(&outKeys, `
+		SELECT DISTINCT ilks.ilk, cash.msg_sender AS identifier
+		FROM maker.end_cash cash
+		INNER JOIN maker.ilks ilks ON ilks.id = cash.ilk_id`)
+	return outKeys, err
+}
+
+func (repository *MakerStorageRepository) GetDaiBalanceOfKeys() ([]string, error) {
+	var balanceKeys []string
+	err := repository.db.Select(&balanceKeys, `
+		SELECT DISTINCT src FROM maker.dai_transfer
+		UNION
+		SELECT DISTINCT dst FROM maker.dai_transfer`)
+	return balanceKeys, err
+}
+
+func (repository *MakerStorageRepository) GetDaiAllowanceKeys() ([]Allowance, error) {
+	var allowanceKeys []Allowance
+	err := repository.db.Select(&allowanceKeys, `
+		SELECT DISTINCT src AS owner, guy AS spender
+		FROM maker.dai_approval`)
+	return allowanceKeys, err
+}
+
+// Nonces are only incremented by permit, which always emits an Approval for the holder
+func (repository *MakerStorageRepository) GetDaiNoncesKeys() ([]string, error) {
+	var nonceKeys []string
+	err := repository.db.Select(&nonceKeys, `SELECT DISTINCT src FROM maker.dai_approval`)
+	return nonceKeys, err
+}
+
+// Vat log notes don't include msg.sender, so each hope or nope is paired with the addresses that could have made the
+// call in its transaction: the transaction sender, proxies owned by the sender, and the UrnHandler of a cdp opened in
+// the same transaction (which hopes the cdp manager from its constructor)
+func (repository *MakerStorageRepository) GetVatCanKeys() ([]Can, error) {
+	var canKeys []Can
+	err := repository.db.Select(&canKeys, `
+		WITH permissions AS (
+			SELECT header_id, log_id, usr FROM maker.vat_hope
+			UNION
+			SELECT header_id, log_id, usr FROM maker.vat_nope
+		),
+		     senders AS (
+		         SELECT permissions.header_id, header_sync_logs.tx_index, transactions.tx_from, permissions.usr
+		         FROM permissions
+		             INNER JOIN public.header_sync_logs ON header_sync_logs.id = permissions.log_id
+		             INNER JOIN public.header_sync_transactions AS transactions
+		                 ON transactions.header_id = permissions.header_id
+		                 AND transactions.tx_index = header_sync_logs.tx_index
+		     )
+		SELECT tx_from AS bit, usr FROM senders
+		UNION
+		SELECT proxies.proxy AS bit, senders.usr
+		FROM senders
+		    INNER JOIN maker.proxies ON LOWER(proxies.owner) = LOWER(senders.tx_from)
+		UNION
+		SELECT cdp_manager_urns.urn AS bit, senders.usr
+		FROM senders
+		    INNER JOIN public.header_sync_logs
+		        ON header_sync_logs.header_id = senders.header_id
+		        AND header_sync_logs.tx_index = senders.tx_index
+		    INNER JOIN maker.new_cdp ON new_cdp.log_id = header_sync_logs.id
+		    INNER JOIN maker.cdp_manager_urns ON cdp_manager_urns.cdpi = new_cdp.cdp`)
+	return canKeys, err
+}
+
+func (repository *MakerStorageRepository) GetWardsKeys(contractAddress string) ([]string, error) {
+	var wardsKeys []string
+	addressId, addressErr := repository.GetOrCreateAddress(contractAddress)
+	if addressErr != nil {
+		return []string{}, addressErr
+	}
+	err := repository.db.Select(&wardsKeys, `SELECT DISTINCT usr FROM maker.auth WHERE address_id = $1`, addressId)
+	return wardsKeys, err
+}
+
 func (repository *MakerStorageRepository) GetOrCreateAddress(contractAddress string) (int64, error) {
 	return repository2.GetOrCreateAddress(repository.db, contractAddress)
 }
@@ -39,6 +39,7 @@ import (
 	"math/big"
 	"math/rand"
 	"strconv"
+	"strings"
 )
 
 var _ = Describe("Maker storage repository", func() {
@@ -623,6 +624,356 @@ var _ = Describe("Maker storage repository", func() {
 			Expect(cdpis).To(BeEmpty())
 		})
 	})
+
+	Describe("getting pot pie users", func() {
+		It("fetches unique msg senders from pot_join + pot_exit", func() {
+			insertPotJoin(guy1, 1, db)
+			insertPotJoin(guy2, 2, db)
+			insertPotExit(guy1, 3, db)
+			insertPotExit(guy3, 4, db)
+
+			users, err := repository.GetPotPieUsers()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(users)).To(Equal(3))
+			Expect(users).To(ConsistOf(guy1, guy2, guy3))
+		})
+
+		It("does not return error if no matching rows", func() {
+			users, err := repository.GetPotPieUsers()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(users)).To(BeZero())
+		})
+	})
+
+	Describe("getting esm sum users", func() {
+		It("fetches unique msg senders from esm_join", func() {
+			insertEsmJoin(guy1, 1, db)
+			insertEsmJoin(guy1, 2, db)
+			insertEsmJoin(guy2, 3, db)
+
+			users, err := repository.GetEsmSumUsers()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(users)).To(Equal(2))
+			Expect(users).To(ConsistOf(guy1, guy2))
+		})
+
+		It("does not return error if no matching rows", func() {
+			users, err := repository.GetEsmSumUsers()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(users)).To(BeZero())
+		})
+	})
+
+	Describe("getting end bag keys", func() {
+		It("fetches unique msg senders from end_pack + end_cash", func() {
+			insertEndPack(guy1, 1, db)
+			insertEndPack(guy2, 2, db)
+			insertEndCash(ilk1, guy1, 3, db)
+			insertEndCash(ilk2, guy3, 4, db)
+
+			keys, err := repository.GetEndBagKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(3))
+			Expect(keys).To(ConsistOf(guy1, guy2, guy3))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetEndBagKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting end out keys", func() {
+		It("fetches unique ilk and msg sender pairs from end_cash", func() {
+			insertEndCash(ilk1, guy1, 1, db)
+			insertEndCash(ilk1, guy1, 2, db)
+			insertEndCash(ilk1, guy2, 3, db)
+			insertEndCash(ilk2, guy1, 4, db)
+
+			keys, err := repository.GetEndOutKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(3))
+			Expect(keys).To(ConsistOf([]storage.Urn{{
+				Ilk:        ilk1,
+				Identifier: guy1,
+			}, {
+				Ilk:        ilk1,
+				Identifier: guy2,
+			}, {
+				Ilk:        ilk2,
+				Identifier: guy1,
+			}}))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetEndOutKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting dai balanceOf keys", func() {
+		It("fetches unique sources and destinations from dai_transfer", func() {
+			insertDaiTransfer(guy1, guy2, 1, db)
+			insertDaiTransfer(guy2, guy1, 2, db)
+			insertDaiTransfer(guy1, guy3, 3, db)
+
+			keys, err := repository.GetDaiBalanceOfKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(3))
+			Expect(keys).To(ConsistOf(guy1, guy2, guy3))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetDaiBalanceOfKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting dai allowance keys", func() {
+		It("fetches unique owner and spender pairs from dai_approval", func() {
+			insertDaiApproval(guy1, guy2, 1, db)
+			insertDaiApproval(guy1, guy2, 2, db)
+			insertDaiApproval(guy2, guy1, 3, db)
+
+			keys, err := repository.GetDaiAllowanceKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(2))
+			Expect(keys).To(ConsistOf([]storage.Allowance{{
+				Owner:   guy1,
+				Spender: guy2,
+			}, {
+				Owner:   guy2,
+				Spender: guy1,
+			}}))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetDaiAllowanceKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting dai nonces keys", func() {
+		It("fetches unique owners from dai_approval", func() {
+			insertDaiApproval(guy1, guy2, 1, db)
+			insertDaiApproval(guy1, guy3, 2, db)
+			insertDaiApproval(guy2, guy1, 3, db)
+
+			keys, err := repository.GetDaiNoncesKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(2))
+			Expect(keys).To(ConsistOf(guy1, guy2))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetDaiNoncesKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting vat can keys", func() {
+		It("pairs each usr from vat_hope + vat_nope with the sender of its transaction", func() {
+			transactionFromGuy2 := core.TransactionModel{From: guy2, TxIndex: 2, Value: "0"}
+			insertVatHopeOrNope("vat_hope", guy2, 1, transactionFromGuy1, db)
+			insertVatHopeOrNope("vat_nope", guy2, 2, transactionFromGuy1, db)
+			insertVatHopeOrNope("vat_hope", guy3, 3, transactionFromGuy2, db)
+
+			keys, err := repository.GetVatCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(ConsistOf([]storage.Can{
+				{Bit: guy1, Usr: guy2},
+				{Bit: guy2, Usr: guy3},
+			}))
+		})
+
+		It("includes proxies owned by the transaction sender as bits", func() {
+			proxy := "0x50524f5859"
+			insertProxy(strings.ToUpper(guy1), proxy, 1, db)
+			insertProxy(guy3, "0x4f54484552", 2, db)
+			insertVatHopeOrNope("vat_hope", guy2, 3, transactionFromGuy1, db)
+
+			keys, err := repository.GetVatCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(ConsistOf([]storage.Can{
+				{Bit: guy1, Usr: guy2},
+				{Bit: proxy, Usr: guy2},
+			}))
+		})
+
+		It("includes the urn of a cdp opened in the same transaction as a bit", func() {
+			managedUrn := "0x4d414e41474544"
+			_, managedUrnErr := db.Exec(`INSERT INTO maker.cdp_manager_urns (block_number, block_hash, cdpi, urn) VALUES (1, '', 1, $1)`, managedUrn)
+			Expect(managedUrnErr).NotTo(HaveOccurred())
+			_, otherUrnErr := db.Exec(`INSERT INTO maker.cdp_manager_urns (block_number, block_hash, cdpi, urn) VALUES (1, '', 2, '0x4f54484552')`)
+			Expect(otherUrnErr).NotTo(HaveOccurred())
+			insertVatHopeOrNope("vat_hope", guy2, 2, transactionFromGuy1, db)
+			insertNewCdpInTransaction(1, 2, transactionFromGuy1, db)
+			insertNewCdpInTransaction(2, 3, transactionFromGuy1, db)
+
+			keys, err := repository.GetVatCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(ConsistOf([]storage.Can{
+				{Bit: guy1, Usr: guy2},
+				{Bit: managedUrn, Usr: guy2},
+			}))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetVatCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting cdp manager cdpCan keys", func() {
+		It("fetches unique owner, cdpi and usr combinations from cdp_manager_cdp_allow + cdp_manager_owns", func() {
+			insertCdpManagerOwns(1, 1, guy1, db)
+			insertCdpManagerOwns(2, 1, guy2, db)
+			insertCdpManagerOwns(3, 2, guy3, db)
+			insertCdpManagerCdpAllow(1, guy3, 4, db)
+			insertCdpManagerCdpAllow(1, guy3, 5, db)
+			insertCdpManagerCdpAllow(3, guy1, 6, db)
+
+			keys, err := repository.GetCdpCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(2))
+			Expect(keys).To(ConsistOf([]storage.CdpCan{{
+				Owner: guy1,
+				Cdpi:  "1",
+				Usr:   guy3,
+			}, {
+				Owner: guy2,
+				Cdpi:  "1",
+				Usr:   guy3,
+			}}))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetCdpCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting cdp manager urnCan keys", func() {
+		It("fetches unique msg sender and usr pairs from cdp_manager_urn_allow", func() {
+			insertCdpManagerUrnAllow(guy1, guy2, 1, db)
+			insertCdpManagerUrnAllow(guy1, guy2, 2, db)
+			insertCdpManagerUrnAllow(guy2, guy3, 3, db)
+
+			keys, err := repository.GetUrnCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(2))
+			Expect(keys).To(ConsistOf([]storage.UrnCan{{
+				Owner: guy1,
+				Usr:   guy2,
+			}, {
+				Owner: guy2,
+				Usr:   guy3,
+			}}))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetUrnCanKeys()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
+
+	Describe("getting chief voters", func() {
+		It("fetches unique msg senders from chief lock, free, vote and vote yays logs", func() {
+			insertChiefLockOrFree("maker.chief_lock", guy1, 1, db)
+			insertChiefLockOrFree("maker.chief_free", guy1, 2, db)
+			insertChiefLockOrFree("maker.chief_free", guy2, 3, db)
+			insertChiefVote(guy3, 4, db)
+			insertChiefEtchOrVoteYays("maker.chief_vote_yays", guy1, []string{guy2}, 5, db)
+
+			voters, err := repository.GetChiefVoters()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(voters)).To(Equal(3))
+			Expect(voters).To(ConsistOf(guy1, guy2, guy3))
+		})
+
+		It("does not return error if no matching rows", func() {
+			voters, err := repository.GetChiefVoters()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(voters)).To(BeZero())
+		})
+	})
+
+	Describe("getting chief candidates", func() {
+		It("fetches unique candidates from chief lift logs and etched or voted slates", func() {
+			insertChiefLift(guy1, 1, db)
+			insertChiefEtchOrVoteYays("maker.chief_etch", guy3, []string{guy1, guy2}, 2, db)
+			insertChiefEtchOrVoteYays("maker.chief_vote_yays", guy3, []string{guy2, guy3}, 3, db)
+
+			candidates, err := repository.GetChiefCandidates()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(candidates)).To(Equal(3))
+			Expect(candidates).To(ConsistOf(guy1, guy2, guy3))
+		})
+
+		It("does not return error if no matching rows", func() {
+			candidates, err := repository.GetChiefCandidates()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(candidates)).To(BeZero())
+		})
+	})
+
+	Describe("getting wards keys", func() {
+		It("fetches unique usrs from rely and deny logs on the given contract", func() {
+			otherAddressId, otherAddressErr := shared.GetOrCreateAddress("0x4f26ffbe5f04ed43630fdc30a87638d53d0b0876", db)
+			Expect(otherAddressErr).NotTo(HaveOccurred())
+			insertAuth("rely", guy1, 1, addressId, db)
+			insertAuth("deny", guy1, 2, addressId, db)
+			insertAuth("rely", guy2, 3, addressId, db)
+			insertAuth("rely", guy3, 4, otherAddressId, db)
+
+			keys, err := repository.GetWardsKeys(address)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(Equal(2))
+			Expect(keys).To(ConsistOf(guy1, guy2))
+		})
+
+		It("does not return error if no matching rows", func() {
+			keys, err := repository.GetWardsKeys(address)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(keys)).To(BeZero())
+		})
+	})
 })
 
 func insertFlapKick(blockNumber int64, bidId string, contractAddressId int64, db *postgres.DB) {
@@ -733,6 +1084,79 @@ func insertCdpManagerCdpi(blockNumber int64, cdpi int, db *postgres.DB) {
 	Expect(err).NotTo(HaveOccurred())
 }
 
+func insertCdpManagerOwns(blockNumber int64, cdpi int, owner string, db *postgres.DB) {
+	_, err := db.Exec(`INSERT INTO maker.cdp_manager_owns (block_number, block_hash, cdpi, owner)
+		VALUES($1, '', $2::NUMERIC, $3)`,
+		blockNumber, cdpi, owner)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+func insertCdpManagerCdpAllow(cdpi int, usr string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	cdpAllowLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.cdp_manager_cdp_allow (header_id, cdpi, usr, ok, log_id)
+			VALUES($1, $2::NUMERIC, $3, 1, $4)`,
+		headerID, cdpi, usr, cdpAllowLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertCdpManagerUrnAllow(msgSender, usr string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	urnAllowLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.cdp_manager_urn_allow (header_id, msg_sender, usr, ok, log_id)
+			VALUES($1, $2, $3, 1, $4)`,
+		headerID, msgSender, usr, urnAllowLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertChiefLockOrFree(table, msgSender string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	chiefLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO `+table+` (header_id, msg_sender, wad, log_id)
+			VALUES($1, $2, $3, $4)`,
+		headerID, msgSender, 0, chiefLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertChiefVote(msgSender string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	chiefVoteLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.chief_vote (header_id, msg_sender, slate, log_id)
+			VALUES($1, $2, $3, $4)`,
+		headerID, msgSender, fakes.FakeHash.Hex(), chiefVoteLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertChiefLift(whom string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	chiefLiftLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.chief_lift (header_id, msg_sender, whom, log_id)
+			VALUES($1, $2, $3, $4)`,
+		headerID, whom, whom, chiefLiftLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertChiefEtchOrVoteYays(table, msgSender string, yays []string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	chiefLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO `+table+` (header_id, msg_sender, slate, yays, log_id)
+			VALUES($1, $2, $3, $4, $5)`,
+		headerID, msgSender, fakes.FakeHash.Hex(), "{"+strings.Join(yays, ",")+"}", chiefLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
 func insertVatFold(urn string, blockNumber int64, db *postgres.DB) {
 	headerID := insertHeader(db, blockNumber)
 	vatFoldLog := test_data.CreateTestLog(headerID, db)
@@ -870,6 +1294,55 @@ func insertVatHeal(blockNumber int64, transaction core.TransactionModel, db *pos
 	Expect(execErr).NotTo(HaveOccurred())
 }
 
+func insertVatHopeOrNope(table, usr string, blockNumber int64, transaction core.TransactionModel, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	log := types.Log{TxIndex: uint(transaction.TxIndex), BlockNumber: uint64(blockNumber)}
+	logs := test_data.CreateLogs(headerID, []types.Log{log}, db)
+	Expect(len(logs)).To(Equal(1))
+	insertTransaction(blockNumber, transaction, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.`+table+` (header_id, usr, log_id)
+			VALUES($1, $2, $3)`,
+		headerID, usr, logs[0].ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertProxy(owner, proxy string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	proxyLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.proxies (header_id, sender, owner, proxy, log_id)
+			VALUES($1, $2, $2, $3, $4)`,
+		headerID, owner, proxy, proxyLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertNewCdpInTransaction(cdpi, blockNumber int64, transaction core.TransactionModel, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	log := types.Log{TxIndex: uint(transaction.TxIndex), Index: 1, BlockNumber: uint64(blockNumber)}
+	logs := test_data.CreateLogs(headerID, []types.Log{log}, db)
+	Expect(len(logs)).To(Equal(1))
+	_, execErr := db.Exec(
+		`INSERT INTO maker.new_cdp (header_id, cdp, log_id)
+			VALUES($1, $2, $3)`,
+		headerID, cdpi, logs[0].ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertAuth(action, usr string, blockNumber, contractAddressId int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	authLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.auth (header_id, address_id, usr, action, log_id)
+			VALUES($1, $2, $3, $4, $5)`,
+		headerID, contractAddressId, usr, action, authLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
 func insertVatMove(src, dst string, blockNumber int64, db *postgres.DB) {
 	headerID := insertHeader(db, blockNumber)
 	vatMoveLog := test_data.CreateTestLog(headerID, db)
@@ -894,6 +1367,85 @@ func insertVatSlip(ilk, usr string, blockNumber int64, db *postgres.DB) {
 	Expect(execErr).NotTo(HaveOccurred())
 }
 
+func insertPotJoin(msgSender string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	potJoinLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.pot_join (header_id, msg_sender, wad, log_id)
+			VALUES($1, $2, $3, $4)`,
+		headerID, msgSender, 0, potJoinLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertPotExit(msgSender string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	potExitLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.pot_exit (header_id, msg_sender, wad, log_id)
+			VALUES($1, $2, $3, $4)`,
+		headerID, msgSender, 0, potExitLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertEsmJoin(msgSender string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	esmJoinLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.esm_join (header_id, msg_sender, wad, log_id)
+			VALUES($1, $2, $3, $4)`,
+		headerID, msgSender, 0, esmJoinLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertEndPack(msgSender string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	endPackLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.end_pack (header_id, msg_sender, wad, log_id)
+			VALUES($1, $2, $3, $4)`,
+		headerID, msgSender, 0, endPackLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertEndCash(ilk, msgSender string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	endCashLog := test_data.CreateTestLog(headerID, db)
+	ilkID, err := shared.GetOrCreateIlk(ilk, db)
+	Expect(err).NotTo(HaveOccurred())
+	_, execErr := db.Exec(
+		`INSERT INTO maker.end_cash (header_id, ilk_id, msg_sender, wad, log_id)
+			VALUES($1, $2, $3, $4, $5)`,
+		headerID, ilkID, msgSender, 0, endCashLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertDaiTransfer(src, dst string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	daiTransferLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.dai_transfer (header_id, src, dst, wad, log_id)
+			VALUES($1, $2, $3, $4, $5)`,
+		headerID, src, dst, 0, daiTransferLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
+func insertDaiApproval(src, guy string, blockNumber int64, db *postgres.DB) {
+	headerID := insertHeader(db, blockNumber)
+	daiApprovalLog := test_data.CreateTestLog(headerID, db)
+	_, execErr := db.Exec(
+		`INSERT INTO maker.dai_approval (header_id, src, guy, wad, log_id)
+			VALUES($1, $2, $3, $4, $5)`,
+		headerID, src, guy, 0, daiApprovalLog.ID,
+	)
+	Expect(execErr).NotTo(HaveOccurred())
+}
+
 func insertHeader(db *postgres.DB, blockNumber int64) int64 {
 	headerRepository := repositories.NewHeaderRepository(db)
 	headerID, err := headerRepository.CreateOrUpdateHeader(fakes.GetFakeHeader(blockNumber))
@@ -44,10 +44,14 @@ var (
 
 type keysLoader struct {
 	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
 }
 
-func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository) storage.KeysLoader {
-	return &keysLoader{storageRepository: storageRepository}
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) storage.KeysLoader {
+	return &keysLoader{
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}
 }
 
 func (loader *keysLoader) SetDB(db *postgres.DB) {
@@ -64,7 +68,7 @@ func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMeta
 		mappings[getPipKey(ilk)] = getPipMetadata(ilk)
 		mappings[getMatKey(ilk)] = getMatMetadata(ilk)
 	}
-	return mappings, nil
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
 }
 
 func getStaticMappings() map[common.Hash]utils.StorageValueMetadata {
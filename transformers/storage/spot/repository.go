@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
@@ -32,7 +33,8 @@ const (
 )
 
 type SpotStorageRepository struct {
-	db *postgres.DB
+	ContractAddress string
+	db              *postgres.DB
 }
 
 func (repository *SpotStorageRepository) SetDB(db *postgres.DB) {
@@ -49,9 +51,11 @@ func (repository SpotStorageRepository) Create(blockNumber int, blockHash string
 		return repository.insertSpotVat(blockNumber, blockHash, value.(string))
 	case Par:
 		return repository.insertSpotPar(blockNumber, blockHash, value.(string))
+	case mcdStorage.Wards:
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
 
 	default:
-		panic(fmt.Sprintf("unrecognized spot contract storage name: %s", metadata.Name))
+		return fmt.Errorf("unrecognized spot contract storage name: %s", metadata.Name)
 	}
 }
 
@@ -23,6 +23,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data"
 )
 
 func TestMaker(t *testing.T) {
@@ -30,6 +31,9 @@ func TestMaker(t *testing.T) {
 	RunSpecs(t, "Storage Suite")
 }
 
+// Deployment initializers read contract deployments from config
+var configSet = test_data.SetTestConfig()
+
 var _ = BeforeSuite(func() {
 	logrus.SetOutput(ioutil.Discard)
 })
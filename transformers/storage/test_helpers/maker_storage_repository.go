@@ -7,38 +7,77 @@ import (
 )
 
 type MockMakerStorageRepository struct {
-	Cdpis                   []string
-	DaiKeys                 []string
-	FlapBidIds              []string
-	FlipBidIds              []string
-	FlopBidIds              []string
-	GemKeys                 []storage.Urn
-	Ilks                    []string
-	Owners                  []string
-	SinKeys                 []string
-	Urns                    []storage.Urn
-	GetCdpisCalled          bool
-	GetCdpisError           error
-	GetDaiKeysCalled        bool
-	GetDaiKeysError         error
-	GetGemKeysCalled        bool
-	GetGemKeysError         error
-	GetFlapBidIdsCalled     bool
-	GetFlapBidIdsError      error
-	GetFlipBidIdsCalledWith string
-	GetFlipBidIdsError      error
-	GetFlopBidIdsCalledWith string
-	GetFlopBidIdsError      error
-	GetIlksCalled           bool
-	GetIlksError            error
-	GetOwnersCalled         bool
-	GetOwnersError          error
-	GetVatSinKeysCalled     bool
-	GetVatSinKeysError      error
-	GetVowSinKeysCalled     bool
-	GetVowSinKeysError      error
-	GetUrnsCalled           bool
-	GetUrnsError            error
+	Cdpis                     []string
+	DaiKeys                   []string
+	EndBagKeys                []string
+	EndOutKeys                []storage.Urn
+	FlapBidIds                []string
+	FlipBidIds                []string
+	FlopBidIds                []string
+	CdpCanKeys                []storage.CdpCan
+	ChiefCandidates           []string
+	ChiefVoters               []string
+	DaiAllowanceKeys          []storage.Allowance
+	DaiBalanceOfKeys          []string
+	DaiNoncesKeys             []string
+	EsmSumUsers               []string
+	GemKeys                   []storage.Urn
+	Ilks                      []string
+	Owners                    []string
+	PotPieUsers               []string
+	SinKeys                   []string
+	UrnCanKeys                []storage.UrnCan
+	Urns                      []storage.Urn
+	VatCanKeys                []storage.Can
+	WardsKeys                 []string
+	GetCdpCanKeysCalled       bool
+	GetCdpCanKeysError        error
+	GetCdpisCalled            bool
+	GetCdpisError             error
+	GetChiefCandidatesCalled  bool
+	GetChiefCandidatesError   error
+	GetChiefVotersCalled      bool
+	GetChiefVotersError       error
+	GetDaiAllowanceKeysCalled bool
+	GetDaiAllowanceKeysError  error
+	GetDaiBalanceOfKeysCalled bool
+	GetDaiBalanceOfKeysError  error
+	GetDaiKeysCalled          bool
+	GetDaiKeysError           error
+	GetDaiNoncesKeysCalled    bool
+	GetDaiNoncesKeysError     error
+	GetEndBagKeysCalled       bool
+	GetEndBagKeysError        error
+	GetEndOutKeysCalled       bool
+	GetEndOutKeysError        error
+	GetEsmSumUsersCalled      bool
+	GetEsmSumUsersError       error
+	GetGemKeysCalled          bool
+	GetGemKeysError           error
+	GetFlapBidIdsCalled       bool
+	GetFlapBidIdsError        error
+	GetFlipBidIdsCalledWith   string
+	GetFlipBidIdsError        error
+	GetFlopBidIdsCalledWith   string
+	GetFlopBidIdsError        error
+	GetIlksCalled             bool
+	GetIlksError              error
+	GetOwnersCalled           bool
+	GetOwnersError            error
+	GetPotPieUsersCalled      bool
+	GetPotPieUsersError       error
+	GetVatCanKeysCalled       bool
+	GetVatCanKeysError        error
+	GetVatSinKeysCalled       bool
+	GetVatSinKeysError        error
+	GetVowSinKeysCalled       bool
+	GetVowSinKeysError        error
+	GetUrnCanKeysCalled       bool
+	GetUrnCanKeysError        error
+	GetUrnsCalled             bool
+	GetUrnsError              error
+	GetWardsKeysCalledWith    string
+	GetWardsKeysError         error
 }
 
 func (repository *MockMakerStorageRepository) GetFlapBidIds(string) ([]string, error) {
@@ -96,4 +135,69 @@ func (repository *MockMakerStorageRepository) GetOwners() ([]string, error) {
 	return repository.Owners, repository.GetOwnersError
 }
 
+func (repository *MockMakerStorageRepository) GetEsmSumUsers() ([]string, error) {
+	repository.GetEsmSumUsersCalled = true
+	return repository.EsmSumUsers, repository.GetEsmSumUsersError
+}
+
+func (repository *MockMakerStorageRepository) GetPotPieUsers() ([]string, error) {
+	repository.GetPotPieUsersCalled = true
+	return repository.PotPieUsers, repository.GetPotPieUsersError
+}
+
+func (repository *MockMakerStorageRepository) GetEndBagKeys() ([]string, error) {
+	repository.GetEndBagKeysCalled = true
+	return repository.EndBagKeys, repository.GetEndBagKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetEndOutKeys() ([]storage.Urn, error) {
+	repository.GetEndOutKeysCalled = true
+	return repository.EndOutKeys, repository.GetEndOutKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetDaiBalanceOfKeys() ([]string, error) {
+	repository.GetDaiBalanceOfKeysCalled = true
+	return repository.DaiBalanceOfKeys, repository.GetDaiBalanceOfKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetDaiAllowanceKeys() ([]storage.Allowance, error) {
+	repository.GetDaiAllowanceKeysCalled = true
+	return repository.DaiAllowanceKeys, repository.GetDaiAllowanceKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetDaiNoncesKeys() ([]string, error) {
+	repository.GetDaiNoncesKeysCalled = true
+	return repository.DaiNoncesKeys, repository.GetDaiNoncesKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetCdpCanKeys() ([]storage.CdpCan, error) {
+	repository.GetCdpCanKeysCalled = true
+	return repository.CdpCanKeys, repository.GetCdpCanKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetChiefVoters() ([]string, error) {
+	repository.GetChiefVotersCalled = true
+	return repository.ChiefVoters, repository.GetChiefVotersError
+}
+
+func (repository *MockMakerStorageRepository) GetChiefCandidates() ([]string, error) {
+	repository.GetChiefCandidatesCalled = true
+	return repository.ChiefCandidates, repository.GetChiefCandidatesError
+}
+
+func (repository *MockMakerStorageRepository) GetUrnCanKeys() ([]storage.UrnCan, error) {
+	repository.GetUrnCanKeysCalled = true
+	return repository.UrnCanKeys, repository.GetUrnCanKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetVatCanKeys() ([]storage.Can, error) {
+	repository.GetVatCanKeysCalled = true
+	return repository.VatCanKeys, repository.GetVatCanKeysError
+}
+
+func (repository *MockMakerStorageRepository) GetWardsKeys(contractAddress string) ([]string, error) {
+	repository.GetWardsKeysCalledWith = contractAddress
+	return repository.WardsKeys, repository.GetWardsKeysError
+}
+
 func (repository *MockMakerStorageRepository) SetDB(db *postgres.DB) {}
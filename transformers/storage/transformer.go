@@ -0,0 +1,55 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+// Transformer mirrors vulcanizedb's storage.Transformer, but decodes diffs with Decode so contracts can declare
+// packed slots holding types the upstream decoder doesn't know
+type Transformer struct {
+	HashedAddress     common.Hash
+	StorageKeysLookup storage.KeysLookup
+	Repository        storage.Repository
+}
+
+func (t Transformer) NewTransformer(db *postgres.DB) transformer.StorageTransformer {
+	t.StorageKeysLookup.SetDB(db)
+	t.Repository.SetDB(db)
+	return t
+}
+
+func (t Transformer) KeccakContractAddress() common.Hash {
+	return t.HashedAddress
+}
+
+func (t Transformer) Execute(diff utils.StorageDiff) error {
+	metadata, lookupErr := t.StorageKeysLookup.Lookup(diff.StorageKey)
+	if lookupErr != nil {
+		return lookupErr
+	}
+	value, decodeErr := Decode(diff, metadata)
+	if decodeErr != nil {
+		return decodeErr
+	}
+	return t.Repository.Create(diff.BlockHeight, diff.BlockHash.Hex(), metadata, value)
+}
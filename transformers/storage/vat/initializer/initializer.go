@@ -17,16 +17,27 @@
 package initializer
 
 import (
-	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	log "github.com/sirupsen/logrus"
 	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/vat"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/transformer"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
 
-var StorageTransformerInitializer transformer.StorageTransformerInitializer = storage.Transformer{
-	HashedAddress:     utils.HexToKeccak256Hash(constants.GetContractAddress("MCD_VAT")),
-	StorageKeysLookup: storage.NewKeysLookup(vat.NewKeysLoader(&mcdStorage.MakerStorageRepository{})),
-	Repository:        &vat.VatStorageRepository{},
-}.NewTransformer
+var StorageTransformerInitializer = mcdStorage.GenerateDeploymentInitializer("MCD_VAT", GenerateStorageTransformerInitializer)
+
+func GenerateStorageTransformerInitializer(contractAddress string) transformer.StorageTransformerInitializer {
+	return func(db *postgres.DB) transformer.StorageTransformer {
+		keysLoader, layoutErr := vat.NewKeysLoader(&mcdStorage.MakerStorageRepository{}, contractAddress)
+		if layoutErr != nil {
+			log.Fatalf("Invalid vat storage layout: %v", layoutErr)
+		}
+		return mcdStorage.Transformer{
+			HashedAddress:     utils.HexToKeccak256Hash(contractAddress),
+			StorageKeysLookup: storage.NewKeysLookup(keysLoader),
+			Repository:        &vat.VatStorageRepository{ContractAddress: contractAddress},
+		}.NewTransformer(db)
+	}
+}
@@ -20,13 +20,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
 	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
-	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/layout"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/factories/storage"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
-	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
 
 const (
+	Can     = "can"
 	Dai     = "dai"
 	Gem     = "gem"
 	IlkArt  = "Art"
@@ -44,245 +44,131 @@ const (
 )
 
 var (
+	CanMappingIndex  = utils.IndexOne
 	IlksMappingIndex = utils.IndexTwo
 	UrnsMappingIndex = utils.IndexThree
 	GemsMappingIndex = utils.IndexFour
 	DaiMappingIndex  = utils.IndexFive
 	SinMappingIndex  = utils.IndexSix
 
-	DebtKey      = common.HexToHash(utils.IndexSeven)
-	DebtMetadata = utils.StorageValueMetadata{
-		Name: Debt,
-		Keys: nil,
-		Type: utils.Uint256,
-	}
-
-	ViceKey      = common.HexToHash(utils.IndexEight)
-	ViceMetadata = utils.StorageValueMetadata{
-		Name: Vice,
-		Keys: nil,
-		Type: utils.Uint256,
-	}
-
-	LineKey      = common.HexToHash(utils.IndexNine)
-	LineMetadata = utils.StorageValueMetadata{
-		Name: Line,
-		Keys: nil,
-		Type: utils.Uint256,
-	}
+	DebtKey, DebtMetadata = staticMapping(Debt)
+	ViceKey, ViceMetadata = staticMapping(Vice)
+	LineKey, LineMetadata = staticMapping(Line)
+	LiveKey, LiveMetadata = staticMapping(Live)
+)
 
-	LiveKey      = common.HexToHash(utils.IndexTen)
-	LiveMetadata = utils.StorageValueMetadata{
-		Name: Live,
-		Keys: nil,
-		Type: utils.Uint256,
+var (
+	ilkKey    = layout.Key{Name: constants.Ilk, Kind: layout.Bytes32Key}
+	ilkFKKey  = layout.Key{Name: constants.Ilk, Kind: layout.Bytes32Key, ForeignKey: constants.IlkFK}
+	guyKey    = layout.Key{Name: constants.Guy, Kind: layout.AddressKey, Column: "guy"}
+	urnFKKey  = layout.Key{Name: constants.Guy, Kind: layout.AddressKey, ForeignKey: constants.UrnFK}
+	canKeys   = []layout.Key{{Name: constants.Bit, Kind: layout.AddressKey, Column: "bit"}, {Name: constants.Usr, Kind: layout.AddressKey, Column: "usr"}}
+	ilkFKKeys = []layout.Key{ilkFKKey}
+
+	StorageLayout = layout.Layout{
+		Contract: "vat",
+		Wards:    true,
+		Variables: []layout.Variable{
+			{Name: Can, Slot: CanMappingIndex, Type: utils.Uint256, Keys: canKeys, LoadKeys: loadCanKeys, Table: "vat_can", ValueColumn: "can"},
+			{Name: IlkArt, Slot: IlksMappingIndex, Type: utils.Uint256, Keys: ilkFKKeys, LoadKeys: loadIlkKeys, Table: "vat_ilk_art", ValueColumn: "art"},
+			{Name: IlkRate, Slot: IlksMappingIndex, Offset: 1, Type: utils.Uint256, Keys: ilkFKKeys, LoadKeys: loadIlkKeys, Table: "vat_ilk_rate", ValueColumn: "rate"},
+			{Name: IlkSpot, Slot: IlksMappingIndex, Offset: 2, Type: utils.Uint256, Keys: ilkFKKeys, LoadKeys: loadIlkKeys, Table: "vat_ilk_spot", ValueColumn: "spot"},
+			{Name: IlkLine, Slot: IlksMappingIndex, Offset: 3, Type: utils.Uint256, Keys: ilkFKKeys, LoadKeys: loadIlkKeys, Table: "vat_ilk_line", ValueColumn: "line"},
+			{Name: IlkDust, Slot: IlksMappingIndex, Offset: 4, Type: utils.Uint256, Keys: ilkFKKeys, LoadKeys: loadIlkKeys, Table: "vat_ilk_dust", ValueColumn: "dust"},
+			{Name: UrnInk, Slot: UrnsMappingIndex, Type: utils.Uint256, Keys: []layout.Key{ilkKey, urnFKKey}, LoadKeys: loadUrnKeys, Table: "vat_urn_ink", ValueColumn: "ink"},
+			{Name: UrnArt, Slot: UrnsMappingIndex, Offset: 1, Type: utils.Uint256, Keys: []layout.Key{ilkKey, urnFKKey}, LoadKeys: loadUrnKeys, Table: "vat_urn_art", ValueColumn: "art"},
+			{Name: Gem, Slot: GemsMappingIndex, Type: utils.Uint256, Keys: []layout.Key{ilkFKKey, guyKey}, LoadKeys: loadGemKeys, Table: "vat_gem", ValueColumn: "gem"},
+			{Name: Dai, Slot: DaiMappingIndex, Type: utils.Uint256, Keys: []layout.Key{guyKey}, LoadKeys: loadDaiKeys, Table: "vat_dai", ValueColumn: "dai"},
+			{Name: Sin, Slot: SinMappingIndex, Type: utils.Uint256, Keys: []layout.Key{guyKey}, LoadKeys: loadSinKeys, Table: "vat_sin", ValueColumn: "sin"},
+			{Name: Debt, Slot: utils.IndexSeven, Type: utils.Uint256, Table: "vat_debt", ValueColumn: "debt"},
+			{Name: Vice, Slot: utils.IndexEight, Type: utils.Uint256, Table: "vat_vice", ValueColumn: "vice"},
+			{Name: Line, Slot: utils.IndexNine, Type: utils.Uint256, Table: "vat_line", ValueColumn: "line"},
+			{Name: Live, Slot: utils.IndexTen, Type: utils.Uint256, Table: "vat_live", ValueColumn: "live"},
+		},
 	}
 )
 
-type keysLoader struct {
-	storageRepository mcdStorage.IMakerStorageRepository
-}
-
-func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository) storage.KeysLoader {
-	return &keysLoader{storageRepository: storageRepository}
-}
-
-func (loader *keysLoader) SetDB(db *postgres.DB) {
-	loader.storageRepository.SetDB(db)
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) (storage.KeysLoader, error) {
+	return layout.NewKeysLoader(StorageLayout, storageRepository, contractAddress)
 }
 
-func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
-	mappings := loadStaticMappings()
-	mappings, daiErr := loader.addDaiKeys(mappings)
-	if daiErr != nil {
-		return nil, daiErr
-	}
-	mappings, gemErr := loader.addGemKeys(mappings)
-	if gemErr != nil {
-		return nil, gemErr
-	}
-	mappings, ilkErr := loader.addIlkKeys(mappings)
-	if ilkErr != nil {
-		return nil, ilkErr
-	}
-	mappings, sinErr := loader.addSinKeys(mappings)
-	if sinErr != nil {
-		return nil, sinErr
+// staticMapping reads a static variable's key and metadata from StorageLayout, so they can't drift apart
+func staticMapping(name string) (common.Hash, utils.StorageValueMetadata) {
+	key, metadata, err := StorageLayout.Mapping(name)
+	if err != nil {
+		panic(err)
 	}
-	return loader.addUrnKeys(mappings)
-}
-
-func loadStaticMappings() map[common.Hash]utils.StorageValueMetadata {
-	mappings := make(map[common.Hash]utils.StorageValueMetadata)
-	mappings[DebtKey] = DebtMetadata
-	mappings[ViceKey] = ViceMetadata
-	mappings[LineKey] = LineMetadata
-	mappings[LiveKey] = LiveMetadata
-	return mappings
+	return key, metadata
 }
 
-func (loader *keysLoader) addDaiKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
-	daiKeys, err := loader.storageRepository.GetDaiKeys()
+func loadCanKeys(repository mcdStorage.IMakerStorageRepository, _ string) ([][]string, error) {
+	cans, err := repository.GetVatCanKeys()
 	if err != nil {
 		return nil, err
 	}
-	for _, d := range daiKeys {
-		paddedDai, padErr := utilities.PadAddress(d)
-		if padErr != nil {
-			return nil, padErr
-		}
-		mappings[getDaiKey(paddedDai)] = getDaiMetadata(d)
+	var rows [][]string
+	for _, can := range cans {
+		rows = append(rows, []string{can.Bit, can.Usr})
 	}
-	return mappings, nil
+	return rows, nil
 }
 
-func (loader *keysLoader) addGemKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
-	gemKeys, err := loader.storageRepository.GetGemKeys()
+func loadIlkKeys(repository mcdStorage.IMakerStorageRepository, _ string) ([][]string, error) {
+	ilks, err := repository.GetIlks()
 	if err != nil {
 		return nil, err
 	}
-	for _, gem := range gemKeys {
-		paddedGem, padErr := utilities.PadAddress(gem.Identifier)
-		if padErr != nil {
-			return nil, padErr
-		}
-		mappings[getGemKey(gem.Ilk, paddedGem)] = getGemMetadata(gem.Ilk, gem.Identifier)
+	var rows [][]string
+	for _, ilk := range ilks {
+		rows = append(rows, []string{ilk})
 	}
-	return mappings, nil
+	return rows, nil
 }
 
-func (loader *keysLoader) addIlkKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
-	ilks, err := loader.storageRepository.GetIlks()
+func loadUrnKeys(repository mcdStorage.IMakerStorageRepository, _ string) ([][]string, error) {
+	urns, err := repository.GetUrns()
 	if err != nil {
 		return nil, err
 	}
-	for _, ilk := range ilks {
-		mappings[getIlkArtKey(ilk)] = getIlkArtMetadata(ilk)
-		mappings[getIlkRateKey(ilk)] = getIlkRateMetadata(ilk)
-		mappings[getIlkSpotKey(ilk)] = getIlkSpotMetadata(ilk)
-		mappings[getIlkLineKey(ilk)] = getIlkLineMetadata(ilk)
-		mappings[getIlkDustKey(ilk)] = getIlkDustMetadata(ilk)
-	}
-	return mappings, nil
+	return urnRows(urns), nil
 }
 
-func (loader *keysLoader) addSinKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
-	sinKeys, err := loader.storageRepository.GetVatSinKeys()
+func loadGemKeys(repository mcdStorage.IMakerStorageRepository, _ string) ([][]string, error) {
+	gems, err := repository.GetGemKeys()
 	if err != nil {
 		return nil, err
 	}
-	for _, s := range sinKeys {
-		paddedSin, padErr := utilities.PadAddress(s)
-		if padErr != nil {
-			return nil, padErr
-		}
-		mappings[getSinKey(paddedSin)] = getSinMetadata(s)
-	}
-	return mappings, nil
+	return urnRows(gems), nil
 }
 
-func (loader *keysLoader) addUrnKeys(mappings map[common.Hash]utils.StorageValueMetadata) (map[common.Hash]utils.StorageValueMetadata, error) {
-	urns, err := loader.storageRepository.GetUrns()
+func loadDaiKeys(repository mcdStorage.IMakerStorageRepository, _ string) ([][]string, error) {
+	guys, err := repository.GetDaiKeys()
 	if err != nil {
 		return nil, err
 	}
-	for _, urn := range urns {
-		paddedGuy, padErr := utilities.PadAddress(urn.Identifier)
-		if padErr != nil {
-			return nil, padErr
-		}
-		mappings[getUrnArtKey(urn.Ilk, paddedGuy)] = getUrnArtMetadata(urn.Ilk, urn.Identifier)
-		mappings[getUrnInkKey(urn.Ilk, paddedGuy)] = getUrnInkMetadata(urn.Ilk, urn.Identifier)
-	}
-	return mappings, nil
-}
-
-func getIlkArtKey(ilk string) common.Hash {
-	return utils.GetStorageKeyForMapping(IlksMappingIndex, ilk)
-}
-
-func getIlkArtMetadata(ilk string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk}
-	return utils.GetStorageValueMetadata(IlkArt, keys, utils.Uint256)
-}
-
-func getIlkRateKey(ilk string) common.Hash {
-	return utils.GetIncrementedStorageKey(getIlkArtKey(ilk), 1)
-}
-
-func getIlkRateMetadata(ilk string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk}
-	return utils.GetStorageValueMetadata(IlkRate, keys, utils.Uint256)
+	return guyRows(guys), nil
 }
 
-func getIlkSpotKey(ilk string) common.Hash {
-	return utils.GetIncrementedStorageKey(getIlkArtKey(ilk), 2)
-}
-
-func getIlkSpotMetadata(ilk string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk}
-	return utils.GetStorageValueMetadata(IlkSpot, keys, utils.Uint256)
-}
-
-func getIlkLineKey(ilk string) common.Hash {
-	return utils.GetIncrementedStorageKey(getIlkArtKey(ilk), 3)
-}
-
-func getIlkLineMetadata(ilk string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk}
-	return utils.GetStorageValueMetadata(IlkLine, keys, utils.Uint256)
-}
-
-func getIlkDustKey(ilk string) common.Hash {
-	return utils.GetIncrementedStorageKey(getIlkArtKey(ilk), 4)
-}
-
-func getIlkDustMetadata(ilk string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk}
-	return utils.GetStorageValueMetadata(IlkDust, keys, utils.Uint256)
-}
-
-func getUrnInkKey(ilk, guy string) common.Hash {
-	return utils.GetStorageKeyForNestedMapping(UrnsMappingIndex, ilk, guy)
-}
-
-func getUrnInkMetadata(ilk, guy string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk, constants.Guy: guy}
-	return utils.GetStorageValueMetadata(UrnInk, keys, utils.Uint256)
-}
-
-func getUrnArtKey(ilk, guy string) common.Hash {
-	return utils.GetIncrementedStorageKey(getUrnInkKey(ilk, guy), 1)
-}
-
-func getUrnArtMetadata(ilk, guy string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk, constants.Guy: guy}
-	return utils.GetStorageValueMetadata(UrnArt, keys, utils.Uint256)
-}
-
-func getGemKey(ilk, guy string) common.Hash {
-	return utils.GetStorageKeyForNestedMapping(GemsMappingIndex, ilk, guy)
-}
-
-func getGemMetadata(ilk, guy string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Ilk: ilk, constants.Guy: guy}
-	return utils.GetStorageValueMetadata(Gem, keys, utils.Uint256)
-}
-
-func getDaiKey(guy string) common.Hash {
-	return utils.GetStorageKeyForMapping(DaiMappingIndex, guy)
-}
-
-func getDaiMetadata(guy string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Guy: guy}
-	return utils.GetStorageValueMetadata(Dai, keys, utils.Uint256)
+func loadSinKeys(repository mcdStorage.IMakerStorageRepository, _ string) ([][]string, error) {
+	guys, err := repository.GetVatSinKeys()
+	if err != nil {
+		return nil, err
+	}
+	return guyRows(guys), nil
 }
 
-func getSinKey(guy string) common.Hash {
-	return utils.GetStorageKeyForMapping(SinMappingIndex, guy)
+func urnRows(urns []mcdStorage.Urn) [][]string {
+	var rows [][]string
+	for _, urn := range urns {
+		rows = append(rows, []string{urn.Ilk, urn.Identifier})
+	}
+	return rows
 }
 
-func getSinMetadata(guy string) utils.StorageValueMetadata {
-	keys := map[utils.Key]string{constants.Guy: guy}
-	return utils.GetStorageValueMetadata(Sin, keys, utils.Uint256)
+func guyRows(guys []string) [][]string {
+	var rows [][]string
+	for _, guy := range guys {
+		rows = append(rows, []string{guy})
+	}
+	return rows
 }
@@ -39,7 +39,9 @@ var _ = Describe("Vat storage keys loader", func() {
 
 	BeforeEach(func() {
 		storageRepository = &test_helpers.MockMakerStorageRepository{}
-		storageKeysLoader = vat.NewKeysLoader(storageRepository)
+		var layoutErr error
+		storageKeysLoader, layoutErr = vat.NewKeysLoader(storageRepository, test_helpers.FakeAddress)
+		Expect(layoutErr).NotTo(HaveOccurred())
 	})
 
 	Describe("looking up static keys", func() {
@@ -56,6 +58,15 @@ var _ = Describe("Vat storage keys loader", func() {
 	})
 
 	Describe("looking up dynamic keys", func() {
+		It("returns error if can keys lookup fails", func() {
+			storageRepository.GetVatCanKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+
 		It("returns error if dai keys lookup fails", func() {
 			storageRepository.GetDaiKeysError = fakes.FakeError
 
@@ -234,6 +245,27 @@ var _ = Describe("Vat storage keys loader", func() {
 			})
 		})
 
+		Describe("can", func() {
+			It("returns value metadata for can", func() {
+				usr := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+				storageRepository.VatCanKeys = []mcdStorage.Can{{Bit: test_helpers.FakeAddress, Usr: usr}}
+				paddedBit := "0x000000000000000000000000" + test_helpers.FakeAddress[2:]
+				encodedPrimaryMapIndex := crypto.Keccak256(common.FromHex(paddedBit + vat.CanMappingIndex))
+				paddedUsr := common.FromHex("0x000000000000000000000000" + usr[2:])
+				canKey := common.BytesToHash(crypto.Keccak256(paddedUsr, encodedPrimaryMapIndex))
+				expectedMetadata := utils.StorageValueMetadata{
+					Name: vat.Can,
+					Keys: map[utils.Key]string{constants.Bit: test_helpers.FakeAddress, constants.Usr: usr},
+					Type: utils.Uint256,
+				}
+				mappings, err := storageKeysLoader.LoadMappings()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storageRepository.GetVatCanKeysCalled).To(BeTrue())
+				Expect(mappings[canKey]).To(Equal(expectedMetadata))
+			})
+		})
+
 		Describe("dai", func() {
 			It("returns value metadata for dai", func() {
 				storageRepository.DaiKeys = []string{test_helpers.FakeAddress}
@@ -268,4 +300,28 @@ var _ = Describe("Vat storage keys loader", func() {
 			})
 		})
 	})
+
+	Describe("wards", func() {
+		It("returns value metadata for wards", func() {
+			wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+			storageRepository.WardsKeys = []string{wardsUser}
+			paddedWardsUser := "0x000000000000000000000000" + wardsUser[2:]
+			wardsKey := common.BytesToHash(crypto.Keccak256(common.FromHex(paddedWardsUser + mcdStorage.WardsMappingIndex)))
+
+			mappings, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storageRepository.GetWardsKeysCalledWith).To(Equal(test_helpers.FakeAddress))
+			Expect(mappings[wardsKey]).To(Equal(mcdStorage.GetWardsMetadata(wardsUser)))
+		})
+
+		It("returns error if wards keys lookup fails", func() {
+			storageRepository.GetWardsKeysError = fakes.FakeError
+
+			_, err := storageKeysLoader.LoadMappings()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(fakes.FakeError))
+		})
+	})
 })
@@ -24,8 +24,11 @@ import (
 	"github.com/vulcanize/mcd_transformers/transformers/component_tests/queries/test_helpers"
 	"github.com/vulcanize/mcd_transformers/transformers/shared"
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/layout"
 	. "github.com/vulcanize/mcd_transformers/transformers/storage/test_helpers"
 	"github.com/vulcanize/mcd_transformers/transformers/storage/vat"
+	"github.com/vulcanize/mcd_transformers/transformers/test_data/shared_behaviors"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 	"math/rand"
@@ -45,10 +48,65 @@ var _ = Describe("Vat storage repository", func() {
 	BeforeEach(func() {
 		db = test_config.NewTestDB(test_config.NewTestNode())
 		test_config.CleanTestDB(db)
-		repo = vat.VatStorageRepository{}
+		repo = vat.VatStorageRepository{ContractAddress: FakeAddress}
 		repo.SetDB(db)
 	})
 
+	It("returns an error if the metadata name is not recognized", func() {
+		unrecognizedMetadata := utils.StorageValueMetadata{Name: "unrecognized"}
+
+		err := repo.Create(fakeBlockNumber, fakeBlockHash, unrecognizedMetadata, "")
+
+		Expect(err).To(MatchError(layout.ErrUnrecognizedStorageName{Contract: "vat", Name: "unrecognized"}))
+	})
+
+	Describe("can", func() {
+		var (
+			fakeUsr     = "fake_usr"
+			canMetadata = utils.GetStorageValueMetadata(vat.Can, map[utils.Key]string{constants.Bit: fakeGuy, constants.Usr: fakeUsr}, utils.Uint256)
+		)
+
+		It("writes a row", func() {
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, canMetadata, fakeUint256)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			var result DoubleMappingRes
+			err = db.Get(&result, `SELECT block_number, block_hash, bit AS key_one, usr AS key_two, can AS value FROM maker.vat_can`)
+			Expect(err).NotTo(HaveOccurred())
+			AssertDoubleMapping(result, fakeBlockNumber, fakeBlockHash, fakeGuy, fakeUsr, fakeUint256)
+		})
+
+		It("does not duplicate row", func() {
+			insertOneErr := repo.Create(fakeBlockNumber, fakeBlockHash, canMetadata, fakeUint256)
+			Expect(insertOneErr).NotTo(HaveOccurred())
+
+			insertTwoErr := repo.Create(fakeBlockNumber, fakeBlockHash, canMetadata, fakeUint256)
+
+			Expect(insertTwoErr).NotTo(HaveOccurred())
+			var count int
+			getCountErr := db.Get(&count, `SELECT count(*) FROM maker.vat_can`)
+			Expect(getCountErr).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("returns error if metadata missing bit", func() {
+			malformedCanMetadata := utils.GetStorageValueMetadata(vat.Can, map[utils.Key]string{constants.Usr: fakeUsr}, utils.Uint256)
+
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, malformedCanMetadata, fakeUint256)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Bit}))
+		})
+
+		It("returns error if metadata missing usr", func() {
+			malformedCanMetadata := utils.GetStorageValueMetadata(vat.Can, map[utils.Key]string{constants.Bit: fakeGuy}, utils.Uint256)
+
+			err := repo.Create(fakeBlockNumber, fakeBlockHash, malformedCanMetadata, fakeUint256)
+
+			Expect(err).To(MatchError(utils.ErrMetadataMalformed{MissingData: constants.Usr}))
+		})
+	})
+
 	Describe("dai", func() {
 		It("writes a row", func() {
 			daiMetadata := utils.GetStorageValueMetadata(vat.Dai, map[utils.Key]string{constants.Guy: fakeGuy}, utils.Uint256)
@@ -1137,4 +1195,20 @@ var _ = Describe("Vat storage repository", func() {
 		Expect(getCountErr).NotTo(HaveOccurred())
 		Expect(count).To(Equal(1))
 	})
+
+	Describe("wards", func() {
+		wardsUser := "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6"
+		inputs := shared_behaviors.StorageVariableBehaviorInputs{
+			KeyFieldName:     "usr",
+			ValueFieldName:   mcdStorage.Wards,
+			Key:              wardsUser,
+			Value:            "1",
+			IsAMapping:       true,
+			StorageTableName: "maker.wards",
+			Repository:       &repo,
+			Metadata:         mcdStorage.GetWardsMetadata(wardsUser),
+		}
+
+		shared_behaviors.SharedStorageRepositoryVariableBehaviors(&inputs)
+	})
 })
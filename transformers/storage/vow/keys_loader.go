@@ -116,15 +116,23 @@ var (
 
 type keysLoader struct {
 	storageRepository mcdStorage.IMakerStorageRepository
+	contractAddress   string
 }
 
-func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository) storage.KeysLoader {
-	return &keysLoader{storageRepository: storageRepository}
+func NewKeysLoader(storageRepository mcdStorage.IMakerStorageRepository, contractAddress string) storage.KeysLoader {
+	return &keysLoader{
+		storageRepository: storageRepository,
+		contractAddress:   contractAddress,
+	}
 }
 
 func (loader *keysLoader) LoadMappings() (map[common.Hash]utils.StorageValueMetadata, error) {
 	mappings := addStaticMappings(make(map[common.Hash]utils.StorageValueMetadata))
-	return loader.addDynamicMappings(mappings)
+	mappings, dynamicMappingsErr := loader.addDynamicMappings(mappings)
+	if dynamicMappingsErr != nil {
+		return nil, dynamicMappingsErr
+	}
+	return mcdStorage.AddWardsKeys(mappings, loader.contractAddress, loader.storageRepository)
 }
 
 func (loader *keysLoader) SetDB(db *postgres.DB) {
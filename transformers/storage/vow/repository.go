@@ -17,7 +17,10 @@
 package vow
 
 import (
+	"fmt"
+
 	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	mcdStorage "github.com/vulcanize/mcd_transformers/transformers/storage"
 	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
 	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
 )
@@ -37,7 +40,8 @@ const (
 )
 
 type VowStorageRepository struct {
-	db *postgres.DB
+	ContractAddress string
+	db              *postgres.DB
 }
 
 func (repository *VowStorageRepository) SetDB(db *postgres.DB) {
@@ -68,8 +72,10 @@ func (repository VowStorageRepository) Create(blockNumber int, blockHash string,
 		return repository.insertVowBump(blockNumber, blockHash, value.(string))
 	case Hump:
 		return repository.insertVowHump(blockNumber, blockHash, value.(string))
+	case mcdStorage.Wards:
+		return mcdStorage.InsertWards(blockNumber, blockHash, metadata, repository.ContractAddress, value.(string), repository.db)
 	default:
-		panic("unrecognized storage metadata name")
+		return fmt.Errorf("unrecognized vow contract storage name: %s", metadata.Name)
 	}
 }
 
@@ -0,0 +1,89 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/mcd_transformers/transformers/storage/utilities"
+	"github.com/vulcanize/vulcanizedb/libraries/shared/storage/utils"
+	"github.com/vulcanize/vulcanizedb/pkg/datastore/postgres"
+)
+
+// Every auth'd MCD contract declares `mapping (address => uint) public wards` as its first storage variable
+const (
+	Wards = "wards"
+
+	insertWardsQuery = `INSERT INTO maker.wards (block_number, block_hash, address_id, usr, wards) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+)
+
+var WardsMappingIndex = utils.IndexZero
+
+// AddWardsKeys adds a wards key for every address that has been relied or denied on the given contract
+func AddWardsKeys(mappings map[common.Hash]utils.StorageValueMetadata, contractAddress string, repository IMakerStorageRepository) (map[common.Hash]utils.StorageValueMetadata, error) {
+	users, err := repository.GetWardsKeys(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		paddedUser, padErr := utilities.PadAddress(user)
+		if padErr != nil {
+			return nil, padErr
+		}
+		mappings[GetWardsKey(paddedUser)] = GetWardsMetadata(user)
+	}
+	return mappings, nil
+}
+
+func GetWardsKey(paddedUser string) common.Hash {
+	return utils.GetStorageKeyForMapping(WardsMappingIndex, paddedUser)
+}
+
+func GetWardsMetadata(user string) utils.StorageValueMetadata {
+	keys := map[utils.Key]string{constants.Usr: user}
+	return utils.GetStorageValueMetadata(Wards, keys, utils.Uint256)
+}
+
+// InsertWards persists a wards value, keyed by the contract that emitted the storage diff
+func InsertWards(blockNumber int, blockHash string, metadata utils.StorageValueMetadata, contractAddress, wards string, db *postgres.DB) error {
+	user, ok := metadata.Keys[constants.Usr]
+	if !ok {
+		return utils.ErrMetadataMalformed{MissingData: constants.Usr}
+	}
+	tx, txErr := db.Beginx()
+	if txErr != nil {
+		return txErr
+	}
+	addressId, addressErr := shared.GetOrCreateAddressInTransaction(contractAddress, tx)
+	if addressErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError("wards address", addressErr.Error())
+		}
+		return addressErr
+	}
+	_, insertErr := tx.Exec(insertWardsQuery, blockNumber, blockHash, addressId, user, wards)
+	if insertErr != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return shared.FormatRollbackError("wards", insertErr.Error())
+		}
+		return insertErr
+	}
+	return tx.Commit()
+}
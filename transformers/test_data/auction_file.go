@@ -0,0 +1,70 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawAuctionFileLog = types.Log{
+	Address: common.HexToAddress(EthFlipAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.AuctionFileSignature()),
+		common.HexToHash("0x000000000000000000000000be8e3e3618f7474f8cb1d074a26affef007e98fb"),
+		common.HexToHash("0x74746c0000000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000002a30"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e029ae811474746c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002a30000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374605,
+	TxHash:      common.HexToHash("0x3ec2cd1ae0a1a2e56ed6ba3fbf8e1ea9a6ab9cf5b0d6e1e5b2d8f7a2a8c9b104"),
+	TxIndex:     3,
+	BlockHash:   fakes.FakeHash,
+	Index:       4,
+	Removed:     false,
+}
+
+var AuctionFileHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawAuctionFileLog,
+	Transformed: false,
+}
+
+var AuctionFileModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "auction_file",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), "what", "data", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"what":             "ttl",
+		"data":             "10800",
+		constants.HeaderFK: AuctionFileHeaderSyncLog.HeaderID,
+		constants.LogFK:    AuctionFileHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawAuctionFileLog.Address.String(),
+	},
+}
@@ -0,0 +1,111 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawRelyLog = types.Log{
+	Address: common.HexToAddress(VatAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.RelySignature()),
+		common.HexToHash("0x0000000000000000000000009759a6ac90977b93b58547b4a71c78317f391a28"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e065fae35e0000000000000000000000009759a6ac90977b93b58547b4a71c78317f391a280000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374569,
+	TxHash:      common.HexToHash("0x6e5c1e4fd7b9a2c3a1e0e09c44c3ea9c7fa9a6e1b0e11e1b2a13a8a5b0d2e4c7"),
+	TxIndex:     1,
+	BlockHash:   fakes.FakeHash,
+	Index:       3,
+	Removed:     false,
+}
+
+var RelyHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawRelyLog,
+	Transformed: false,
+}
+
+var RelyModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "auth",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), "usr", "action", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"usr":              "0x9759A6Ac90977b93B58547b4A71c78317f391A28",
+		"action":           "rely",
+		constants.HeaderFK: RelyHeaderSyncLog.HeaderID,
+		constants.LogFK:    RelyHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawRelyLog.Address.String(),
+	},
+}
+
+var rawDenyLog = types.Log{
+	Address: common.HexToAddress(CatAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.DenySignature()),
+		common.HexToHash("0x00000000000000000000000000ca405026e9018c29c26cb081dcc9653428bfe9"),
+		common.HexToHash("0x000000000000000000000000ba0d1e6b2a1bab5fb2a5bb53a5fd0f8e04a0bf86"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e09c52a7f1000000000000000000000000ba0d1e6b2a1bab5fb2a5bb53a5fd0f8e04a0bf860000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374581,
+	TxHash:      common.HexToHash("0x1a2e8ad0f14d2b0bb7b3e5d4fd4b1c65e0c9a3f9b1c50e2e3e6c0f8d7a4b9e21"),
+	TxIndex:     2,
+	BlockHash:   fakes.FakeHash,
+	Index:       1,
+	Removed:     false,
+}
+
+var DenyHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawDenyLog,
+	Transformed: false,
+}
+
+var DenyModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "auth",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), "usr", "action", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"usr":              "0xbA0d1E6B2A1bab5fB2a5BB53A5Fd0F8e04A0Bf86",
+		"action":           "deny",
+		constants.HeaderFK: DenyHeaderSyncLog.HeaderID,
+		constants.LogFK:    DenyHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawDenyLog.Address.String(),
+	},
+}
@@ -0,0 +1,109 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawVatCageLog = types.Log{
+	Address: common.HexToAddress(VatAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.CageSignature()),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e06924500900000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764643,
+	TxHash:      common.HexToHash("0x4b3c6a0ad6e85e4c4d0a9e17b8c2ee8e3d0f1a6e9b7d2c5f8a1e3b6d9c2f5a8e"),
+	TxIndex:     2,
+	BlockHash:   fakes.FakeHash,
+	Index:       4,
+	Removed:     false,
+}
+
+var VatCageHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawVatCageLog,
+	Transformed: false,
+}
+
+var VatCageModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "cage",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), "module", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"module":           "vat",
+		constants.HeaderFK: VatCageHeaderSyncLog.HeaderID,
+		constants.LogFK:    VatCageHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawVatCageLog.Address.String(),
+	},
+}
+
+var rawFlapCageLog = types.Log{
+	Address: common.HexToAddress(FlapAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.FlapCageSignature()),
+		common.HexToHash("0x0000000000000000000000006740282231148a5d8a81da510ae25b21b226ba13"),
+		common.HexToHash("0x000000000000000000000000002cd76fe086b93ce2f768a00b22a00000000000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0a2f91af2000000000000000000000000002cd76fe086b93ce2f768a00b22a000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764643,
+	TxHash:      common.HexToHash("0x4b3c6a0ad6e85e4c4d0a9e17b8c2ee8e3d0f1a6e9b7d2c5f8a1e3b6d9c2f5a8e"),
+	TxIndex:     2,
+	BlockHash:   fakes.FakeHash,
+	Index:       7,
+	Removed:     false,
+}
+
+var FlapCageHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawFlapCageLog,
+	Transformed: false,
+}
+
+var FlapCageModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "cage",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), "module", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"module":           "flap",
+		constants.HeaderFK: FlapCageHeaderSyncLog.HeaderID,
+		constants.LogFK:    FlapCageHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawFlapCageLog.Address.String(),
+	},
+}
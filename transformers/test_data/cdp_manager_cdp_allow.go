@@ -0,0 +1,70 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawCdpManagerCdpAllowLog = types.Log{
+	Address: common.HexToAddress(CdpManagerAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.CdpManagerCdpAllowSignature()),
+		common.HexToHash("0x0000000000000000000000001c4a8f6f2d3b5e7a9c0d1e2f3a4b5c6d7e8f9a0b"),
+		common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000004d2"),
+		common.HexToHash("0x0000000000000000000000007a3e2b5c8d9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e00b63fb6200000000000000000000000000000000000000000000000000000000000004d20000000000000000000000007a3e2b5c8d9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14388035,
+	TxHash:      common.HexToHash("0x740f015e0947d7e894c399dcfff992b8d8b380a6dfe3b86ad854d852e785ba3f"),
+	TxIndex:     4,
+	BlockHash:   fakes.FakeHash,
+	Index:       9,
+	Removed:     false,
+}
+
+var CdpManagerCdpAllowHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawCdpManagerCdpAllowLog,
+	Transformed: false,
+}
+
+var CdpManagerCdpAllowModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "cdp_manager_cdp_allow",
+	OrderedColumns: []string{
+		constants.HeaderFK, "msg_sender", "cdpi", "usr", "ok", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0x1C4A8f6F2D3B5e7a9C0D1e2f3A4B5C6D7e8F9A0B",
+		"cdpi":             "1234",
+		"usr":              "0x7a3E2b5C8d9f0a1B2C3D4e5F6a7B8c9d0E1f2a3b",
+		"ok":               "1",
+		constants.HeaderFK: CdpManagerCdpAllowHeaderSyncLog.HeaderID,
+		constants.LogFK:    CdpManagerCdpAllowHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
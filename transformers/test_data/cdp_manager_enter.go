@@ -0,0 +1,69 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawCdpManagerEnterLog = types.Log{
+	Address: common.HexToAddress(CdpManagerAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.CdpManagerEnterSignature()),
+		common.HexToHash("0x0000000000000000000000001c4a8f6f2d3b5e7a9c0d1e2f3a4b5c6d7e8f9a0b"),
+		common.HexToHash("0x0000000000000000000000001c4a8f6f2d3b5e7a9c0d1e2f3a4b5c6d7e8f9a0b"),
+		common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000004d2"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e07e348b7d0000000000000000000000001c4a8f6f2d3b5e7a9c0d1e2f3a4b5c6d7e8f9a0b00000000000000000000000000000000000000000000000000000000000004d2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374901,
+	TxHash:      common.HexToHash("0x7365dcad8e6f553e49028709f6a87f4cc311cb4b075ef7c8e29c2ed6cada2c65"),
+	TxIndex:     6,
+	BlockHash:   fakes.FakeHash,
+	Index:       17,
+	Removed:     false,
+}
+
+var CdpManagerEnterHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawCdpManagerEnterLog,
+	Transformed: false,
+}
+
+var CdpManagerEnterModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "cdp_manager_enter",
+	OrderedColumns: []string{
+		constants.HeaderFK, "msg_sender", "src", "cdpi", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0x1C4A8f6F2D3B5e7a9C0D1e2f3A4B5C6D7e8F9A0B",
+		"src":              "0x1C4A8f6F2D3B5e7a9C0D1e2f3A4B5C6D7e8F9A0B",
+		"cdpi":             "1234",
+		constants.HeaderFK: CdpManagerEnterHeaderSyncLog.HeaderID,
+		constants.LogFK:    CdpManagerEnterHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
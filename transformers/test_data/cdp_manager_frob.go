@@ -0,0 +1,70 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawCdpManagerFrobLog = types.Log{
+	Address: common.HexToAddress(CdpManagerAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.CdpManagerFrobSignature()),
+		common.HexToHash("0x0000000000000000000000001c4a8f6f2d3b5e7a9c0d1e2f3a4b5c6d7e8f9a0b"),
+		common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000004d2"),
+		common.HexToHash("0xfffffffffffffffffffffffffffffffffffffffffffffffff21f494c589c0000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e045e6bdcd00000000000000000000000000000000000000000000000000000000000004d2fffffffffffffffffffffffffffffffffffffffffffffffff21f494c589c000000000000000000000000000000000000000000000000000821ab0d441498000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14393805,
+	TxHash:      common.HexToHash("0x4c268e37f110ec31821091a7de196509acb80edb5f6d032822df4c4104948300"),
+	TxIndex:     9,
+	BlockHash:   fakes.FakeHash,
+	Index:       2,
+	Removed:     false,
+}
+
+var CdpManagerFrobHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawCdpManagerFrobLog,
+	Transformed: false,
+}
+
+var CdpManagerFrobModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "cdp_manager_frob",
+	OrderedColumns: []string{
+		constants.HeaderFK, "msg_sender", "cdpi", "dink", "dart", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0x1C4A8f6F2D3B5e7a9C0D1e2f3A4B5C6D7e8F9A0B",
+		"cdpi":             "1234",
+		"dink":             "-1000000000000000000",
+		"dart":             "150000000000000000000",
+		constants.HeaderFK: CdpManagerFrobHeaderSyncLog.HeaderID,
+		constants.LogFK:    CdpManagerFrobHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
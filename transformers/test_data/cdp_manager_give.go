@@ -0,0 +1,69 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawCdpManagerGiveLog = types.Log{
+	Address: common.HexToAddress(CdpManagerAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.CdpManagerGiveSignature()),
+		common.HexToHash("0x0000000000000000000000001c4a8f6f2d3b5e7a9c0d1e2f3a4b5c6d7e8f9a0b"),
+		common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000004d2"),
+		common.HexToHash("0x000000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0fcafcc6800000000000000000000000000000000000000000000000000000000000004d2000000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14385074,
+	TxHash:      common.HexToHash("0x9aa42331742d78c62e079208ae60cc59ab27dfa071cadb4d5ffa7fa20bf33f6b"),
+	TxIndex:     3,
+	BlockHash:   fakes.FakeHash,
+	Index:       9,
+	Removed:     false,
+}
+
+var CdpManagerGiveHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawCdpManagerGiveLog,
+	Transformed: false,
+}
+
+var CdpManagerGiveModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "cdp_manager_give",
+	OrderedColumns: []string{
+		constants.HeaderFK, "msg_sender", "cdpi", "dst", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0x1C4A8f6F2D3B5e7a9C0D1e2f3A4B5C6D7e8F9A0B",
+		"cdpi":             "1234",
+		"dst":              "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+		constants.HeaderFK: CdpManagerGiveHeaderSyncLog.HeaderID,
+		constants.LogFK:    CdpManagerGiveHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
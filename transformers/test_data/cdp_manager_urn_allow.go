@@ -0,0 +1,69 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawCdpManagerUrnAllowLog = types.Log{
+	Address: common.HexToAddress(CdpManagerAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.CdpManagerUrnAllowSignature()),
+		common.HexToHash("0x0000000000000000000000001c4a8f6f2d3b5e7a9c0d1e2f3a4b5c6d7e8f9a0b"),
+		common.HexToHash("0x0000000000000000000000007a3e2b5c8d9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0b68f40040000000000000000000000007a3e2b5c8d9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14389579,
+	TxHash:      common.HexToHash("0xce22227f9b94dd873de9641bc175feae8a4dd09b69569fca5db78c0db3ac509f"),
+	TxIndex:     1,
+	BlockHash:   fakes.FakeHash,
+	Index:       10,
+	Removed:     false,
+}
+
+var CdpManagerUrnAllowHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawCdpManagerUrnAllowLog,
+	Transformed: false,
+}
+
+var CdpManagerUrnAllowModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "cdp_manager_urn_allow",
+	OrderedColumns: []string{
+		constants.HeaderFK, "msg_sender", "usr", "ok", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0x1C4A8f6F2D3B5e7a9C0D1e2f3A4B5C6D7e8F9A0B",
+		"usr":              "0x7a3E2b5C8d9f0a1B2C3D4e5F6a7B8c9d0E1f2a3b",
+		"ok":               "1",
+		constants.HeaderFK: CdpManagerUrnAllowHeaderSyncLog.HeaderID,
+		constants.LogFK:    CdpManagerUrnAllowHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
@@ -0,0 +1,254 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+// DSChief uses ds-note, so LogNote data is msg.value followed by the full calldata
+
+var rawChiefLockLog = types.Log{
+	Address: common.HexToAddress(ChiefAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0xdd46706400000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000004563918244f40000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000024dd4670640000000000000000000000000000000000000000000000004563918244f4000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764600,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1e3a0d11"),
+	TxIndex:     1,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b0e0c3b7"),
+	Index:       2,
+	Removed:     false,
+}
+
+var ChiefLockHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawChiefLockLog,
+	Transformed: false,
+}
+
+var ChiefLockModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "chief_lock",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "wad", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: ChiefLockHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"wad":              "5000000000000000000",
+		constants.LogFK:    ChiefLockHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawChiefFreeLog = types.Log{
+	Address: common.HexToAddress(ChiefAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0xd8ccd0f300000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000004563918244f40000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000024d8ccd0f30000000000000000000000000000000000000000000000004563918244f4000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764601,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1e3a2c00"),
+	TxIndex:     2,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b0e25cd0"),
+	Index:       3,
+	Removed:     false,
+}
+
+var ChiefFreeHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawChiefFreeLog,
+	Transformed: false,
+}
+
+var ChiefFreeModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "chief_free",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "wad", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: ChiefFreeHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"wad":              "5000000000000000000",
+		constants.LogFK:    ChiefFreeHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawChiefVoteLog = types.Log{
+	Address: common.HexToAddress(ChiefAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0xa69beaba00000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0xa89957e1de993e2295bd5d93db03c212e00d14561902888c87e54af6832e985c"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000024a69beabaa89957e1de993e2295bd5d93db03c212e00d14561902888c87e54af6832e985c00000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764602,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1e3a4aef"),
+	TxIndex:     3,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b0e3f5e9"),
+	Index:       4,
+	Removed:     false,
+}
+
+var ChiefVoteHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawChiefVoteLog,
+	Transformed: false,
+}
+
+var ChiefVoteModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "chief_vote",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "slate", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: ChiefVoteHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"slate":            "0xa89957e1de993e2295bd5d93db03c212e00d14561902888c87e54af6832e985c",
+		constants.LogFK:    ChiefVoteHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawChiefLiftLog = types.Log{
+	Address: common.HexToAddress(ChiefAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0x3c278bd500000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000001f6f0b6c7f3d2ed8c6ec0a0d1e0b5b6bcb5bd6c1"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000243c278bd50000000000000000000000001f6f0b6c7f3d2ed8c6ec0a0d1e0b5b6bcb5bd6c100000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764603,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1e3a69de"),
+	TxIndex:     4,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b0e58f02"),
+	Index:       5,
+	Removed:     false,
+}
+
+var ChiefLiftHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawChiefLiftLog,
+	Transformed: false,
+}
+
+var ChiefLiftModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "chief_lift",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "whom", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: ChiefLiftHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"whom":             "0x1F6f0b6c7F3d2ED8C6Ec0A0d1E0B5b6bCB5BD6C1",
+		constants.LogFK:    ChiefLiftHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawChiefEtchLog = types.Log{
+	Address: common.HexToAddress(ChiefAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0x5123e1fa00000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000020"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+	},
+	Data:        hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000845123e1fa000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000020000000000000000000000001f6f0b6c7f3d2ed8c6ec0a0d1e0b5b6bcb5bd6c10000000000000000000000007f0c18f7f2e1e3f1f3a3e6d6c6cabc1c93b8e6f400000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764604,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1e3a88cd"),
+	TxIndex:     5,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b0e7281b"),
+	Index:       6,
+	Removed:     false,
+}
+
+var ChiefEtchHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawChiefEtchLog,
+	Transformed: false,
+}
+
+var ChiefEtchModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "chief_etch",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "slate", "yays", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: ChiefEtchHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"slate":            "0xa89957e1de993e2295bd5d93db03c212e00d14561902888c87e54af6832e985c",
+		"yays":             "{0x1F6f0b6c7F3d2ED8C6Ec0A0d1E0B5b6bCB5BD6C1,0x7F0C18f7F2e1e3F1F3a3E6D6C6cAbc1c93b8E6f4}",
+		constants.LogFK:    ChiefEtchHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawChiefVoteYaysLog = types.Log{
+	Address: common.HexToAddress(ChiefAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0xed08132900000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000020"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000084ed081329000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000020000000000000000000000001f6f0b6c7f3d2ed8c6ec0a0d1e0b5b6bcb5bd6c10000000000000000000000007f0c18f7f2e1e3f1f3a3e6d6c6cabc1c93b8e6f400000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764605,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1e3aa7bc"),
+	TxIndex:     6,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b0e8c134"),
+	Index:       7,
+	Removed:     false,
+}
+
+var ChiefVoteYaysHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawChiefVoteYaysLog,
+	Transformed: false,
+}
+
+var ChiefVoteYaysModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "chief_vote_yays",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "slate", "yays", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: ChiefVoteYaysHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"slate":            "0xa89957e1de993e2295bd5d93db03c212e00d14561902888c87e54af6832e985c",
+		"yays":             "{0x1F6f0b6c7F3d2ED8C6Ec0A0d1E0B5b6bCB5BD6C1,0x7F0C18f7F2e1e3F1F3a3E6D6C6cAbc1c93b8E6f4}",
+		constants.LogFK:    ChiefVoteYaysHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
@@ -12,10 +12,23 @@ func FlipAddresses() []string {
 		"MCD_FLIP_REP_A", "MCD_FLIP_ZRX_A", "MCD_FLIP_OMG_A", "MCD_FLIP_BAT_A", "MCD_FLIP_DGD_A", "MCD_FLIP_GNT_A",
 	})
 }
+func DaiAddress() string        { return constants.GetContractAddress("MCD_DAI") }
+func DaiJoinAddress() string    { return constants.GetContractAddress("MCD_JOIN_DAI") }
 func EthFlipAddress() string    { return constants.GetContractAddress("MCD_FLIP_ETH_A") }
 func FlopAddress() string       { return constants.GetContractAddress("MCD_FLOP") }
+func EndAddress() string        { return constants.GetContractAddress("MCD_END") }
+func EthGemJoinAddress() string { return constants.GetContractAddress("MCD_JOIN_ETH_A") }
 func JugAddress() string        { return constants.GetContractAddress("MCD_JUG") }
+func MedianEthAddress() string  { return constants.GetContractAddress("MEDIAN_ETH_A") }
+func OsmEthAddress() string     { return constants.GetContractAddress("PIP_ETH") }
+func PotAddress() string        { return constants.GetContractAddress("MCD_POT") }
 func SpotAddress() string       { return constants.GetContractAddress("MCD_SPOT") }
 func VatAddress() string        { return constants.GetContractAddress("MCD_VAT") }
 func VowAddress() string        { return constants.GetContractAddress("MCD_VOW") }
 func CdpManagerAddress() string { return constants.GetContractAddress("CDP_MANAGER") }
+func ChiefAddress() string      { return constants.GetContractAddress("MCD_ADM") }
+func EsmAddress() string        { return constants.GetContractAddress("MCD_ESM") }
+func PauseAddress() string      { return constants.GetContractAddress("MCD_PAUSE") }
+func ProxyFactoryAddress() string {
+	return constants.GetContractAddress("PROXY_FACTORY")
+}
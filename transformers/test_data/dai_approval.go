@@ -0,0 +1,68 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawDaiApprovalLog = types.Log{
+	Address: common.HexToAddress(DaiAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.DaiApprovalSignature()),
+		common.HexToHash("0x000000000000000000000000e7bc397dbd069fc7d0109c0636d06888bb50668c"),
+		common.HexToHash("0x0000000000000000000000007d7bee5fcfd8028cf7b00876c5b1421c800561a6"),
+	},
+	Data:        hexutil.MustDecode("0x00000000000000000000000000000000000000000000000029a2241af62c0000"),
+	BlockNumber: 14374645,
+	TxHash:      common.HexToHash("0x9f5ee64b2679f6276d119a0e37b51096ae5b42deed8337c159fcbb07085277e9"),
+	TxIndex:     3,
+	BlockHash:   fakes.FakeHash,
+	Index:       4,
+	Removed:     false,
+}
+
+var DaiApprovalHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawDaiApprovalLog,
+	Transformed: false,
+}
+
+var DaiApprovalModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "dai_approval",
+	OrderedColumns: []string{
+		constants.HeaderFK, constants.LogFK, "src", "guy", "wad",
+	},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: DaiApprovalHeaderSyncLog.HeaderID,
+		constants.LogFK:    DaiApprovalHeaderSyncLog.ID,
+		"src":              "0xe7bc397DBd069fC7d0109C0636d06888bb50668c",
+		"guy":              "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6",
+		"wad":              "3000000000000000000",
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
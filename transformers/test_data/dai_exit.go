@@ -0,0 +1,71 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawDaiExitLog = types.Log{
+	Address: common.HexToAddress(DaiJoinAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.DaiExitSignature()),
+		common.HexToHash("0x000000000000000000000000e7bc397dbd069fc7d0109c0636d06888bb50668c"),
+		common.HexToHash("0x0000000000000000000000007d7bee5fcfd8028cf7b00876c5b1421c800561a6"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000004563918244f40000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0ef693bed0000000000000000000000007d7bee5fcfd8028cf7b00876c5b1421c800561a60000000000000000000000000000000000000000000000004563918244f40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374635,
+	TxHash:      common.HexToHash("0x533b51fe0bf329cd7339136dc2bf164bf43b06a98061296aed1fd9abca238af7"),
+	TxIndex:     1,
+	BlockHash:   fakes.FakeHash,
+	Index:       2,
+	Removed:     false,
+}
+
+var DaiExitHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawDaiExitLog,
+	Transformed: false,
+}
+
+var DaiExitModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "dai_exit",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), "msg_sender", "usr", "wad", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0xe7bc397DBd069fC7d0109C0636d06888bb50668c",
+		"usr":              "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6",
+		"wad":              "5000000000000000000",
+		constants.HeaderFK: DaiExitHeaderSyncLog.HeaderID,
+		constants.LogFK:    DaiExitHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawDaiExitLog.Address.Hex(),
+	},
+}
@@ -0,0 +1,70 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawEndCashLog = types.Log{
+	Address: common.HexToAddress(EndAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.EndCashSignature()),
+		common.HexToHash("0x000000000000000000000000e7bc397dbd069fc7d0109c0636d06888bb50668c"),
+		common.HexToHash("0x4554482d41000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000000000000000000000000002b5e3af16b1880000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0fe8507c64554482d41000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002b5e3af16b1880000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14388985,
+	TxHash:      common.HexToHash("0x5dbf658d7b0f07969e65c10b9127e2c63f7c626bf27a04d0b10251608eee3546"),
+	TxIndex:     9,
+	BlockHash:   fakes.FakeHash,
+	Index:       9,
+	Removed:     false,
+}
+
+var EndCashHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawEndCashLog,
+	Transformed: false,
+}
+
+var EndCashModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "end_cash",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.IlkFK), "msg_sender", "wad", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0xe7bc397DBd069fC7d0109C0636d06888bb50668c",
+		"wad":              "50000000000000000000",
+		constants.HeaderFK: EndCashHeaderSyncLog.HeaderID,
+		constants.LogFK:    EndCashHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.IlkFK: "0x4554482d41000000000000000000000000000000000000000000000000000000",
+	},
+}
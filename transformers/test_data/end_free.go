@@ -0,0 +1,68 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawEndFreeLog = types.Log{
+	Address: common.HexToAddress(EndAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.EndFreeSignature()),
+		common.HexToHash("0x000000000000000000000000e7bc397dbd069fc7d0109c0636d06888bb50668c"),
+		common.HexToHash("0x4554482d41000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0c83062c64554482d410000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14381547,
+	TxHash:      common.HexToHash("0x700dd5dfb2d75148f189410fd9ef0e6b9eea4883e5ddbef1077ae1a32ac8d1a2"),
+	TxIndex:     3,
+	BlockHash:   fakes.FakeHash,
+	Index:       13,
+	Removed:     false,
+}
+
+var EndFreeHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawEndFreeLog,
+	Transformed: false,
+}
+
+var EndFreeModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "end_free",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.UrnFK), constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: EndFreeHeaderSyncLog.HeaderID,
+		constants.LogFK:    EndFreeHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.IlkFK: "0x4554482d41000000000000000000000000000000000000000000000000000000",
+		constants.UrnFK: "0xe7bc397DBd069fC7d0109C0636d06888bb50668c",
+	},
+}
@@ -0,0 +1,69 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawEndSkimLog = types.Log{
+	Address: common.HexToAddress(EndAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.EndSkimSignature()),
+		common.HexToHash("0x000000000000000000000000e7bc397dbd069fc7d0109c0636d06888bb50668c"),
+		common.HexToHash("0x4554482d41000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x0000000000000000000000001e8a6a5fb3ae7d3a84d1b7e8cee44f4ca2ca4c6d"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e089ea45d34554482d410000000000000000000000000000000000000000000000000000000000000000000000000000001e8a6a5fb3ae7d3a84d1b7e8cee44f4ca2ca4c6d000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14399974,
+	TxHash:      common.HexToHash("0xdd455f5dd277d5fb38ed72796989f1315e8dda752ad46407fa858edfd0c9883c"),
+	TxIndex:     3,
+	BlockHash:   fakes.FakeHash,
+	Index:       18,
+	Removed:     false,
+}
+
+var EndSkimHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawEndSkimLog,
+	Transformed: false,
+}
+
+var EndSkimModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "end_skim",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.UrnFK), constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: EndSkimHeaderSyncLog.HeaderID,
+		constants.LogFK:    EndSkimHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.IlkFK: "0x4554482d41000000000000000000000000000000000000000000000000000000",
+		constants.UrnFK: "0x1E8a6a5Fb3ae7D3a84D1b7E8cEe44f4CA2cA4c6d",
+	},
+}
@@ -0,0 +1,101 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+var rawEsmJoinLog = types.Log{
+	Address: common.HexToAddress(EsmAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0x049878f300000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x000000000000000000000000000000000000000000000002b5e3af16b1880000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000024049878f3000000000000000000000000000000000000000000000002b5e3af16b188000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764800,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c2b0c1d2e"),
+	TxIndex:     2,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b2c3d4e5"),
+	Index:       1,
+	Removed:     false,
+}
+
+var EsmJoinHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawEsmJoinLog,
+	Transformed: false,
+}
+
+var EsmJoinModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "esm_join",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "wad", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: EsmJoinHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"wad":              "50000000000000000000",
+		constants.LogFK:    EsmJoinHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawEsmFireLog = types.Log{
+	Address: common.HexToAddress(EsmAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0x457094cc00000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000004457094cc00000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764801,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c2b0c2e3f"),
+	TxIndex:     4,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b2c3e5f6"),
+	Index:       3,
+	Removed:     false,
+}
+
+var EsmFireHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawEsmFireLog,
+	Transformed: false,
+}
+
+var EsmFireModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "esm_fire",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: EsmFireHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		constants.LogFK:    EsmFireHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
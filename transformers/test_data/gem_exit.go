@@ -0,0 +1,72 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawGemExitLog = types.Log{
+	Address: common.HexToAddress(EthGemJoinAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.GemExitSignature()),
+		common.HexToHash("0x000000000000000000000000e7bc397dbd069fc7d0109c0636d06888bb50668c"),
+		common.HexToHash("0x0000000000000000000000007d7bee5fcfd8028cf7b00876c5b1421c800561a6"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000004563918244f40000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0ef693bed0000000000000000000000007d7bee5fcfd8028cf7b00876c5b1421c800561a60000000000000000000000000000000000000000000000004563918244f40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374625,
+	TxHash:      common.HexToHash("0x2b5af39cf06ddea2a27c299c8561fd193f7d838ed8410025c6d29a6f50668119"),
+	TxIndex:     1,
+	BlockHash:   fakes.FakeHash,
+	Index:       2,
+	Removed:     false,
+}
+
+var GemExitHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawGemExitLog,
+	Transformed: false,
+}
+
+var GemExitModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "gem_exit",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), string(constants.IlkFK), "msg_sender", "usr", "wad", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0xe7bc397DBd069fC7d0109C0636d06888bb50668c",
+		"usr":              "0x7d7bEe5fCfD8028cf7b00876C5b1421c800561A6",
+		"wad":              "5000000000000000000",
+		constants.HeaderFK: GemExitHeaderSyncLog.HeaderID,
+		constants.LogFK:    GemExitHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawGemExitLog.Address.Hex(),
+		constants.IlkFK:     "0x4554482d41000000000000000000000000000000000000000000000000000000",
+	},
+}
@@ -0,0 +1,119 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawGenericFileAddressLog = types.Log{
+	Address: common.HexToAddress(VatAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.GenericFileAddressSignature()),
+		common.HexToHash("0x73706f7474657200000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x00000000000000000000000065c79fcb50ca1594b025960e539ed7a9a6d434a3"),
+		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0d4e8be8373706f747465720000000000000000000000000000000000000000000000000000000000000000000000000065c79fcb50ca1594b025960e539ed7a9a6d434a3000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374611,
+	TxHash:      common.HexToHash("0x8f3c2a6d1e5b4790c2d8a1f6e3b5c7d9a2e4f6081b3d5c7e9f1a2b4c6d8e0f13"),
+	TxIndex:     2,
+	BlockHash:   fakes.FakeHash,
+	Index:       4,
+	Removed:     false,
+}
+
+var GenericFileAddressHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawGenericFileAddressLog,
+	Transformed: false,
+}
+
+func GenericFileAddressModel() shared.InsertionModel { return CopyModel(genericFileAddressModel) }
+
+var genericFileAddressModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "generic_file",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), string(constants.IlkFK), "signature", "what", "data", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		string(constants.IlkFK): nil,
+		"signature":             "0xd4e8be83",
+		"what":                  "spotter",
+		"data":                  "0x00000000000000000000000065c79fcb50ca1594b025960e539ed7a9a6d434a3",
+		constants.HeaderFK:      GenericFileAddressHeaderSyncLog.HeaderID,
+		constants.LogFK:         GenericFileAddressHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawGenericFileAddressLog.Address.String(),
+	},
+}
+
+var rawGenericFileIlkAddressLog = types.Log{
+	Address: common.HexToAddress(JugAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.GenericFileIlkAddressSignature()),
+		common.HexToHash("0x000000000000000000000000be8e3e3618f7474f8cb1d074a26affef007e98fb"),
+		common.HexToHash("0x4554482d41000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x63616c6300000000000000000000000000000000000000000000000000000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0ebecb39d4554482d4100000000000000000000000000000000000000000000000000000063616c63000000000000000000000000000000000000000000000000000000000000000000000000000000001ff2f2b9a6c2c0ae5e2e3f9bcb6c73d23c1e8a1b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14374622,
+	TxHash:      common.HexToHash("0x1d4b7e0a3c6f9285b1e4d7a0c3f6b9e2d5a8c1f4b7e0d3a6c9f2b5e8d1a4c7f0"),
+	TxIndex:     3,
+	BlockHash:   fakes.FakeHash,
+	Index:       6,
+	Removed:     false,
+}
+
+var GenericFileIlkAddressHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawGenericFileIlkAddressLog,
+	Transformed: false,
+}
+
+func GenericFileIlkAddressModel() shared.InsertionModel { return CopyModel(genericFileIlkAddressModel) }
+
+var genericFileIlkAddressModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "generic_file",
+	OrderedColumns: []string{
+		constants.HeaderFK, string(constants.AddressFK), string(constants.IlkFK), "signature", "what", "data", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"signature":        "0xebecb39d",
+		"what":             "calc",
+		"data":             "0x0000000000000000000000001ff2f2b9a6c2c0ae5e2e3f9bcb6c73d23c1e8a1b",
+		constants.HeaderFK: GenericFileIlkAddressHeaderSyncLog.HeaderID,
+		constants.LogFK:    GenericFileIlkAddressHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawGenericFileIlkAddressLog.Address.String(),
+		constants.IlkFK:     "0x4554482d41000000000000000000000000000000000000000000000000000000",
+	},
+}
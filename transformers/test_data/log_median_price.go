@@ -0,0 +1,68 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawLogMedianPriceLog = types.Log{
+	Address: common.HexToAddress(MedianEthAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.LogMedianPriceSignature()),
+	},
+	Data:        hexutil.MustDecode("0x00000000000000000000000000000000000000000000000ad78ebc5ac6200000000000000000000000000000000000000000000000000000000000005d944c80"),
+	BlockNumber: 14374610,
+	TxHash:      common.HexToHash("0x5a0b1e6b4e4d3dc6f1ac1b7a3a0c1e9d7d83bf6e0b6be1d8e5a6b74f4fba2c31"),
+	TxIndex:     4,
+	BlockHash:   fakes.FakeHash,
+	Index:       1,
+	Removed:     false,
+}
+
+var LogMedianPriceHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawLogMedianPriceLog,
+	Transformed: false,
+}
+
+var LogMedianPriceModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "log_median_price",
+	OrderedColumns: []string{
+		constants.HeaderFK, constants.LogFK, string(constants.AddressFK), string(constants.IlkFK), "val", "age",
+	},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: LogMedianPriceHeaderSyncLog.HeaderID,
+		constants.LogFK:    LogMedianPriceHeaderSyncLog.ID,
+		"val":              "200000000000000000000",
+		"age":              "1570000000",
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawLogMedianPriceLog.Address.Hex(),
+		constants.IlkFK:     "0x4554482d41000000000000000000000000000000000000000000000000000000",
+	},
+}
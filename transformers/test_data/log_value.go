@@ -0,0 +1,66 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawLogValueLog = types.Log{
+	Address: common.HexToAddress(OsmEthAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.LogValueSignature()),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000009d6d1885f2d660000"),
+	BlockNumber: 14374620,
+	TxHash:      common.HexToHash("0x8f3c6ad1e0b7d4c2a9e5f1b3c7d9e2a4b6c8d0e1f3a5b7c9d2e4f6a8b0c1d3e5"),
+	TxIndex:     4,
+	BlockHash:   fakes.FakeHash,
+	Index:       1,
+	Removed:     false,
+}
+
+var LogValueHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawLogValueLog,
+	Transformed: false,
+}
+
+var LogValueModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "log_value",
+	OrderedColumns: []string{
+		constants.HeaderFK, constants.LogFK, string(constants.AddressFK), "val",
+	},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: LogValueHeaderSyncLog.HeaderID,
+		constants.LogFK:    LogValueHeaderSyncLog.ID,
+		"val":              "181500000000000000000",
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{
+		constants.AddressFK: rawLogValueLog.Address.Hex(),
+	},
+}
@@ -0,0 +1,150 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+)
+
+// DSPause uses ds-note, so LogNote data is msg.value followed by the full calldata
+
+var rawPausePlotLog = types.Log{
+	Address: common.HexToAddress(PauseAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0x46d2fbbb00000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000004f4ba8e6c1d8f8e1f7b8e8c8b1c2d3e4f5a6b7c8"),
+		common.HexToHash("0xc2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec8"),
+	},
+	Data:        hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000c446d2fbbb0000000000000000000000004f4ba8e6c1d8f8e1f7b8e8c8b1c2d3e4f5a6b7c8c2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec80000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000005da38ec00000000000000000000000000000000000000000000000000000000000000004614619540000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764700,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1f0a1b2c"),
+	TxIndex:     3,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b1a2c3d4"),
+	Index:       4,
+	Removed:     false,
+}
+
+var PausePlotHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawPausePlotLog,
+	Transformed: false,
+}
+
+var PausePlotModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "pause_plot",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "usr", "tag", "fax", "eta", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: PausePlotHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"usr":              "0x4f4BA8E6c1d8F8e1f7B8E8C8b1C2d3E4f5a6b7c8",
+		"tag":              "0xc2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec8",
+		"fax":              "0x61461954",
+		"eta":              "1571000000",
+		constants.LogFK:    PausePlotHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawPauseDropLog = types.Log{
+	Address: common.HexToAddress(PauseAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0x162c7de300000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000004f4ba8e6c1d8f8e1f7b8e8c8b1c2d3e4f5a6b7c8"),
+		common.HexToHash("0xc2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec8"),
+	},
+	Data:        hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000c4162c7de30000000000000000000000004f4ba8e6c1d8f8e1f7b8e8c8b1c2d3e4f5a6b7c8c2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec80000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000005da38ec00000000000000000000000000000000000000000000000000000000000000004614619540000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764701,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1f0a2d3e"),
+	TxIndex:     1,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b1a2d4e5"),
+	Index:       2,
+	Removed:     false,
+}
+
+var PauseDropHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawPauseDropLog,
+	Transformed: false,
+}
+
+var PauseDropModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "pause_drop",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "usr", "tag", "fax", "eta", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: PauseDropHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"usr":              "0x4f4BA8E6c1d8F8e1f7B8E8C8b1C2d3E4f5a6b7c8",
+		"tag":              "0xc2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec8",
+		"fax":              "0x61461954",
+		"eta":              "1571000000",
+		constants.LogFK:    PauseDropHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
+var rawPauseExecLog = types.Log{
+	Address: common.HexToAddress(PauseAddress()),
+	Topics: []common.Hash{
+		common.HexToHash("0x168ccd6700000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x000000000000000000000000db33dfd3d61308c33c63209845dad3e6bfb2c674"),
+		common.HexToHash("0x0000000000000000000000004f4ba8e6c1d8f8e1f7b8e8c8b1c2d3e4f5a6b7c8"),
+		common.HexToHash("0xc2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec8"),
+	},
+	Data:        hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000c4168ccd670000000000000000000000004f4ba8e6c1d8f8e1f7b8e8c8b1c2d3e4f5a6b7c8c2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec80000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000005da38ec00000000000000000000000000000000000000000000000000000000000000004614619540000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14764702,
+	TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000005c1f0a3e4f"),
+	TxIndex:     2,
+	BlockHash:   common.HexToHash("0x00000000000000000000000000000000000000000000000000000009b1a2e5f6"),
+	Index:       5,
+	Removed:     false,
+}
+
+var PauseExecHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawPauseExecLog,
+	Transformed: false,
+}
+
+var PauseExecModel = shared.InsertionModel{
+	SchemaName:     "maker",
+	TableName:      "pause_exec",
+	OrderedColumns: []string{constants.HeaderFK, "msg_sender", "usr", "tag", "fax", "eta", constants.LogFK},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: PauseExecHeaderSyncLog.HeaderID,
+		"msg_sender":       "0xdB33dFD3D61308C33C63209845DaD3e6bfb2c674",
+		"usr":              "0x4f4BA8E6c1d8F8e1f7B8E8C8b1C2d3E4f5a6b7c8",
+		"tag":              "0xc2ad2fe7a8a9c6a08c62b4cbf4e8c1bd7df59d0b2dd5dc69b1ac1c5f1c0c3ec8",
+		"fax":              "0x61461954",
+		"eta":              "1571000000",
+		constants.LogFK:    PauseExecHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
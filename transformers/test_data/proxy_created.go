@@ -0,0 +1,74 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+)
+
+const proxyCreatedData = "0x0000000000000000000000008d4f22f6d0a4d2ac3a6e83b8eb5d6c0a92bd35a6000000000000000000000000271293c67e2d3140a0e9381eff1f9b01e07b0795"
+
+var rawProxyCreatedLog = types.Log{
+	Address: common.HexToAddress(ProxyFactoryAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.ProxyCreatedSignature()),
+		common.HexToHash("0x0000000000000000000000004678f0a6958e4d2bc4f1baf7bc52e8f3564f3fe4"),
+		common.HexToHash("0x000000000000000000000000a9fccb07dd3f774d5b9d02e99de1a27f47f91189"),
+	},
+	Data:        hexutil.MustDecode(proxyCreatedData),
+	BlockNumber: 14764552,
+	TxHash:      common.HexToHash("0x0e1d6b47e2fa5bc4e6c6f48c8e57a8ef1f2d7a1dc3a4d3e0b9a7c95f09bd2e11"),
+	TxIndex:     3,
+	BlockHash:   fakes.FakeHash,
+	Index:       5,
+	Removed:     false,
+}
+
+var ProxyCreatedHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawProxyCreatedLog,
+	Transformed: false,
+}
+
+func ProxyCreatedModel() shared.InsertionModel { return CopyModel(proxyCreatedModel) }
+
+var proxyCreatedModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "proxies",
+	OrderedColumns: []string{
+		constants.HeaderFK, constants.LogFK, "sender", "owner", "proxy", "cache",
+	},
+	ColumnValues: shared.ColumnValues{
+		constants.HeaderFK: ProxyCreatedHeaderSyncLog.HeaderID,
+		constants.LogFK:    ProxyCreatedHeaderSyncLog.ID,
+		"sender":           common.HexToAddress("0x4678f0a6958e4d2bc4f1baf7bc52e8f3564f3fe4").Hex(),
+		"owner":            common.HexToAddress("0xa9fccb07dd3f774d5b9d02e99de1a27f47f91189").Hex(),
+		"proxy":            common.HexToAddress("0x8d4f22f6d0a4d2ac3a6e83b8eb5d6c0a92bd35a6").Hex(),
+		"cache":            common.HexToAddress("0x271293c67e2d3140a0e9381eff1f9b01e07b0795").Hex(),
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
@@ -69,6 +69,47 @@ var spotFileMatModel = shared.InsertionModel{
 	},
 }
 
+var rawSpotFileParLog = types.Log{
+	Address: common.HexToAddress(SpotAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.SpotFileParSignature()),
+		common.HexToHash("0x000000000000000000000000be8e3e3618f7474f8cb1d074a26affef007e98fb"),
+		common.HexToHash("0x7061720000000000000000000000000000000000000000000000000000000000"),
+		common.HexToHash("0x0000000000000000000000000000000000000000033b2e3c9fd0803ce8000000"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e029ae811470617200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000033b2e3c9fd0803ce8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 11257412,
+	TxHash:      common.HexToHash("0x2a4c7e5a1b0d9f36c8e2b47f0d61a5c93e8b2f4d7a0c16e95b3d8f2a4c6e0b17"),
+	TxIndex:     4,
+	BlockHash:   common.HexToHash("0x5d8b3f1a7c2e9046b1d5a83f7e0c2b649d1a8e5f3c7b2064e9d1f5a8b3c7e204"),
+	Index:       5,
+	Removed:     false,
+}
+
+var SpotFileParHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawSpotFileParLog,
+	Transformed: false,
+}
+
+func SpotFileParModel() shared.InsertionModel { return CopyModel(spotFileParModel) }
+
+var spotFileParModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "spot_file_par",
+	OrderedColumns: []string{
+		constants.HeaderFK, "what", "data", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"what":             "par",
+		"data":             "1000000000000000000000000000",
+		constants.HeaderFK: SpotFileParHeaderSyncLog.HeaderID,
+		constants.LogFK:    SpotFileParHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
+
 var rawSpotFilePipLog = types.Log{
 	Address: common.HexToAddress(SpotAddress()),
 	Topics: []common.Hash{
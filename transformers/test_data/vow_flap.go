@@ -0,0 +1,65 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawVowFlapLog = types.Log{
+	Address: common.HexToAddress(VowAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.VowFlapSignature()),
+		common.HexToHash("0x0000000000000000000000005d5a2e3ffe34a1b9ebcbf7b8b52f8b4f3a1b2c9d"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e00e01198b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14394388,
+	TxHash:      common.HexToHash("0x294c4d67a9b81b4d1705db856f7b86dd2e8581da83c16c2ce5a8b7fb56b95f7e"),
+	TxIndex:     4,
+	BlockHash:   fakes.FakeHash,
+	Index:       11,
+	Removed:     false,
+}
+
+var VowFlapHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawVowFlapLog,
+	Transformed: false,
+}
+
+var VowFlapModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "vow_flap",
+	OrderedColumns: []string{
+		constants.HeaderFK, "msg_sender", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0x5d5a2E3FFe34A1B9EBCBF7b8b52F8B4F3A1B2c9d",
+		constants.HeaderFK: VowFlapHeaderSyncLog.HeaderID,
+		constants.LogFK:    VowFlapHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}
@@ -0,0 +1,65 @@
+// VulcanizeDB
+// Copyright © 2019 Vulcanize
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package test_data
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vulcanize/mcd_transformers/transformers/shared"
+	"github.com/vulcanize/mcd_transformers/transformers/shared/constants"
+	"github.com/vulcanize/vulcanizedb/pkg/core"
+	"github.com/vulcanize/vulcanizedb/pkg/fakes"
+)
+
+var rawVowFlopLog = types.Log{
+	Address: common.HexToAddress(VowAddress()),
+	Topics: []common.Hash{
+		common.HexToHash(constants.VowFlopSignature()),
+		common.HexToHash("0x0000000000000000000000008e2a84d6ade1e7fffee039a35ef5f19f13057152"),
+	},
+	Data:        hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e0bbbb0d7b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+	BlockNumber: 14380000,
+	TxHash:      common.HexToHash("0x1aacc89168da5735600141eeeecc58e4b2f8ad59ab6c20baa5782e04bc112864"),
+	TxIndex:     1,
+	BlockHash:   fakes.FakeHash,
+	Index:       20,
+	Removed:     false,
+}
+
+var VowFlopHeaderSyncLog = core.HeaderSyncLog{
+	ID:          int64(rand.Int31()),
+	HeaderID:    int64(rand.Int31()),
+	Log:         rawVowFlopLog,
+	Transformed: false,
+}
+
+var VowFlopModel = shared.InsertionModel{
+	SchemaName: "maker",
+	TableName:  "vow_flop",
+	OrderedColumns: []string{
+		constants.HeaderFK, "msg_sender", constants.LogFK,
+	},
+	ColumnValues: shared.ColumnValues{
+		"msg_sender":       "0x8E2a84D6adE1E7ffFEe039A35EF5F19F13057152",
+		constants.HeaderFK: VowFlopHeaderSyncLog.HeaderID,
+		constants.LogFK:    VowFlopHeaderSyncLog.ID,
+	},
+	ForeignKeyValues: shared.ForeignKeyValues{},
+}